@@ -0,0 +1,77 @@
+//nolint:forbidigo // it's okay to use fmt in this file
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ya-breeze/diary.be/pkg/database"
+)
+
+func CmdAudit() *cobra.Command {
+	var familyName string
+	var action string
+	var limit int
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Show the security audit log",
+		Long: `Lists audit events (logins, rate-limit hits, tag renames and deletions,
+health fixes and orphan deletions), newest first.
+
+Without --family, events of all families are shown, including those that
+could not be attributed to a family (e.g. failed logins for unknown users).`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, logger, err := createConfigAndLogger(cmd)
+			if err != nil {
+				return err
+			}
+
+			db := database.NewStorage(logger, cfg)
+			if err := db.Open(); err != nil {
+				return fmt.Errorf("opening database: %w", err)
+			}
+			defer db.Close() //nolint:errcheck
+
+			filter := database.AuditFilter{Action: action, Limit: limit}
+			if familyName != "" {
+				family, err := db.GetFamilyByName(familyName)
+				if err != nil {
+					return fmt.Errorf("family %q: %w", familyName, err)
+				}
+				filter.FamilyID = &family.ID
+			}
+
+			events, err := db.GetAuditEvents(filter)
+			if err != nil {
+				return err
+			}
+
+			if format == "json" {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(events)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "TIME\tFAMILY\tACTOR\tIP\tACTION\tTARGET")
+			for _, e := range events {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+					e.Timestamp.Format(time.RFC3339), e.FamilyID, e.Actor, e.IPAddress, e.Action, e.Target)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().StringVar(&familyName, "family", "", "only show events of this family")
+	cmd.Flags().StringVar(&action, "action", "", "only show events with this action (e.g. login.failed)")
+	cmd.Flags().IntVar(&limit, "limit", 100, "maximum number of events to show (0 for all)")
+	cmd.Flags().StringVar(&format, "format", "text", "output format: text or json")
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+//nolint:forbidigo // it's okay to use fmt in this file
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/ya-breeze/diary.be/pkg/database"
+)
+
+func CmdCache() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the AI tag suggestion cache",
+	}
+	cmd.AddCommand(cmdCacheClear())
+	return cmd
+}
+
+func cmdCacheClear() *cobra.Command {
+	var familyName string
+	var expired bool
+
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Delete cached AI tag suggestions",
+		Long: `Deletes cached tag suggestion answers, so the next suggestion for an
+entry calls the model again (e.g. after changing the model's prompt or
+settings on the provider side).
+
+Without --family, the entries of all families are deleted. With --expired,
+only entries older than ai_suggestion_cache_ttl are deleted.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, logger, err := createConfigAndLogger(cmd)
+			if err != nil {
+				return err
+			}
+
+			var before time.Time
+			if expired {
+				ttl, err := cfg.SuggestionCacheTTL()
+				if err != nil {
+					return err
+				}
+				before = time.Now().Add(-ttl)
+			}
+
+			db := database.NewStorage(logger, cfg)
+			if err := db.Open(); err != nil {
+				return fmt.Errorf("opening database: %w", err)
+			}
+			defer db.Close() //nolint:errcheck
+
+			var familyID *uuid.UUID
+			if familyName != "" {
+				family, err := db.GetFamilyByName(familyName)
+				if err != nil {
+					return fmt.Errorf("family %q: %w", familyName, err)
+				}
+				familyID = &family.ID
+			}
+
+			n, err := db.ClearSuggestionCache(familyID, before)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Deleted %d cached suggestion(s)\n", n)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&familyName, "family", "", "only clear the cache of this family")
+	cmd.Flags().BoolVar(&expired, "expired", false, "only delete entries older than ai_suggestion_cache_ttl")
+
+	return cmd
+}
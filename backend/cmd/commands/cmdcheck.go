@@ -15,6 +15,7 @@ var allChecks = []checker.Check{
 	checker.MimeCheck{},
 	checker.OrphansCheck{},
 	checker.RefsCheck{},
+	checker.LinksCheck{},
 }
 
 func CmdCheck() *cobra.Command {
@@ -31,6 +32,7 @@ Available checks:
   mime     - asset files with wrong extension (e.g. video saved as .jpg)
   orphans  - asset files not referenced by any diary entry
   refs     - diary entries referencing missing asset files
+  links    - diary entries linking to days without an entry
 
 Exits with code 0 if no issues are found, 1 if issues exist.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -55,7 +57,7 @@ Exits with code 0 if no issues are found, 1 if issues exist.`,
 					}
 				}
 				if len(selected) == 0 {
-					return fmt.Errorf("no valid checks selected (available: mime, orphans, refs)")
+					return fmt.Errorf("no valid checks selected (available: mime, orphans, refs, links)")
 				}
 			}
 
@@ -95,7 +97,7 @@ Exits with code 0 if no issues are found, 1 if issues exist.`,
 
 	cmd.Flags().BoolVar(&fix, "fix", false, "automatically repair fixable issues")
 	cmd.Flags().StringVar(&format, "format", "text", "output format: text or json")
-	cmd.Flags().StringVar(&checksFlag, "checks", "all", "comma-separated list of checks to run (mime,orphans,refs) or 'all'")
+	cmd.Flags().StringVar(&checksFlag, "checks", "all", "comma-separated list of checks to run (mime,orphans,refs,links) or 'all'")
 
 	return cmd
 }
@@ -2,12 +2,19 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/howeyc/gopass"
 	"github.com/spf13/cobra"
 	kinauth "github.com/ya-breeze/kin-core/auth"
+
+	"github.com/ya-breeze/diary.be/pkg/auth"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
 )
 
 func CmdUser(log *slog.Logger) *cobra.Command {
@@ -19,6 +26,7 @@ func CmdUser(log *slog.Logger) *cobra.Command {
 	}
 
 	res.AddCommand(NewUserAdd(log))
+	res.AddCommand(NewUserUnlock())
 
 	return res
 }
@@ -62,3 +70,54 @@ func NewUserAdd(log *slog.Logger) *cobra.Command {
 
 	return res
 }
+
+func NewUserUnlock() *cobra.Command {
+	res := &cobra.Command{
+		Use:   "unlock <username>",
+		Short: "Lift a lockout caused by failed logins",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, logger, err := createConfigAndLogger(cmd)
+			if err != nil {
+				return err
+			}
+
+			db := database.NewStorage(logger, cfg)
+			if err := db.Open(); err != nil {
+				return fmt.Errorf("opening database: %w", err)
+			}
+			defer db.Close() //nolint:errcheck
+
+			username := args[0]
+			lockout, err := db.GetLoginLockout(auth.LoginKey(username))
+			if errors.Is(err, database.ErrNotFound) {
+				fmt.Printf("No failed logins recorded for %s\n", username)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := db.ClearLoginLockout(auth.LoginKey(username)); err != nil {
+				return err
+			}
+
+			familyID := uuid.Nil
+			if user, err := db.GetUserByUsername(username); err == nil {
+				familyID = user.FamilyID
+			}
+			event := models.NewAuditEvent(familyID, models.AuditActor{Name: "cli"}, models.AuditAccountUnlocked, username)
+			if err := db.CreateAuditEvent(event); err != nil {
+				logger.Warn("Failed to record audit event", "error", err)
+			}
+
+			if lockout.Locked(time.Now()) {
+				fmt.Printf("Unlocked %s (was locked until %s)\n", username, lockout.LockedUntil.Format(time.RFC3339))
+			} else {
+				fmt.Printf("Reset %d failed login(s) for %s\n", lockout.Failures, username)
+			}
+			return nil
+		},
+	}
+
+	return res
+}
@@ -38,6 +38,8 @@ func newRootCmd(cfgFile *string, logger *slog.Logger) *cobra.Command {
 		commands.CmdUser(logger),
 		commands.CmdServer(),
 		commands.CmdCheck(),
+		commands.CmdAudit(),
+		commands.CmdCache(),
 	)
 
 	return rootCmd
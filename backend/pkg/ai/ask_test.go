@@ -0,0 +1,40 @@
+package ai
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOpenAIAnswerKeepsOnlyKnownCitations(t *testing.T) {
+	srv, calls := chatServer(t, func(w http.ResponseWriter, req map[string]any, _ int) {
+		messages, _ := req["messages"].([]any)
+		prompt, _ := messages[0].(map[string]any)["content"].(string)
+		if !strings.Contains(prompt, "Question: When did we hike?") || !strings.Contains(prompt, "--- 2024-05-01: Hike") {
+			t.Errorf("unexpected prompt: %q", prompt)
+		}
+		writeChatContent(w, "We hiked on [2024-05-01] and again [2024-05-01], maybe also [1999-01-01].")
+	})
+
+	a := NewAnswerer(newOpenAISuggester(discardLogger(), srv.URL+"/v1", "m", ""))
+	entries := []EntryText{{Date: "2024-05-01", Title: "Hike", Body: "trail"}, {Date: "2024-05-02", Body: "office"}}
+	got, err := a.Answer(context.Background(), "When did we hike?", entries)
+	if err != nil {
+		t.Fatalf("Answer: %v", err)
+	}
+	if !reflect.DeepEqual(got.Citations, []string{"2024-05-01"}) || !strings.HasPrefix(got.Text, "We hiked") {
+		t.Fatalf("unexpected answer %+v", got)
+	}
+
+	if got, err := a.Answer(context.Background(), "Anything?", nil); err != nil || got.Text != "" || *calls != 1 {
+		t.Fatalf("no entries must not call the model: %+v, %v", got, err)
+	}
+}
+
+func TestNewAnswererFallsBackToDisabled(t *testing.T) {
+	if NewAnswerer(NewDisabledSuggester()).Enabled() {
+		t.Fatal("disabled suggester must give a disabled answerer")
+	}
+}
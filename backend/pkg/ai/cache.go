@@ -0,0 +1,136 @@
+package ai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/utils"
+)
+
+// SuggestionCache persists tag suggestion answers; database.Storage
+// implements it.
+type SuggestionCache interface {
+	// GetSuggestionCache returns the entry stored under key no earlier than
+	// notBefore, or nil when there is none.
+	GetSuggestionCache(key string, notBefore time.Time) (*models.SuggestionCacheEntry, error)
+	// PutSuggestionCache stores (or replaces) an entry.
+	PutSuggestionCache(entry *models.SuggestionCacheEntry) error
+}
+
+// cachingSuggester answers a repeated tag suggestion request for a family (see
+// WithFamily) from the cache instead of calling the model, so re-opening an
+// unchanged entry or re-running the backfill costs nothing. Failed calls are
+// not cached; calls without a family pass straight through.
+type cachingSuggester struct {
+	Suggester
+	cache  SuggestionCache
+	ttl    time.Duration
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewCachingSuggester wraps s with a suggestion cache whose entries are reused
+// for ttl. A ttl of 0 or less returns s unchanged. Wrap the metered suggester,
+// not the other way round, so cache hits are neither counted nor refused.
+func NewCachingSuggester(s Suggester, cache SuggestionCache, ttl time.Duration, logger *slog.Logger) Suggester {
+	if ttl <= 0 {
+		return s
+	}
+	return &cachingSuggester{Suggester: s, cache: cache, ttl: ttl, logger: logger, now: time.Now}
+}
+
+// Unwrap returns the wrapped Suggester.
+func (c *cachingSuggester) Unwrap() Suggester { return c.Suggester }
+
+func (c *cachingSuggester) SuggestTags(
+	ctx context.Context, title, body string, images []ImageAsset, knownTags []string, policy TagPolicy,
+) ([]TagSuggestion, error) {
+	familyID, ok := familyFromContext(ctx)
+	if !ok || !c.Enabled() {
+		return c.Suggester.SuggestTags(ctx, title, body, images, knownTags, policy)
+	}
+
+	key := suggestionCacheKey(familyID, modelName(c.Suggester), title, body, images, knownTags, policy)
+	entry, err := c.cache.GetSuggestionCache(key, c.now().Add(-c.ttl))
+	if err != nil {
+		c.logger.Warn("Failed to read suggestion cache", "error", err, "familyID", familyID)
+	} else if entry != nil {
+		var cached []TagSuggestion
+		if err := json.Unmarshal([]byte(entry.Suggestions), &cached); err == nil {
+			return cached, nil
+		}
+		// A corrupt entry is simply replaced below.
+	}
+
+	suggestions, err := c.Suggester.SuggestTags(ctx, title, body, images, knownTags, policy)
+	if err != nil {
+		return nil, err
+	}
+	if suggestions == nil {
+		suggestions = []TagSuggestion{}
+	}
+	data, err := json.Marshal(suggestions)
+	if err == nil {
+		err = c.cache.PutSuggestionCache(&models.SuggestionCacheEntry{
+			Key:         key,
+			FamilyID:    familyID,
+			Suggestions: string(data),
+			CreatedAt:   c.now(),
+		})
+	}
+	if err != nil {
+		c.logger.Warn("Failed to store suggestion cache", "error", err, "familyID", familyID)
+	}
+	return suggestions, nil
+}
+
+// suggestionCacheKey hashes everything a suggestion depends on: the family,
+// the model, the entry content (as utils.ComputeTagsSourceHash sees it), the
+// set of images sent, the tag vocabulary and the family's tag policy
+// (including the tag aliases).
+func suggestionCacheKey(
+	familyID uuid.UUID, model, title, body string, images []ImageAsset, knownTags []string, policy TagPolicy,
+) string {
+	imageHashes := make([]string, 0, len(images))
+	for _, img := range images {
+		sum := sha256.Sum256(img.Data)
+		imageHashes = append(imageHashes, img.MIMEType+":"+hex.EncodeToString(sum[:]))
+	}
+	sort.Strings(imageHashes)
+	vocabulary := append([]string(nil), knownTags...)
+	sort.Strings(vocabulary)
+
+	h := sha256.New()
+	for _, part := range []string{
+		familyID.String(), model, utils.ComputeTagsSourceHash(title, body),
+		policy.Instructions, strconv.FormatBool(policy.ClosedVocabulary), strconv.Itoa(policy.maxTags()),
+	} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	for _, list := range [][]string{imageHashes, vocabulary, policy.aliasLines()} {
+		for _, part := range list {
+			h.Write([]byte(part))
+			h.Write([]byte{0})
+		}
+		h.Write([]byte{1})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// modelName names the model behind s, or "" when the provider does not say.
+func modelName(s Suggester) string {
+	if m, ok := unwrap(s).(interface{ Model() string }); ok {
+		return m.Model()
+	}
+	return ""
+}
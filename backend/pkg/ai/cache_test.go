@@ -0,0 +1,140 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+// memSuggestionCache is an in-memory SuggestionCache.
+type memSuggestionCache struct {
+	entries map[string]models.SuggestionCacheEntry
+}
+
+func (m *memSuggestionCache) GetSuggestionCache(key string, notBefore time.Time) (*models.SuggestionCacheEntry, error) {
+	e, ok := m.entries[key]
+	if !ok || e.CreatedAt.Before(notBefore) {
+		return nil, nil
+	}
+	return &e, nil
+}
+
+func (m *memSuggestionCache) PutSuggestionCache(e *models.SuggestionCacheEntry) error {
+	if m.entries == nil {
+		m.entries = map[string]models.SuggestionCacheEntry{}
+	}
+	m.entries[e.Key] = *e
+	return nil
+}
+
+// stubSuggester counts calls and answers with out, or fails with err.
+type stubSuggester struct {
+	calls int
+	out   []TagSuggestion
+	err   error
+}
+
+func (s *stubSuggester) Enabled() bool { return true }
+func (s *stubSuggester) SuggestTags(
+	context.Context, string, string, []ImageAsset, []string, TagPolicy,
+) ([]TagSuggestion, error) {
+	s.calls++
+	return s.out, s.err
+}
+
+func TestCachingSuggesterReusesIdenticalRequests(t *testing.T) {
+	inner := &stubSuggester{out: []TagSuggestion{{Name: "beach", Confidence: 0.9}}}
+	s := NewCachingSuggester(inner, &memSuggestionCache{}, time.Hour, discardLogger())
+	ctx := WithFamily(context.Background(), uuid.New())
+	img := []ImageAsset{{MIMEType: "image/png", Data: []byte("a")}}
+
+	for range 2 {
+		got, err := s.SuggestTags(ctx, "Beach", "Sand", img, []string{"sea", "beach"}, TagPolicy{})
+		if err != nil || len(got) != 1 || got[0].Name != "beach" {
+			t.Fatalf("got %v, %v", got, err)
+		}
+	}
+	// Vocabulary order does not matter.
+	_, _ = s.SuggestTags(ctx, "Beach", "Sand", img, []string{"beach", "sea"}, TagPolicy{})
+	if inner.calls != 1 {
+		t.Fatalf("identical requests called the model %d times", inner.calls)
+	}
+
+	// Anything the answer depends on changes the key.
+	_, _ = s.SuggestTags(ctx, "Beach", "Sand and sea", img, []string{"sea", "beach"}, TagPolicy{})
+	_, _ = s.SuggestTags(ctx, "Beach", "Sand", nil, []string{"sea", "beach"}, TagPolicy{})
+	_, _ = s.SuggestTags(ctx, "Beach", "Sand", img, []string{"sea"}, TagPolicy{})
+	_, _ = s.SuggestTags(WithFamily(context.Background(), uuid.New()), "Beach", "Sand", img, []string{"sea", "beach"}, TagPolicy{})
+	_, _ = s.SuggestTags(ctx, "Beach", "Sand", img, []string{"sea", "beach"},
+		TagPolicy{Aliases: map[string][]string{"sea": {"ocean"}}})
+	if inner.calls != 6 {
+		t.Fatalf("changed requests must miss the cache, got %d calls", inner.calls)
+	}
+}
+
+func TestCachingSuggesterExpiresEntries(t *testing.T) {
+	inner := &stubSuggester{out: []TagSuggestion{}}
+	s := NewCachingSuggester(inner, &memSuggestionCache{}, time.Hour, discardLogger()).(*cachingSuggester)
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	ctx := WithFamily(context.Background(), uuid.New())
+
+	_, _ = s.SuggestTags(ctx, "Quiet day", "", nil, nil, TagPolicy{})
+	_, _ = s.SuggestTags(ctx, "Quiet day", "", nil, nil, TagPolicy{})
+	now = now.Add(2 * time.Hour)
+	_, _ = s.SuggestTags(ctx, "Quiet day", "", nil, nil, TagPolicy{})
+	if inner.calls != 2 {
+		t.Fatalf("want a cached empty answer, then a fresh call after the TTL; got %d calls", inner.calls)
+	}
+}
+
+func TestCachingSuggesterDoesNotCacheFailures(t *testing.T) {
+	inner := &stubSuggester{err: errors.New("boom")}
+	s := NewCachingSuggester(inner, &memSuggestionCache{}, time.Hour, discardLogger())
+	ctx := WithFamily(context.Background(), uuid.New())
+
+	for range 2 {
+		if _, err := s.SuggestTags(ctx, "Beach", "", nil, nil, TagPolicy{}); err == nil {
+			t.Fatal("want the model's error")
+		}
+	}
+	if inner.calls != 2 {
+		t.Fatalf("failed answer was cached: %d calls", inner.calls)
+	}
+}
+
+func TestCachingSuggesterHitIsNotMetered(t *testing.T) {
+	url, calls := usageServer(t)
+	usage := &memUsageStore{}
+	metered := NewMeteredSuggester(newOpenAISuggester(discardLogger(), url, "vision", ""), usage, 150, discardLogger())
+	s := NewCachingSuggester(metered, &memSuggestionCache{}, time.Hour, discardLogger())
+	ctx := WithFamily(context.Background(), uuid.New())
+
+	// The first call spends the whole budget; repeating it is still answered.
+	for range 2 {
+		if _, err := s.SuggestTags(ctx, "Beach", "", nil, nil, TagPolicy{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if *calls != 1 || usage.only(t).Requests != 1 {
+		t.Fatalf("cache hit reached the model or the meter: %d calls, %+v", *calls, usage.only(t))
+	}
+	if _, err := s.SuggestTags(ctx, "Mountains", "", nil, nil, TagPolicy{}); !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("a new request must still be refused, got %v", err)
+	}
+	if !NewCaptioner(s).Enabled() {
+		t.Fatal("caching must not hide the provider's other capabilities")
+	}
+}
+
+func TestNewCachingSuggesterDisabledByTTL(t *testing.T) {
+	inner := &stubSuggester{}
+	if NewCachingSuggester(inner, &memSuggestionCache{}, 0, discardLogger()) != Suggester(inner) {
+		t.Fatal("a zero TTL must not wrap the suggester")
+	}
+}
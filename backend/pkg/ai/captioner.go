@@ -0,0 +1,75 @@
+package ai
+
+import (
+	"context"
+	"strings"
+)
+
+// maxCaptionChars bounds a stored caption; the prompt asks for one sentence,
+// this only guards against a model that rambles.
+const maxCaptionChars = 300
+
+// Captioner describes image assets in a short sentence, used as alt text and
+// for search. It shares the provider, credentials and retry/backoff of the
+// Suggester it was built from.
+type Captioner interface {
+	// Enabled reports whether captioning is actually available.
+	Enabled() bool
+	// Model names the model writing the captions; it is stored next to each
+	// caption.
+	Model() string
+	// CaptionImage returns a one-sentence description of image, or "" when the
+	// model gave no usable answer or the captioner is disabled.
+	CaptionImage(ctx context.Context, image ImageAsset) (string, error)
+}
+
+// NewCaptioner returns the Captioner side of a Suggester built by
+// NewSuggester. Suggesters that cannot caption (disabled ones, test fakes)
+// yield a disabled Captioner.
+func NewCaptioner(s Suggester) Captioner {
+	if c, ok := unwrap(s).(Captioner); ok {
+		if m := meterOf(s); m != nil {
+			return &meteredCaptioner{Captioner: c, meter: m}
+		}
+		return c
+	}
+	return disabledSuggester{}
+}
+
+func (disabledSuggester) Model() string { return "" }
+
+func (disabledSuggester) CaptionImage(_ context.Context, _ ImageAsset) (string, error) {
+	return "", nil
+}
+
+// captionImage implements Captioner.CaptionImage on top of a provider.
+func captionImage(ctx context.Context, g textGenerator, image ImageAsset) (string, error) {
+	text, err := g.generateText(ctx, buildCaptionPrompt(), []ImageAsset{image})
+	if err != nil {
+		return "", err
+	}
+	return cleanCaption(text), nil
+}
+
+// buildCaptionPrompt assembles the image caption prompt.
+func buildCaptionPrompt() string {
+	var b strings.Builder
+	b.WriteString("You describe a photo attached to a personal diary entry.\n\n")
+	b.WriteString("Rules:\n")
+	b.WriteString("- Write one plain sentence of at most 20 words, suitable as alt text.\n")
+	b.WriteString("- Describe what is visible: people, animals, places, objects, activity.\n")
+	b.WriteString("- Do not guess names, and do not start with \"An image of\" or similar.\n")
+	b.WriteString("- Plain text only: no quotes or markdown.\n")
+	return b.String()
+}
+
+// cleanCaption reduces a model answer to a single trimmed line of at most
+// maxCaptionChars.
+func cleanCaption(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	text = strings.Trim(text, "\"'` ")
+	if len(text) > maxCaptionChars {
+		text = strings.TrimSpace(text[:maxCaptionChars])
+	}
+	return text
+}
@@ -0,0 +1,48 @@
+package ai
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestOpenAICaptionImage(t *testing.T) {
+	srv, _ := chatServer(t, func(w http.ResponseWriter, req map[string]any, _ int) {
+		messages, _ := req["messages"].([]any)
+		parts, _ := messages[0].(map[string]any)["content"].([]any)
+		if len(parts) != 2 {
+			t.Fatalf("want prompt + image parts, got %v", messages[0])
+		}
+		prompt, _ := parts[0].(map[string]any)["text"].(string)
+		if !strings.Contains(prompt, "alt text") {
+			t.Errorf("unexpected prompt: %q", prompt)
+		}
+		url, _ := parts[1].(map[string]any)["image_url"].(map[string]any)["url"].(string)
+		if !strings.HasPrefix(url, "data:image/png;base64,") {
+			t.Errorf("image not attached as data URL: %q", url)
+		}
+		writeChatContent(w, "\"A dog  running\non a beach.\"\n")
+	})
+
+	c := NewCaptioner(newOpenAISuggester(discardLogger(), srv.URL+"/v1", "vision", ""))
+	if !c.Enabled() || c.Model() != "vision" {
+		t.Fatalf("unexpected captioner %v/%q", c.Enabled(), c.Model())
+	}
+	got, err := c.CaptionImage(context.Background(), ImageAsset{MIMEType: "image/png", Data: []byte("png")})
+	if err != nil || got != "A dog running on a beach." {
+		t.Fatalf("got %q, %v", got, err)
+	}
+}
+
+func TestNewCaptionerFallsBackToDisabled(t *testing.T) {
+	if NewCaptioner(NewDisabledSuggester()).Enabled() {
+		t.Fatal("disabled suggester must give a disabled captioner")
+	}
+}
+
+func TestCleanCaptionCapsLength(t *testing.T) {
+	if got := cleanCaption(strings.Repeat("word ", 100)); len(got) > maxCaptionChars || strings.HasSuffix(got, " ") {
+		t.Fatalf("caption not capped: %d chars", len(got))
+	}
+}
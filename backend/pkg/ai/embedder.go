@@ -0,0 +1,157 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+)
+
+// ProviderFake selects the deterministic local embedder (tests, demos).
+const ProviderFake = "fake"
+
+// Embedder turns text into vectors for semantic search. Vectors of different
+// models are not comparable, so callers store Model() next to each vector.
+type Embedder interface {
+	// Enabled reports whether embedding is actually available.
+	Enabled() bool
+	// Model names the embedding model; it changes whenever vectors would.
+	Model() string
+	// EmbedDocuments returns one vector per text, in order.
+	EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error)
+	// EmbedQuery returns the vector of a search query.
+	EmbedQuery(ctx context.Context, query string) ([]float32, error)
+}
+
+// NewEmbedder builds the Embedder for config.Config.AIEmbeddingProvider, which
+// defaults to the suggestion provider. As with NewSuggester, a provider that
+// lacks its credentials yields a disabled embedder and an unknown name is an
+// error.
+func NewEmbedder(ctx context.Context, logger *slog.Logger, cfg *config.Config) (Embedder, error) {
+	provider := cfg.AIEmbeddingProvider
+	if provider == "" {
+		provider = cfg.AIProvider
+	}
+	switch strings.ToLower(strings.TrimSpace(provider)) {
+	case "", ProviderGemini:
+		return newGeminiEmbedder(ctx, logger, cfg.AIEmbeddingModel)
+	case ProviderOpenAI:
+		return newOpenAIEmbedder(logger, cfg.AIBaseURL, cfg.AIEmbeddingModel, cfg.AIAPIKey), nil
+	case ProviderFake:
+		return NewFakeEmbedder(), nil
+	case ProviderNone:
+		logger.Info("Semantic search disabled by configuration")
+		return disabledEmbedder{}, nil
+	default:
+		return nil, fmt.Errorf("unknown ai_embedding_provider %q (want %s, %s, %s or %s)",
+			provider, ProviderGemini, ProviderOpenAI, ProviderFake, ProviderNone)
+	}
+}
+
+// NewDisabledEmbedder returns an Embedder that is always disabled.
+func NewDisabledEmbedder() Embedder { return disabledEmbedder{} }
+
+type disabledEmbedder struct{}
+
+func (disabledEmbedder) Enabled() bool { return false }
+
+func (disabledEmbedder) Model() string { return "" }
+
+func (disabledEmbedder) EmbedDocuments(context.Context, []string) ([][]float32, error) {
+	return nil, nil
+}
+
+func (disabledEmbedder) EmbedQuery(context.Context, string) ([]float32, error) {
+	return nil, nil
+}
+
+// fakeDimensions is the vector size of the fake embedder.
+const fakeDimensions = 256
+
+// fakeEmbedder hashes lower-cased words into a fixed-size bag-of-words vector.
+// It runs offline and is deterministic, so entries sharing words come out
+// similar — enough to exercise the pipeline, not to understand synonyms.
+type fakeEmbedder struct{}
+
+// NewFakeEmbedder returns the deterministic local Embedder.
+func NewFakeEmbedder() Embedder { return fakeEmbedder{} }
+
+func (fakeEmbedder) Enabled() bool { return true }
+
+func (fakeEmbedder) Model() string { return fmt.Sprintf("fake-bow-%d", fakeDimensions) }
+
+func (f fakeEmbedder) EmbedDocuments(_ context.Context, texts []string) ([][]float32, error) {
+	out := make([][]float32, len(texts))
+	for i, t := range texts {
+		out[i] = f.embed(t)
+	}
+	return out, nil
+}
+
+func (f fakeEmbedder) EmbedQuery(_ context.Context, query string) ([]float32, error) {
+	return f.embed(query), nil
+}
+
+func (fakeEmbedder) embed(text string) []float32 {
+	vec := make([]float32, fakeDimensions)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, w := range words {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(w))
+		vec[h.Sum32()%fakeDimensions]++
+	}
+	return normalize(vec)
+}
+
+// EmbeddingText is the text embedded for an entry: its title and body, capped
+// at maxBodyChars like the tagging prompt. Returns "" for a blank entry.
+func EmbeddingText(title, body string) string {
+	if isBlank(title, body) {
+		return ""
+	}
+	if len(body) > maxBodyChars {
+		body = body[:maxBodyChars]
+	}
+	return strings.TrimSpace(title + "\n\n" + body)
+}
+
+// CosineSimilarity returns the cosine of the angle between a and b, or 0 when
+// their lengths differ or either is a zero vector.
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+// normalize scales vec to unit length in place (a zero vector is left as is).
+func normalize(vec []float32) []float32 {
+	var sum float64
+	for _, v := range vec {
+		sum += float64(v) * float64(v)
+	}
+	if sum == 0 {
+		return vec
+	}
+	n := float32(math.Sqrt(sum))
+	for i := range vec {
+		vec[i] /= n
+	}
+	return vec
+}
@@ -0,0 +1,99 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+)
+
+func TestFakeEmbedderIsDeterministicAndRanksSharedWords(t *testing.T) {
+	ctx := context.Background()
+	e := NewFakeEmbedder()
+	docs, _ := e.EmbedDocuments(ctx, []string{
+		"Hiking the mountain trail with the kids",
+		"Office day, long meetings",
+	})
+	again, _ := e.EmbedDocuments(ctx, []string{"Hiking the mountain trail with the kids"})
+	if CosineSimilarity(docs[0], again[0]) < 0.9999 {
+		t.Fatal("same text must give the same vector")
+	}
+
+	q, _ := e.EmbedQuery(ctx, "mountain trail")
+	if CosineSimilarity(q, docs[0]) <= CosineSimilarity(q, docs[1]) {
+		t.Fatal("query must be closer to the entry sharing its words")
+	}
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	cases := []struct {
+		a, b []float32
+		want float64
+	}{
+		{[]float32{1, 0}, []float32{2, 0}, 1},
+		{[]float32{1, 0}, []float32{0, 3}, 0},
+		{[]float32{1, 1}, []float32{-1, -1}, -1},
+		{[]float32{1, 0}, []float32{1, 0, 0}, 0},
+		{[]float32{0, 0}, []float32{1, 0}, 0},
+	}
+	for _, c := range cases {
+		if got := CosineSimilarity(c.a, c.b); math.Abs(got-c.want) > 1e-9 {
+			t.Errorf("CosineSimilarity(%v, %v) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestOpenAIEmbedderOrdersByIndex(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		var req embeddingRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Model != "nomic-embed-text" || len(req.Input) != 2 {
+			t.Errorf("unexpected request %+v", req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"index":1,"embedding":[0,1]},{"index":0,"embedding":[1,0]}]}`))
+	}))
+	defer srv.Close()
+
+	e := newOpenAIEmbedder(discardLogger(), srv.URL+"/v1", "nomic-embed-text", "")
+	got, err := e.EmbedDocuments(context.Background(), []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("EmbedDocuments: %v", err)
+	}
+	if got[0][0] != 1 || got[1][1] != 1 {
+		t.Fatalf("vectors not in input order: %v", got)
+	}
+}
+
+func TestNewEmbedderProviderSelection(t *testing.T) {
+	t.Setenv("GEMINI_API_KEY", "")
+	ctx := context.Background()
+
+	e, err := NewEmbedder(ctx, discardLogger(), &config.Config{AIProvider: "openai", AIBaseURL: "http://x/v1", AIModel: "m"})
+	if err != nil || e.Enabled() {
+		t.Errorf("openai without embedding model: want disabled, got err=%v", err)
+	}
+	e, err = NewEmbedder(ctx, discardLogger(), &config.Config{AIProvider: "none", AIEmbeddingProvider: "fake"})
+	if err != nil || !e.Enabled() || e.Model() == "" {
+		t.Errorf("fake: want enabled, got err=%v", err)
+	}
+	if _, err := NewEmbedder(ctx, discardLogger(), &config.Config{AIEmbeddingProvider: "word2vec"}); err == nil {
+		t.Error("unknown provider must be an error")
+	}
+}
+
+func TestEmbeddingText(t *testing.T) {
+	if EmbeddingText(" ", "\n") != "" {
+		t.Fatal("blank entry must give no text")
+	}
+	if got := EmbeddingText("Title", "Body"); got != "Title\n\nBody" {
+		t.Fatalf("got %q", got)
+	}
+}
@@ -24,9 +24,10 @@ type geminiSuggester struct {
 	logger *slog.Logger
 }
 
-// NewSuggester builds a Suggester. If GEMINI_API_KEY is unset it returns a
-// disabled suggester (no error) so callers can wire it unconditionally.
-func NewSuggester(ctx context.Context, logger *slog.Logger) (Suggester, error) {
+// newGeminiSuggester builds the Gemini-backed Suggester. If GEMINI_API_KEY is
+// unset it returns a disabled suggester (no error). An empty model selects
+// defaultModel.
+func newGeminiSuggester(ctx context.Context, logger *slog.Logger, model string) (Suggester, error) {
 	apiKey := os.Getenv("GEMINI_API_KEY")
 	if apiKey == "" {
 		logger.Info("AI tagging disabled: GEMINI_API_KEY not set")
@@ -38,7 +39,10 @@ func NewSuggester(ctx context.Context, logger *slog.Logger) (Suggester, error) {
 		return nil, fmt.Errorf("creating gemini client: %w", err)
 	}
 
-	return &geminiSuggester{client: client, model: defaultModel, logger: logger}, nil
+	if model == "" {
+		model = defaultModel
+	}
+	return &geminiSuggester{client: client, model: model, logger: logger}, nil
 }
 
 func (g *geminiSuggester) Enabled() bool { return true }
@@ -48,7 +52,7 @@ func (g *geminiSuggester) Enabled() bool { return true }
 const maxBodyChars = 8000
 
 func (g *geminiSuggester) SuggestTags(
-	ctx context.Context, title, body string, images []ImageAsset, knownTags []string,
+	ctx context.Context, title, body string, images []ImageAsset, knownTags []string, policy TagPolicy,
 ) ([]TagSuggestion, error) {
 	if isBlank(title, body) {
 		return nil, nil
@@ -57,7 +61,7 @@ func (g *geminiSuggester) SuggestTags(
 	if len(body) > maxBodyChars {
 		body = body[:maxBodyChars]
 	}
-	prompt := buildPrompt(title, body, knownTags)
+	prompt := buildPrompt(title, body, knownTags, policy)
 
 	parts := make([]*genai.Part, 0, 1+len(images))
 	parts = append(parts, &genai.Part{Text: prompt})
@@ -66,10 +70,15 @@ func (g *geminiSuggester) SuggestTags(
 	}
 	contents := []*genai.Content{{Role: genai.RoleUser, Parts: parts}}
 
-	resp, err := g.generateWithRetry(ctx, contents)
+	config := &genai.GenerateContentConfig{
+		ResponseMIMEType: "application/json",
+		ResponseSchema:   tagSuggestionSchema(),
+	}
+	resp, err := g.generateWithRetry(ctx, contents, config)
 	if err != nil {
 		return nil, fmt.Errorf("gemini tag suggestion: %w", err)
 	}
+	addGeminiUsage(ctx, resp, len(images))
 
 	// An empty response is a legitimate "no suggestions" outcome (blocked,
 	// token-limited, or no candidate) — log the reason and degrade gracefully
@@ -80,7 +89,64 @@ func (g *geminiSuggester) SuggestTags(
 		return nil, nil
 	}
 
-	return parseSuggestions([]byte(text))
+	suggestions, err := parseSuggestions([]byte(text))
+	if err != nil {
+		return nil, err
+	}
+	return policy.enforce(suggestions, knownTags), nil
+}
+
+func (g *geminiSuggester) SummarizeEntry(ctx context.Context, title, body string) (string, error) {
+	return summarizeEntry(ctx, g, title, body)
+}
+
+func (g *geminiSuggester) SummarizePeriod(ctx context.Context, label string, entries []EntryText) (string, error) {
+	return summarizePeriod(ctx, g, label, entries)
+}
+
+func (g *geminiSuggester) Answer(ctx context.Context, question string, entries []EntryText) (Answer, error) {
+	return answerQuestion(ctx, g, question, entries)
+}
+
+func (g *geminiSuggester) CaptionImage(ctx context.Context, image ImageAsset) (string, error) {
+	return captionImage(ctx, g, image)
+}
+
+func (g *geminiSuggester) ClusterTags(ctx context.Context, tags []string) ([][]string, error) {
+	return clusterTags(ctx, g, tags)
+}
+
+func (g *geminiSuggester) Model() string { return g.model }
+
+// generateText asks for a plain-text answer to prompt and images.
+func (g *geminiSuggester) generateText(ctx context.Context, prompt string, images []ImageAsset) (string, error) {
+	parts := make([]*genai.Part, 0, 1+len(images))
+	parts = append(parts, &genai.Part{Text: prompt})
+	for _, img := range images {
+		parts = append(parts, genai.NewPartFromBytes(img.Data, img.MIMEType))
+	}
+	contents := []*genai.Content{{Role: genai.RoleUser, Parts: parts}}
+	resp, err := g.generateWithRetry(ctx, contents, nil)
+	if err != nil {
+		return "", fmt.Errorf("gemini text generation: %w", err)
+	}
+	addGeminiUsage(ctx, resp, len(images))
+	text := strings.TrimSpace(resp.Text())
+	if text == "" {
+		g.logEmptyResponse(resp)
+	}
+	return text, nil
+}
+
+// addGeminiUsage records the tokens resp was billed for (see addUsage).
+func addGeminiUsage(ctx context.Context, resp *genai.GenerateContentResponse, images int) {
+	meta := resp.UsageMetadata
+	if meta == nil {
+		addUsage(ctx, 0, 0, images)
+		return
+	}
+	// Thinking tokens are billed as output.
+	addUsage(ctx, int64(meta.PromptTokenCount), int64(meta.CandidatesTokenCount+meta.ThoughtsTokenCount), images)
 }
 
 const (
@@ -95,12 +161,8 @@ const (
 
 // generateWithRetry calls the model, retrying transient failures.
 func (g *geminiSuggester) generateWithRetry(
-	ctx context.Context, contents []*genai.Content,
+	ctx context.Context, contents []*genai.Content, config *genai.GenerateContentConfig,
 ) (*genai.GenerateContentResponse, error) {
-	config := &genai.GenerateContentConfig{
-		ResponseMIMEType: "application/json",
-		ResponseSchema:   tagSuggestionSchema(),
-	}
 	return retryTransient(ctx, g.logger, func() (*genai.GenerateContentResponse, error) {
 		return g.client.Models.GenerateContent(ctx, g.model, contents, config)
 	})
@@ -110,12 +172,13 @@ func (g *geminiSuggester) generateWithRetry(
 // number of times with a short, context-aware backoff. A 429's Retry-After hint
 // is honored but capped by maxRetryWait; a hint that exceeds the cap fails fast.
 // Non-transient errors, an exhausted budget, or the final attempt return the
-// error for the caller to wrap.
-func retryTransient(
+// error for the caller to wrap. Shared by every provider.
+func retryTransient[T any](
 	ctx context.Context,
 	logger *slog.Logger,
-	gen func() (*genai.GenerateContentResponse, error),
-) (*genai.GenerateContentResponse, error) {
+	gen func() (T, error),
+) (T, error) {
+	var zero T
 	var lastErr error
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
 		resp, err := gen()
@@ -124,21 +187,21 @@ func retryTransient(
 		}
 		lastErr = err
 		if !isRetryable(err) || attempt == maxAttempts {
-			return nil, err
+			return zero, err
 		}
 		wait, ok := backoffFor(attempt, err)
 		if !ok {
-			return nil, err // Retry-After exceeds our budget — fail fast.
+			return zero, err // Retry-After exceeds our budget — fail fast.
 		}
-		logger.Warn("gemini call failed; retrying",
+		logger.Warn("model call failed; retrying",
 			"attempt", attempt, "maxAttempts", maxAttempts, "wait", wait.String(), "error", err)
 		select {
 		case <-ctx.Done():
-			return nil, ctx.Err()
+			return zero, ctx.Err()
 		case <-time.After(wait):
 		}
 	}
-	return nil, lastErr
+	return zero, lastErr
 }
 
 // isRetryable reports whether a model-provider error is transient: an HTTP 5xx
@@ -147,11 +210,20 @@ func retryTransient(
 func isRetryable(err error) bool {
 	var apiErr genai.APIError
 	if errors.As(err, &apiErr) {
-		return apiErr.Code == 429 || (apiErr.Code >= 500 && apiErr.Code <= 599)
+		return isTransientStatus(apiErr.Code)
+	}
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return isTransientStatus(statusErr.Code)
 	}
 	return false
 }
 
+// isTransientStatus reports whether an HTTP status is worth retrying.
+func isTransientStatus(code int) bool {
+	return code == 429 || (code >= 500 && code <= 599)
+}
+
 // backoffFor returns how long to wait before the next attempt and whether it is
 // within budget. It prefers a server-provided Retry-After hint (Google RPC
 // RetryInfo); otherwise it uses exponential backoff with jitter. A wait longer
@@ -172,9 +244,14 @@ func backoffFor(attempt int, err error) (time.Duration, bool) {
 	return d, true
 }
 
-// retryAfter extracts a server-provided retry delay from a transient error, if
-// the provider included Google RPC RetryInfo details ({"retryDelay": "5s"}).
+// retryAfter extracts a server-provided retry delay from a transient error:
+// a Retry-After header from an HTTP provider, or Google RPC RetryInfo details
+// ({"retryDelay": "5s"}) from Gemini.
 func retryAfter(err error) (time.Duration, bool) {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.RetryAfter, statusErr.RetryAfter > 0
+	}
 	var apiErr genai.APIError
 	if !errors.As(err, &apiErr) {
 		return 0, false
@@ -204,7 +281,7 @@ func (g *geminiSuggester) logEmptyResponse(resp *genai.GenerateContentResponse)
 	if resp != nil && resp.PromptFeedback != nil {
 		blockReason = string(resp.PromptFeedback.BlockReason)
 	}
-	g.logger.Warn("gemini returned no usable tag suggestions",
+	g.logger.Warn("gemini returned no usable content",
 		"finishReason", finishReason,
 		"candidateCount", candidateCount,
 		"blockReason", blockReason,
@@ -231,3 +308,132 @@ func tagSuggestionSchema() *genai.Schema {
 		Required: []string{"tags"},
 	}
 }
+
+// defaultEmbeddingModel is Gemini's text embedding model; vectors are cut to
+// geminiEmbeddingDimensions to keep them small in SQLite.
+const (
+	defaultEmbeddingModel     = "gemini-embedding-001"
+	geminiEmbeddingDimensions = 768
+)
+
+// geminiEmbedder is the Gemini-backed Embedder.
+type geminiEmbedder struct {
+	client *genai.Client
+	model  string
+	logger *slog.Logger
+}
+
+// newGeminiEmbedder builds the Gemini-backed Embedder; like the suggester it
+// is disabled without GEMINI_API_KEY. An empty model selects
+// defaultEmbeddingModel.
+func newGeminiEmbedder(ctx context.Context, logger *slog.Logger, model string) (Embedder, error) {
+	apiKey := os.Getenv("GEMINI_API_KEY")
+	if apiKey == "" {
+		logger.Info("Semantic search disabled: GEMINI_API_KEY not set")
+		return disabledEmbedder{}, nil
+	}
+
+	client, err := genai.NewClient(ctx, &genai.ClientConfig{APIKey: apiKey})
+	if err != nil {
+		return nil, fmt.Errorf("creating gemini client: %w", err)
+	}
+
+	if model == "" {
+		model = defaultEmbeddingModel
+	}
+	return &geminiEmbedder{client: client, model: model, logger: logger}, nil
+}
+
+func (g *geminiEmbedder) Enabled() bool { return true }
+
+func (g *geminiEmbedder) Model() string { return g.model }
+
+func (g *geminiEmbedder) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+	return g.embed(ctx, texts, "RETRIEVAL_DOCUMENT")
+}
+
+func (g *geminiEmbedder) EmbedQuery(ctx context.Context, query string) ([]float32, error) {
+	vectors, err := g.embed(ctx, []string{query}, "RETRIEVAL_QUERY")
+	if err != nil {
+		return nil, err
+	}
+	return vectors[0], nil
+}
+
+func (g *geminiEmbedder) embed(ctx context.Context, texts []string, taskType string) ([][]float32, error) {
+	contents := make([]*genai.Content, len(texts))
+	for i, t := range texts {
+		contents[i] = genai.NewContentFromText(t, genai.RoleUser)
+	}
+	dims := int32(geminiEmbeddingDimensions)
+	config := &genai.EmbedContentConfig{TaskType: taskType, OutputDimensionality: &dims}
+
+	resp, err := retryTransient(ctx, g.logger, func() (*genai.EmbedContentResponse, error) {
+		return g.client.Models.EmbedContent(ctx, g.model, contents, config)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gemini embedding: %w", err)
+	}
+	if len(resp.Embeddings) != len(texts) {
+		return nil, fmt.Errorf("gemini embedding: got %d vectors for %d texts", len(resp.Embeddings), len(texts))
+	}
+
+	out := make([][]float32, len(texts))
+	var tokens float32
+	for i, e := range resp.Embeddings {
+		// Truncated Gemini vectors are not unit length.
+		out[i] = normalize(e.Values)
+		// Only Vertex AI reports token counts; the Gemini API counts as 0.
+		if e.Statistics != nil {
+			tokens += e.Statistics.TokenCount
+		}
+	}
+	addUsage(ctx, int64(tokens), 0, 0)
+	return out, nil
+}
+
+// geminiTranscriber is the Gemini-backed Transcriber; the audio is sent inline
+// with a transcription prompt.
+type geminiTranscriber struct {
+	client *genai.Client
+	model  string
+	logger *slog.Logger
+}
+
+// newGeminiTranscriber builds the Gemini-backed Transcriber; like the
+// suggester it is disabled without GEMINI_API_KEY. An empty model selects
+// defaultModel.
+func newGeminiTranscriber(ctx context.Context, logger *slog.Logger, model string) (Transcriber, error) {
+	apiKey := os.Getenv("GEMINI_API_KEY")
+	if apiKey == "" {
+		logger.Info("Voice memo transcription disabled: GEMINI_API_KEY not set")
+		return disabledTranscriber{}, nil
+	}
+
+	client, err := genai.NewClient(ctx, &genai.ClientConfig{APIKey: apiKey})
+	if err != nil {
+		return nil, fmt.Errorf("creating gemini client: %w", err)
+	}
+
+	if model == "" {
+		model = defaultModel
+	}
+	return &geminiTranscriber{client: client, model: model, logger: logger}, nil
+}
+
+func (g *geminiTranscriber) Enabled() bool { return true }
+
+func (g *geminiTranscriber) Transcribe(ctx context.Context, audio AudioAsset) (string, error) {
+	contents := []*genai.Content{{Role: genai.RoleUser, Parts: []*genai.Part{
+		{Text: buildTranscriptionPrompt()},
+		genai.NewPartFromBytes(audio.Data, audio.MIMEType),
+	}}}
+	resp, err := retryTransient(ctx, g.logger, func() (*genai.GenerateContentResponse, error) {
+		return g.client.Models.GenerateContent(ctx, g.model, contents, nil)
+	})
+	if err != nil {
+		return "", fmt.Errorf("gemini transcription: %w", err)
+	}
+	addGeminiUsage(ctx, resp, 0)
+	return strings.TrimSpace(resp.Text()), nil
+}
@@ -28,7 +28,6 @@ func LoadImageAssets(body, dataPath, familyID string) []ImageAsset {
 		return nil
 	}
 
-	assetsDir := filepath.Join(dataPath, config.AssetsDirName, familyID)
 	seen := make(map[string]struct{}, len(names))
 	var assets []ImageAsset
 	for _, name := range names {
@@ -36,27 +35,38 @@ func LoadImageAssets(body, dataPath, familyID string) []ImageAsset {
 			break
 		}
 		clean := filepath.Clean(name)
-		if strings.Contains(clean, "..") {
-			continue
-		}
 		if _, dup := seen[clean]; dup {
 			continue
 		}
 		seen[clean] = struct{}{}
 
-		path := filepath.Join(assetsDir, clean)
-		data, err := os.ReadFile(path)
-		if err != nil {
-			continue
-		}
-		mime := http.DetectContentType(data)
-		if i := strings.IndexByte(mime, ';'); i >= 0 {
-			mime = strings.TrimSpace(mime[:i])
+		if asset, ok := LoadImageAsset(dataPath, familyID, clean); ok {
+			assets = append(assets, asset)
 		}
-		if !supportedImageMIMEs[mime] {
-			continue
-		}
-		assets = append(assets, ImageAsset{MIMEType: mime, Data: data})
 	}
 	return assets
 }
+
+// LoadImageAsset reads one file of the family's asset directory. ok is false
+// when the name escapes the directory, the file cannot be read, or it is not a
+// supported image.
+func LoadImageAsset(dataPath, familyID, name string) (ImageAsset, bool) {
+	clean := filepath.Clean(name)
+	if strings.Contains(clean, "..") {
+		return ImageAsset{}, false
+	}
+
+	path := filepath.Join(dataPath, config.AssetsDirName, familyID, clean)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ImageAsset{}, false
+	}
+	mime := http.DetectContentType(data)
+	if i := strings.IndexByte(mime, ';'); i >= 0 {
+		mime = strings.TrimSpace(mime[:i])
+	}
+	if !supportedImageMIMEs[mime] {
+		return ImageAsset{}, false
+	}
+	return ImageAsset{MIMEType: mime, Data: data}, true
+}
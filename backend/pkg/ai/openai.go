@@ -0,0 +1,386 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// openAIRequestTimeout bounds a single chat completions call. Local models can
+// be slow on modest hardware, so it is more generous than the retry budget.
+const openAIRequestTimeout = 2 * time.Minute
+
+// openAIClient holds the connection settings shared by the OpenAI-compatible
+// suggester and embedder.
+type openAIClient struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+	logger     *slog.Logger
+}
+
+func newOpenAIClient(logger *slog.Logger, baseURL, apiKey string) *openAIClient {
+	return &openAIClient{
+		httpClient: &http.Client{Timeout: openAIRequestTimeout},
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		logger:     logger,
+	}
+}
+
+// openAISuggester is a Suggester backed by an OpenAI-compatible chat
+// completions endpoint (OpenAI, Ollama, llama.cpp server, vLLM, …).
+type openAISuggester struct {
+	*openAIClient
+	model string
+}
+
+// newOpenAISuggester builds the OpenAI-compatible Suggester. Without a base URL
+// and model it returns a disabled suggester.
+func newOpenAISuggester(logger *slog.Logger, baseURL, model, apiKey string) Suggester {
+	if baseURL == "" || model == "" {
+		logger.Info("AI tagging disabled: ai_base_url and ai_model are required for the openai provider")
+		return disabledSuggester{}
+	}
+	return &openAISuggester{openAIClient: newOpenAIClient(logger, baseURL, apiKey), model: model}
+}
+
+func (o *openAISuggester) Enabled() bool { return true }
+
+func (o *openAISuggester) SuggestTags(
+	ctx context.Context, title, body string, images []ImageAsset, knownTags []string, policy TagPolicy,
+) ([]TagSuggestion, error) {
+	if isBlank(title, body) {
+		return nil, nil
+	}
+
+	if len(body) > maxBodyChars {
+		body = body[:maxBodyChars]
+	}
+	payload, err := json.Marshal(o.newRequest(buildPrompt(title, body, knownTags, policy), images, &chatResponseFormat{
+		Type: "json_schema",
+		JSONSchema: chatJSONSchema{
+			Name:   "tag_suggestions",
+			Strict: true,
+			Schema: tagSuggestionJSONSchema(),
+		},
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("encoding chat request: %w", err)
+	}
+
+	resp, err := retryTransient(ctx, o.logger, func() (*chatCompletionResponse, error) {
+		return o.complete(ctx, payload)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("openai-compatible tag suggestion: %w", err)
+	}
+	addUsage(ctx, resp.Usage.PromptTokens, resp.Usage.CompletionTokens, len(images))
+
+	// As with Gemini, an empty answer (refusal, length cut-off, no choice) is
+	// "no suggestions" rather than a failure.
+	if len(resp.Choices) == 0 || strings.TrimSpace(resp.Choices[0].Message.Content) == "" {
+		finishReason := ""
+		if len(resp.Choices) > 0 {
+			finishReason = resp.Choices[0].FinishReason
+		}
+		o.logger.Warn("model returned no usable tag suggestions",
+			"finishReason", finishReason, "choiceCount", len(resp.Choices))
+		return nil, nil
+	}
+
+	suggestions, err := parseSuggestions([]byte(resp.Choices[0].Message.Content))
+	if err != nil {
+		return nil, err
+	}
+	return policy.enforce(suggestions, knownTags), nil
+}
+
+func (o *openAISuggester) SummarizeEntry(ctx context.Context, title, body string) (string, error) {
+	return summarizeEntry(ctx, o, title, body)
+}
+
+func (o *openAISuggester) SummarizePeriod(ctx context.Context, label string, entries []EntryText) (string, error) {
+	return summarizePeriod(ctx, o, label, entries)
+}
+
+func (o *openAISuggester) Answer(ctx context.Context, question string, entries []EntryText) (Answer, error) {
+	return answerQuestion(ctx, o, question, entries)
+}
+
+func (o *openAISuggester) CaptionImage(ctx context.Context, image ImageAsset) (string, error) {
+	return captionImage(ctx, o, image)
+}
+
+func (o *openAISuggester) ClusterTags(ctx context.Context, tags []string) ([][]string, error) {
+	return clusterTags(ctx, o, tags)
+}
+
+func (o *openAISuggester) Model() string { return o.model }
+
+// generateText asks for a plain-text answer to prompt and images.
+func (o *openAISuggester) generateText(ctx context.Context, prompt string, images []ImageAsset) (string, error) {
+	payload, err := json.Marshal(o.newRequest(prompt, images, nil))
+	if err != nil {
+		return "", fmt.Errorf("encoding chat request: %w", err)
+	}
+	resp, err := retryTransient(ctx, o.logger, func() (*chatCompletionResponse, error) {
+		return o.complete(ctx, payload)
+	})
+	if err != nil {
+		return "", fmt.Errorf("openai-compatible text generation: %w", err)
+	}
+	addUsage(ctx, resp.Usage.PromptTokens, resp.Usage.CompletionTokens, len(images))
+	if len(resp.Choices) == 0 {
+		o.logger.Warn("model returned no choices")
+		return "", nil
+	}
+	return strings.TrimSpace(resp.Choices[0].Message.Content), nil
+}
+
+// complete performs one chat completions call.
+func (o *openAISuggester) complete(ctx context.Context, payload []byte) (*chatCompletionResponse, error) {
+	var resp chatCompletionResponse
+	if err := o.post(ctx, "/chat/completions", payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// post sends one JSON request to path below the base URL and decodes the
+// answer into out.
+func (c *openAIClient) post(ctx context.Context, path string, payload []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(httpResp.Body, 1024))
+		return &httpStatusError{
+			Code:       httpResp.StatusCode,
+			Message:    strings.TrimSpace(string(msg)),
+			RetryAfter: parseRetryAfter(httpResp.Header.Get("Retry-After")),
+		}
+	}
+
+	if err := json.NewDecoder(httpResp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding %s response: %w", path, err)
+	}
+	return nil
+}
+
+// newRequest builds the chat request: the prompt plus inline images as data
+// URLs, optionally constrained to a structured output format.
+func (o *openAISuggester) newRequest(
+	prompt string, images []ImageAsset, format *chatResponseFormat,
+) chatCompletionRequest {
+	var content any = prompt
+	if len(images) > 0 {
+		parts := make([]chatContentPart, 0, 1+len(images))
+		parts = append(parts, chatContentPart{Type: "text", Text: prompt})
+		for _, img := range images {
+			parts = append(parts, chatContentPart{
+				Type: "image_url",
+				ImageURL: &chatImageURL{
+					URL: "data:" + img.MIMEType + ";base64," + base64.StdEncoding.EncodeToString(img.Data),
+				},
+			})
+		}
+		content = parts
+	}
+
+	return chatCompletionRequest{
+		Model:          o.model,
+		Messages:       []chatMessage{{Role: "user", Content: content}},
+		ResponseFormat: format,
+	}
+}
+
+// httpStatusError is a non-200 answer from an HTTP model provider.
+type httpStatusError struct {
+	Code       int
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *httpStatusError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("model server returned HTTP %d", e.Code)
+	}
+	return fmt.Sprintf("model server returned HTTP %d: %s", e.Code, e.Message)
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds (the HTTP-date
+// form is not used by model servers and is ignored).
+func parseRetryAfter(value string) time.Duration {
+	secs, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || secs <= 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
+
+// tagSuggestionJSONSchema is tagSuggestionSchema as plain JSON Schema for
+// structured outputs: {tags:[{name,confidence}]}.
+func tagSuggestionJSONSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"tags": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"name":       map[string]any{"type": "string", "description": "short topical tag"},
+						"confidence": map[string]any{"type": "number", "description": "confidence 0..1"},
+					},
+					"required":             []string{"name", "confidence"},
+					"additionalProperties": false,
+				},
+			},
+		},
+		"required":             []string{"tags"},
+		"additionalProperties": false,
+	}
+}
+
+// Chat completions wire types (only the fields we use).
+type (
+	chatCompletionRequest struct {
+		Model          string              `json:"model"`
+		Messages       []chatMessage       `json:"messages"`
+		ResponseFormat *chatResponseFormat `json:"response_format,omitempty"`
+	}
+	chatMessage struct {
+		Role string `json:"role"`
+		// Content is a string, or []chatContentPart when images are attached.
+		Content any `json:"content"`
+	}
+	chatContentPart struct {
+		Type     string        `json:"type"`
+		Text     string        `json:"text,omitempty"`
+		ImageURL *chatImageURL `json:"image_url,omitempty"`
+	}
+	chatImageURL struct {
+		URL string `json:"url"`
+	}
+	chatResponseFormat struct {
+		Type       string         `json:"type"`
+		JSONSchema chatJSONSchema `json:"json_schema"`
+	}
+	chatJSONSchema struct {
+		Name   string         `json:"name"`
+		Strict bool           `json:"strict"`
+		Schema map[string]any `json:"schema"`
+	}
+	chatCompletionResponse struct {
+		Choices []struct {
+			Message struct {
+				Content string `json:"content"`
+			} `json:"message"`
+			FinishReason string `json:"finish_reason"`
+		} `json:"choices"`
+		// Usage is absent from some local servers; the counts then read 0.
+		Usage struct {
+			PromptTokens     int64 `json:"prompt_tokens"`
+			CompletionTokens int64 `json:"completion_tokens"`
+		} `json:"usage"`
+	}
+)
+
+// openAIEmbedder is an Embedder backed by an OpenAI-compatible /embeddings
+// endpoint (OpenAI, Ollama, llama.cpp server with --embeddings, …).
+type openAIEmbedder struct {
+	*openAIClient
+	model string
+}
+
+// newOpenAIEmbedder builds the OpenAI-compatible Embedder. Without a base URL
+// and embedding model it returns a disabled embedder.
+func newOpenAIEmbedder(logger *slog.Logger, baseURL, model, apiKey string) Embedder {
+	if baseURL == "" || model == "" {
+		logger.Info("Semantic search disabled: ai_base_url and ai_embedding_model are required for the openai provider")
+		return disabledEmbedder{}
+	}
+	return &openAIEmbedder{openAIClient: newOpenAIClient(logger, baseURL, apiKey), model: model}
+}
+
+func (o *openAIEmbedder) Enabled() bool { return true }
+
+func (o *openAIEmbedder) Model() string { return o.model }
+
+func (o *openAIEmbedder) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+	payload, err := json.Marshal(embeddingRequest{Model: o.model, Input: texts})
+	if err != nil {
+		return nil, fmt.Errorf("encoding embedding request: %w", err)
+	}
+	resp, err := retryTransient(ctx, o.logger, func() (*embeddingResponse, error) {
+		var resp embeddingResponse
+		if err := o.post(ctx, "/embeddings", payload, &resp); err != nil {
+			return nil, err
+		}
+		return &resp, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("openai-compatible embedding: %w", err)
+	}
+	if len(resp.Data) != len(texts) {
+		return nil, fmt.Errorf("openai-compatible embedding: got %d vectors for %d texts", len(resp.Data), len(texts))
+	}
+
+	addUsage(ctx, resp.Usage.PromptTokens, 0, 0)
+
+	out := make([][]float32, len(texts))
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(out) {
+			return nil, fmt.Errorf("openai-compatible embedding: vector index %d out of range", d.Index)
+		}
+		out[d.Index] = d.Embedding
+	}
+	return out, nil
+}
+
+func (o *openAIEmbedder) EmbedQuery(ctx context.Context, query string) ([]float32, error) {
+	vectors, err := o.EmbedDocuments(ctx, []string{query})
+	if err != nil {
+		return nil, err
+	}
+	return vectors[0], nil
+}
+
+// Embeddings wire types (only the fields we use).
+type (
+	embeddingRequest struct {
+		Model string   `json:"model"`
+		Input []string `json:"input"`
+	}
+	embeddingResponse struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+		// Usage is absent from some local servers; the count then reads 0.
+		Usage struct {
+			PromptTokens int64 `json:"prompt_tokens"`
+		} `json:"usage"`
+	}
+)
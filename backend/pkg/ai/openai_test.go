@@ -0,0 +1,169 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+)
+
+// chatServer is an httptest stand-in for an OpenAI-compatible server. handle
+// receives the decoded request and the 1-based call number.
+func chatServer(t *testing.T, handle func(w http.ResponseWriter, req map[string]any, call int)) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var req map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		handle(w, req, int(atomic.AddInt32(&calls, 1)))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func writeChatContent(w http.ResponseWriter, content string) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"choices": []map[string]any{{
+			"message":       map[string]any{"role": "assistant", "content": content},
+			"finish_reason": "stop",
+		}},
+	})
+}
+
+func TestOpenAISuggesterSuggestTags(t *testing.T) {
+	srv, _ := chatServer(t, func(w http.ResponseWriter, req map[string]any, _ int) {
+		if req["model"] != "llama3.2" {
+			t.Errorf("model = %v", req["model"])
+		}
+		format, _ := req["response_format"].(map[string]any)
+		if format["type"] != "json_schema" {
+			t.Errorf("response_format = %v", format)
+		}
+		messages, _ := req["messages"].([]any)
+		prompt, _ := messages[0].(map[string]any)["content"].(string)
+		if !strings.Contains(prompt, "Existing tags: hiking, family") || !strings.Contains(prompt, "Went up the hill") {
+			t.Errorf("prompt lacks entry or vocabulary: %q", prompt)
+		}
+		writeChatContent(w, `{"tags":[{"name":"hiking","confidence":0.7},{"name":"Hiking","confidence":0.9},{"name":"outdoors","confidence":1.4}]}`)
+	})
+
+	s := newOpenAISuggester(discardLogger(), srv.URL+"/v1/", "llama3.2", "")
+	got, err := s.SuggestTags(context.Background(), "Hike", "Went up the hill", nil, []string{"hiking", "family"}, TagPolicy{})
+	if err != nil {
+		t.Fatalf("SuggestTags: %v", err)
+	}
+	if len(got) != 2 || got[0] != (TagSuggestion{Name: "outdoors", Confidence: 1}) || got[1] != (TagSuggestion{Name: "Hiking", Confidence: 0.9}) {
+		t.Fatalf("unexpected suggestions: %+v", got)
+	}
+}
+
+func TestOpenAISuggesterSendsImagesAndKey(t *testing.T) {
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		var req chatCompletionRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		parts, _ := req.Messages[0].Content.([]any)
+		if len(parts) != 2 {
+			t.Errorf("want text + image parts, got %v", req.Messages[0].Content)
+		} else if url := parts[1].(map[string]any)["image_url"].(map[string]any)["url"]; url != "data:image/png;base64,AQID" {
+			t.Errorf("image data URL = %v", url)
+		}
+		writeChatContent(w, `{"tags":[]}`)
+	}))
+	defer srv.Close()
+
+	s := newOpenAISuggester(discardLogger(), srv.URL, "llava", "sk-local")
+	images := []ImageAsset{{MIMEType: "image/png", Data: []byte{1, 2, 3}}}
+	if _, err := s.SuggestTags(context.Background(), "Photo", "", images, nil, TagPolicy{}); err != nil {
+		t.Fatalf("SuggestTags: %v", err)
+	}
+	if auth != "Bearer sk-local" {
+		t.Fatalf("Authorization = %q", auth)
+	}
+}
+
+func TestOpenAISuggesterRetries(t *testing.T) {
+	cases := []struct {
+		name      string
+		status    int
+		header    string
+		wantErr   bool
+		wantCalls int32
+	}{
+		{"transient then success", http.StatusServiceUnavailable, "", false, 2},
+		{"non-transient not retried", http.StatusBadRequest, "", true, 1},
+		{"retry-after beyond budget fails fast", http.StatusTooManyRequests, "30", true, 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			srv, calls := chatServer(t, func(w http.ResponseWriter, _ map[string]any, call int) {
+				if call == 1 {
+					if c.header != "" {
+						w.Header().Set("Retry-After", c.header)
+					}
+					http.Error(w, "busy", c.status)
+					return
+				}
+				writeChatContent(w, `{"tags":[{"name":"work","confidence":0.5}]}`)
+			})
+
+			s := newOpenAISuggester(discardLogger(), srv.URL+"/v1", "m", "")
+			got, err := s.SuggestTags(context.Background(), "Day", "Office", nil, nil, TagPolicy{})
+			if (err != nil) != c.wantErr || *calls != c.wantCalls {
+				t.Fatalf("err=%v calls=%d; want err=%v calls=%d", err, *calls, c.wantErr, c.wantCalls)
+			}
+			if !c.wantErr && len(got) != 1 {
+				t.Fatalf("unexpected suggestions: %+v", got)
+			}
+		})
+	}
+}
+
+func TestOpenAISuggesterEmptyContent(t *testing.T) {
+	srv, _ := chatServer(t, func(w http.ResponseWriter, _ map[string]any, _ int) {
+		writeChatContent(w, "")
+	})
+	s := newOpenAISuggester(discardLogger(), srv.URL+"/v1", "m", "")
+	got, err := s.SuggestTags(context.Background(), "Day", "Text", nil, nil, TagPolicy{})
+	if err != nil || got != nil {
+		t.Fatalf("want (nil, nil), got (%v, %v)", got, err)
+	}
+}
+
+func TestNewSuggesterProviderSelection(t *testing.T) {
+	t.Setenv("GEMINI_API_KEY", "")
+	ctx := context.Background()
+
+	for _, provider := range []string{"", "gemini", "none"} {
+		s, err := NewSuggester(ctx, discardLogger(), &config.Config{AIProvider: provider})
+		if err != nil || s.Enabled() {
+			t.Errorf("provider %q: want disabled, got enabled=%v err=%v", provider, s != nil && s.Enabled(), err)
+		}
+	}
+
+	s, err := NewSuggester(ctx, discardLogger(), &config.Config{AIProvider: "openai", AIModel: "m"})
+	if err != nil || s.Enabled() {
+		t.Errorf("openai without base URL: want disabled, got err=%v", err)
+	}
+	s, err = NewSuggester(ctx, discardLogger(),
+		&config.Config{AIProvider: "OpenAI", AIBaseURL: "http://localhost:11434/v1", AIModel: "m"})
+	if err != nil || !s.Enabled() {
+		t.Errorf("openai: want enabled, got err=%v", err)
+	}
+
+	if _, err := NewSuggester(ctx, discardLogger(), &config.Config{AIProvider: "bard"}); err == nil {
+		t.Error("unknown provider must be an error")
+	}
+}
@@ -1,9 +1,14 @@
 // Package ai provides AI-assisted tag suggestion for diary entries.
 //
-// The package degrades gracefully: when no GEMINI_API_KEY is configured,
-// NewSuggester returns a disabled implementation whose Enabled() reports false
-// and whose SuggestTags is a no-op. Callers can therefore wire the suggester
-// unconditionally and let configuration decide whether it does anything.
+// The model provider is chosen by config.Config.AIProvider: Google Gemini, or
+// any server speaking the OpenAI-compatible chat completions API (a local
+// Ollama or llama.cpp server keeps diary text in the house).
+//
+// The package degrades gracefully: when the selected provider is not
+// configured (e.g. no GEMINI_API_KEY), NewSuggester returns a disabled
+// implementation whose Enabled() reports false and whose SuggestTags is a
+// no-op. Callers can therefore wire the suggester unconditionally and let
+// configuration decide whether it does anything.
 package ai
 
 import (
@@ -11,8 +16,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"sort"
 	"strings"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+)
+
+// Supported values of config.Config.AIProvider.
+const (
+	ProviderGemini = "gemini"
+	ProviderOpenAI = "openai"
+	ProviderNone   = "none"
 )
 
 // TagSuggestion is a single suggested tag with a confidence in [0,1].
@@ -39,8 +54,29 @@ type Suggester interface {
 	// text-only suggestion. Results are de-duplicated and confidence-sorted but NOT
 	// filtered against confirmed tags — that is the caller's responsibility. Returns
 	// an empty slice (no error) when there is nothing to tag or the suggester is
-	// disabled.
-	SuggestTags(ctx context.Context, title, body string, images []ImageAsset, knownTags []string) ([]TagSuggestion, error)
+	// disabled. policy carries the family's instructions and limits; the result
+	// honours its closed vocabulary and tag cap.
+	SuggestTags(
+		ctx context.Context, title, body string, images []ImageAsset, knownTags []string, policy TagPolicy,
+	) ([]TagSuggestion, error)
+}
+
+// NewSuggester builds the Suggester for the configured provider. A provider
+// that lacks its credentials or endpoint yields a disabled suggester (no
+// error); an unknown provider name is an error.
+func NewSuggester(ctx context.Context, logger *slog.Logger, cfg *config.Config) (Suggester, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.AIProvider)) {
+	case "", ProviderGemini:
+		return newGeminiSuggester(ctx, logger, cfg.AIModel)
+	case ProviderOpenAI:
+		return newOpenAISuggester(logger, cfg.AIBaseURL, cfg.AIModel, cfg.AIAPIKey), nil
+	case ProviderNone:
+		logger.Info("AI tagging disabled by configuration")
+		return disabledSuggester{}, nil
+	default:
+		return nil, fmt.Errorf("unknown ai_provider %q (want %s, %s or %s)",
+			cfg.AIProvider, ProviderGemini, ProviderOpenAI, ProviderNone)
+	}
 }
 
 // NewDisabledSuggester returns a Suggester that is always disabled. Useful for
@@ -53,7 +89,7 @@ type disabledSuggester struct{}
 func (disabledSuggester) Enabled() bool { return false }
 
 func (disabledSuggester) SuggestTags(
-	_ context.Context, _, _ string, _ []ImageAsset, _ []string,
+	_ context.Context, _, _ string, _ []ImageAsset, _ []string, _ TagPolicy,
 ) ([]TagSuggestion, error) {
 	return nil, nil
 }
@@ -84,17 +120,13 @@ func Partition(suggestions []TagSuggestion, confirmed []string, threshold float6
 	return names, confident
 }
 
-// buildPrompt assembles the hybrid-vocabulary tagging prompt.
-func buildPrompt(title, body string, knownTags []string) string {
+// buildPrompt assembles the hybrid-vocabulary tagging prompt under the
+// family's policy. User text (entry and instructions) is quoted with
+// quoteUserText.
+func buildPrompt(title, body string, knownTags []string, policy TagPolicy) string {
 	var b strings.Builder
 	b.WriteString("You assign short topical tags to a personal diary entry.\n\n")
-	b.WriteString("Rules:\n")
-	b.WriteString("- Prefer tags from the existing tag list below; reuse an existing tag ")
-	b.WriteString("whenever it fits rather than inventing a near-duplicate.\n")
-	b.WriteString("- You may introduce at most 2 new tags not in the existing list.\n")
-	b.WriteString("- Tags are lowercase, single words or short phrases, no punctuation.\n")
-	b.WriteString("- Return 1 to 6 tags. Use a confidence between 0 and 1 for each.\n")
-	b.WriteString("- If nothing fits, return an empty list.\n\n")
+	policy.writeRules(&b)
 
 	if len(knownTags) > 0 {
 		b.WriteString("Existing tags: ")
@@ -103,11 +135,12 @@ func buildPrompt(title, body string, knownTags []string) string {
 	} else {
 		b.WriteString("Existing tags: (none yet)\n\n")
 	}
+	policy.writeAliases(&b)
 
-	b.WriteString("Entry title: ")
-	b.WriteString(title)
+	b.WriteString("Entry title:\n")
+	b.WriteString(quoteUserText("entry_title", title))
 	b.WriteString("\n\nEntry body:\n")
-	b.WriteString(body)
+	b.WriteString(quoteUserText("entry_body", body))
 	return b.String()
 }
 
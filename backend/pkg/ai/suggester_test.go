@@ -10,7 +10,7 @@ func TestDisabledSuggester(t *testing.T) {
 	if s.Enabled() {
 		t.Fatal("disabled suggester should report Enabled()=false")
 	}
-	got, err := s.SuggestTags(context.Background(), "Title", "Body", nil, nil)
+	got, err := s.SuggestTags(context.Background(), "Title", "Body", nil, nil, TagPolicy{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -37,7 +37,7 @@ func TestIsBlank(t *testing.T) {
 }
 
 func TestBuildPromptIncludesKnownTags(t *testing.T) {
-	p := buildPrompt("My day", "went to the beach", []string{"travel", "family"})
+	p := buildPrompt("My day", "went to the beach", []string{"travel", "family"}, TagPolicy{})
 	for _, want := range []string{"travel", "family", "My day", "went to the beach", "at most 2 new"} {
 		if !contains(p, want) {
 			t.Errorf("prompt missing %q\n---\n%s", want, p)
@@ -46,7 +46,7 @@ func TestBuildPromptIncludesKnownTags(t *testing.T) {
 }
 
 func TestBuildPromptNoKnownTags(t *testing.T) {
-	p := buildPrompt("t", "b", nil)
+	p := buildPrompt("t", "b", nil, TagPolicy{})
 	if !contains(p, "(none yet)") {
 		t.Errorf("expected '(none yet)' when no known tags:\n%s", p)
 	}
@@ -0,0 +1,139 @@
+package ai
+
+import (
+	"context"
+	"strings"
+)
+
+// EntryText is one diary entry handed to SummarizePeriod or Answer.
+type EntryText struct {
+	Date  string
+	Title string
+	Body  string
+}
+
+// Summarizer writes short prose summaries of diary entries. It shares the
+// provider, credentials and retry/backoff of the Suggester it was built from.
+type Summarizer interface {
+	// Enabled reports whether summarization is actually available.
+	Enabled() bool
+	// SummarizeEntry returns a one-paragraph summary of a single entry, or ""
+	// when the entry is blank or the summarizer is disabled.
+	SummarizeEntry(ctx context.Context, title, body string) (string, error)
+	// SummarizePeriod returns a recap of the given entries; label names the
+	// period in the prompt (e.g. "the week of 2024-03-04"). Returns "" when
+	// there are no entries or the summarizer is disabled.
+	SummarizePeriod(ctx context.Context, label string, entries []EntryText) (string, error)
+}
+
+// NewSummarizer returns the Summarizer side of a Suggester built by
+// NewSuggester. Suggesters that cannot summarize (disabled ones, test fakes)
+// yield a disabled Summarizer.
+func NewSummarizer(s Suggester) Summarizer {
+	if sum, ok := unwrap(s).(Summarizer); ok {
+		if m := meterOf(s); m != nil {
+			return &meteredSummarizer{Summarizer: sum, meter: m}
+		}
+		return sum
+	}
+	return disabledSuggester{}
+}
+
+func (disabledSuggester) SummarizeEntry(_ context.Context, _, _ string) (string, error) {
+	return "", nil
+}
+
+func (disabledSuggester) SummarizePeriod(_ context.Context, _ string, _ []EntryText) (string, error) {
+	return "", nil
+}
+
+const (
+	// maxRecapChars caps the total entry text sent for one recap or question.
+	maxRecapChars = 4 * maxBodyChars
+	// minRecapEntryChars is the least each entry gets, however many there are.
+	minRecapEntryChars = 500
+)
+
+// textGenerator is the plain-text completion call a provider offers for
+// summaries, answers and captions; images are attached inline. It retries
+// transient failures and returns "" for an empty answer.
+type textGenerator interface {
+	generateText(ctx context.Context, prompt string, images []ImageAsset) (string, error)
+}
+
+// summarizeEntry implements Summarizer.SummarizeEntry on top of a provider.
+func summarizeEntry(ctx context.Context, g textGenerator, title, body string) (string, error) {
+	if isBlank(title, body) {
+		return "", nil
+	}
+	if len(body) > maxBodyChars {
+		body = body[:maxBodyChars]
+	}
+	return g.generateText(ctx, buildSummaryPrompt(title, body), nil)
+}
+
+// summarizePeriod implements Summarizer.SummarizePeriod on top of a provider.
+func summarizePeriod(ctx context.Context, g textGenerator, label string, entries []EntryText) (string, error) {
+	nonBlank := make([]EntryText, 0, len(entries))
+	for _, e := range entries {
+		if !isBlank(e.Title, e.Body) {
+			nonBlank = append(nonBlank, e)
+		}
+	}
+	if len(nonBlank) == 0 {
+		return "", nil
+	}
+	return g.generateText(ctx, buildRecapPrompt(label, nonBlank), nil)
+}
+
+// buildSummaryPrompt assembles the single-entry summary prompt.
+func buildSummaryPrompt(title, body string) string {
+	var b strings.Builder
+	b.WriteString("You summarize a personal diary entry.\n\n")
+	b.WriteString("Rules:\n")
+	b.WriteString("- Write a single paragraph of at most 4 sentences.\n")
+	b.WriteString("- Keep the writer's first-person voice and the entry's language.\n")
+	b.WriteString("- Plain text only: no headings, lists or markdown.\n\n")
+	b.WriteString("Entry title: ")
+	b.WriteString(title)
+	b.WriteString("\n\nEntry body:\n")
+	b.WriteString(body)
+	return b.String()
+}
+
+// buildRecapPrompt assembles the period recap prompt.
+func buildRecapPrompt(label string, entries []EntryText) string {
+	var b strings.Builder
+	b.WriteString("You write a recap of a personal diary for ")
+	b.WriteString(label)
+	b.WriteString(".\n\n")
+	b.WriteString("Rules:\n")
+	b.WriteString("- Write one or two paragraphs covering the main events, people, places and moods.\n")
+	b.WriteString("- Keep the writer's first-person voice and the entries' language.\n")
+	b.WriteString("- Plain text only: no headings, lists or markdown.\n\n")
+	writeEntries(&b, entries)
+	return b.String()
+}
+
+// writeEntries appends entries as dated sections. Entry bodies share
+// maxRecapChars so a busy month cannot blow the token budget.
+func writeEntries(b *strings.Builder, entries []EntryText) {
+	perEntry := max(maxRecapChars/max(len(entries), 1), minRecapEntryChars)
+
+	b.WriteString("Entries:\n")
+	for _, e := range entries {
+		body := e.Body
+		if len(body) > perEntry {
+			body = body[:perEntry]
+		}
+		b.WriteString("\n--- ")
+		b.WriteString(e.Date)
+		if e.Title != "" {
+			b.WriteString(": ")
+			b.WriteString(e.Title)
+		}
+		b.WriteString("\n")
+		b.WriteString(body)
+		b.WriteString("\n")
+	}
+}
@@ -0,0 +1,81 @@
+package ai
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestOpenAISummarizeEntry(t *testing.T) {
+	srv, calls := chatServer(t, func(w http.ResponseWriter, req map[string]any, call int) {
+		if _, ok := req["response_format"]; ok {
+			t.Errorf("summaries must not request structured output: %v", req["response_format"])
+		}
+		messages, _ := req["messages"].([]any)
+		prompt, _ := messages[0].(map[string]any)["content"].(string)
+		if !strings.Contains(prompt, "single paragraph") || !strings.Contains(prompt, "Went up the hill") {
+			t.Errorf("unexpected prompt: %q", prompt)
+		}
+		if call == 1 {
+			http.Error(w, "busy", http.StatusServiceUnavailable)
+			return
+		}
+		writeChatContent(w, "  I climbed the hill.\n")
+	})
+
+	s := NewSummarizer(newOpenAISuggester(discardLogger(), srv.URL+"/v1", "m", ""))
+	got, err := s.SummarizeEntry(context.Background(), "Hike", "Went up the hill")
+	if err != nil || got != "I climbed the hill." || *calls != 2 {
+		t.Fatalf("got %q, %v after %d calls", got, err, *calls)
+	}
+
+	if got, err := s.SummarizeEntry(context.Background(), " ", ""); err != nil || got != "" || *calls != 2 {
+		t.Fatalf("blank entry must not call the model: %q, %v", got, err)
+	}
+}
+
+func TestOpenAISummarizePeriodSkipsBlankEntries(t *testing.T) {
+	srv, calls := chatServer(t, func(w http.ResponseWriter, req map[string]any, _ int) {
+		messages, _ := req["messages"].([]any)
+		prompt, _ := messages[0].(map[string]any)["content"].(string)
+		if !strings.Contains(prompt, "the week of 2024-03-04") ||
+			!strings.Contains(prompt, "--- 2024-03-05: Tuesday\nOffice") ||
+			strings.Contains(prompt, "2024-03-06") {
+			t.Errorf("unexpected prompt: %q", prompt)
+		}
+		writeChatContent(w, "A quiet week.")
+	})
+
+	s := NewSummarizer(newOpenAISuggester(discardLogger(), srv.URL+"/v1", "m", ""))
+	got, err := s.SummarizePeriod(context.Background(), "the week of 2024-03-04", []EntryText{
+		{Date: "2024-03-05", Title: "Tuesday", Body: "Office"},
+		{Date: "2024-03-06"},
+	})
+	if err != nil || got != "A quiet week." {
+		t.Fatalf("got %q, %v", got, err)
+	}
+
+	if got, err := s.SummarizePeriod(context.Background(), "x", []EntryText{{Date: "2024-03-06"}}); err != nil || got != "" || *calls != 1 {
+		t.Fatalf("all-blank period must not call the model: %q, %v", got, err)
+	}
+}
+
+func TestBuildRecapPromptSharesBudget(t *testing.T) {
+	long := strings.Repeat("a", maxRecapChars)
+	entries := []EntryText{{Date: "2024-03-01", Body: long}, {Date: "2024-03-02", Body: long}}
+	prompt := buildRecapPrompt("March 2024", entries)
+	if n := strings.Count(prompt, "a"); n > maxRecapChars+100 {
+		t.Fatalf("prompt carries %d body chars, want about %d", n, maxRecapChars)
+	}
+}
+
+func TestNewSummarizerFallsBackToDisabled(t *testing.T) {
+	if NewSummarizer(NewDisabledSuggester()).Enabled() {
+		t.Fatal("disabled suggester must give a disabled summarizer")
+	}
+	type tagOnly struct{ Suggester }
+	if NewSummarizer(tagOnly{NewDisabledSuggester()}).Enabled() {
+		t.Fatal("a suggester without summaries must give a disabled summarizer")
+	}
+}
@@ -0,0 +1,49 @@
+package ai
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOpenAIClusterTagsKeepsKnownTags(t *testing.T) {
+	srv, calls := chatServer(t, func(w http.ResponseWriter, req map[string]any, _ int) {
+		messages, _ := req["messages"].([]any)
+		prompt, _ := messages[0].(map[string]any)["content"].(string)
+		if !strings.Contains(prompt, `<tags>`+"\n"+`["car","auto","holiday","vacation","dog"]`) {
+			t.Errorf("unexpected prompt: %q", prompt)
+		}
+		writeChatContent(w, "```json\n[[\"car\", \"auto\", \"vehicle\"], [\"holiday\", \"vacation\"], [\"dog\", \"puppy\"]]\n```")
+	})
+
+	c := NewTagClusterer(newOpenAISuggester(discardLogger(), srv.URL+"/v1", "m", ""))
+	got, err := c.ClusterTags(context.Background(), []string{"car", "auto", "holiday", "vacation", "dog"})
+	if err != nil {
+		t.Fatalf("ClusterTags: %v", err)
+	}
+	want := [][]string{{"car", "auto"}, {"holiday", "vacation"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v (made-up tags and singletons dropped)", got, want)
+	}
+
+	if got, err := c.ClusterTags(context.Background(), []string{"car"}); err != nil || got != nil || *calls != 1 {
+		t.Fatalf("a single tag must not call the model: %v, %v", got, err)
+	}
+}
+
+func TestParseClustersRejectsProse(t *testing.T) {
+	if _, err := parseClusters("No duplicates here.", []string{"a", "b"}); err == nil {
+		t.Fatal("expected an error for an answer without a JSON array")
+	}
+	if got, err := parseClusters("[]", []string{"a", "b"}); err != nil || got != nil {
+		t.Fatalf("empty answer = %v, %v", got, err)
+	}
+}
+
+func TestNewTagClustererDisabledForFakes(t *testing.T) {
+	if NewTagClusterer(disabledSuggester{}).Enabled() {
+		t.Fatal("a disabled suggester must yield a disabled clusterer")
+	}
+}
@@ -0,0 +1,140 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+)
+
+// ProviderWhisper selects a local whisper.cpp server for transcription.
+const ProviderWhisper = "whisper"
+
+// maxAudioBytes caps an audio asset sent for transcription; Gemini rejects
+// inline requests above 20 MB.
+const maxAudioBytes = 20 << 20
+
+var (
+	// ErrUnsupportedAudio is returned by LoadAudioAsset for a file that is not
+	// a supported audio format.
+	ErrUnsupportedAudio = errors.New("unsupported audio format")
+	// ErrAudioTooLarge is returned by LoadAudioAsset for a file above
+	// maxAudioBytes.
+	ErrAudioTooLarge = errors.New("audio file too large")
+)
+
+// audioMIMEs maps the accepted audio extensions to their MIME types.
+var audioMIMEs = map[string]string{
+	".mp3":  "audio/mpeg",
+	".m4a":  "audio/mp4",
+	".wav":  "audio/wav",
+	".aac":  "audio/aac",
+	".flac": "audio/flac",
+	".opus": "audio/ogg",
+}
+
+// AudioAsset is a voice memo to transcribe. Name is the asset's file name,
+// which some backends use to detect the format.
+type AudioAsset struct {
+	Name     string
+	MIMEType string
+	Data     []byte
+}
+
+// Transcriber turns spoken audio into text.
+type Transcriber interface {
+	// Enabled reports whether transcription is actually available.
+	Enabled() bool
+	// Transcribe returns the spoken text of audio, or "" when there is no
+	// speech or the transcriber is disabled.
+	Transcribe(ctx context.Context, audio AudioAsset) (string, error)
+}
+
+// NewTranscriber builds the Transcriber for
+// config.Config.AITranscriptionProvider. Empty follows AIProvider when that is
+// Gemini; the OpenAI-compatible chat API takes no audio, so any other provider
+// leaves transcription disabled unless a whisper.cpp server is configured. As
+// with NewSuggester, a provider that lacks its credentials or endpoint yields a
+// disabled transcriber and an unknown name is an error.
+func NewTranscriber(ctx context.Context, logger *slog.Logger, cfg *config.Config) (Transcriber, error) {
+	provider := strings.ToLower(strings.TrimSpace(cfg.AITranscriptionProvider))
+	if provider == "" {
+		provider = strings.ToLower(strings.TrimSpace(cfg.AIProvider))
+		if provider != "" && provider != ProviderGemini {
+			provider = ProviderNone
+		}
+	}
+	switch provider {
+	case "", ProviderGemini:
+		return newGeminiTranscriber(ctx, logger, cfg.AITranscriptionModel)
+	case ProviderWhisper:
+		return newWhisperTranscriber(logger, cfg.AITranscriptionURL), nil
+	case ProviderNone:
+		logger.Info("Voice memo transcription disabled by configuration")
+		return disabledTranscriber{}, nil
+	default:
+		return nil, fmt.Errorf("unknown ai_transcription_provider %q (want %s, %s or %s)",
+			cfg.AITranscriptionProvider, ProviderGemini, ProviderWhisper, ProviderNone)
+	}
+}
+
+// NewDisabledTranscriber returns a Transcriber that is always disabled.
+func NewDisabledTranscriber() Transcriber { return disabledTranscriber{} }
+
+type disabledTranscriber struct{}
+
+func (disabledTranscriber) Enabled() bool { return false }
+
+func (disabledTranscriber) Transcribe(context.Context, AudioAsset) (string, error) {
+	return "", nil
+}
+
+// IsAudioFile reports whether name has one of the accepted audio extensions.
+func IsAudioFile(name string) bool {
+	_, ok := audioMIMEs[strings.ToLower(filepath.Ext(name))]
+	return ok
+}
+
+// LoadAudioAsset reads one audio file of the family's asset directory. It
+// fails with ErrUnsupportedAudio for names that are not audio or escape the
+// directory, ErrAudioTooLarge above maxAudioBytes, and an os.ErrNotExist error
+// for a missing file.
+func LoadAudioAsset(dataPath, familyID, name string) (AudioAsset, error) {
+	clean := filepath.Clean(name)
+	mime, ok := audioMIMEs[strings.ToLower(filepath.Ext(clean))]
+	if !ok || strings.Contains(clean, "..") {
+		return AudioAsset{}, ErrUnsupportedAudio
+	}
+
+	path := filepath.Join(dataPath, config.AssetsDirName, familyID, clean)
+	info, err := os.Stat(path)
+	if err != nil {
+		return AudioAsset{}, err
+	}
+	if info.Size() > maxAudioBytes {
+		return AudioAsset{}, ErrAudioTooLarge
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return AudioAsset{}, err
+	}
+	return AudioAsset{Name: clean, MIMEType: mime, Data: data}, nil
+}
+
+// buildTranscriptionPrompt is the instruction sent with the audio to a
+// general-purpose model.
+func buildTranscriptionPrompt() string {
+	var b strings.Builder
+	b.WriteString("Transcribe this voice memo for a personal diary.\n\n")
+	b.WriteString("Rules:\n")
+	b.WriteString("- Write down what is said, in the language it is spoken in.\n")
+	b.WriteString("- Add punctuation and paragraph breaks; drop filler words like \"um\".\n")
+	b.WriteString("- Plain text only: no timestamps, speaker labels, headings or markdown.\n")
+	b.WriteString("- If nothing is said, answer with nothing.\n")
+	return b.String()
+}
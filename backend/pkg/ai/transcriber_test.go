@@ -0,0 +1,102 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+)
+
+func TestWhisperTranscribe(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/inference" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if atomic.AddInt32(&calls, 1) == 1 {
+			http.Error(w, "loading model", http.StatusServiceUnavailable)
+			return
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("no file part: %v", err)
+		}
+		data, _ := io.ReadAll(file)
+		if header.Filename != "memo.m4a" || string(data) != "audio" || r.FormValue("response_format") != "json" {
+			t.Errorf("unexpected form: %q %q %q", header.Filename, data, r.FormValue("response_format"))
+		}
+		_, _ = io.WriteString(w, `{"text":"  Went to the market today.\n"}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	tr := newWhisperTranscriber(discardLogger(), srv.URL+"/")
+	got, err := tr.Transcribe(context.Background(), AudioAsset{Name: "memo.m4a", MIMEType: "audio/mp4", Data: []byte("audio")})
+	if err != nil || got != "Went to the market today." || calls != 2 {
+		t.Fatalf("got %q, %v after %d calls", got, err, calls)
+	}
+}
+
+func TestWhisperReportsServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `{"error":"failed to read audio data"}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	if _, err := newWhisperTranscriber(discardLogger(), srv.URL).Transcribe(context.Background(), AudioAsset{}); err == nil {
+		t.Fatal("want the server's error")
+	}
+}
+
+func TestNewTranscriberSelection(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name    string
+		cfg     config.Config
+		enabled bool
+	}{
+		{"openai chat provider has no audio", config.Config{AIProvider: ProviderOpenAI}, false},
+		{"whisper without url", config.Config{AITranscriptionProvider: ProviderWhisper}, false},
+		{"whisper", config.Config{AIProvider: ProviderOpenAI, AITranscriptionProvider: "Whisper", AITranscriptionURL: "http://x"}, true},
+		{"none", config.Config{AITranscriptionProvider: ProviderNone}, false},
+	} {
+		tr, err := NewTranscriber(ctx, discardLogger(), &tc.cfg)
+		if err != nil || tr.Enabled() != tc.enabled {
+			t.Errorf("%s: enabled=%v, err=%v", tc.name, tr != nil && tr.Enabled(), err)
+		}
+	}
+	if _, err := NewTranscriber(ctx, discardLogger(), &config.Config{AITranscriptionProvider: "siri"}); err == nil {
+		t.Error("unknown provider must be an error")
+	}
+}
+
+func TestLoadAudioAsset(t *testing.T) {
+	dataPath := t.TempDir()
+	dir := filepath.Join(dataPath, config.AssetsDirName, "fam")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "memo.MP3"), []byte("id3"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	audio, err := LoadAudioAsset(dataPath, "fam", "memo.MP3")
+	if err != nil || audio.MIMEType != "audio/mpeg" || string(audio.Data) != "id3" {
+		t.Fatalf("got %+v, %v", audio, err)
+	}
+	if _, err := LoadAudioAsset(dataPath, "fam", "photo.jpg"); !errors.Is(err, ErrUnsupportedAudio) {
+		t.Errorf("image: %v", err)
+	}
+	if _, err := LoadAudioAsset(dataPath, "fam", "../other/memo.mp3"); !errors.Is(err, ErrUnsupportedAudio) {
+		t.Errorf("escaping path: %v", err)
+	}
+	if _, err := LoadAudioAsset(dataPath, "fam", "gone.wav"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("missing file: %v", err)
+	}
+}
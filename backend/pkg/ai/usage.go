@@ -0,0 +1,328 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+// ErrBudgetExhausted is returned by a metered Suggester, Embedder or
+// Transcriber, and by the capabilities built from the Suggester, instead of
+// calling the model once the family has used up its monthly token allowance.
+var ErrBudgetExhausted = errors.New("AI budget exhausted")
+
+// UsageMonthFormat is the layout of models.AIUsage.Month.
+const UsageMonthFormat = "2006-01"
+
+// UsageStore persists the per-family monthly usage counters;
+// database.Storage implements it.
+type UsageStore interface {
+	// AddAIUsage adds usage's counters to the family's row of usage.Month.
+	AddAIUsage(usage *models.AIUsage) error
+	// GetAIUsage returns the family's usage of month (zero counters when none).
+	GetAIUsage(familyID uuid.UUID, month string) (*models.AIUsage, error)
+}
+
+type familyKey struct{}
+
+// WithFamily marks ctx as a model call made on behalf of familyID, so a
+// metered Suggester can check its budget and record its usage.
+func WithFamily(ctx context.Context, familyID uuid.UUID) context.Context {
+	return context.WithValue(ctx, familyKey{}, familyID)
+}
+
+func familyFromContext(ctx context.Context) (uuid.UUID, bool) {
+	familyID, ok := ctx.Value(familyKey{}).(uuid.UUID)
+	return familyID, ok
+}
+
+// usage is what one or more model calls consumed.
+type usage struct {
+	requests     int64
+	inputTokens  int64
+	outputTokens int64
+	images       int64
+}
+
+type usageKey struct{}
+
+// withUsageCollector returns a context whose model calls add their usage to
+// the returned counters.
+func withUsageCollector(ctx context.Context) (context.Context, *usage) {
+	u := &usage{}
+	return context.WithValue(ctx, usageKey{}, u), u
+}
+
+// addUsage records one answered model call; a no-op unless the call runs under
+// withUsageCollector.
+func addUsage(ctx context.Context, inputTokens, outputTokens int64, images int) {
+	if u, ok := ctx.Value(usageKey{}).(*usage); ok {
+		u.requests++
+		u.inputTokens += inputTokens
+		u.outputTokens += outputTokens
+		u.images += int64(images)
+	}
+}
+
+// BudgetExhausted reports whether u has reached monthlyTokens (0 = unlimited).
+func BudgetExhausted(u *models.AIUsage, monthlyTokens int64) bool {
+	return monthlyTokens > 0 && u.InputTokens+u.OutputTokens >= monthlyTokens
+}
+
+// usageMeter checks a family's monthly token budget before a model call and
+// records what the call consumed afterwards. Every capability of a metered
+// Suggester shares one meter, so the budget caps all of a family's AI use.
+type usageMeter struct {
+	store         UsageStore
+	monthlyTokens int64
+	logger        *slog.Logger
+	now           func() time.Time
+}
+
+// run performs call on behalf of the family in ctx (see WithFamily), or
+// returns ErrBudgetExhausted without calling it once the budget is spent.
+// Calls without a family pass straight through.
+func (m *usageMeter) run(ctx context.Context, call func(ctx context.Context) error) error {
+	familyID, ok := familyFromContext(ctx)
+	if !ok {
+		return call(ctx)
+	}
+
+	month := m.now().UTC().Format(UsageMonthFormat)
+	current, err := m.store.GetAIUsage(familyID, month)
+	if err != nil {
+		return err
+	}
+	if BudgetExhausted(current, m.monthlyTokens) {
+		return ErrBudgetExhausted
+	}
+
+	ctx, used := withUsageCollector(ctx)
+	err = call(ctx)
+	if used.requests > 0 {
+		if rerr := m.store.AddAIUsage(&models.AIUsage{
+			FamilyID:     familyID,
+			Month:        month,
+			Requests:     used.requests,
+			InputTokens:  used.inputTokens,
+			OutputTokens: used.outputTokens,
+			Images:       used.images,
+		}); rerr != nil {
+			// The call has been paid for already; losing the record must not
+			// lose the answer.
+			m.logger.Error("Failed to record AI usage", "error", rerr, "familyID", familyID)
+		}
+	}
+	return err
+}
+
+// meteredSuggester records the usage of every tag suggestion made for a family
+// (see WithFamily) and refuses to call the model once the family's monthly
+// token budget is spent. Calls without a family pass straight through.
+type meteredSuggester struct {
+	Suggester
+	meter *usageMeter
+}
+
+// NewMeteredSuggester wraps s with per-family usage accounting and a monthly
+// token budget (0 = unlimited). NewSummarizer and the other capability
+// constructors apply the same meter to the capabilities they return.
+func NewMeteredSuggester(s Suggester, store UsageStore, monthlyTokens int64, logger *slog.Logger) Suggester {
+	return &meteredSuggester{Suggester: s, meter: newUsageMeter(store, monthlyTokens, logger)}
+}
+
+func newUsageMeter(store UsageStore, monthlyTokens int64, logger *slog.Logger) *usageMeter {
+	return &usageMeter{
+		store:         store,
+		monthlyTokens: monthlyTokens,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// Unwrap returns the wrapped Suggester.
+func (m *meteredSuggester) Unwrap() Suggester { return m.Suggester }
+
+func (m *meteredSuggester) SuggestTags(
+	ctx context.Context, title, body string, images []ImageAsset, knownTags []string, policy TagPolicy,
+) ([]TagSuggestion, error) {
+	if !m.Enabled() {
+		return m.Suggester.SuggestTags(ctx, title, body, images, knownTags, policy)
+	}
+
+	var suggestions []TagSuggestion
+	err := m.meter.run(ctx, func(ctx context.Context) error {
+		var err error
+		suggestions, err = m.Suggester.SuggestTags(ctx, title, body, images, knownTags, policy)
+		return err
+	})
+	return suggestions, err
+}
+
+// meteredSummarizer is a Summarizer whose calls go through a usage meter.
+type meteredSummarizer struct {
+	Summarizer
+	meter *usageMeter
+}
+
+func (m *meteredSummarizer) SummarizeEntry(ctx context.Context, title, body string) (string, error) {
+	var summary string
+	err := m.meter.run(ctx, func(ctx context.Context) error {
+		var err error
+		summary, err = m.Summarizer.SummarizeEntry(ctx, title, body)
+		return err
+	})
+	return summary, err
+}
+
+func (m *meteredSummarizer) SummarizePeriod(ctx context.Context, label string, entries []EntryText) (string, error) {
+	var summary string
+	err := m.meter.run(ctx, func(ctx context.Context) error {
+		var err error
+		summary, err = m.Summarizer.SummarizePeriod(ctx, label, entries)
+		return err
+	})
+	return summary, err
+}
+
+// meteredAnswerer is an Answerer whose calls go through a usage meter.
+type meteredAnswerer struct {
+	Answerer
+	meter *usageMeter
+}
+
+func (m *meteredAnswerer) Answer(ctx context.Context, question string, entries []EntryText) (Answer, error) {
+	var answer Answer
+	err := m.meter.run(ctx, func(ctx context.Context) error {
+		var err error
+		answer, err = m.Answerer.Answer(ctx, question, entries)
+		return err
+	})
+	return answer, err
+}
+
+// meteredCaptioner is a Captioner whose calls go through a usage meter.
+type meteredCaptioner struct {
+	Captioner
+	meter *usageMeter
+}
+
+func (m *meteredCaptioner) CaptionImage(ctx context.Context, image ImageAsset) (string, error) {
+	var caption string
+	err := m.meter.run(ctx, func(ctx context.Context) error {
+		var err error
+		caption, err = m.Captioner.CaptionImage(ctx, image)
+		return err
+	})
+	return caption, err
+}
+
+// meteredTagClusterer is a TagClusterer whose calls go through a usage meter.
+type meteredTagClusterer struct {
+	TagClusterer
+	meter *usageMeter
+}
+
+func (m *meteredTagClusterer) ClusterTags(ctx context.Context, tags []string) ([][]string, error) {
+	var groups [][]string
+	err := m.meter.run(ctx, func(ctx context.Context) error {
+		var err error
+		groups, err = m.TagClusterer.ClusterTags(ctx, tags)
+		return err
+	})
+	return groups, err
+}
+
+// meteredEmbedder is an Embedder whose calls go through a usage meter.
+type meteredEmbedder struct {
+	Embedder
+	meter *usageMeter
+}
+
+// NewMeteredEmbedder wraps e with the same per-family usage accounting and
+// monthly token budget as NewMeteredSuggester.
+func NewMeteredEmbedder(e Embedder, store UsageStore, monthlyTokens int64, logger *slog.Logger) Embedder {
+	if !e.Enabled() {
+		return e
+	}
+	return &meteredEmbedder{Embedder: e, meter: newUsageMeter(store, monthlyTokens, logger)}
+}
+
+func (m *meteredEmbedder) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+	var vectors [][]float32
+	err := m.meter.run(ctx, func(ctx context.Context) error {
+		var err error
+		vectors, err = m.Embedder.EmbedDocuments(ctx, texts)
+		return err
+	})
+	return vectors, err
+}
+
+func (m *meteredEmbedder) EmbedQuery(ctx context.Context, query string) ([]float32, error) {
+	var vector []float32
+	err := m.meter.run(ctx, func(ctx context.Context) error {
+		var err error
+		vector, err = m.Embedder.EmbedQuery(ctx, query)
+		return err
+	})
+	return vector, err
+}
+
+// meteredTranscriber is a Transcriber whose calls go through a usage meter.
+type meteredTranscriber struct {
+	Transcriber
+	meter *usageMeter
+}
+
+// NewMeteredTranscriber wraps t with the same per-family usage accounting and
+// monthly token budget as NewMeteredSuggester.
+func NewMeteredTranscriber(
+	t Transcriber, store UsageStore, monthlyTokens int64, logger *slog.Logger,
+) Transcriber {
+	if !t.Enabled() {
+		return t
+	}
+	return &meteredTranscriber{Transcriber: t, meter: newUsageMeter(store, monthlyTokens, logger)}
+}
+
+func (m *meteredTranscriber) Transcribe(ctx context.Context, audio AudioAsset) (string, error) {
+	var transcript string
+	err := m.meter.run(ctx, func(ctx context.Context) error {
+		var err error
+		transcript, err = m.Transcriber.Transcribe(ctx, audio)
+		return err
+	})
+	return transcript, err
+}
+
+// unwrap strips usage metering and caching so the provider's other
+// capabilities are visible to type assertions.
+func unwrap(s Suggester) Suggester {
+	for {
+		u, ok := s.(interface{ Unwrap() Suggester })
+		if !ok {
+			return s
+		}
+		s = u.Unwrap()
+	}
+}
+
+// meterOf returns the usage meter s is wrapped in, or nil when it is not
+// metered.
+func meterOf(s Suggester) *usageMeter {
+	for {
+		if m, ok := s.(*meteredSuggester); ok {
+			return m.meter
+		}
+		u, ok := s.(interface{ Unwrap() Suggester })
+		if !ok {
+			return nil
+		}
+		s = u.Unwrap()
+	}
+}
@@ -0,0 +1,253 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+// memUsageStore is an in-memory UsageStore.
+type memUsageStore struct {
+	rows map[string]models.AIUsage
+}
+
+func (m *memUsageStore) AddAIUsage(u *models.AIUsage) error {
+	if m.rows == nil {
+		m.rows = map[string]models.AIUsage{}
+	}
+	key := u.FamilyID.String() + "/" + u.Month
+	row := m.rows[key]
+	row.FamilyID, row.Month = u.FamilyID, u.Month
+	row.Requests += u.Requests
+	row.InputTokens += u.InputTokens
+	row.OutputTokens += u.OutputTokens
+	row.Images += u.Images
+	m.rows[key] = row
+	return nil
+}
+
+func (m *memUsageStore) GetAIUsage(familyID uuid.UUID, month string) (*models.AIUsage, error) {
+	row, ok := m.rows[familyID.String()+"/"+month]
+	if !ok {
+		row = models.AIUsage{FamilyID: familyID, Month: month}
+	}
+	return &row, nil
+}
+
+func (m *memUsageStore) only(t *testing.T) models.AIUsage {
+	t.Helper()
+	if len(m.rows) != 1 {
+		t.Fatalf("want one usage row, got %v", m.rows)
+	}
+	for _, row := range m.rows {
+		return row
+	}
+	return models.AIUsage{}
+}
+
+func usageServer(t *testing.T) (string, *int32) {
+	t.Helper()
+	srv, calls := chatServer(t, func(w http.ResponseWriter, _ map[string]any, _ int) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{{
+				"message":       map[string]any{"role": "assistant", "content": `{"tags":[{"name":"beach","confidence":0.9}]}`},
+				"finish_reason": "stop",
+			}},
+			"usage": map[string]any{"prompt_tokens": 120, "completion_tokens": 30},
+		})
+	})
+	return srv.URL + "/v1", calls
+}
+
+func TestMeteredSuggesterRecordsUsage(t *testing.T) {
+	url, _ := usageServer(t)
+	store := &memUsageStore{}
+	s := NewMeteredSuggester(newOpenAISuggester(discardLogger(), url, "vision", ""), store, 0, discardLogger())
+
+	ctx := WithFamily(context.Background(), uuid.New())
+	images := []ImageAsset{{MIMEType: "image/png", Data: []byte("png")}}
+	for range 2 {
+		if _, err := s.SuggestTags(ctx, "Beach", "Sand", images, nil, TagPolicy{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := store.only(t)
+	if got.Requests != 2 || got.InputTokens != 240 || got.OutputTokens != 60 || got.Images != 2 {
+		t.Fatalf("unexpected usage %+v", got)
+	}
+}
+
+func TestMeteredSuggesterRefusesOverBudget(t *testing.T) {
+	url, calls := usageServer(t)
+	store := &memUsageStore{}
+	s := NewMeteredSuggester(newOpenAISuggester(discardLogger(), url, "vision", ""), store, 200, discardLogger())
+	ctx := WithFamily(context.Background(), uuid.New())
+
+	// The first call (150 tokens) is under the cap, the second reaches it.
+	for range 2 {
+		if _, err := s.SuggestTags(ctx, "Beach", "", nil, nil, TagPolicy{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.SuggestTags(ctx, "Beach", "", nil, nil, TagPolicy{}); !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("want ErrBudgetExhausted, got %v", err)
+	}
+	if *calls != 2 {
+		t.Fatalf("model called %d times, want 2", *calls)
+	}
+
+	// Another family has its own budget.
+	if _, err := s.SuggestTags(WithFamily(context.Background(), uuid.New()), "Beach", "", nil, nil, TagPolicy{}); err != nil {
+		t.Fatalf("other family refused: %v", err)
+	}
+}
+
+func TestMeteredSuggesterWithoutFamilyIsNotMetered(t *testing.T) {
+	url, _ := usageServer(t)
+	store := &memUsageStore{}
+	s := NewMeteredSuggester(newOpenAISuggester(discardLogger(), url, "vision", ""), store, 1, discardLogger())
+
+	if _, err := s.SuggestTags(context.Background(), "Beach", "", nil, nil, TagPolicy{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(store.rows) != 0 {
+		t.Fatalf("usage recorded without a family: %v", store.rows)
+	}
+}
+
+func TestMeteredSuggesterKeepsCapabilities(t *testing.T) {
+	url, _ := usageServer(t)
+	s := NewMeteredSuggester(newOpenAISuggester(discardLogger(), url, "vision", ""), &memUsageStore{}, 0, discardLogger())
+	if !NewSummarizer(s).Enabled() || !NewCaptioner(s).Enabled() || !NewAnswerer(s).Enabled() {
+		t.Fatal("metering must not hide the provider's other capabilities")
+	}
+}
+
+func TestMeteredCapabilitiesRecordUsage(t *testing.T) {
+	url, _ := usageServer(t)
+	store := &memUsageStore{}
+	s := NewMeteredSuggester(newOpenAISuggester(discardLogger(), url, "vision", ""), store, 0, discardLogger())
+	ctx := WithFamily(context.Background(), uuid.New())
+
+	entries := []EntryText{{Date: "2024-03-01", Title: "Beach", Body: "Sand"}}
+	if _, err := NewSummarizer(s).SummarizePeriod(ctx, "March 2024", entries); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewAnswerer(s).Answer(ctx, "Where was I?", entries); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewCaptioner(s).CaptionImage(ctx, ImageAsset{MIMEType: "image/png", Data: []byte("png")}); err != nil {
+		t.Fatal(err)
+	}
+
+	got := store.only(t)
+	if got.Requests != 3 || got.InputTokens != 360 || got.OutputTokens != 90 || got.Images != 1 {
+		t.Fatalf("unexpected usage %+v", got)
+	}
+}
+
+func TestMeteredCapabilitiesShareTheBudget(t *testing.T) {
+	url, calls := usageServer(t)
+	store := &memUsageStore{}
+	s := NewMeteredSuggester(newOpenAISuggester(discardLogger(), url, "vision", ""), store, 200, discardLogger())
+	ctx := WithFamily(context.Background(), uuid.New())
+
+	// Two tag suggestions (300 tokens) spend the budget.
+	for range 2 {
+		if _, err := s.SuggestTags(ctx, "Beach", "", nil, nil, TagPolicy{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries := []EntryText{{Date: "2024-03-01", Title: "Beach", Body: "Sand"}}
+	if _, err := NewSummarizer(s).SummarizePeriod(ctx, "March 2024", entries); !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("recap: want ErrBudgetExhausted, got %v", err)
+	}
+	if _, err := NewAnswerer(s).Answer(ctx, "Where was I?", entries); !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("ask: want ErrBudgetExhausted, got %v", err)
+	}
+	if _, err := NewTagClusterer(s).ClusterTags(ctx, []string{"car", "auto"}); !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("clustering: want ErrBudgetExhausted, got %v", err)
+	}
+	if *calls != 2 {
+		t.Fatalf("model called %d times, want 2", *calls)
+	}
+}
+
+func TestMeteredEmbedderRecordsUsageAndRefusesOverBudget(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"index":0,"embedding":[1,0]}],"usage":{"prompt_tokens":120}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	store := &memUsageStore{}
+	e := NewMeteredEmbedder(newOpenAIEmbedder(discardLogger(), srv.URL+"/v1", "nomic-embed-text", ""),
+		store, 200, discardLogger())
+	ctx := WithFamily(context.Background(), uuid.New())
+
+	if _, err := e.EmbedDocuments(ctx, []string{"beach"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.EmbedQuery(ctx, "sand"); err != nil {
+		t.Fatal(err)
+	}
+	if got := store.only(t); got.Requests != 2 || got.InputTokens != 240 || got.OutputTokens != 0 {
+		t.Fatalf("unexpected usage %+v", got)
+	}
+	if _, err := e.EmbedQuery(ctx, "sand"); !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("want ErrBudgetExhausted, got %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("model called %d times, want 2", calls)
+	}
+}
+
+func TestMeteredTranscriberRefusesOverBudget(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = io.WriteString(w, `{"text":"Went to the market today."}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	familyID := uuid.New()
+	store := &memUsageStore{}
+	tr := NewMeteredTranscriber(newWhisperTranscriber(discardLogger(), srv.URL), store, 200, discardLogger())
+	ctx := WithFamily(context.Background(), familyID)
+	memo := AudioAsset{Name: "memo.m4a", MIMEType: "audio/mp4", Data: []byte("audio")}
+
+	if _, err := tr.Transcribe(ctx, memo); err != nil {
+		t.Fatal(err)
+	}
+	if got := store.only(t); got.Requests != 1 {
+		t.Fatalf("unexpected usage %+v", got)
+	}
+
+	// Tokens spent by other capabilities exhaust the transcriber's budget too.
+	if err := store.AddAIUsage(&models.AIUsage{
+		FamilyID: familyID, Month: time.Now().UTC().Format(UsageMonthFormat), InputTokens: 200,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tr.Transcribe(ctx, memo); !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("want ErrBudgetExhausted, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("model called %d times, want 1", calls)
+	}
+}
@@ -0,0 +1,102 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+// whisperTranscriber is a Transcriber backed by the HTTP server of whisper.cpp
+// (examples/server), which keeps the audio in the house. Start it with
+// --convert so formats other than WAV are converted with ffmpeg.
+type whisperTranscriber struct {
+	httpClient *http.Client
+	baseURL    string
+	logger     *slog.Logger
+}
+
+// newWhisperTranscriber builds the whisper.cpp Transcriber; without a server
+// URL it returns a disabled transcriber.
+func newWhisperTranscriber(logger *slog.Logger, baseURL string) Transcriber {
+	if baseURL == "" {
+		logger.Info("Voice memo transcription disabled: ai_transcription_url is required for the whisper provider")
+		return disabledTranscriber{}
+	}
+	return &whisperTranscriber{
+		httpClient: &http.Client{Timeout: openAIRequestTimeout},
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		logger:     logger,
+	}
+}
+
+func (w *whisperTranscriber) Enabled() bool { return true }
+
+func (w *whisperTranscriber) Transcribe(ctx context.Context, audio AudioAsset) (string, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", audio.Name)
+	if err != nil {
+		return "", fmt.Errorf("encoding whisper request: %w", err)
+	}
+	if _, err := part.Write(audio.Data); err != nil {
+		return "", fmt.Errorf("encoding whisper request: %w", err)
+	}
+	_ = form.WriteField("response_format", "json")
+	_ = form.WriteField("temperature", "0.0")
+	if err := form.Close(); err != nil {
+		return "", fmt.Errorf("encoding whisper request: %w", err)
+	}
+
+	text, err := retryTransient(ctx, w.logger, func() (string, error) {
+		return w.inference(ctx, body.Bytes(), form.FormDataContentType())
+	})
+	if err != nil {
+		return "", fmt.Errorf("whisper transcription: %w", err)
+	}
+	// whisper.cpp reports no token counts, so only the request is counted.
+	addUsage(ctx, 0, 0, 0)
+	return strings.TrimSpace(text), nil
+}
+
+// inference performs one /inference call.
+func (w *whisperTranscriber) inference(ctx context.Context, payload []byte, contentType string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.baseURL+"/inference", bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", &httpStatusError{
+			Code:       resp.StatusCode,
+			Message:    strings.TrimSpace(string(msg)),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	var out struct {
+		Text  string `json:"text"`
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("decoding /inference response: %w", err)
+	}
+	if out.Error != "" {
+		// whisper.cpp reports some failures (e.g. unreadable audio) with 200.
+		return "", fmt.Errorf("whisper server: %s", out.Error)
+	}
+	return out.Text, nil
+}
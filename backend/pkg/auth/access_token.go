@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	kinauth "github.com/ya-breeze/kin-core/auth"
+)
+
+// AccessTokenTTL is how long an access token issued at sign-in or refresh
+// stays valid.
+const AccessTokenTTL = 15 * time.Minute
+
+// GenerateAccessToken issues a kin-core access token like
+// kinauth.GenerateAccessToken, but with a random token ID. Without it two
+// logins of the same user within one second produce identical tokens, and
+// sessions are told apart by their access token.
+func GenerateAccessToken(userID uuid.UUID, familyID *uuid.UUID, secret []byte, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := kinauth.Claims{
+		UserID:   userID,
+		FamilyID: familyID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+}
@@ -36,12 +36,16 @@ func GenerateRandomString(length int) string {
 }
 
 func CreateJWT(userID, issuer, secret string) (string, error) {
+	return createJWT(userID, issuer, secret, 24*time.Hour)
+}
+
+func createJWT(userID, issuer, secret string, ttl time.Duration) (string, error) {
 	signingKey := []byte(secret)
 
 	claims := &jwt.RegisteredClaims{
 		Issuer:    issuer,
 		Subject:   userID,
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"strings"
+	"time"
+)
+
+// Account lockout policy. Every failed login counts against the account name,
+// whether or not it exists, so a lockout reveals nothing about which accounts
+// are real. Once LockoutThreshold consecutive failures accumulate, each further
+// failure locks the account for twice as long as the previous one.
+const (
+	LockoutThreshold = 5
+	lockoutBase      = time.Minute
+	lockoutMax       = time.Hour
+	// lockoutResetAfter forgets old failures after a quiet period so an
+	// occasional typo never adds up to a lockout.
+	lockoutResetAfter = 24 * time.Hour
+)
+
+// LoginKey normalises a login name for failure tracking so that case
+// variants share one counter.
+func LoginKey(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+// LockoutDuration returns how long an account is locked after its n-th
+// consecutive failed login, or zero below the threshold.
+func LockoutDuration(failures int) time.Duration {
+	if failures < LockoutThreshold {
+		return 0
+	}
+	d := lockoutBase
+	for i := LockoutThreshold; i < failures; i++ {
+		d *= 2
+		if d >= lockoutMax {
+			return lockoutMax
+		}
+	}
+	return d
+}
+
+// RegisterLoginFailure applies a failed login at now to an account that had
+// failures consecutive failures, the last one at lastFailure. It returns the
+// new failure count and the time until which the account is locked (zero if
+// it is not).
+func RegisterLoginFailure(failures int, lastFailure, now time.Time) (int, time.Time) {
+	if now.Sub(lastFailure) > lockoutResetAfter {
+		failures = 0
+	}
+	failures++
+	if d := LockoutDuration(failures); d > 0 {
+		return failures, now.Add(d)
+	}
+	return failures, time.Time{}
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockoutDurationDoublesUpToCap(t *testing.T) {
+	cases := map[int]time.Duration{
+		1:  0,
+		4:  0,
+		5:  time.Minute,
+		6:  2 * time.Minute,
+		8:  8 * time.Minute,
+		10: 32 * time.Minute,
+		11: time.Hour,
+		50: time.Hour,
+	}
+	for failures, want := range cases {
+		if got := LockoutDuration(failures); got != want {
+			t.Errorf("LockoutDuration(%d) = %v, want %v", failures, got, want)
+		}
+	}
+}
+
+func TestRegisterLoginFailure(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	failures, until := RegisterLoginFailure(3, now.Add(-time.Minute), now)
+	if failures != 4 || !until.IsZero() {
+		t.Fatalf("below threshold: got %d, %v", failures, until)
+	}
+
+	failures, until = RegisterLoginFailure(failures, now, now)
+	if failures != 5 || !until.Equal(now.Add(time.Minute)) {
+		t.Fatalf("at threshold: got %d, %v", failures, until)
+	}
+
+	// A long quiet period starts the count over.
+	failures, until = RegisterLoginFailure(9, now.Add(-48*time.Hour), now)
+	if failures != 1 || !until.IsZero() {
+		t.Fatalf("after reset: got %d, %v", failures, until)
+	}
+}
+
+func TestLoginKeyFoldsCase(t *testing.T) {
+	if LoginKey(" Alice@Example.com ") != LoginKey("alice@example.com") {
+		t.Fatal("case and surrounding space variants must share a key")
+	}
+}
@@ -0,0 +1,339 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// OIDCStateTTL bounds how long the user may spend at the identity provider.
+	OIDCStateTTL     = 10 * time.Minute
+	oidcStateIssuer  = "diary-oidc-state"
+	oidcHTTPTimeout  = 10 * time.Second
+	oidcMaxBodyBytes = 1 << 20
+)
+
+var (
+	ErrOIDCInvalidState   = errors.New("invalid OIDC state")
+	ErrOIDCInvalidIDToken = errors.New("invalid OIDC ID token")
+)
+
+// OIDCClaims are the verified claims of an ID token. Raw keeps every claim so
+// the account mapping claim is configurable.
+type OIDCClaims struct {
+	Subject string
+	Raw     map[string]any
+}
+
+// StringClaim returns a string-valued claim, or "" if absent.
+func (c OIDCClaims) StringClaim(name string) string {
+	v, _ := c.Raw[name].(string)
+	return v
+}
+
+// OIDCFlowState is carried between the login redirect and the callback in a
+// signed cookie, so no server-side session store is needed.
+type OIDCFlowState struct {
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"cv"`
+	Redirect     string `json:"redirect,omitempty"`
+	jwt.RegisteredClaims
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// OIDCProvider implements the relying-party side of the OpenID Connect
+// authorization-code flow with PKCE. Discovery and signing keys are fetched
+// lazily and cached; unknown key IDs trigger a JWKS refresh to follow key
+// rotation.
+type OIDCProvider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       []string
+	httpClient   *http.Client
+
+	mu        sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]*rsa.PublicKey
+}
+
+// NewOIDCProvider creates a provider for the given issuer and client registration.
+func NewOIDCProvider(issuer, clientID, clientSecret, redirectURL string, scopes []string) *OIDCProvider {
+	return &OIDCProvider{
+		issuer:       issuer,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		scopes:       scopes,
+		httpClient:   &http.Client{Timeout: oidcHTTPTimeout},
+	}
+}
+
+// NewOIDCFlowState generates fresh state, nonce and PKCE verifier values.
+func NewOIDCFlowState(redirect string) (OIDCFlowState, error) {
+	state, err := RandomURLString(24)
+	if err != nil {
+		return OIDCFlowState{}, err
+	}
+	nonce, err := RandomURLString(24)
+	if err != nil {
+		return OIDCFlowState{}, err
+	}
+	verifier, err := RandomURLString(48)
+	if err != nil {
+		return OIDCFlowState{}, err
+	}
+	return OIDCFlowState{State: state, Nonce: nonce, CodeVerifier: verifier, Redirect: redirect}, nil
+}
+
+// PKCEChallenge derives the S256 code challenge for a verifier (RFC 7636).
+func PKCEChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// SignOIDCFlowState serialises the flow state into a short-lived signed token.
+func SignOIDCFlowState(st OIDCFlowState, secret string) (string, error) {
+	st.RegisteredClaims = jwt.RegisteredClaims{
+		Issuer:    oidcStateIssuer,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(OIDCStateTTL)),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, st).SignedString([]byte(oidcStateKey(secret)))
+}
+
+// ParseOIDCFlowState verifies a token produced by SignOIDCFlowState.
+func ParseOIDCFlowState(token, secret string) (OIDCFlowState, error) {
+	var st OIDCFlowState
+	_, err := jwt.ParseWithClaims(token, &st, func(*jwt.Token) (any, error) {
+		return []byte(oidcStateKey(secret)), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(oidcStateIssuer))
+	if err != nil {
+		return OIDCFlowState{}, fmt.Errorf("%w: %w", ErrOIDCInvalidState, err)
+	}
+	return st, nil
+}
+
+func oidcStateKey(secret string) string {
+	return secret + "|" + oidcStateIssuer
+}
+
+// AuthCodeURL returns the identity provider URL to send the browser to.
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, st OIDCFlowState) (string, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", p.clientID)
+	q.Set("redirect_uri", p.redirectURL)
+	q.Set("scope", strings.Join(p.scopes, " "))
+	q.Set("state", st.State)
+	q.Set("nonce", st.Nonce)
+	q.Set("code_challenge", PKCEChallenge(st.CodeVerifier))
+	q.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(d.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return d.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+// Exchange redeems an authorization code and returns the verified ID token claims.
+func (p *OIDCProvider) Exchange(ctx context.Context, code string, st OIDCFlowState) (*OIDCClaims, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.redirectURL)
+	form.Set("code_verifier", st.CodeVerifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(p.clientID), url.QueryEscape(p.clientSecret))
+
+	var tokenResp struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := p.doJSON(req, &tokenResp); err != nil {
+		return nil, fmt.Errorf("token exchange failed: %w", err)
+	}
+	if tokenResp.IDToken == "" {
+		return nil, fmt.Errorf("%w: token response has no id_token", ErrOIDCInvalidIDToken)
+	}
+	return p.verifyIDToken(ctx, tokenResp.IDToken, st.Nonce)
+}
+
+func (p *OIDCProvider) verifyIDToken(ctx context.Context, raw, nonce string) (*OIDCClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.getKey(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(p.issuer),
+		jwt.WithAudience(p.clientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidIDToken, err)
+	}
+	if got, _ := claims["nonce"].(string); got != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrOIDCInvalidIDToken)
+	}
+	sub, _ := claims.GetSubject()
+	if sub == "" {
+		return nil, fmt.Errorf("%w: missing sub", ErrOIDCInvalidIDToken)
+	}
+	return &OIDCClaims{Subject: sub, Raw: claims}, nil
+}
+
+func (p *OIDCProvider) getDiscovery(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(p.issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	var d oidcDiscovery
+	if err := p.doJSON(req, &d); err != nil {
+		return nil, fmt.Errorf("OIDC discovery failed: %w", err)
+	}
+	// OpenID Connect Discovery 1.0 §4.3: the issuer must match exactly.
+	if d.Issuer != p.issuer {
+		return nil, fmt.Errorf("OIDC discovery issuer mismatch: %q != %q", d.Issuer, p.issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, errors.New("OIDC discovery document is missing endpoints")
+	}
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+func (p *OIDCProvider) getKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	key, ok := p.keys[kid]
+	p.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := p.fetchJWKS(ctx, d.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	// A provider publishing a single key may omit kid from the token header.
+	if kid == "" && len(keys) == 1 {
+		for _, k := range keys {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (p *OIDCProvider) fetchJWKS(ctx context.Context, uri string) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := p.doJSON(req, &set); err != nil {
+		return nil, fmt.Errorf("JWKS fetch failed: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return keys, nil
+}
+
+func (p *OIDCProvider) doJSON(req *http.Request, out any) error {
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, oidcMaxBodyBytes))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, out)
+}
+
+// RandomURLString returns n cryptographically random bytes, base64url-encoded.
+func RandomURLString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to read random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
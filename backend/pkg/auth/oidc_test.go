@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/auth/oidctest"
+)
+
+const testRedirect = "http://diary.test/web/oidc/callback"
+
+func newTestOIDC(t *testing.T) (*oidctest.Provider, *OIDCProvider) {
+	t.Helper()
+	idp := oidctest.New("diary", "s3cret")
+	t.Cleanup(idp.Close)
+	return idp, NewOIDCProvider(idp.Issuer, "diary", "s3cret", testRedirect, []string{"openid", "email"})
+}
+
+// login runs the redirect → approve → exchange round trip.
+func login(t *testing.T, idp *oidctest.Provider, rp *OIDCProvider, tamper func(*OIDCFlowState)) (*OIDCClaims, error) {
+	t.Helper()
+	st, err := NewOIDCFlowState("/")
+	if err != nil {
+		t.Fatalf("NewOIDCFlowState: %v", err)
+	}
+	authURL, err := rp.AuthCodeURL(context.Background(), st)
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	if !strings.Contains(authURL, "code_challenge_method=S256") {
+		t.Fatalf("authorization URL lacks PKCE: %s", authURL)
+	}
+	callback, err := idp.Approve(authURL)
+	if err != nil {
+		t.Fatalf("Approve: %v", err)
+	}
+	if callback.Query().Get("state") != st.State {
+		t.Fatalf("state not echoed back")
+	}
+	if tamper != nil {
+		tamper(&st)
+	}
+	return rp.Exchange(context.Background(), callback.Query().Get("code"), st)
+}
+
+func TestOIDCExchangeReturnsVerifiedClaims(t *testing.T) {
+	idp, rp := newTestOIDC(t)
+	idp.SetClaims(map[string]any{"email": "a@b.c", "email_verified": true})
+
+	claims, err := login(t, idp, rp, nil)
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if claims.Subject != "subject-1" || claims.StringClaim("email") != "a@b.c" {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+}
+
+func TestOIDCExchangeRejectsWrongVerifier(t *testing.T) {
+	idp, rp := newTestOIDC(t)
+	_, err := login(t, idp, rp, func(st *OIDCFlowState) { st.CodeVerifier = "tampered" })
+	if err == nil {
+		t.Fatal("exchange with a wrong PKCE verifier must fail")
+	}
+}
+
+func TestOIDCExchangeRejectsNonceMismatch(t *testing.T) {
+	idp, rp := newTestOIDC(t)
+	idp.SetNonce("replayed")
+	_, err := login(t, idp, rp, nil)
+	if !errors.Is(err, ErrOIDCInvalidIDToken) {
+		t.Fatalf("want ErrOIDCInvalidIDToken, got %v", err)
+	}
+}
+
+func TestOIDCExchangeRejectsForeignAudience(t *testing.T) {
+	idp, rp := newTestOIDC(t)
+	idp.SetAudience("another-client")
+	_, err := login(t, idp, rp, nil)
+	if !errors.Is(err, ErrOIDCInvalidIDToken) {
+		t.Fatalf("want ErrOIDCInvalidIDToken, got %v", err)
+	}
+}
+
+func TestOIDCFlowStateRoundTrip(t *testing.T) {
+	st, err := NewOIDCFlowState("/web/search")
+	if err != nil {
+		t.Fatalf("NewOIDCFlowState: %v", err)
+	}
+	signed, err := SignOIDCFlowState(st, "secret")
+	if err != nil {
+		t.Fatalf("SignOIDCFlowState: %v", err)
+	}
+	got, err := ParseOIDCFlowState(signed, "secret")
+	if err != nil || got.State != st.State || got.CodeVerifier != st.CodeVerifier || got.Redirect != "/web/search" {
+		t.Fatalf("round trip mismatch: %+v, %v", got, err)
+	}
+	if _, err := ParseOIDCFlowState(signed, "other"); !errors.Is(err, ErrOIDCInvalidState) {
+		t.Fatalf("state signed with another secret must be rejected, got %v", err)
+	}
+}
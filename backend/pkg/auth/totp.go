@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 default algorithm, required by authenticator apps
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// TOTPPeriod is the RFC 6238 time step.
+	TOTPPeriod = 30 * time.Second
+	// TOTPDigits is the number of digits in a generated code.
+	TOTPDigits = 6
+	// totpSkew is how many steps before/after "now" are still accepted, to
+	// tolerate clock drift between server and authenticator.
+	totpSkew = 1
+	// totpSecretBytes is 160 bits, the key size recommended by RFC 4226.
+	totpSecretBytes = 20
+
+	// RecoveryCodeCount is how many single-use recovery codes are issued at once.
+	RecoveryCodeCount = 10
+	recoveryCodeBytes = 5
+
+	// MFAChallengeIssuer marks the short-lived token handed out between the
+	// password step and the TOTP step of a login.
+	MFAChallengeIssuer = "diary-mfa"
+	// MFAChallengeTTL bounds how long a user has to type the second factor.
+	MFAChallengeTTL = 5 * time.Minute
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a new random base32-encoded TOTP secret.
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPStep returns the RFC 6238 counter for the given time.
+func TOTPStep(t time.Time) int64 {
+	return t.Unix() / int64(TOTPPeriod/time.Second)
+}
+
+// TOTPCode computes the code for the given secret and counter (RFC 4226 HOTP).
+func TOTPCode(secret string, step int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step)) //nolint:gosec // step is a positive unix counter
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for range TOTPDigits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", TOTPDigits, value%mod), nil
+}
+
+// ValidateTOTP checks code against the secret at time t, allowing a small
+// clock skew. It returns the matched step so callers can reject replays of a
+// code that was already used; lastStep is the most recently accepted step
+// (0 if none) and any step at or before it is refused.
+func ValidateTOTP(secret, code string, t time.Time, lastStep int64) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+	now := TOTPStep(t)
+	for delta := int64(-totpSkew); delta <= totpSkew; delta++ {
+		step := now + delta
+		if step <= lastStep {
+			continue
+		}
+		expected, err := TOTPCode(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// TOTPProvisioningURI builds the otpauth:// URI that authenticator apps read
+// from a QR code.
+func TOTPProvisioningURI(issuer, account, secret string) string {
+	label := url.PathEscape(issuer + ":" + account)
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(TOTPDigits))
+	q.Set("period", fmt.Sprint(int(TOTPPeriod/time.Second)))
+	return "otpauth://totp/" + label + "?" + q.Encode()
+}
+
+// GenerateRecoveryCodes returns RecoveryCodeCount plain codes (to show the
+// user once) and their hashes (to store).
+func GenerateRecoveryCodes() (plain, hashed []string, err error) {
+	plain = make([]string, 0, RecoveryCodeCount)
+	hashed = make([]string, 0, RecoveryCodeCount)
+	for range RecoveryCodeCount {
+		b := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		code := hex.EncodeToString(b)
+		code = code[:5] + "-" + code[5:]
+		plain = append(plain, code)
+		hashed = append(hashed, HashRecoveryCode(code))
+	}
+	return plain, hashed, nil
+}
+
+// HashRecoveryCode normalises a recovery code and returns its SHA-256 hex
+// digest. Codes are high-entropy random values, so a fast hash is sufficient.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// ConsumeRecoveryCode looks code up among the stored hashes. On a match it
+// returns the remaining hashes with the used one removed.
+func ConsumeRecoveryCode(hashes []string, code string) ([]string, bool) {
+	h := HashRecoveryCode(code)
+	for i, stored := range hashes {
+		if subtle.ConstantTimeCompare([]byte(stored), []byte(h)) == 1 {
+			remaining := make([]string, 0, len(hashes)-1)
+			remaining = append(remaining, hashes[:i]...)
+			remaining = append(remaining, hashes[i+1:]...)
+			return remaining, true
+		}
+	}
+	return hashes, false
+}
+
+// CreateMFAChallenge issues a short-lived token proving the password step
+// succeeded for userID. It is exchanged for real tokens once the second
+// factor is verified.
+func CreateMFAChallenge(userID, secret string) (string, error) {
+	return createJWT(userID, MFAChallengeIssuer, mfaSigningKey(secret), MFAChallengeTTL)
+}
+
+// CheckMFAChallenge validates a token produced by CreateMFAChallenge and
+// returns the user ID it was issued for.
+func CheckMFAChallenge(token, secret string) (string, error) {
+	return CheckJWT(token, MFAChallengeIssuer, mfaSigningKey(secret))
+}
+
+// mfaSigningKey derives a separate key so a challenge can never be mistaken
+// for an access token signed with the main JWT secret.
+func mfaSigningKey(secret string) string {
+	return secret + "|" + MFAChallengeIssuer
+}
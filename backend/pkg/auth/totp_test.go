@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the RFC 6238 Appendix B SHA-1 key "12345678901234567890" in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCodeRFCVectors(t *testing.T) {
+	// RFC 6238 publishes 8-digit codes; the 6-digit code is the low 6 digits.
+	cases := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, want := range cases {
+		got, err := TOTPCode(rfcSecret, TOTPStep(time.Unix(unix, 0)))
+		if err != nil {
+			t.Fatalf("TOTPCode(%d): %v", unix, err)
+		}
+		if got != want {
+			t.Errorf("TOTPCode(%d) = %s, want %s", unix, got, want)
+		}
+	}
+}
+
+func TestValidateTOTPSkewAndReplay(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	prev, _ := TOTPCode(rfcSecret, TOTPStep(now)-1)
+	step, ok := ValidateTOTP(rfcSecret, prev, now, 0)
+	if !ok || step != TOTPStep(now)-1 {
+		t.Fatalf("previous-step code should be accepted, got step=%d ok=%v", step, ok)
+	}
+	if _, ok := ValidateTOTP(rfcSecret, prev, now, step); ok {
+		t.Fatal("a code at or before lastStep must be rejected")
+	}
+	old, _ := TOTPCode(rfcSecret, TOTPStep(now)-3)
+	if _, ok := ValidateTOTP(rfcSecret, old, now, 0); ok {
+		t.Fatal("code outside the skew window must be rejected")
+	}
+	if _, ok := ValidateTOTP(rfcSecret, "12345", now, 0); ok {
+		t.Fatal("short code must be rejected")
+	}
+}
+
+func TestRecoveryCodesSingleUse(t *testing.T) {
+	plain, hashed, err := GenerateRecoveryCodes()
+	if err != nil {
+		t.Fatalf("GenerateRecoveryCodes: %v", err)
+	}
+	if len(plain) != RecoveryCodeCount || len(hashed) != RecoveryCodeCount {
+		t.Fatalf("expected %d codes, got %d/%d", RecoveryCodeCount, len(plain), len(hashed))
+	}
+	// Codes are matched case-insensitively and without the dash.
+	entered := strings.ToUpper(strings.ReplaceAll(plain[3], "-", ""))
+	remaining, ok := ConsumeRecoveryCode(hashed, entered)
+	if !ok || len(remaining) != RecoveryCodeCount-1 {
+		t.Fatalf("expected code to be consumed, ok=%v remaining=%d", ok, len(remaining))
+	}
+	if _, ok := ConsumeRecoveryCode(remaining, plain[3]); ok {
+		t.Fatal("recovery code must not be usable twice")
+	}
+}
+
+func TestMFAChallengeIsNotAnAccessToken(t *testing.T) {
+	token, err := CreateMFAChallenge("user-1", "secret")
+	if err != nil {
+		t.Fatalf("CreateMFAChallenge: %v", err)
+	}
+	if sub, err := CheckMFAChallenge(token, "secret"); err != nil || sub != "user-1" {
+		t.Fatalf("CheckMFAChallenge = %q, %v", sub, err)
+	}
+	if _, err := CheckJWT(token, MFAChallengeIssuer, "secret"); err == nil {
+		t.Fatal("challenge must not verify with the plain JWT secret")
+	}
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	uri := TOTPProvisioningURI("Diary", "a@b.c", rfcSecret)
+	if !strings.HasPrefix(uri, "otpauth://totp/Diary:a@b.c?") || !strings.Contains(uri, "secret="+rfcSecret) {
+		t.Fatalf("unexpected URI %q", uri)
+	}
+}
@@ -0,0 +1,50 @@
+package checker
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+)
+
+// LinksCheck finds diary entries that link with [[YYYY-MM-DD]] to days
+// without an entry. Whether to write the missing day or fix the link is the
+// user's call, so the issues are not fixable.
+type LinksCheck struct{}
+
+func (LinksCheck) Name() string { return "links" }
+
+func (LinksCheck) Run(db database.Storage, _ *config.Config, _ *slog.Logger) ([]Issue, error) {
+	users, err := db.GetAllUsers()
+	if err != nil {
+		return nil, fmt.Errorf("getting users: %w", err)
+	}
+
+	var issues []Issue
+	seen := map[uuid.UUID]bool{}
+	for _, user := range users {
+		familyID := user.FamilyID
+		if seen[familyID] {
+			continue
+		}
+		seen[familyID] = true
+
+		links, err := db.GetBrokenLinks(familyID)
+		if err != nil {
+			return nil, fmt.Errorf("getting broken links for family %s: %w", familyID, err)
+		}
+		for _, link := range links {
+			issues = append(issues, Issue{
+				Check:    "links",
+				FamilyID: familyID.String(),
+				Path:     link.Date + " -> " + link.Target,
+				Message:  fmt.Sprintf("entry %q links to missing day %q", link.Date, link.Target),
+				Fixable:  false,
+			})
+		}
+	}
+
+	return issues, nil
+}
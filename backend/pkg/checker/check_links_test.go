@@ -0,0 +1,44 @@
+package checker
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func TestLinksCheckReportsLinksToMissingDays(t *testing.T) {
+	s, cfg, cleanup := setupUntagged(t)
+	defer cleanup()
+
+	fam, err := s.CreateFamily("fam")
+	if err != nil {
+		t.Fatalf("CreateFamily: %v", err)
+	}
+	// Two members of one family must not report its links twice.
+	for _, name := range []string{"ann", "bob"} {
+		if _, err := s.CreateUser(name, "pw", fam.ID); err != nil {
+			t.Fatalf("CreateUser: %v", err)
+		}
+	}
+	for _, item := range []*models.Item{
+		{Date: "2024-05-01", Title: "a", Body: "see [[2024-05-02]] and [[2024-04-30]]"},
+		{Date: "2024-05-02", Title: "b"},
+	} {
+		if err := s.PutItem(fam.ID, item); err != nil {
+			t.Fatalf("PutItem: %v", err)
+		}
+	}
+
+	issues, err := LinksCheck{}.Run(s, cfg, slog.Default())
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %+v", issues)
+	}
+	got := issues[0]
+	if got.Check != "links" || got.FamilyID != fam.ID.String() || got.Path != "2024-05-01 -> 2024-04-30" || got.Fixable {
+		t.Fatalf("issue got %+v", got)
+	}
+}
@@ -2,6 +2,7 @@ package checker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -13,21 +14,19 @@ import (
 	"github.com/ya-breeze/diary.be/pkg/utils"
 )
 
-// maxBackfillPerRun bounds how many AI suggestion calls the untagged check makes
-// per family in a single run, so a family with a long untagged history doesn't
-// trigger a huge batch every sweep. Remaining days are picked up on later runs.
-const maxBackfillPerRun = 25
-
 // UntaggedCheck finds untagged or stale days for families that have enabled the
-// AI tagging backfill, and surfaces them through the health-issues flow. It is a
-// no-op unless a suggester is available and the family has opted in.
+// AI tagging backfill. Days with staged suggestions are surfaced through the
+// health-issues flow; days that still need analysis are queued as
+// models.AIJobTagDay jobs, which the AI job queue runs through TagDay, so a
+// slow model never holds up the sweep. It is a no-op unless a suggester is
+// available and the family has opted in.
 type UntaggedCheck struct {
 	Suggester ai.Suggester
 }
 
 func (UntaggedCheck) Name() string { return "untagged" }
 
-func (c UntaggedCheck) Run(db database.Storage, cfg *config.Config, logger *slog.Logger) ([]Issue, error) {
+func (c UntaggedCheck) Run(db database.Storage, _ *config.Config, logger *slog.Logger) ([]Issue, error) {
 	if c.Suggester == nil || !c.Suggester.Enabled() {
 		return nil, nil // AI unavailable → no backfill
 	}
@@ -54,7 +53,7 @@ func (c UntaggedCheck) Run(db database.Storage, cfg *config.Config, logger *slog
 			continue
 		}
 
-		famIssues, err := c.runForFamily(db, cfg, logger, family)
+		famIssues, err := c.runForFamily(db, logger, family)
 		if err != nil {
 			return nil, err
 		}
@@ -63,68 +62,48 @@ func (c UntaggedCheck) Run(db database.Storage, cfg *config.Config, logger *slog
 	return issues, nil
 }
 
-func (c UntaggedCheck) runForFamily(
-	db database.Storage, cfg *config.Config, logger *slog.Logger, family *models.Family,
-) ([]Issue, error) {
+func (c UntaggedCheck) runForFamily(db database.Storage, logger *slog.Logger, family *models.Family) ([]Issue, error) {
 	familyID := family.ID
-	knownTags, err := db.GetDistinctTags(familyID)
-	if err != nil {
-		return nil, fmt.Errorf("getting tags for family %s: %w", familyID, err)
-	}
 	items, _, err := db.GetItems(familyID, database.SearchParams{})
 	if err != nil {
 		return nil, fmt.Errorf("getting items for family %s: %w", familyID, err)
 	}
 
 	issues := make([]Issue, 0)
-	generated := 0
-	hitCap := false
-	analysisFailed := false
+	remaining := 0
+	queued := 0
 	for _, item := range items {
-		untagged := len(item.Tags) == 0
-		stale := item.TagsSourceHash != utils.ComputeTagsSourceHash(item.Title, item.Body)
-
-		// Skip days we've already processed for this content that have nothing
-		// pending — whether they got tagged or the user dismissed every suggestion.
-		// A never-processed day has an empty hash, so it reads as stale.
-		if !stale && len(item.PendingTags) == 0 {
-			continue
-		}
-
-		// Already-staged suggestions (content unchanged): surface without a new call.
-		if len(item.PendingTags) > 0 && !stale {
+		// Already-staged suggestions (content unchanged): surface for review.
+		if hasStagedReview(item) {
 			issues = append(issues, c.reviewIssue(familyID, item.Date, len(item.PendingTags)))
 			continue
 		}
-
 		// Fresh analysis is the one-time backfill's job only: once the family's
 		// backfill has completed, un-analyzed days are left alone (completion
 		// stops new model calls, not the review of already-staged pending).
-		if family.AITaggingBackfillDone {
+		if family.AITaggingBackfillDone || !needsAnalysis(item) {
 			continue
 		}
 
-		if generated >= maxBackfillPerRun {
-			hitCap = true
-			break // bound AI cost; remaining days handled next run
-		}
-		generated++
-
-		issue, ok, analyzed := c.processItem(db, cfg, logger, family, item, knownTags, untagged)
-		if !analyzed {
-			analysisFailed = true
+		remaining++
+		added, err := db.EnqueueAIJob(familyID, models.AIJobTagDay, item.Date)
+		if err != nil {
+			return nil, fmt.Errorf("queueing tag job for family %s: %w", familyID, err)
 		}
-		if ok {
-			issues = append(issues, issue)
+		if added {
+			queued++
 		}
 	}
+	if queued > 0 {
+		logger.Info("Untagged check: queued days for AI tagging", "familyID", familyID, "days", queued)
+	}
 
-	// The backfill is exhausted when a full scan needed no further model calls:
-	// it did not stop at the per-run cap and no analysis failed (a failed day is
-	// still un-analyzed and must be retried next run). Flip the flag so no
-	// automatic analysis runs for this family again (until backfill is
-	// re-toggled off->on, which resets it).
-	if !family.AITaggingBackfillDone && !hitCap && !analysisFailed {
+	// The backfill is exhausted when no day needs analysis any more: every
+	// queued job has run and stamped its day (a failed day still reads as
+	// stale and is queued again). Flip the flag so no automatic analysis runs
+	// for this family again (until backfill is re-toggled off->on, which
+	// resets it).
+	if !family.AITaggingBackfillDone && remaining == 0 {
 		if err := db.SetFamilyBackfillDone(familyID, true); err != nil {
 			logger.Error("Untagged check: failed to mark backfill done", "familyID", familyID, "error", err)
 		} else {
@@ -134,17 +113,90 @@ func (c UntaggedCheck) runForFamily(
 	return issues, nil
 }
 
+// needsAnalysis reports whether the backfill still has to ask the model about
+// item: its content changed since it was last analyzed (a never-analyzed day
+// has an empty hash, so it reads as stale) and no suggestions are staged.
+// Days processed for their current content are skipped whether they got
+// tagged or the user dismissed every suggestion.
+func needsAnalysis(item *models.Item) bool {
+	return item.TagsSourceHash != utils.ComputeTagsSourceHash(item.Title, item.Body) && !hasStagedReview(item)
+}
+
+// hasStagedReview reports whether item has suggestions staged for its current
+// content.
+func hasStagedReview(item *models.Item) bool {
+	return len(item.PendingTags) > 0 && item.TagsSourceHash == utils.ComputeTagsSourceHash(item.Title, item.Body)
+}
+
+// BackfillCandidates returns the dates the family's AI tagging backfill still
+// has to analyze; none when the backfill is off or complete.
+func BackfillCandidates(db database.Storage, family *models.Family) ([]string, error) {
+	if !family.AITaggingEnabled || !family.AITaggingBackfill || family.AITaggingBackfillDone {
+		return nil, nil
+	}
+	items, _, err := db.GetItems(family.ID, database.SearchParams{})
+	if err != nil {
+		return nil, fmt.Errorf("getting items for family %s: %w", family.ID, err)
+	}
+	var dates []string
+	for _, item := range items {
+		if needsAnalysis(item) {
+			dates = append(dates, item.Date)
+		}
+	}
+	return dates, nil
+}
+
+// TagDay runs one models.AIJobTagDay job: it asks the model about the family's
+// day and applies or stages the suggestions (see processItem). A day that no
+// longer needs analysis — edited and tagged meanwhile, deleted, or the family
+// turned the backfill off or completed it — is a no-op. Errors, including
+// ai.ErrBudgetExhausted, are returned for the queue to schedule a retry.
+func (c UntaggedCheck) TagDay(
+	ctx context.Context, db database.Storage, cfg *config.Config, logger *slog.Logger,
+	familyID uuid.UUID, date string,
+) error {
+	if c.Suggester == nil || !c.Suggester.Enabled() {
+		return nil
+	}
+	family, err := db.GetFamily(familyID)
+	if errors.Is(err, database.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting family %s: %w", familyID, err)
+	}
+	if !family.AITaggingEnabled || !family.AITaggingBackfill || family.AITaggingBackfillDone {
+		return nil
+	}
+	item, err := db.GetItem(familyID, date)
+	if errors.Is(err, database.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting item %s: %w", date, err)
+	}
+	if !needsAnalysis(item) {
+		return nil
+	}
+	knownTags, err := db.GetDistinctTags(familyID)
+	if err != nil {
+		return fmt.Errorf("getting tags for family %s: %w", familyID, err)
+	}
+	// The model call is made on the family's behalf so it counts against its
+	// monthly AI budget.
+	return c.processItem(ai.WithFamily(ctx, familyID), db, cfg, logger, family, item, knownTags)
+}
+
 // processItem generates suggestions for one candidate day. Under auto mode it
-// applies confident tags immediately (resolving the day, no issue). Otherwise it
-// stages the suggestions as pending and returns a non-fixable "review" issue.
-// ok=false when there's nothing to surface (resolved, empty, or error).
-// analyzed reports whether the day was fully processed and marked (hash
-// stamped); it is false on any failure so the day is retried next run and the
-// backfill is not marked complete over it.
+// applies confident tags to an untagged day immediately (resolving it).
+// Otherwise it stages the suggestions as pending for the next sweep to surface
+// as a "review" issue. Any failure leaves the day's hash unstamped, so it is
+// analyzed again and the backfill is not marked complete over it.
 func (c UntaggedCheck) processItem(
-	db database.Storage, cfg *config.Config, logger *slog.Logger,
-	family *models.Family, item *models.Item, knownTags []string, untagged bool,
-) (Issue, bool, bool) {
+	ctx context.Context, db database.Storage, cfg *config.Config, logger *slog.Logger,
+	family *models.Family, item *models.Item, knownTags []string,
+) error {
 	familyID := family.ID
 	var images []ai.ImageAsset
 	if family.AITaggingUseImages {
@@ -153,47 +205,43 @@ func (c UntaggedCheck) processItem(
 	if family.AITaggingUseVideo {
 		images = append(images, ai.LoadVideoKeyframes(item.Body, cfg.DataPath, familyID.String(), logger, ai.MaxImages-len(images))...)
 	}
-	suggestions, err := c.Suggester.SuggestTags(context.Background(), item.Title, item.Body, images, knownTags)
+	tagMetadata, err := db.GetTagMetadata(familyID)
+	if err != nil {
+		return fmt.Errorf("getting tag metadata for family %s: %w", familyID, err)
+	}
+	suggestions, err := c.Suggester.SuggestTags(
+		ctx, item.Title, item.Body, images, knownTags, ai.FamilyTagPolicy(family, tagMetadata))
 	if err != nil {
-		logger.Error("Untagged check: suggestion failed", "familyID", familyID, "date", item.Date, "error", err)
-		return Issue{}, false, false
+		return err
 	}
 	names, confident := ai.Partition(suggestions, item.Tags, cfg.AITaggingThreshold)
 	if len(names) == 0 {
 		// Nothing to suggest is still a completed analysis: stamp the hash (via an
 		// empty pending write) so the one-time backfill never revisits this day.
 		if err := db.SetPendingTags(familyID, item.Date, nil); err != nil {
-			logger.Error("Untagged check: failed to mark analyzed", "familyID", familyID, "date", item.Date, "error", err)
-			return Issue{}, false, false
+			return fmt.Errorf("marking %s analyzed: %w", item.Date, err)
 		}
-		return Issue{}, false, true
+		return nil
 	}
 
 	// Auto mode: apply confident tags to an untagged day right away — no manual
 	// "fix" step. Low-confidence suggestions are still staged for review.
-	if family.AITaggingAuto && untagged && len(confident) > 0 {
+	if family.AITaggingAuto && len(item.Tags) == 0 && len(confident) > 0 {
 		if err := db.AddConfirmedTags(familyID, item.Date, confident); err != nil {
-			logger.Error("Untagged check: auto-apply failed", "familyID", familyID, "date", item.Date, "error", err)
-			return Issue{}, false, false
+			return fmt.Errorf("auto-applying tags to %s: %w", item.Date, err)
 		}
 		logger.Info("Untagged check: auto-applied confident tags", "familyID", familyID, "date", item.Date, "tags", confident)
-		uncertain := subtractStrings(names, confident)
-		if len(uncertain) > 0 {
-			if err := db.SetPendingTags(familyID, item.Date, uncertain); err != nil {
-				logger.Error("Untagged check: failed to stage pending tags", "familyID", familyID, "date", item.Date, "error", err)
-				return Issue{}, false, false
-			}
-			return c.reviewIssue(familyID, item.Date, len(uncertain)), true, true
+		names = subtractStrings(names, confident)
+		if len(names) == 0 {
+			return nil
 		}
-		return Issue{}, false, true
 	}
 
-	// Non-auto, or auto with no confident suggestions: stage all for review.
+	// Non-auto, or the uncertain rest under auto: stage for review.
 	if err := db.SetPendingTags(familyID, item.Date, names); err != nil {
-		logger.Error("Untagged check: failed to stage pending tags", "familyID", familyID, "date", item.Date, "error", err)
-		return Issue{}, false, false
+		return fmt.Errorf("staging pending tags for %s: %w", item.Date, err)
 	}
-	return c.reviewIssue(familyID, item.Date, len(names)), true, true
+	return nil
 }
 
 func subtractStrings(all, exclude []string) []string {
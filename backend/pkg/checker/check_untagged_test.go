@@ -2,10 +2,12 @@ package checker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/ya-breeze/diary.be/pkg/ai"
 	"github.com/ya-breeze/diary.be/pkg/config"
@@ -21,7 +23,7 @@ type fakeSuggester struct {
 
 func (f fakeSuggester) Enabled() bool { return f.enabled }
 func (f fakeSuggester) SuggestTags(
-	_ context.Context, _, _ string, _ []ai.ImageAsset, _ []string,
+	_ context.Context, _, _ string, _ []ai.ImageAsset, _ []string, _ ai.TagPolicy,
 ) ([]ai.TagSuggestion, error) {
 	return f.suggestions, nil
 }
@@ -50,7 +52,8 @@ func makeLegacy(t *testing.T, s database.Storage, date string) {
 	}
 }
 
-func runUntagged(t *testing.T, s database.Storage, cfg *config.Config, sug ai.Suggester) []Issue {
+// sweepUntagged runs the untagged check once, which only queues jobs.
+func sweepUntagged(t *testing.T, s database.Storage, cfg *config.Config, sug ai.Suggester) []Issue {
 	t.Helper()
 	issues, err := UntaggedCheck{Suggester: sug}.Run(s, cfg, slog.Default())
 	if err != nil {
@@ -59,6 +62,41 @@ func runUntagged(t *testing.T, s database.Storage, cfg *config.Config, sug ai.Su
 	return issues
 }
 
+// drainJobs runs every due job through TagDay, as the AI job queue would, and
+// returns the errors of the failed ones.
+func drainJobs(t *testing.T, s database.Storage, cfg *config.Config, sug ai.Suggester) []error {
+	t.Helper()
+	var errs []error
+	for {
+		job, err := s.ClaimAIJob(time.Now(), 100)
+		if err != nil {
+			t.Fatalf("ClaimAIJob: %v", err)
+		}
+		if job == nil {
+			return errs
+		}
+		job.Status = models.AIJobDone
+		if err := (UntaggedCheck{Suggester: sug}).TagDay(
+			context.Background(), s, cfg, slog.Default(), job.FamilyID, job.Date,
+		); err != nil {
+			errs = append(errs, err)
+			job.Status = models.AIJobFailed
+		}
+		if err := s.UpdateAIJob(job); err != nil {
+			t.Fatalf("UpdateAIJob: %v", err)
+		}
+	}
+}
+
+// runUntagged is a sweep that queues jobs, the jobs running, and the sweep
+// that surfaces their results.
+func runUntagged(t *testing.T, s database.Storage, cfg *config.Config, sug ai.Suggester) []Issue {
+	t.Helper()
+	sweepUntagged(t, s, cfg, sug)
+	drainJobs(t, s, cfg, sug)
+	return sweepUntagged(t, s, cfg, sug)
+}
+
 func TestUntaggedDisabledSuggester(t *testing.T) {
 	s, cfg, done := setupUntagged(t)
 	defer done()
@@ -119,35 +157,49 @@ func TestUntaggedBackfillDoneFlipsWhenExhausted(t *testing.T) {
 	}
 }
 
-func TestUntaggedBackfillDoneNotFlippedAtCap(t *testing.T) {
+func TestUntaggedSweepOnlyQueuesJobs(t *testing.T) {
 	s, cfg, done := setupUntagged(t)
 	defer done()
 	fam, _ := s.CreateFamily("f")
 	_, _ = s.CreateUser("u", "p", fam.ID)
 	_ = s.SetFamilyAISettings(fam.ID, true, true, false, false, false)
-	// One more candidate than the per-run cap: the run must stop at the cap and
-	// NOT mark the backfill done (work remains for the next run).
-	for i := 0; i <= maxBackfillPerRun; i++ {
-		date := fmt.Sprintf("2024-02-%02d", i+1)
+	for i := 1; i <= 3; i++ {
+		date := fmt.Sprintf("2024-02-%02d", i)
 		_ = s.PutItem(fam.ID, &models.Item{Date: date, Title: "day " + date})
 		makeLegacy(t, s, date)
 	}
 
+	// The sweep makes no model calls: it queues one job per day, once, and
+	// keeps the backfill open while they are pending.
 	sug := &countingSuggester{out: nil}
-	_ = runUntagged(t, s, cfg, sug)
-	if sug.calls != maxBackfillPerRun {
-		t.Fatalf("expected exactly %d model calls (the cap), got %d", maxBackfillPerRun, sug.calls)
+	sweepUntagged(t, s, cfg, sug)
+	sweepUntagged(t, s, cfg, sug)
+	if sug.calls != 0 {
+		t.Fatalf("sweep called the model %d times", sug.calls)
+	}
+	if counts, _ := s.CountAIJobs(fam.ID); counts[models.AIJobQueued] != 3 {
+		t.Fatalf("expected 3 queued jobs, got %v", counts)
+	}
+	if got, _ := s.GetFamily(fam.ID); got.AITaggingBackfillDone {
+		t.Fatal("backfill must not be marked done while days are queued")
 	}
 	got, _ := s.GetFamily(fam.ID)
-	if got.AITaggingBackfillDone {
-		t.Fatal("backfill must not be marked done when the run stopped at the cap")
+	if dates, _ := BackfillCandidates(s, got); len(dates) != 3 {
+		t.Fatalf("expected 3 remaining days, got %v", dates)
 	}
 
-	// The next run finishes the remainder and flips done.
-	_ = runUntagged(t, s, cfg, sug)
+	// Running the jobs finishes the backfill on the next sweep.
+	drainJobs(t, s, cfg, sug)
+	if sug.calls != 3 {
+		t.Fatalf("expected 3 model calls, got %d", sug.calls)
+	}
+	sweepUntagged(t, s, cfg, sug)
 	got, _ = s.GetFamily(fam.ID)
 	if !got.AITaggingBackfillDone {
-		t.Fatal("expected done after the second run exhausted the corpus")
+		t.Fatal("expected done after the jobs exhausted the corpus")
+	}
+	if dates, _ := BackfillCandidates(s, got); len(dates) != 0 {
+		t.Fatalf("expected no remaining days, got %v", dates)
 	}
 }
 
@@ -327,7 +379,7 @@ type countingSuggester struct {
 
 func (c *countingSuggester) Enabled() bool { return true }
 func (c *countingSuggester) SuggestTags(
-	_ context.Context, _, _ string, _ []ai.ImageAsset, _ []string,
+	_ context.Context, _, _ string, _ []ai.ImageAsset, _ []string, _ ai.TagPolicy,
 ) ([]ai.TagSuggestion, error) {
 	c.calls++
 	return c.out, nil
@@ -390,3 +442,35 @@ func TestUntaggedDismissClearsFromReview(t *testing.T) {
 		t.Fatalf("after dismiss: model should not be called again, got %d calls", sug.calls)
 	}
 }
+
+func TestUntaggedRespectsAIBudget(t *testing.T) {
+	s, cfg, done := setupUntagged(t)
+	defer done()
+	fam, _ := s.CreateFamily("f")
+	_, _ = s.CreateUser("u", "p", fam.ID)
+	_ = s.SetFamilyAISettings(fam.ID, true, true, false, false, false)
+	_ = s.PutItem(fam.ID, &models.Item{Date: "2024-01-01", Title: "beach day"})
+	makeLegacy(t, s, "2024-01-01")
+
+	month := time.Now().UTC().Format(ai.UsageMonthFormat)
+	if err := s.AddAIUsage(&models.AIUsage{FamilyID: fam.ID, Month: month, Requests: 1, InputTokens: 100}); err != nil {
+		t.Fatalf("AddAIUsage: %v", err)
+	}
+
+	inner := &countingSuggester{out: []ai.TagSuggestion{{Name: "beach", Confidence: 0.95}}}
+	sug := ai.NewMeteredSuggester(inner, s, 100, slog.Default())
+	sweepUntagged(t, s, cfg, sug)
+	errs := drainJobs(t, s, cfg, sug)
+	if len(errs) != 1 || !errors.Is(errs[0], ai.ErrBudgetExhausted) {
+		t.Fatalf("expected the job to report the exhausted budget, got %v", errs)
+	}
+	if inner.calls != 0 {
+		t.Fatalf("model called %d times over budget", inner.calls)
+	}
+	if issues := sweepUntagged(t, s, cfg, sug); len(issues) != 0 {
+		t.Fatalf("expected no issues over budget, got %d", len(issues))
+	}
+	if f, _ := s.GetFamily(fam.ID); f.AITaggingBackfillDone {
+		t.Fatal("backfill must not be marked done while days are still un-analyzed")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -34,6 +35,128 @@ type Config struct {
 	// AI tagging — confidence threshold τ above which suggestions may be
 	// auto-applied to untagged days when a family enables auto mode.
 	AITaggingThreshold float64 `mapstructure:"ai_tagging_threshold" default:"0.8"`
+	// AIProvider selects the model backend: "gemini" (GEMINI_API_KEY), "openai"
+	// for any OpenAI-compatible chat completions server such as Ollama or
+	// llama.cpp (AIBaseURL + AIModel), or "none".
+	AIProvider string `mapstructure:"ai_provider" default:"gemini"`
+	// AIBaseURL is the OpenAI-compatible API root, e.g. http://localhost:11434/v1.
+	AIBaseURL string `mapstructure:"ai_base_url" default:""`
+	// AIModel overrides the provider's model; required for "openai".
+	AIModel string `mapstructure:"ai_model" default:""`
+	// AIAPIKey is sent as a bearer token to the OpenAI-compatible server (optional).
+	AIAPIKey string `mapstructure:"ai_api_key" default:""`
+	// AIMonthlyTokenBudget caps the AI tokens (input + output) each family may
+	// spend per calendar month; 0 means unlimited.
+	AIMonthlyTokenBudget int64 `mapstructure:"ai_monthly_token_budget" default:"0"`
+	// AISuggestionCacheTTL is how long a tag suggestion answer is reused for an
+	// identical request (same content, model, images and vocabulary); "0"
+	// disables the cache.
+	AISuggestionCacheTTL string `mapstructure:"ai_suggestion_cache_ttl" default:"720h"`
+	// AIEmbeddingProvider selects the semantic search backend: "gemini",
+	// "openai", "fake" (deterministic, offline) or "none"; empty follows
+	// AIProvider.
+	AIEmbeddingProvider string `mapstructure:"ai_embedding_provider" default:""`
+	// AIEmbeddingModel overrides the embedding model; required for "openai".
+	AIEmbeddingModel string `mapstructure:"ai_embedding_model" default:""`
+	// AITranscriptionProvider selects the voice memo transcription backend:
+	// "gemini", "whisper" (a whisper.cpp server at AITranscriptionURL) or
+	// "none"; empty follows AIProvider when that is Gemini.
+	AITranscriptionProvider string `mapstructure:"ai_transcription_provider" default:""`
+	// AITranscriptionURL is the root of the whisper.cpp server, e.g.
+	// http://localhost:8081.
+	AITranscriptionURL string `mapstructure:"ai_transcription_url" default:""`
+	// AITranscriptionModel overrides the Gemini model used for transcription.
+	AITranscriptionModel string `mapstructure:"ai_transcription_model" default:""`
+	// EmbeddingInterval is how often entries of families with semantic search
+	// enabled are checked for missing or stale embeddings.
+	EmbeddingInterval string `mapstructure:"embedding_interval" default:"1h"`
+	// RecapInterval is how often families opted into AI summaries are checked
+	// for a finished week or month that still needs its recap.
+	RecapInterval string `mapstructure:"recap_interval" default:"24h"`
+	// CaptionInterval is how often families opted into AI image captions are
+	// checked for referenced images that still lack a caption.
+	CaptionInterval string `mapstructure:"caption_interval" default:"1h"`
+	// AIJobWorkers is the number of goroutines running queued AI jobs (such as
+	// the tagging backfill); AIJobsPerFamily caps how many run at once for one
+	// family.
+	AIJobWorkers    int `mapstructure:"ai_job_workers" default:"2"`
+	AIJobsPerFamily int `mapstructure:"ai_jobs_per_family" default:"1"`
+	// AIJobMaxAttempts is how often a failing AI job is tried before it is
+	// marked failed; retries back off exponentially.
+	AIJobMaxAttempts int `mapstructure:"ai_job_max_attempts" default:"5"`
+	// AIJobPollInterval is how often idle workers look for due jobs.
+	AIJobPollInterval string `mapstructure:"ai_job_poll_interval" default:"10s"`
+
+	// WeatherProvider selects where entries with coordinates get their weather
+	// snapshot: "open-meteo", "fake" (deterministic, offline) or "none".
+	WeatherProvider string `mapstructure:"weather_provider" default:"none"`
+	// WeatherURL overrides the provider's API root, e.g. a self-hosted
+	// Open-Meteo instance.
+	WeatherURL string `mapstructure:"weather_url" default:""`
+
+	// MemoriesNotifier selects how the daily memories digest of opted-in
+	// families is delivered: "webhook" (a JSON POST to MemoriesWebhookURL),
+	// "log" or "none".
+	MemoriesNotifier   string `mapstructure:"memories_notifier" default:"none"`
+	MemoriesWebhookURL string `mapstructure:"memories_webhook_url" default:""`
+	// MemoriesDigestHour is the local hour from which the day's digest is sent;
+	// MemoriesDigestInterval is how often the task checks for digests due.
+	MemoriesDigestHour     int    `mapstructure:"memories_digest_hour" default:"8"`
+	MemoriesDigestInterval string `mapstructure:"memories_digest_interval" default:"15m"`
+
+	// OpenID Connect single sign-on — enabled when OIDCIssuer is set.
+	// OIDCRedirectURL must point at /web/oidc/callback on this server and be
+	// registered with the identity provider.
+	OIDCIssuer       string `mapstructure:"oidc_issuer" default:""`
+	OIDCClientID     string `mapstructure:"oidc_client_id" default:""`
+	OIDCClientSecret string `mapstructure:"oidc_client_secret" default:""`
+	OIDCRedirectURL  string `mapstructure:"oidc_redirect_url" default:""`
+	OIDCScopes       string `mapstructure:"oidc_scopes" default:"openid email profile"`
+	// OIDCUsernameClaim is the ID token claim matched against Diary usernames.
+	OIDCUsernameClaim string `mapstructure:"oidc_username_claim" default:"email"`
+	// OIDCJITFamily, if set, creates unknown users on first login as members
+	// of this family (created if missing). Empty means only existing accounts
+	// may sign in.
+	OIDCJITFamily string `mapstructure:"oidc_jit_family" default:""`
+}
+
+// redactedValue stands in for a secret in the verbose config dump.
+const redactedValue = "[redacted]"
+
+// redacted returns a copy of c that is safe to print: secrets that are set are
+// replaced by redactedValue.
+func (c Config) redacted() Config {
+	for _, secret := range []*string{&c.JWTSecret, &c.OIDCClientSecret, &c.AIAPIKey} {
+		if *secret != "" {
+			*secret = redactedValue
+		}
+	}
+	return c
+}
+
+// OIDCEnabled reports whether OpenID Connect login is configured.
+func (c *Config) OIDCEnabled() bool {
+	return c.OIDCIssuer != "" && c.OIDCClientID != ""
+}
+
+// SuggestionCacheTTL returns AISuggestionCacheTTL as a duration; an unset
+// value means the field's default.
+func (c *Config) SuggestionCacheTTL() (time.Duration, error) {
+	value := c.AISuggestionCacheTTL
+	if value == "" {
+		value = fieldDefault("AISuggestionCacheTTL")
+	}
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ai_suggestion_cache_ttl %q: %w", value, err)
+	}
+	return ttl, nil
+}
+
+// fieldDefault returns the default tag of the named Config field.
+func fieldDefault(name string) string {
+	field, _ := reflect.TypeFor[Config]().FieldByName(name)
+	return field.Tag.Get("default")
 }
 
 func InitiateConfig(cfgFile string) (*Config, error) {
@@ -55,8 +178,12 @@ func InitiateConfig(cfgFile string) (*Config, error) {
 		return nil, err
 	}
 
+	if _, err := cfg.SuggestionCacheTTL(); err != nil {
+		return nil, err
+	}
+
 	if cfg.Verbose {
-		fmt.Printf("Config: %+v\n", cfg)
+		fmt.Printf("Config: %+v\n", cfg.redacted())
 	}
 
 	return &cfg, nil
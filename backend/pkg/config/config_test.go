@@ -0,0 +1,26 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSuggestionCacheTTL(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 720 * time.Hour}, // the field's default
+		{"0", 0},
+		{"2h", 2 * time.Hour},
+	} {
+		got, err := (&Config{AISuggestionCacheTTL: tc.value}).SuggestionCacheTTL()
+		if err != nil || got != tc.want {
+			t.Errorf("SuggestionCacheTTL(%q) = %v, %v; want %v", tc.value, got, err, tc.want)
+		}
+	}
+
+	if _, err := (&Config{AISuggestionCacheTTL: "a month"}).SuggestionCacheTTL(); err == nil {
+		t.Error("want an error for an invalid TTL")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/utils"
 	"github.com/ya-breeze/kin-core/authdb"
 )
 
@@ -17,6 +18,23 @@ func autoMigrateModels(db *gorm.DB) error {
 		&models.Item{},
 		&models.ItemChange{},
 		&models.OrphanIgnore{},
+		&models.AccessToken{},
+		&models.Session{},
+		&models.RevokedSessionToken{},
+		&models.AuditEvent{},
+		&models.LoginLockout{},
+		&models.Recap{},
+		&models.ItemEmbedding{},
+		&models.AssetCaption{},
+		&models.AssetTranscript{},
+		&models.AIUsage{},
+		&models.SuggestionCacheEntry{},
+		&models.AIJob{},
+		&models.Tag{},
+		&models.ItemTag{},
+		&models.ItemLink{},
+		&models.ItemAsset{},
+		&models.CustomField{},
 		&authdb.RefreshToken{},
 		&authdb.BlacklistedToken{},
 	); err != nil {
@@ -24,7 +42,142 @@ func autoMigrateModels(db *gorm.DB) error {
 	}
 	// Composite unique index on items(family_id, date) — can't be defined via GORM field
 	// tags because FamilyID lives in embedded TenantModel (kin-core).
-	return db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_items_family_date ON items(family_id, date)").Error
+	if err := db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_items_family_date ON items(family_id, date)").Error; err != nil {
+		return err
+	}
+	// Tag filters compare case-insensitively; GORM field tags cannot declare
+	// an index with a collation.
+	return db.Exec(
+		"CREATE INDEX IF NOT EXISTS idx_item_tags_family_tag ON item_tags(family_id, tag COLLATE NOCASE)",
+	).Error
+}
+
+// rebuildItemTags rewrites the item_tags index from the tags column of every
+// item. Runs at startup after the tag columns are cleaned up: it converts
+// databases written before the index existed and repairs any drift (e.g.
+// rows edited by hand or by an older version).
+func rebuildItemTags(log *slog.Logger, db *gorm.DB) error {
+	var before int64
+	var after int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.ItemTag{}).Count(&before).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM item_tags").Error; err != nil {
+			return err
+		}
+		res := tx.Exec("INSERT OR IGNORE INTO item_tags (family_id, date, tag) " +
+			"SELECT items.family_id, items.date, trim(j.value) FROM items, json_each(items.tags) j " +
+			"WHERE items.deleted_at IS NULL AND json_valid(items.tags) AND trim(j.value) <> ''")
+		after = res.RowsAffected
+		return res.Error
+	})
+	if err != nil {
+		return err
+	}
+	if after != before {
+		log.Info("Rebuilt item tag index", "rowsBefore", before, "rows", after)
+	}
+	return nil
+}
+
+// rebuildItemLinks rewrites the item_links index from the bodies of every
+// item. Runs at startup: it converts databases written before the index
+// existed and picks up changes to what counts as a link.
+func rebuildItemLinks(log *slog.Logger, db *gorm.DB) error {
+	var items []*models.Item
+	if err := db.Select("family_id, date, body").Where("instr(body, '[[') > 0").Find(&items).Error; err != nil {
+		return err
+	}
+	var before int64
+	rows := []models.ItemLink{}
+	for _, item := range items {
+		rows = append(rows, itemLinkRows(item)...)
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.ItemLink{}).Count(&before).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM item_links").Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(&rows, 500).Error
+	})
+	if err != nil {
+		return err
+	}
+	if int64(len(rows)) != before {
+		log.Info("Rebuilt item link index", "rowsBefore", before, "rows", len(rows))
+	}
+	return nil
+}
+
+// rebuildItemAssets rewrites the item_assets index from the bodies of every
+// item. Runs at startup: it converts databases written before the index
+// existed and picks up changes to what counts as an embedded asset.
+func rebuildItemAssets(log *slog.Logger, db *gorm.DB) error {
+	var items []*models.Item
+	if err := db.Select("family_id, date, body").Where("instr(body, '![') > 0").Find(&items).Error; err != nil {
+		return err
+	}
+	var before int64
+	rows := []models.ItemAsset{}
+	for _, item := range items {
+		rows = append(rows, itemAssetRows(item)...)
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.ItemAsset{}).Count(&before).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM item_assets").Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(&rows, 500).Error
+	})
+	if err != nil {
+		return err
+	}
+	if int64(len(rows)) != before {
+		log.Info("Rebuilt item asset index", "rowsBefore", before, "rows", len(rows))
+	}
+	return nil
+}
+
+// backfillBodyCounts fills in the word and asset counts of items written
+// before they were stored. Runs at startup in one transaction, reading the rows
+// in batches, so a crash part-way leaves every row as it was; a no-op once
+// every row has both.
+func backfillBodyCounts(log *slog.Logger, db *gorm.DB) error {
+	total := 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var items []models.Item
+		return tx.Unscoped().Select("id, body").Where("word_count < 0 OR asset_count < 0").
+			FindInBatches(&items, 500, func(_ *gorm.DB, _ int) error {
+				for _, item := range items {
+					if err := tx.Unscoped().Model(&item).UpdateColumns(map[string]any{
+						"word_count":  utils.CountWords(item.Body),
+						"asset_count": len(utils.GetAssetsFromMarkdown(item.Body)),
+					}).Error; err != nil {
+						return err
+					}
+				}
+				total += len(items)
+				return nil
+			}).Error
+	})
+	if err != nil {
+		return err
+	}
+	if total > 0 {
+		log.Info("Backfilled item word and asset counts", "count", total)
+	}
+	return nil
 }
 
 // normalizeTagColumns rewrites any items whose tags / pending_tags column is not
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -37,6 +38,20 @@ func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 	return m.recorder
 }
 
+// AddAIUsage mocks base method.
+func (m *MockStorage) AddAIUsage(arg0 *models.AIUsage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAIUsage", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAIUsage indicates an expected call of AddAIUsage.
+func (mr *MockStorageMockRecorder) AddAIUsage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAIUsage", reflect.TypeOf((*MockStorage)(nil).AddAIUsage), arg0)
+}
+
 // AddConfirmedTags mocks base method.
 func (m *MockStorage) AddConfirmedTags(arg0 uuid.UUID, arg1 string, arg2 []string) error {
 	m.ctrl.T.Helper()
@@ -65,6 +80,50 @@ func (mr *MockStorageMockRecorder) AddIgnoredOrphan(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIgnoredOrphan", reflect.TypeOf((*MockStorage)(nil).AddIgnoredOrphan), arg0, arg1)
 }
 
+// ClaimAIJob mocks base method.
+func (m *MockStorage) ClaimAIJob(arg0 time.Time, arg1 int) (*models.AIJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimAIJob", arg0, arg1)
+	ret0, _ := ret[0].(*models.AIJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimAIJob indicates an expected call of ClaimAIJob.
+func (mr *MockStorageMockRecorder) ClaimAIJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimAIJob", reflect.TypeOf((*MockStorage)(nil).ClaimAIJob), arg0, arg1)
+}
+
+// ClearLoginLockout mocks base method.
+func (m *MockStorage) ClearLoginLockout(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearLoginLockout", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearLoginLockout indicates an expected call of ClearLoginLockout.
+func (mr *MockStorageMockRecorder) ClearLoginLockout(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearLoginLockout", reflect.TypeOf((*MockStorage)(nil).ClearLoginLockout), arg0)
+}
+
+// ClearSuggestionCache mocks base method.
+func (m *MockStorage) ClearSuggestionCache(arg0 *uuid.UUID, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearSuggestionCache", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearSuggestionCache indicates an expected call of ClearSuggestionCache.
+func (mr *MockStorageMockRecorder) ClearSuggestionCache(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSuggestionCache", reflect.TypeOf((*MockStorage)(nil).ClearSuggestionCache), arg0, arg1)
+}
+
 // Close mocks base method.
 func (m *MockStorage) Close() error {
 	m.ctrl.T.Helper()
@@ -79,6 +138,49 @@ func (mr *MockStorageMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStorage)(nil).Close))
 }
 
+// CountAIJobs mocks base method.
+func (m *MockStorage) CountAIJobs(arg0 uuid.UUID) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAIJobs", arg0)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAIJobs indicates an expected call of CountAIJobs.
+func (mr *MockStorageMockRecorder) CountAIJobs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAIJobs", reflect.TypeOf((*MockStorage)(nil).CountAIJobs), arg0)
+}
+
+// CreateAccessToken mocks base method.
+func (m *MockStorage) CreateAccessToken(arg0 *models.AccessToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessToken", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAccessToken indicates an expected call of CreateAccessToken.
+func (mr *MockStorageMockRecorder) CreateAccessToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessToken", reflect.TypeOf((*MockStorage)(nil).CreateAccessToken), arg0)
+}
+
+// CreateAuditEvent mocks base method.
+func (m *MockStorage) CreateAuditEvent(arg0 *models.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditEvent indicates an expected call of CreateAuditEvent.
+func (mr *MockStorageMockRecorder) CreateAuditEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEvent", reflect.TypeOf((*MockStorage)(nil).CreateAuditEvent), arg0)
+}
+
 // CreateChangeRecord mocks base method.
 func (m *MockStorage) CreateChangeRecord(arg0 uuid.UUID, arg1 string, arg2 models.OperationType, arg3 *models.Item, arg4 []string) error {
 	m.ctrl.T.Helper()
@@ -93,6 +195,20 @@ func (mr *MockStorageMockRecorder) CreateChangeRecord(arg0, arg1, arg2, arg3, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeRecord", reflect.TypeOf((*MockStorage)(nil).CreateChangeRecord), arg0, arg1, arg2, arg3, arg4)
 }
 
+// CreateCustomField mocks base method.
+func (m *MockStorage) CreateCustomField(arg0 *models.CustomField) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomField", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCustomField indicates an expected call of CreateCustomField.
+func (mr *MockStorageMockRecorder) CreateCustomField(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomField", reflect.TypeOf((*MockStorage)(nil).CreateCustomField), arg0)
+}
+
 // CreateFamily mocks base method.
 func (m *MockStorage) CreateFamily(arg0 string) (*models.Family, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +224,34 @@ func (mr *MockStorageMockRecorder) CreateFamily(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFamily", reflect.TypeOf((*MockStorage)(nil).CreateFamily), arg0)
 }
 
+// CreateRecap mocks base method.
+func (m *MockStorage) CreateRecap(arg0 *models.Recap) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecap", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRecap indicates an expected call of CreateRecap.
+func (mr *MockStorageMockRecorder) CreateRecap(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecap", reflect.TypeOf((*MockStorage)(nil).CreateRecap), arg0)
+}
+
+// CreateSession mocks base method.
+func (m *MockStorage) CreateSession(arg0 *models.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStorageMockRecorder) CreateSession(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStorage)(nil).CreateSession), arg0)
+}
+
 // CreateUser mocks base method.
 func (m *MockStorage) CreateUser(arg0, arg1 string, arg2 uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +267,49 @@ func (mr *MockStorageMockRecorder) CreateUser(arg0, arg1, arg2 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStorage)(nil).CreateUser), arg0, arg1, arg2)
 }
 
+// DeleteCustomField mocks base method.
+func (m *MockStorage) DeleteCustomField(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomField", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomField indicates an expected call of DeleteCustomField.
+func (mr *MockStorageMockRecorder) DeleteCustomField(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomField", reflect.TypeOf((*MockStorage)(nil).DeleteCustomField), arg0, arg1)
+}
+
+// DeleteFinishedAIJobs mocks base method.
+func (m *MockStorage) DeleteFinishedAIJobs(arg0 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFinishedAIJobs", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFinishedAIJobs indicates an expected call of DeleteFinishedAIJobs.
+func (mr *MockStorageMockRecorder) DeleteFinishedAIJobs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFinishedAIJobs", reflect.TypeOf((*MockStorage)(nil).DeleteFinishedAIJobs), arg0)
+}
+
+// DeleteInactiveSessions mocks base method.
+func (m *MockStorage) DeleteInactiveSessions() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInactiveSessions")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInactiveSessions indicates an expected call of DeleteInactiveSessions.
+func (mr *MockStorageMockRecorder) DeleteInactiveSessions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInactiveSessions", reflect.TypeOf((*MockStorage)(nil).DeleteInactiveSessions))
+}
+
 // DeleteItem mocks base method.
 func (m *MockStorage) DeleteItem(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -137,6 +324,125 @@ func (mr *MockStorageMockRecorder) DeleteItem(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockStorage)(nil).DeleteItem), arg0, arg1)
 }
 
+// DeleteTag mocks base method.
+func (m *MockStorage) DeleteTag(arg0 uuid.UUID, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockStorageMockRecorder) DeleteTag(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockStorage)(nil).DeleteTag), arg0, arg1, arg2)
+}
+
+// EnqueueAIJob mocks base method.
+func (m *MockStorage) EnqueueAIJob(arg0 uuid.UUID, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueAIJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueAIJob indicates an expected call of EnqueueAIJob.
+func (mr *MockStorageMockRecorder) EnqueueAIJob(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueAIJob", reflect.TypeOf((*MockStorage)(nil).EnqueueAIJob), arg0, arg1, arg2)
+}
+
+// GetAIJobs mocks base method.
+func (m *MockStorage) GetAIJobs(arg0 uuid.UUID, arg1 int) ([]models.AIJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAIJobs", arg0, arg1)
+	ret0, _ := ret[0].([]models.AIJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAIJobs indicates an expected call of GetAIJobs.
+func (mr *MockStorageMockRecorder) GetAIJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIJobs", reflect.TypeOf((*MockStorage)(nil).GetAIJobs), arg0, arg1)
+}
+
+// GetAIUsage mocks base method.
+func (m *MockStorage) GetAIUsage(arg0 uuid.UUID, arg1 string) (*models.AIUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAIUsage", arg0, arg1)
+	ret0, _ := ret[0].(*models.AIUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAIUsage indicates an expected call of GetAIUsage.
+func (mr *MockStorageMockRecorder) GetAIUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIUsage", reflect.TypeOf((*MockStorage)(nil).GetAIUsage), arg0, arg1)
+}
+
+// GetAIUsageHistory mocks base method.
+func (m *MockStorage) GetAIUsageHistory(arg0 uuid.UUID) ([]models.AIUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAIUsageHistory", arg0)
+	ret0, _ := ret[0].([]models.AIUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAIUsageHistory indicates an expected call of GetAIUsageHistory.
+func (mr *MockStorageMockRecorder) GetAIUsageHistory(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIUsageHistory", reflect.TypeOf((*MockStorage)(nil).GetAIUsageHistory), arg0)
+}
+
+// GetAccessTokenByHash mocks base method.
+func (m *MockStorage) GetAccessTokenByHash(arg0 string) (*models.AccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessTokenByHash", arg0)
+	ret0, _ := ret[0].(*models.AccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessTokenByHash indicates an expected call of GetAccessTokenByHash.
+func (mr *MockStorageMockRecorder) GetAccessTokenByHash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessTokenByHash", reflect.TypeOf((*MockStorage)(nil).GetAccessTokenByHash), arg0)
+}
+
+// GetAccessTokens mocks base method.
+func (m *MockStorage) GetAccessTokens(arg0 uuid.UUID) ([]models.AccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessTokens", arg0)
+	ret0, _ := ret[0].([]models.AccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessTokens indicates an expected call of GetAccessTokens.
+func (mr *MockStorageMockRecorder) GetAccessTokens(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessTokens", reflect.TypeOf((*MockStorage)(nil).GetAccessTokens), arg0)
+}
+
+// GetActiveSessions mocks base method.
+func (m *MockStorage) GetActiveSessions(arg0 uuid.UUID) ([]models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveSessions", arg0)
+	ret0, _ := ret[0].([]models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveSessions indicates an expected call of GetActiveSessions.
+func (mr *MockStorageMockRecorder) GetActiveSessions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveSessions", reflect.TypeOf((*MockStorage)(nil).GetActiveSessions), arg0)
+}
+
 // GetAllUsers mocks base method.
 func (m *MockStorage) GetAllUsers() ([]*models.User, error) {
 	m.ctrl.T.Helper()
@@ -152,6 +458,81 @@ func (mr *MockStorageMockRecorder) GetAllUsers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockStorage)(nil).GetAllUsers))
 }
 
+// GetAssetCaptions mocks base method.
+func (m *MockStorage) GetAssetCaptions(arg0 uuid.UUID) ([]models.AssetCaption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetCaptions", arg0)
+	ret0, _ := ret[0].([]models.AssetCaption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetCaptions indicates an expected call of GetAssetCaptions.
+func (mr *MockStorageMockRecorder) GetAssetCaptions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetCaptions", reflect.TypeOf((*MockStorage)(nil).GetAssetCaptions), arg0)
+}
+
+// GetAssetTranscript mocks base method.
+func (m *MockStorage) GetAssetTranscript(arg0 uuid.UUID, arg1 string) (*models.AssetTranscript, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssetTranscript", arg0, arg1)
+	ret0, _ := ret[0].(*models.AssetTranscript)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssetTranscript indicates an expected call of GetAssetTranscript.
+func (mr *MockStorageMockRecorder) GetAssetTranscript(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssetTranscript", reflect.TypeOf((*MockStorage)(nil).GetAssetTranscript), arg0, arg1)
+}
+
+// GetAuditEvents mocks base method.
+func (m *MockStorage) GetAuditEvents(arg0 database.AuditFilter) ([]models.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEvents", arg0)
+	ret0, _ := ret[0].([]models.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditEvents indicates an expected call of GetAuditEvents.
+func (mr *MockStorageMockRecorder) GetAuditEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEvents", reflect.TypeOf((*MockStorage)(nil).GetAuditEvents), arg0)
+}
+
+// GetBacklinks mocks base method.
+func (m *MockStorage) GetBacklinks(arg0 uuid.UUID, arg1 string) ([]*models.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBacklinks", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBacklinks indicates an expected call of GetBacklinks.
+func (mr *MockStorageMockRecorder) GetBacklinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBacklinks", reflect.TypeOf((*MockStorage)(nil).GetBacklinks), arg0, arg1)
+}
+
+// GetBrokenLinks mocks base method.
+func (m *MockStorage) GetBrokenLinks(arg0 uuid.UUID) ([]models.ItemLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrokenLinks", arg0)
+	ret0, _ := ret[0].([]models.ItemLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBrokenLinks indicates an expected call of GetBrokenLinks.
+func (mr *MockStorageMockRecorder) GetBrokenLinks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrokenLinks", reflect.TypeOf((*MockStorage)(nil).GetBrokenLinks), arg0)
+}
+
 // GetChangesSince mocks base method.
 func (m *MockStorage) GetChangesSince(arg0 uuid.UUID, arg1 uint, arg2 int) ([]*models.ItemChange, error) {
 	m.ctrl.T.Helper()
@@ -167,6 +548,21 @@ func (mr *MockStorageMockRecorder) GetChangesSince(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangesSince", reflect.TypeOf((*MockStorage)(nil).GetChangesSince), arg0, arg1, arg2)
 }
 
+// GetCustomFields mocks base method.
+func (m *MockStorage) GetCustomFields(arg0 uuid.UUID) ([]models.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomFields", arg0)
+	ret0, _ := ret[0].([]models.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomFields indicates an expected call of GetCustomFields.
+func (mr *MockStorageMockRecorder) GetCustomFields(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomFields", reflect.TypeOf((*MockStorage)(nil).GetCustomFields), arg0)
+}
+
 // GetDB mocks base method.
 func (m *MockStorage) GetDB() *gorm.DB {
 	m.ctrl.T.Helper()
@@ -181,6 +577,21 @@ func (mr *MockStorageMockRecorder) GetDB() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDB", reflect.TypeOf((*MockStorage)(nil).GetDB))
 }
 
+// GetDayWordCounts mocks base method.
+func (m *MockStorage) GetDayWordCounts(arg0 uuid.UUID, arg1, arg2 string) ([]database.DayWords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDayWordCounts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]database.DayWords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDayWordCounts indicates an expected call of GetDayWordCounts.
+func (mr *MockStorageMockRecorder) GetDayWordCounts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDayWordCounts", reflect.TypeOf((*MockStorage)(nil).GetDayWordCounts), arg0, arg1, arg2)
+}
+
 // GetDistinctTags mocks base method.
 func (m *MockStorage) GetDistinctTags(arg0 uuid.UUID) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +637,21 @@ func (mr *MockStorageMockRecorder) GetFamilyByName(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFamilyByName", reflect.TypeOf((*MockStorage)(nil).GetFamilyByName), arg0)
 }
 
+// GetFieldStats mocks base method.
+func (m *MockStorage) GetFieldStats(arg0 uuid.UUID, arg1 models.CustomField, arg2, arg3 string) (*database.FieldStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFieldStats", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*database.FieldStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFieldStats indicates an expected call of GetFieldStats.
+func (mr *MockStorageMockRecorder) GetFieldStats(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFieldStats", reflect.TypeOf((*MockStorage)(nil).GetFieldStats), arg0, arg1, arg2, arg3)
+}
+
 // GetIgnoredOrphans mocks base method.
 func (m *MockStorage) GetIgnoredOrphans(arg0 uuid.UUID) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +682,21 @@ func (mr *MockStorageMockRecorder) GetItem(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockStorage)(nil).GetItem), arg0, arg1)
 }
 
+// GetItemEmbeddings mocks base method.
+func (m *MockStorage) GetItemEmbeddings(arg0 uuid.UUID, arg1 string) ([]models.ItemEmbedding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemEmbeddings", arg0, arg1)
+	ret0, _ := ret[0].([]models.ItemEmbedding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemEmbeddings indicates an expected call of GetItemEmbeddings.
+func (mr *MockStorageMockRecorder) GetItemEmbeddings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemEmbeddings", reflect.TypeOf((*MockStorage)(nil).GetItemEmbeddings), arg0, arg1)
+}
+
 // GetItems mocks base method.
 func (m *MockStorage) GetItems(arg0 uuid.UUID, arg1 database.SearchParams) ([]*models.Item, int, error) {
 	m.ctrl.T.Helper()
@@ -272,6 +713,21 @@ func (mr *MockStorageMockRecorder) GetItems(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItems", reflect.TypeOf((*MockStorage)(nil).GetItems), arg0, arg1)
 }
 
+// GetItemsWithStaleEmbeddings mocks base method.
+func (m *MockStorage) GetItemsWithStaleEmbeddings(arg0 uuid.UUID, arg1 string, arg2 int) ([]*models.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemsWithStaleEmbeddings", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemsWithStaleEmbeddings indicates an expected call of GetItemsWithStaleEmbeddings.
+func (mr *MockStorageMockRecorder) GetItemsWithStaleEmbeddings(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemsWithStaleEmbeddings", reflect.TypeOf((*MockStorage)(nil).GetItemsWithStaleEmbeddings), arg0, arg1, arg2)
+}
+
 // GetLatestChangeID mocks base method.
 func (m *MockStorage) GetLatestChangeID(arg0 uuid.UUID) (uint, error) {
 	m.ctrl.T.Helper()
@@ -287,21 +743,51 @@ func (mr *MockStorageMockRecorder) GetLatestChangeID(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestChangeID", reflect.TypeOf((*MockStorage)(nil).GetLatestChangeID), arg0)
 }
 
-// GetNextDate mocks base method.
-func (m *MockStorage) GetNextDate(arg0 uuid.UUID, arg1 string) (string, error) {
+// GetLoginLockout mocks base method.
+func (m *MockStorage) GetLoginLockout(arg0 string) (*models.LoginLockout, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNextDate", arg0, arg1)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetLoginLockout", arg0)
+	ret0, _ := ret[0].(*models.LoginLockout)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNextDate indicates an expected call of GetNextDate.
-func (mr *MockStorageMockRecorder) GetNextDate(arg0, arg1 interface{}) *gomock.Call {
+// GetLoginLockout indicates an expected call of GetLoginLockout.
+func (mr *MockStorageMockRecorder) GetLoginLockout(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginLockout", reflect.TypeOf((*MockStorage)(nil).GetLoginLockout), arg0)
+}
+
+// GetNextDate mocks base method.
+func (m *MockStorage) GetNextDate(arg0 uuid.UUID, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextDate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextDate indicates an expected call of GetNextDate.
+func (mr *MockStorageMockRecorder) GetNextDate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextDate", reflect.TypeOf((*MockStorage)(nil).GetNextDate), arg0, arg1)
 }
 
+// GetPlaces mocks base method.
+func (m *MockStorage) GetPlaces(arg0 uuid.UUID, arg1, arg2 string) ([]database.PlaceStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaces", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]database.PlaceStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaces indicates an expected call of GetPlaces.
+func (mr *MockStorageMockRecorder) GetPlaces(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaces", reflect.TypeOf((*MockStorage)(nil).GetPlaces), arg0, arg1, arg2)
+}
+
 // GetPreviousDate mocks base method.
 func (m *MockStorage) GetPreviousDate(arg0 uuid.UUID, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -317,6 +803,111 @@ func (mr *MockStorageMockRecorder) GetPreviousDate(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousDate", reflect.TypeOf((*MockStorage)(nil).GetPreviousDate), arg0, arg1)
 }
 
+// GetRecap mocks base method.
+func (m *MockStorage) GetRecap(arg0 uuid.UUID, arg1, arg2 string) (*models.Recap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecap", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Recap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecap indicates an expected call of GetRecap.
+func (mr *MockStorageMockRecorder) GetRecap(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecap", reflect.TypeOf((*MockStorage)(nil).GetRecap), arg0, arg1, arg2)
+}
+
+// GetRecaps mocks base method.
+func (m *MockStorage) GetRecaps(arg0 uuid.UUID, arg1 string) ([]models.Recap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecaps", arg0, arg1)
+	ret0, _ := ret[0].([]models.Recap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecaps indicates an expected call of GetRecaps.
+func (mr *MockStorageMockRecorder) GetRecaps(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecaps", reflect.TypeOf((*MockStorage)(nil).GetRecaps), arg0, arg1)
+}
+
+// GetSessionByAccessToken mocks base method.
+func (m *MockStorage) GetSessionByAccessToken(arg0 string) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByAccessToken", arg0)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionByAccessToken indicates an expected call of GetSessionByAccessToken.
+func (mr *MockStorageMockRecorder) GetSessionByAccessToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByAccessToken", reflect.TypeOf((*MockStorage)(nil).GetSessionByAccessToken), arg0)
+}
+
+// GetSessionByRefreshToken mocks base method.
+func (m *MockStorage) GetSessionByRefreshToken(arg0 string) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByRefreshToken", arg0)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionByRefreshToken indicates an expected call of GetSessionByRefreshToken.
+func (mr *MockStorageMockRecorder) GetSessionByRefreshToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByRefreshToken", reflect.TypeOf((*MockStorage)(nil).GetSessionByRefreshToken), arg0)
+}
+
+// GetSuggestionCache mocks base method.
+func (m *MockStorage) GetSuggestionCache(arg0 string, arg1 time.Time) (*models.SuggestionCacheEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuggestionCache", arg0, arg1)
+	ret0, _ := ret[0].(*models.SuggestionCacheEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuggestionCache indicates an expected call of GetSuggestionCache.
+func (mr *MockStorageMockRecorder) GetSuggestionCache(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuggestionCache", reflect.TypeOf((*MockStorage)(nil).GetSuggestionCache), arg0, arg1)
+}
+
+// GetTagMetadata mocks base method.
+func (m *MockStorage) GetTagMetadata(arg0 uuid.UUID) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagMetadata", arg0)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagMetadata indicates an expected call of GetTagMetadata.
+func (mr *MockStorageMockRecorder) GetTagMetadata(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagMetadata", reflect.TypeOf((*MockStorage)(nil).GetTagMetadata), arg0)
+}
+
+// GetTagStats mocks base method.
+func (m *MockStorage) GetTagStats(arg0 uuid.UUID) ([]database.TagStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagStats", arg0)
+	ret0, _ := ret[0].([]database.TagStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagStats indicates an expected call of GetTagStats.
+func (mr *MockStorageMockRecorder) GetTagStats(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagStats", reflect.TypeOf((*MockStorage)(nil).GetTagStats), arg0)
+}
+
 // GetUser mocks base method.
 func (m *MockStorage) GetUser(arg0 uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -347,6 +938,51 @@ func (mr *MockStorageMockRecorder) GetUserByUsername(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockStorage)(nil).GetUserByUsername), arg0)
 }
 
+// GetWritingStats mocks base method.
+func (m *MockStorage) GetWritingStats(arg0 uuid.UUID, arg1, arg2, arg3 string) (*database.WritingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWritingStats", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*database.WritingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWritingStats indicates an expected call of GetWritingStats.
+func (mr *MockStorageMockRecorder) GetWritingStats(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWritingStats", reflect.TypeOf((*MockStorage)(nil).GetWritingStats), arg0, arg1, arg2, arg3)
+}
+
+// IsSessionTokenRevoked mocks base method.
+func (m *MockStorage) IsSessionTokenRevoked(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionTokenRevoked", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionTokenRevoked indicates an expected call of IsSessionTokenRevoked.
+func (mr *MockStorageMockRecorder) IsSessionTokenRevoked(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionTokenRevoked", reflect.TypeOf((*MockStorage)(nil).IsSessionTokenRevoked), arg0)
+}
+
+// MergeTags mocks base method.
+func (m *MockStorage) MergeTags(arg0 uuid.UUID, arg1 map[string]string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockStorageMockRecorder) MergeTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockStorage)(nil).MergeTags), arg0, arg1)
+}
+
 // Open mocks base method.
 func (m *MockStorage) Open() error {
 	m.ctrl.T.Helper()
@@ -361,6 +997,34 @@ func (mr *MockStorageMockRecorder) Open() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockStorage)(nil).Open))
 }
 
+// PutAssetCaption mocks base method.
+func (m *MockStorage) PutAssetCaption(arg0 *models.AssetCaption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutAssetCaption", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutAssetCaption indicates an expected call of PutAssetCaption.
+func (mr *MockStorageMockRecorder) PutAssetCaption(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAssetCaption", reflect.TypeOf((*MockStorage)(nil).PutAssetCaption), arg0)
+}
+
+// PutAssetTranscript mocks base method.
+func (m *MockStorage) PutAssetTranscript(arg0 *models.AssetTranscript) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutAssetTranscript", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutAssetTranscript indicates an expected call of PutAssetTranscript.
+func (mr *MockStorageMockRecorder) PutAssetTranscript(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAssetTranscript", reflect.TypeOf((*MockStorage)(nil).PutAssetTranscript), arg0)
+}
+
 // PutItem mocks base method.
 func (m *MockStorage) PutItem(arg0 uuid.UUID, arg1 *models.Item) error {
 	m.ctrl.T.Helper()
@@ -375,6 +1039,34 @@ func (mr *MockStorageMockRecorder) PutItem(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutItem", reflect.TypeOf((*MockStorage)(nil).PutItem), arg0, arg1)
 }
 
+// PutItemEmbedding mocks base method.
+func (m *MockStorage) PutItemEmbedding(arg0 *models.ItemEmbedding) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutItemEmbedding", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutItemEmbedding indicates an expected call of PutItemEmbedding.
+func (mr *MockStorageMockRecorder) PutItemEmbedding(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutItemEmbedding", reflect.TypeOf((*MockStorage)(nil).PutItemEmbedding), arg0)
+}
+
+// PutSuggestionCache mocks base method.
+func (m *MockStorage) PutSuggestionCache(arg0 *models.SuggestionCacheEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSuggestionCache", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutSuggestionCache indicates an expected call of PutSuggestionCache.
+func (mr *MockStorageMockRecorder) PutSuggestionCache(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSuggestionCache", reflect.TypeOf((*MockStorage)(nil).PutSuggestionCache), arg0)
+}
+
 // PutUser mocks base method.
 func (m *MockStorage) PutUser(arg0 *models.User) error {
 	m.ctrl.T.Helper()
@@ -403,6 +1095,120 @@ func (mr *MockStorageMockRecorder) RemoveIgnoredOrphan(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIgnoredOrphan", reflect.TypeOf((*MockStorage)(nil).RemoveIgnoredOrphan), arg0, arg1)
 }
 
+// RenameTag mocks base method.
+func (m *MockStorage) RenameTag(arg0 uuid.UUID, arg1, arg2 string, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockStorageMockRecorder) RenameTag(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockStorage)(nil).RenameTag), arg0, arg1, arg2, arg3)
+}
+
+// RequeueRunningAIJobs mocks base method.
+func (m *MockStorage) RequeueRunningAIJobs() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueRunningAIJobs")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueRunningAIJobs indicates an expected call of RequeueRunningAIJobs.
+func (mr *MockStorageMockRecorder) RequeueRunningAIJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueRunningAIJobs", reflect.TypeOf((*MockStorage)(nil).RequeueRunningAIJobs))
+}
+
+// RevokeAccessToken mocks base method.
+func (m *MockStorage) RevokeAccessToken(arg0, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccessToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccessToken indicates an expected call of RevokeAccessToken.
+func (mr *MockStorageMockRecorder) RevokeAccessToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccessToken", reflect.TypeOf((*MockStorage)(nil).RevokeAccessToken), arg0, arg1)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockStorage) RevokeOtherSessions(arg0, arg1 uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockStorageMockRecorder) RevokeOtherSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockStorage)(nil).RevokeOtherSessions), arg0, arg1)
+}
+
+// RevokeSession mocks base method.
+func (m *MockStorage) RevokeSession(arg0, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockStorageMockRecorder) RevokeSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockStorage)(nil).RevokeSession), arg0, arg1)
+}
+
+// RotateSession mocks base method.
+func (m *MockStorage) RotateSession(arg0, arg1 uuid.UUID, arg2, arg3, arg4 string, arg5 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSession", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateSession indicates an expected call of RotateSession.
+func (mr *MockStorageMockRecorder) RotateSession(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSession", reflect.TypeOf((*MockStorage)(nil).RotateSession), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SetFamilyAICaptionsEnabled mocks base method.
+func (m *MockStorage) SetFamilyAICaptionsEnabled(arg0 uuid.UUID, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFamilyAICaptionsEnabled", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFamilyAICaptionsEnabled indicates an expected call of SetFamilyAICaptionsEnabled.
+func (mr *MockStorageMockRecorder) SetFamilyAICaptionsEnabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFamilyAICaptionsEnabled", reflect.TypeOf((*MockStorage)(nil).SetFamilyAICaptionsEnabled), arg0, arg1)
+}
+
+// SetFamilyAISearchEnabled mocks base method.
+func (m *MockStorage) SetFamilyAISearchEnabled(arg0 uuid.UUID, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFamilyAISearchEnabled", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFamilyAISearchEnabled indicates an expected call of SetFamilyAISearchEnabled.
+func (mr *MockStorageMockRecorder) SetFamilyAISearchEnabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFamilyAISearchEnabled", reflect.TypeOf((*MockStorage)(nil).SetFamilyAISearchEnabled), arg0, arg1)
+}
+
 // SetFamilyAISettings mocks base method.
 func (m *MockStorage) SetFamilyAISettings(arg0 uuid.UUID, arg1, arg2, arg3, arg4, arg5 bool) error {
 	m.ctrl.T.Helper()
@@ -417,6 +1223,20 @@ func (mr *MockStorageMockRecorder) SetFamilyAISettings(arg0, arg1, arg2, arg3, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFamilyAISettings", reflect.TypeOf((*MockStorage)(nil).SetFamilyAISettings), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// SetFamilyAISummariesEnabled mocks base method.
+func (m *MockStorage) SetFamilyAISummariesEnabled(arg0 uuid.UUID, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFamilyAISummariesEnabled", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFamilyAISummariesEnabled indicates an expected call of SetFamilyAISummariesEnabled.
+func (mr *MockStorageMockRecorder) SetFamilyAISummariesEnabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFamilyAISummariesEnabled", reflect.TypeOf((*MockStorage)(nil).SetFamilyAISummariesEnabled), arg0, arg1)
+}
+
 // SetFamilyAITaggingEnabled mocks base method.
 func (m *MockStorage) SetFamilyAITaggingEnabled(arg0 uuid.UUID, arg1 bool) error {
 	m.ctrl.T.Helper()
@@ -431,6 +1251,76 @@ func (mr *MockStorageMockRecorder) SetFamilyAITaggingEnabled(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFamilyAITaggingEnabled", reflect.TypeOf((*MockStorage)(nil).SetFamilyAITaggingEnabled), arg0, arg1)
 }
 
+// SetFamilyAITranscriptionEnabled mocks base method.
+func (m *MockStorage) SetFamilyAITranscriptionEnabled(arg0 uuid.UUID, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFamilyAITranscriptionEnabled", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFamilyAITranscriptionEnabled indicates an expected call of SetFamilyAITranscriptionEnabled.
+func (mr *MockStorageMockRecorder) SetFamilyAITranscriptionEnabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFamilyAITranscriptionEnabled", reflect.TypeOf((*MockStorage)(nil).SetFamilyAITranscriptionEnabled), arg0, arg1)
+}
+
+// SetFamilyBackfillDone mocks base method.
+func (m *MockStorage) SetFamilyBackfillDone(arg0 uuid.UUID, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFamilyBackfillDone", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFamilyBackfillDone indicates an expected call of SetFamilyBackfillDone.
+func (mr *MockStorageMockRecorder) SetFamilyBackfillDone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFamilyBackfillDone", reflect.TypeOf((*MockStorage)(nil).SetFamilyBackfillDone), arg0, arg1)
+}
+
+// SetFamilyMemoriesDigestEnabled mocks base method.
+func (m *MockStorage) SetFamilyMemoriesDigestEnabled(arg0 uuid.UUID, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFamilyMemoriesDigestEnabled", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFamilyMemoriesDigestEnabled indicates an expected call of SetFamilyMemoriesDigestEnabled.
+func (mr *MockStorageMockRecorder) SetFamilyMemoriesDigestEnabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFamilyMemoriesDigestEnabled", reflect.TypeOf((*MockStorage)(nil).SetFamilyMemoriesDigestEnabled), arg0, arg1)
+}
+
+// SetFamilyMemoriesDigestSent mocks base method.
+func (m *MockStorage) SetFamilyMemoriesDigestSent(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFamilyMemoriesDigestSent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFamilyMemoriesDigestSent indicates an expected call of SetFamilyMemoriesDigestSent.
+func (mr *MockStorageMockRecorder) SetFamilyMemoriesDigestSent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFamilyMemoriesDigestSent", reflect.TypeOf((*MockStorage)(nil).SetFamilyMemoriesDigestSent), arg0, arg1)
+}
+
+// SetFamilyTaggingPolicy mocks base method.
+func (m *MockStorage) SetFamilyTaggingPolicy(arg0 uuid.UUID, arg1 string, arg2 bool, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFamilyTaggingPolicy", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFamilyTaggingPolicy indicates an expected call of SetFamilyTaggingPolicy.
+func (mr *MockStorageMockRecorder) SetFamilyTaggingPolicy(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFamilyTaggingPolicy", reflect.TypeOf((*MockStorage)(nil).SetFamilyTaggingPolicy), arg0, arg1, arg2, arg3)
+}
+
 // SetPendingTags mocks base method.
 func (m *MockStorage) SetPendingTags(arg0 uuid.UUID, arg1 string, arg2 []string) error {
 	m.ctrl.T.Helper()
@@ -444,3 +1334,76 @@ func (mr *MockStorageMockRecorder) SetPendingTags(arg0, arg1, arg2 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPendingTags", reflect.TypeOf((*MockStorage)(nil).SetPendingTags), arg0, arg1, arg2)
 }
+
+// TouchAccessToken mocks base method.
+func (m *MockStorage) TouchAccessToken(arg0 uuid.UUID, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAccessToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAccessToken indicates an expected call of TouchAccessToken.
+func (mr *MockStorageMockRecorder) TouchAccessToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAccessToken", reflect.TypeOf((*MockStorage)(nil).TouchAccessToken), arg0, arg1)
+}
+
+// UpdateAIJob mocks base method.
+func (m *MockStorage) UpdateAIJob(arg0 *models.AIJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAIJob", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAIJob indicates an expected call of UpdateAIJob.
+func (mr *MockStorageMockRecorder) UpdateAIJob(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAIJob", reflect.TypeOf((*MockStorage)(nil).UpdateAIJob), arg0)
+}
+
+// UpdateCustomField mocks base method.
+func (m *MockStorage) UpdateCustomField(arg0 uuid.UUID, arg1 string, arg2 func(*models.CustomField)) (*models.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomField", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomField indicates an expected call of UpdateCustomField.
+func (mr *MockStorageMockRecorder) UpdateCustomField(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomField", reflect.TypeOf((*MockStorage)(nil).UpdateCustomField), arg0, arg1, arg2)
+}
+
+// UpdateLoginLockout mocks base method.
+func (m *MockStorage) UpdateLoginLockout(arg0 string, arg1 func(*models.LoginLockout)) (*models.LoginLockout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoginLockout", arg0, arg1)
+	ret0, _ := ret[0].(*models.LoginLockout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLoginLockout indicates an expected call of UpdateLoginLockout.
+func (mr *MockStorageMockRecorder) UpdateLoginLockout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoginLockout", reflect.TypeOf((*MockStorage)(nil).UpdateLoginLockout), arg0, arg1)
+}
+
+// UpdateTagMetadata mocks base method.
+func (m *MockStorage) UpdateTagMetadata(arg0 uuid.UUID, arg1 string, arg2 func(*models.Tag)) (*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTagMetadata", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTagMetadata indicates an expected call of UpdateTagMetadata.
+func (mr *MockStorageMockRecorder) UpdateTagMetadata(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTagMetadata", reflect.TypeOf((*MockStorage)(nil).UpdateTagMetadata), arg0, arg1, arg2)
+}
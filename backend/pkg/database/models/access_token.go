@@ -0,0 +1,53 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	coremodels "github.com/ya-breeze/kin-core/models"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+)
+
+// AccessToken is a long-lived personal access token for scripts and
+// integrations. Only the SHA-256 hash of the secret is stored; revoking a
+// token soft-deletes the row.
+type AccessToken struct {
+	coremodels.TenantModel
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	Name      string    `gorm:"not null"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	// Prefix is the leading part of the secret, kept so users can tell tokens apart.
+	Prefix     string
+	Scopes     StringList `gorm:"type:json"`
+	LastUsedAt *time.Time
+	// ExpiresAt is optional; nil means the token lives until revoked.
+	ExpiresAt *time.Time
+}
+
+// HasScope reports whether the token was granted scope.
+func (t AccessToken) HasScope(scope string) bool {
+	return slices.Contains(t.Scopes, scope)
+}
+
+// Expired reports whether the token is past its optional expiry at now.
+func (t AccessToken) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+func (t AccessToken) FromDB() goserver.AccessToken {
+	scopes := make([]goserver.AccessTokenScope, len(t.Scopes))
+	for i, s := range t.Scopes {
+		scopes[i] = goserver.AccessTokenScope(s)
+	}
+	return goserver.AccessToken{
+		Id:         t.ID,
+		Name:       t.Name,
+		Prefix:     t.Prefix,
+		Scopes:     scopes,
+		CreatedAt:  t.CreatedAt,
+		LastUsedAt: t.LastUsedAt,
+		ExpiresAt:  t.ExpiresAt,
+	}
+}
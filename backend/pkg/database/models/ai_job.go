@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+)
+
+// AIJobTagDay suggests tags for one day's entry; it is the unit of the AI
+// tagging backfill.
+const AIJobTagDay = "tag_day"
+
+// AI job states. Queued jobs run once RunAfter has passed; a failed attempt
+// puts the job back to queued with a later RunAfter until the attempts are
+// used up.
+const (
+	AIJobQueued  = "queued"
+	AIJobRunning = "running"
+	AIJobDone    = "done"
+	AIJobFailed  = "failed"
+)
+
+// AIJob is one unit of background AI work for a family, kept in the database
+// so queued work survives restarts.
+type AIJob struct {
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
+	FamilyID uuid.UUID `gorm:"type:uuid;not null;index:idx_ai_jobs_family_status"`
+	Kind     string    `gorm:"not null"`
+	// Date is the day (YYYY-MM-DD) the job works on.
+	Date       string    `gorm:"not null"`
+	Status     string    `gorm:"not null;index:idx_ai_jobs_family_status;index:idx_ai_jobs_status_run_after"`
+	Attempts   int       `gorm:"not null;default:0"`
+	LastError  string    `gorm:"not null;default:''"`
+	RunAfter   time.Time `gorm:"not null;index:idx_ai_jobs_status_run_after"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+func (j AIJob) FromDB() goserver.AIJob {
+	var lastError *string
+	if j.LastError != "" {
+		lastError = &j.LastError
+	}
+	return goserver.AIJob{
+		Id:         j.ID,
+		Kind:       goserver.AIJobKind(j.Kind),
+		Date:       openapi_types.Date{Time: mustParseDate(j.Date)},
+		Status:     goserver.AIJobStatus(j.Status),
+		Attempts:   j.Attempts,
+		LastError:  lastError,
+		RunAfter:   j.RunAfter,
+		CreatedAt:  j.CreatedAt,
+		FinishedAt: j.FinishedAt,
+	}
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AIUsage counts a family's AI model calls in one calendar month (UTC,
+// "YYYY-MM"). Tokens are as reported by the provider; servers that report none
+// leave them at 0.
+type AIUsage struct {
+	FamilyID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Month        string    `gorm:"primaryKey"`
+	Requests     int64     `gorm:"not null;default:0"`
+	InputTokens  int64     `gorm:"not null;default:0"`
+	OutputTokens int64     `gorm:"not null;default:0"`
+	// Images counts the images and video keyframes sent.
+	Images    int64 `gorm:"not null;default:0"`
+	UpdatedAt time.Time
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssetCaption is the AI-written description of one image asset, keyed by its
+// file name in the family's asset directory. An image the model could not
+// describe gets a row with an empty Caption so it is not retried on every
+// sweep.
+type AssetCaption struct {
+	FamilyID  uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Filename  string    `gorm:"primaryKey"`
+	Caption   string    `gorm:"not null"`
+	Model     string    `gorm:"not null"`
+	UpdatedAt time.Time
+}
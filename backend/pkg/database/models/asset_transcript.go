@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssetTranscript is the transcript of one voice memo, keyed by its file
+// name in the family's asset directory. It records what was appended to the
+// entry so the same memo is not transcribed and appended twice.
+type AssetTranscript struct {
+	FamilyID   uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Filename   string    `gorm:"primaryKey"`
+	Transcript string    `gorm:"not null"`
+	CreatedAt  time.Time
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+)
+
+// Audited actions.
+const (
+	AuditLoginSucceeded  = "login.succeeded"
+	AuditLoginFailed     = "login.failed"
+	AuditRateLimited     = "auth.rate_limited"
+	AuditAccountLocked   = "account.locked"
+	AuditAccountUnlocked = "account.unlocked"
+	AuditTagRenamed      = "tag.renamed"
+	AuditTagDeleted      = "tag.deleted"
+	AuditTagUpdated      = "tag.updated"
+	AuditFieldDeleted    = "field.deleted"
+	AuditHealthFixed     = "health.fixed"
+	AuditOrphanDeleted   = "orphan.deleted"
+)
+
+// AuditActor identifies who performed an audited action and from where.
+type AuditActor struct {
+	Name      string
+	IPAddress string
+}
+
+// AuditEvent is one entry of the security audit log. Events that cannot be
+// attributed to an account (e.g. logins or rate-limit hits with an unknown
+// username) carry uuid.Nil as FamilyID and are only visible from the CLI.
+type AuditEvent struct {
+	// ID is auto-incrementing so the log can be paged newest-first by ID.
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	FamilyID  uuid.UUID `gorm:"type:uuid;index;not null"`
+	Actor     string
+	Action    string `gorm:"index;not null"`
+	Target    string
+	IPAddress string
+	Timestamp time.Time `gorm:"index;not null"`
+}
+
+func NewAuditEvent(familyID uuid.UUID, actor AuditActor, action, target string) *AuditEvent {
+	return &AuditEvent{
+		FamilyID:  familyID,
+		Actor:     actor.Name,
+		Action:    action,
+		Target:    target,
+		IPAddress: actor.IPAddress,
+		Timestamp: time.Now(),
+	}
+}
+
+// NewLoginAuditEvent records a login attempt made with the given method
+// ("api", "web", "oidc", …). user is nil when no account matched username.
+func NewLoginAuditEvent(user *User, username, method, ipAddress string, succeeded bool) *AuditEvent {
+	familyID := uuid.Nil
+	if user != nil {
+		familyID = user.FamilyID
+		username = user.Username
+	}
+	action := AuditLoginFailed
+	if succeeded {
+		action = AuditLoginSucceeded
+	}
+	return NewAuditEvent(familyID, AuditActor{Name: username, IPAddress: ipAddress}, action, method)
+}
+
+func (e AuditEvent) FromDB() goserver.AuditEvent {
+	var id int32
+	if e.ID <= uint(^uint32(0)>>1) { // Check if it fits in int32 (max positive value)
+		id = int32(e.ID) // #nosec G115 - checked above
+	}
+	return goserver.AuditEvent{
+		Id:        id,
+		Timestamp: e.Timestamp,
+		Actor:     e.Actor,
+		Action:    e.Action,
+		Target:    e.Target,
+		IpAddress: e.IPAddress,
+	}
+}
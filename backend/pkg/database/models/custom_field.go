@@ -0,0 +1,165 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+)
+
+// Custom field types.
+const (
+	FieldTypeNumber  = "number"
+	FieldTypeScale   = "scale"
+	FieldTypeBoolean = "boolean"
+	FieldTypeText    = "text"
+	FieldTypeEnum    = "enum"
+)
+
+// Defaults and limits of custom fields.
+const (
+	DefaultScaleMin    = 1
+	DefaultScaleMax    = 5
+	MaxFieldTextLength = 1000
+)
+
+// CustomField is a family-defined, typed value entries can carry, e.g. a daily
+// 1–5 rating or the hours slept. Entries keep their values in Item.Fields
+// under the field's Key.
+type CustomField struct {
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
+	FamilyID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_custom_fields_family_key"`
+	// Key names the field in entries, filters and stats; it never changes.
+	Key  string `gorm:"not null;uniqueIndex:idx_custom_fields_family_key"`
+	Name string `gorm:"not null"`
+	Type string `gorm:"not null"`
+	// Unit is a display hint for numbers, e.g. "h" or "kg".
+	Unit string `gorm:"not null;default:''"`
+	// Min and Max bound numbers (optional) and scales (defaulting to 1–5).
+	Min *float64
+	Max *float64
+	// Options are the allowed values of an enum.
+	Options   StringList `gorm:"type:json"`
+	Position  int        `gorm:"not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsNumeric reports whether the field's values aggregate as numbers. Booleans
+// count as 1 and 0.
+func (f CustomField) IsNumeric() bool {
+	return f.Type == FieldTypeNumber || f.Type == FieldTypeScale || f.Type == FieldTypeBoolean
+}
+
+// NormalizeValue checks that v, as decoded from JSON, is a valid value of the
+// field and returns it in its stored form: float64 for numbers and scales,
+// bool for booleans and a trimmed string for text and enums.
+func (f CustomField) NormalizeValue(v any) (any, error) {
+	switch f.Type {
+	case FieldTypeNumber, FieldTypeScale:
+		n, ok := v.(float64)
+		if !ok || math.IsNaN(n) || math.IsInf(n, 0) {
+			return nil, fmt.Errorf("%s: expected a number", f.Key)
+		}
+		if f.Type == FieldTypeScale && n != math.Trunc(n) {
+			return nil, fmt.Errorf("%s: expected a whole number", f.Key)
+		}
+		if (f.Min != nil && n < *f.Min) || (f.Max != nil && n > *f.Max) {
+			return nil, fmt.Errorf("%s: %v is out of range", f.Key, n)
+		}
+		return n, nil
+	case FieldTypeBoolean:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected true or false", f.Key)
+		}
+		return b, nil
+	case FieldTypeText:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected text", f.Key)
+		}
+		s = strings.TrimSpace(s)
+		if len([]rune(s)) > MaxFieldTextLength {
+			return nil, fmt.Errorf("%s: text is longer than %d characters", f.Key, MaxFieldTextLength)
+		}
+		return s, nil
+	case FieldTypeEnum:
+		s, ok := v.(string)
+		if !ok || !slices.Contains(f.Options, strings.TrimSpace(s)) {
+			return nil, fmt.Errorf("%s: expected one of %s", f.Key, strings.Join(f.Options, ", "))
+		}
+		return strings.TrimSpace(s), nil
+	default:
+		return nil, fmt.Errorf("%s: unknown field type %q", f.Key, f.Type)
+	}
+}
+
+func (f CustomField) FromDB() goserver.CustomField {
+	res := goserver.CustomField{
+		Key:      f.Key,
+		Name:     f.Name,
+		Type:     goserver.CustomFieldType(f.Type),
+		Min:      f.Min,
+		Max:      f.Max,
+		Options:  []string(f.Options),
+		Position: f.Position,
+	}
+	if res.Options == nil {
+		res.Options = []string{}
+	}
+	if f.Unit != "" {
+		unit := f.Unit
+		res.Unit = &unit
+	}
+	return res
+}
+
+// FieldValues holds an entry's custom field values by field key, stored as a
+// JSON object.
+//
+//nolint:recvcheck // Valuer by value, Scanner by pointer
+type FieldValues map[string]any
+
+// OrEmpty returns the values as a plain map, empty rather than nil, so they
+// serialize as {}.
+func (v FieldValues) OrEmpty() map[string]any {
+	if v == nil {
+		return map[string]any{}
+	}
+	return v
+}
+
+// Implement the sql.Scanner interface
+func (v *FieldValues) Scan(value any) error {
+	switch value := value.(type) {
+	case nil:
+		return nil
+	case string:
+		if len(value) == 0 {
+			return nil
+		}
+		return json.Unmarshal([]byte(value), v)
+	case []byte:
+		if len(value) == 0 {
+			return nil
+		}
+		return json.Unmarshal(value, v)
+	}
+	return fmt.Errorf("failed to unmarshal FieldValues value '%+v'", value)
+}
+
+// Implement the driver.Valuer interface
+func (v FieldValues) Value() (driver.Value, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
@@ -5,6 +5,10 @@ import (
 	coremodels "github.com/ya-breeze/kin-core/models"
 )
 
+// DefaultAITaggingMaxTags is the number of tags suggested per entry when the
+// family has not chosen one (Family.AITaggingMaxTags = 0).
+const DefaultAITaggingMaxTags = 6
+
 type Family struct {
 	coremodels.Family
 	Users []User
@@ -28,6 +32,34 @@ type Family struct {
 	// AITaggingUseVideo sends keyframes extracted from referenced video assets
 	// to Gemini alongside the text. Off by default; requires ffmpeg at runtime.
 	AITaggingUseVideo bool `gorm:"default:false"`
+	// AISummariesEnabled opts the family into on-demand entry summaries and
+	// the scheduled weekly/monthly recaps. Off by default.
+	AISummariesEnabled bool `gorm:"default:false"`
+	// AISearchEnabled opts the family into semantic search: a background task
+	// sends each entry's text to the embedding provider. Off by default.
+	AISearchEnabled bool `gorm:"default:false"`
+	// AICaptionsEnabled opts the family into image captions: a background task
+	// sends each referenced image asset to the model. Off by default.
+	AICaptionsEnabled bool `gorm:"default:false"`
+	// AITranscriptionEnabled opts the family into voice memo transcription:
+	// memos sent for transcription go to the transcription backend. Off by
+	// default.
+	AITranscriptionEnabled bool `gorm:"default:false"`
+	// AITaggingInstructions is the family's own guidance for tag suggestion,
+	// added to the prompt (quoted as user text). Empty by default.
+	AITaggingInstructions string `gorm:"not null;default:''"`
+	// AITaggingClosedVocabulary restricts suggestions to the family's existing
+	// tags; the model may not coin new ones.
+	AITaggingClosedVocabulary bool `gorm:"default:false"`
+	// AITaggingMaxTags caps the tags suggested per entry; 0 means
+	// DefaultAITaggingMaxTags.
+	AITaggingMaxTags int `gorm:"not null;default:0"`
+	// MemoriesDigestEnabled opts the family into the daily "on this day"
+	// digest, sent through the server's memories notifier. Off by default.
+	MemoriesDigestEnabled bool `gorm:"default:false"`
+	// MemoriesDigestSentOn is the last day (YYYY-MM-DD) the family's digest was
+	// assembled, so it goes out at most once a day.
+	MemoriesDigestSentOn string `gorm:"not null;default:''"`
 }
 
 func (f Family) FromDB() goserver.FamilyResponse {
@@ -40,6 +72,17 @@ func (f Family) FromDB() goserver.FamilyResponse {
 	aiTaggingAuto := f.AITaggingAuto
 	aiTaggingUseImages := f.AITaggingUseImages
 	aiTaggingUseVideo := f.AITaggingUseVideo
+	aiSummariesEnabled := f.AISummariesEnabled
+	aiSearchEnabled := f.AISearchEnabled
+	aiCaptionsEnabled := f.AICaptionsEnabled
+	aiTranscriptionEnabled := f.AITranscriptionEnabled
+	aiTaggingInstructions := f.AITaggingInstructions
+	aiTaggingClosedVocabulary := f.AITaggingClosedVocabulary
+	aiTaggingMaxTags := f.AITaggingMaxTags
+	memoriesDigestEnabled := f.MemoriesDigestEnabled
+	if aiTaggingMaxTags <= 0 {
+		aiTaggingMaxTags = DefaultAITaggingMaxTags
+	}
 	return goserver.FamilyResponse{
 		Id:                 f.ID,
 		Name:               f.Name,
@@ -49,5 +92,16 @@ func (f Family) FromDB() goserver.FamilyResponse {
 		AiTaggingAuto:      &aiTaggingAuto,
 		AiTaggingUseImages: &aiTaggingUseImages,
 		AiTaggingUseVideo:  &aiTaggingUseVideo,
+		AiSummariesEnabled: &aiSummariesEnabled,
+		AiSearchEnabled:    &aiSearchEnabled,
+		AiCaptionsEnabled:  &aiCaptionsEnabled,
+
+		AiTranscriptionEnabled: &aiTranscriptionEnabled,
+
+		AiTaggingInstructions:     &aiTaggingInstructions,
+		AiTaggingClosedVocabulary: &aiTaggingClosedVocabulary,
+		AiTaggingMaxTags:          &aiTaggingMaxTags,
+
+		MemoriesDigestEnabled: &memoriesDigestEnabled,
 	}
 }
@@ -18,4 +18,17 @@ type Item struct {
 	// filenames at the time tags were last computed. Used to detect staleness for
 	// edit-triggered retagging and the backfill health check.
 	TagsSourceHash string
+	// WordCount is the number of words of the body (see utils.CountWords),
+	// refreshed on every save for the writing statistics. -1 marks rows
+	// written before it existed until startup fills them in.
+	WordCount int `gorm:"not null;default:-1"`
+	// AssetCount is the number of assets the body embeds (see
+	// utils.GetAssetsFromMarkdown), kept and backfilled like WordCount.
+	AssetCount int `gorm:"not null;default:-1"`
+	// Fields holds the entry's values of the family's custom fields by key.
+	Fields FieldValues `gorm:"type:json"`
+	// Place and Weather are always loaded; left nil on save, the stored ones
+	// are kept.
+	Place   *Place   `gorm:"embedded;embeddedPrefix:place_"`
+	Weather *Weather `gorm:"embedded;embeddedPrefix:weather_"`
 }
@@ -0,0 +1,13 @@
+package models
+
+import "github.com/google/uuid"
+
+// ItemAsset is one asset an entry's body embeds, by the exact path the
+// markdown references. The references live in the body; item_assets indexes
+// them so lookups by asset compare whole paths rather than substrings of the
+// body. Rows are rewritten in the same transaction as the entry.
+type ItemAsset struct {
+	FamilyID uuid.UUID `gorm:"type:uuid;primaryKey;index:idx_item_assets_family_filename,priority:1"`
+	Date     string    `gorm:"primaryKey"`
+	Filename string    `gorm:"primaryKey;index:idx_item_assets_family_filename,priority:2"`
+}
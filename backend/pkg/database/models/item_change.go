@@ -66,11 +66,15 @@ func (ic ItemChange) ToSyncResponse() goserver.SyncChangeResponse {
 		snapshotDate := openapi_types.Date{Time: mustParseDate(ic.ItemSnapshot.Date)}
 		body := ic.ItemSnapshot.Body
 		tags := []string(ic.ItemSnapshot.Tags)
+		fields := ic.ItemSnapshot.Fields.OrEmpty()
 		response.ItemSnapshot = &goserver.ItemsResponse{
-			Date:  snapshotDate,
-			Title: ic.ItemSnapshot.Title,
-			Body:  &body,
-			Tags:  &tags,
+			Date:    snapshotDate,
+			Title:   ic.ItemSnapshot.Title,
+			Body:    &body,
+			Tags:    &tags,
+			Fields:  &fields,
+			Place:   ic.ItemSnapshot.Place.FromDB(),
+			Weather: ic.ItemSnapshot.Weather.FromDB(),
 		}
 	}
 
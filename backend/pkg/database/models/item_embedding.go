@@ -0,0 +1,40 @@
+package models
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ItemEmbedding is the semantic search vector of one entry. SourceHash is the
+// entry's TagsSourceHash when the vector was computed; a mismatch (or a
+// different Model) marks the vector stale. Blank entries get a row with an
+// empty vector so they are not re-embedded on every sweep.
+type ItemEmbedding struct {
+	FamilyID   uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Date       string    `gorm:"primaryKey"`
+	Model      string    `gorm:"not null"`
+	SourceHash string    `gorm:"not null"`
+	// Vector holds little-endian float32 values; see SetVector.
+	Vector    []byte
+	UpdatedAt time.Time
+}
+
+// SetVector encodes vec into Vector.
+func (e *ItemEmbedding) SetVector(vec []float32) {
+	e.Vector = make([]byte, 4*len(vec))
+	for i, v := range vec {
+		binary.LittleEndian.PutUint32(e.Vector[4*i:], math.Float32bits(v))
+	}
+}
+
+// Values decodes Vector.
+func (e ItemEmbedding) Values() []float32 {
+	vec := make([]float32, len(e.Vector)/4)
+	for i := range vec {
+		vec[i] = math.Float32frombits(binary.LittleEndian.Uint32(e.Vector[4*i:]))
+	}
+	return vec
+}
@@ -0,0 +1,13 @@
+package models
+
+import "github.com/google/uuid"
+
+// ItemLink is one [[YYYY-MM-DD]] link from an entry (Date) to another day
+// (Target). The links live in the entry's body; item_links indexes them so
+// backlinks and broken links are SQL. Rows are rewritten in the same
+// transaction as the entry.
+type ItemLink struct {
+	FamilyID uuid.UUID `gorm:"type:uuid;primaryKey;index:idx_item_links_family_target,priority:1"`
+	Date     string    `gorm:"primaryKey"`
+	Target   string    `gorm:"primaryKey;index:idx_item_links_family_target,priority:2"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import "github.com/google/uuid"
+
+// ItemTag is one confirmed tag of an entry. Item.Tags stays the entry's tag
+// list (ordered, synced); item_tags indexes it so tag filters, counts and
+// renames are SQL. Rows are rewritten in the same transaction as the entry.
+//
+// Tags compare case-insensitively in filters; the (family_id, tag COLLATE
+// NOCASE) index serving them is created in the migration.
+type ItemTag struct {
+	FamilyID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Date     string    `gorm:"primaryKey"`
+	Tag      string    `gorm:"primaryKey"`
+}
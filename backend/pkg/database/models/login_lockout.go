@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// LoginLockout tracks consecutive failed logins for an account name (see
+// auth.LoginKey). Names that match no account are tracked too, so a lockout
+// behaves the same whether or not the account exists.
+type LoginLockout struct {
+	Username      string `gorm:"primaryKey"`
+	Failures      int
+	LastFailureAt time.Time
+	// LockedUntil is zero while the account is not locked.
+	LockedUntil time.Time
+}
+
+// Locked reports whether logins for the account are refused at now.
+func (l LoginLockout) Locked(now time.Time) bool {
+	return now.Before(l.LockedUntil)
+}
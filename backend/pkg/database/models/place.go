@@ -0,0 +1,80 @@
+package models
+
+import (
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+)
+
+// Place sources.
+const (
+	PlaceSourceManual = "manual"
+	PlaceSourcePhoto  = "photo"
+)
+
+// Place is where an entry happened: a name, coordinates or both. Coordinates
+// are set together.
+type Place struct {
+	Name      string `gorm:"not null;default:''"`
+	Latitude  *float64
+	Longitude *float64
+	// Source tells whether the user set the place or it was taken from the GPS
+	// position of one of the entry's photos.
+	Source string `gorm:"not null;default:''"`
+}
+
+// IsZero reports whether no place is set.
+func (p *Place) IsZero() bool {
+	return p == nil || (p.Name == "" && !p.HasCoordinates())
+}
+
+// HasCoordinates reports whether the place has a position.
+func (p *Place) HasCoordinates() bool {
+	return p != nil && p.Latitude != nil && p.Longitude != nil
+}
+
+// SameCoordinates reports whether both places have the same position.
+func (p *Place) SameCoordinates(other *Place) bool {
+	return p.HasCoordinates() && other.HasCoordinates() &&
+		*p.Latitude == *other.Latitude && *p.Longitude == *other.Longitude
+}
+
+// FromDB returns the place for API responses, or nil when none is set.
+func (p *Place) FromDB() *goserver.Place {
+	if p.IsZero() {
+		return nil
+	}
+	return &goserver.Place{
+		Name:      p.Name,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+		Source:    goserver.PlaceSource(p.Source),
+	}
+}
+
+// Weather is a snapshot of the weather of an entry's day at its place.
+type Weather struct {
+	Condition       string  `gorm:"not null;default:''"`
+	TempMinC        float64 `gorm:"column:temp_min_c;not null;default:0"`
+	TempMaxC        float64 `gorm:"column:temp_max_c;not null;default:0"`
+	PrecipitationMM float64 `gorm:"column:precipitation_mm;not null;default:0"`
+	// Provider names the weather provider the snapshot came from.
+	Provider string `gorm:"not null;default:''"`
+}
+
+// IsZero reports whether no snapshot is stored.
+func (w *Weather) IsZero() bool {
+	return w == nil || w.Provider == ""
+}
+
+// FromDB returns the snapshot for API responses, or nil when none is stored.
+func (w *Weather) FromDB() *goserver.Weather {
+	if w.IsZero() {
+		return nil
+	}
+	return &goserver.Weather{
+		Condition:       goserver.WeatherCondition(w.Condition),
+		TempMinC:        w.TempMinC,
+		TempMaxC:        w.TempMaxC,
+		PrecipitationMm: w.PrecipitationMM,
+		Provider:        w.Provider,
+	}
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+)
+
+// Recap periods.
+const (
+	RecapPeriodWeek  = "week"
+	RecapPeriodMonth = "month"
+)
+
+// Recap is an AI-written summary of a family's entries over a week (Monday to
+// Sunday) or a calendar month. Recaps are kept apart from diary entries so
+// they never show up in the diary itself or in sync.
+type Recap struct {
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
+	FamilyID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_recaps_family_period_start"`
+	Period   string    `gorm:"not null;uniqueIndex:idx_recaps_family_period_start"`
+	// StartDate and EndDate bound the period (inclusive), as YYYY-MM-DD.
+	StartDate  string `gorm:"not null;uniqueIndex:idx_recaps_family_period_start"`
+	EndDate    string `gorm:"not null"`
+	Summary    string
+	EntryCount int
+	CreatedAt  time.Time
+}
+
+func (r Recap) FromDB() goserver.Recap {
+	return goserver.Recap{
+		Id:         r.ID,
+		Period:     goserver.RecapPeriod(r.Period),
+		StartDate:  openapi_types.Date{Time: mustParseDate(r.StartDate)},
+		EndDate:    openapi_types.Date{Time: mustParseDate(r.EndDate)},
+		Summary:    r.Summary,
+		EntryCount: r.EntryCount,
+		CreatedAt:  r.CreatedAt,
+	}
+}
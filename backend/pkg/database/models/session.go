@@ -0,0 +1,79 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+)
+
+// maxUserAgentLength caps the stored User-Agent header.
+const maxUserAgentLength = 512
+
+// Session describes one signed-in device. kin-core rotates the refresh token on
+// every refresh, so the session keeps a stable ID and follows the current
+// token via RefreshTokenID. A session is active while that token is neither
+// revoked nor expired.
+//
+// The refresh cookie is only sent to the refresh endpoint, so API requests
+// find their own session through the hash of the access token issued with it.
+type Session struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	UserID          uuid.UUID `gorm:"type:uuid;index;not null"`
+	RefreshTokenID  uuid.UUID `gorm:"type:uuid;uniqueIndex;not null"`
+	AccessTokenHash string    `gorm:"index"`
+	UserAgent       string
+	IPAddress       string
+	LastSeenAt      time.Time
+}
+
+// RevokedSessionToken blocks the access token of a signed-out session until
+// it would have expired anyway, so signing a device out takes effect at once
+// instead of at its next refresh.
+type RevokedSessionToken struct {
+	AccessTokenHash string    `gorm:"primaryKey"`
+	ExpiresAt       time.Time `gorm:"index;not null"`
+}
+
+// NewSession builds a session for a freshly issued refresh/access token pair.
+func NewSession(userID, refreshTokenID uuid.UUID, accessToken, userAgent, ipAddress string) *Session {
+	return &Session{
+		ID:              uuid.New(),
+		UserID:          userID,
+		RefreshTokenID:  refreshTokenID,
+		AccessTokenHash: HashSessionToken(accessToken),
+		UserAgent:       TruncateUserAgent(userAgent),
+		IPAddress:       ipAddress,
+		LastSeenAt:      time.Now(),
+	}
+}
+
+// HashSessionToken returns the hex SHA-256 of an access token.
+func HashSessionToken(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return hex.EncodeToString(sum[:])
+}
+
+// TruncateUserAgent bounds a client-supplied User-Agent header for storage.
+func TruncateUserAgent(userAgent string) string {
+	if len(userAgent) > maxUserAgentLength {
+		return userAgent[:maxUserAgentLength]
+	}
+	return userAgent
+}
+
+func (s Session) FromDB(current bool) goserver.Session {
+	return goserver.Session{
+		Id:         s.ID,
+		UserAgent:  s.UserAgent,
+		IpAddress:  s.IPAddress,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+		Current:    current,
+	}
+}
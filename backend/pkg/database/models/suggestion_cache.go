@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SuggestionCacheEntry is a stored tag suggestion answer. Key hashes
+// everything the answer depends on (entry content, model, images and the
+// family's tag vocabulary), so an entry is only reused for an identical
+// request. Suggestions holds the answer as JSON.
+type SuggestionCacheEntry struct {
+	Key         string    `gorm:"primaryKey"`
+	FamilyID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	Suggestions string    `gorm:"type:json;not null"`
+	CreatedAt   time.Time `gorm:"index"`
+}
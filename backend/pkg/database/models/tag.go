@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+)
+
+// Tag is a family's metadata for one tag: display colour, description and
+// aliases. Entries keep their tags as names; a tag needs no row to be used,
+// rows exist only for tags someone has described.
+type Tag struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	FamilyID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_tags_family_name"`
+	Name        string    `gorm:"not null;uniqueIndex:idx_tags_family_name"`
+	Color       string    `gorm:"not null;default:''"`
+	Description string    `gorm:"not null;default:''"`
+	// Aliases are alternative names that resolve to Name when an entry is
+	// saved. Matching ignores case.
+	Aliases   StringList `gorm:"type:json"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsEmpty reports whether the tag carries no metadata at all.
+func (t Tag) IsEmpty() bool {
+	return t.Color == "" && t.Description == "" && len(t.Aliases) == 0
+}
+
+func (t Tag) FromDB() goserver.TagMetadata {
+	res := goserver.TagMetadata{
+		Name:    t.Name,
+		Aliases: []string(t.Aliases),
+	}
+	if res.Aliases == nil {
+		res.Aliases = []string{}
+	}
+	if t.Color != "" {
+		color := t.Color
+		res.Color = &color
+	}
+	if t.Description != "" {
+		description := t.Description
+		res.Description = &description
+	}
+	return res
+}
@@ -10,11 +10,22 @@ import (
 type User struct {
 	coremodels.User
 	StartDate time.Time
+	// TOTPSecret is the base32 RFC 6238 secret. It is set at enrollment and only
+	// takes effect once TOTPEnabled is flipped by a confirmed code.
+	TOTPSecret string
+	// TOTPEnabled requires a second factor on every password login.
+	TOTPEnabled bool `gorm:"default:false"`
+	// TOTPLastStep is the last accepted TOTP time step; codes at or before it
+	// are rejected so an observed code cannot be replayed.
+	TOTPLastStep int64
+	// RecoveryCodes holds SHA-256 hashes of unused single-use recovery codes.
+	RecoveryCodes StringList `gorm:"type:json"`
 }
 
 func (u User) FromDB() goserver.User {
 	return goserver.User{
-		Email:     u.Username,
-		StartDate: u.StartDate,
+		Email:            u.Username,
+		StartDate:        u.StartDate,
+		TwoFactorEnabled: &u.TOTPEnabled,
 	}
 }
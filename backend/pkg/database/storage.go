@@ -1,21 +1,26 @@
 package database
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"path/filepath"
-	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/ya-breeze/diary.be/pkg/auth"
 	"github.com/ya-breeze/diary.be/pkg/config"
 	"github.com/ya-breeze/diary.be/pkg/database/models"
 	"github.com/ya-breeze/diary.be/pkg/utils"
+	"github.com/ya-breeze/kin-core/authdb"
 	coremodels "github.com/ya-breeze/kin-core/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 //go:generate go tool github.com/golang/mock/mockgen -destination=mocks/mock_storage.go -package=mocks github.com/ya-breeze/diary.be/pkg/database Storage //nolint:lll // go:generate directive
@@ -24,6 +29,14 @@ const StorageError = "storage error: %w"
 
 var ErrNotFound = errors.New("not found")
 
+// ErrTagConflict is returned when a tag name or alias is already the name or
+// an alias of another of the family's tags.
+var ErrTagConflict = errors.New("tag name conflict")
+
+// ErrFieldConflict is returned when the family already has a custom field
+// with the key.
+var ErrFieldConflict = errors.New("custom field key conflict")
+
 // SearchParams defines parameters for searching diary items
 type SearchParams struct {
 	// SearchText filters items by title and body content (case-insensitive)
@@ -32,12 +45,135 @@ type SearchParams struct {
 	Tags []string
 	// Date filters items by specific date (optional, for backward compatibility)
 	Date string
+	// DateFrom and DateTo restrict items to an inclusive YYYY-MM-DD range;
+	// either bound may be empty.
+	DateFrom string
+	DateTo   string
+	// Dates restricts items to any of the given YYYY-MM-DD days (optional)
+	Dates []string
+	// Fields filters items whose custom field values satisfy all conditions
+	Fields []FieldFilter
+}
+
+// FieldFilter is a condition on a custom field value: Op is one of =, !=, <,
+// <=, > and >=, Value a float64, bool or string as stored. Entries without a
+// value for the field never match.
+type FieldFilter struct {
+	Key   string
+	Op    string
+	Value any
+}
+
+// fieldFilterOps are the operators a FieldFilter may use.
+var fieldFilterOps = map[string]struct{}{
+	"=": {}, "!=": {}, "<": {}, "<=": {}, ">": {}, ">=": {},
+}
+
+// AuditFilter selects audit events, newest first.
+type AuditFilter struct {
+	// FamilyID restricts events to one family; nil returns events of all
+	// families, including unattributed ones.
+	FamilyID *uuid.UUID
+	// Action restricts events to a single action (optional).
+	Action string
+	// Before returns only events with a smaller ID (0 means from the newest).
+	Before uint
+	// Limit caps the number of returned events (0 means no limit).
+	Limit int
 }
 
 // TagStat is a distinct tag together with the number of entries using it.
+// For hierarchical tags ("travel/italy") counts roll up: Count is the number
+// of entries carrying the tag or any descendant, OwnCount those carrying the
+// tag itself. An ancestor nobody uses directly has OwnCount 0.
 type TagStat struct {
-	Name  string
+	Name     string
+	Count    int
+	OwnCount int
+}
+
+// FieldStats aggregates a custom field's values over a family's entries.
+type FieldStats struct {
+	// Count is the number of entries with a value.
+	Count int
+	// Min, Max, Avg and Sum are set for numeric fields with values; booleans
+	// count as 1 and 0.
+	Min, Max, Avg, Sum *float64
+	// Values counts the entries per value of scale, boolean and enum fields,
+	// most frequent first.
+	Values []FieldValueCount
+	// Months counts the entries with a value per month ("2006-01"), oldest
+	// first.
+	Months []FieldMonthStat
+}
+
+// FieldValueCount is the number of entries with one value of a field.
+type FieldValueCount struct {
+	Value string
+	Count int
+}
+
+// FieldMonthStat is the number of entries with a value of a field in one
+// month, and for numeric fields their average.
+type FieldMonthStat struct {
+	Month string
 	Count int
+	Avg   *float64
+}
+
+// PlaceStat is one place of a family's entries: its name, the average
+// position of its entries and how many there are.
+type PlaceStat struct {
+	Name                string
+	Latitude, Longitude *float64
+	Count               int
+	FirstDate, LastDate string
+}
+
+// WritingStats aggregates a family's entries over a date range.
+type WritingStats struct {
+	Entries int
+	Words   int
+	// CurrentStreak is the run of consecutive days with an entry that ends
+	// today or yesterday; LongestStreak the longest run (the latest of equally
+	// long ones). Both are zero without such a run.
+	CurrentStreak, LongestStreak Streak
+	// Months counts the entries and their words per month ("2006-01"),
+	// oldest first.
+	Months []MonthStat
+	// TagMonths counts the entries carrying each tag per month, oldest month
+	// first and most used tag first within a month.
+	TagMonths []TagMonthStat
+	// AssetRefs is the number of embedded assets; EntriesWithAssets the
+	// number of entries embedding any.
+	AssetRefs         int
+	EntriesWithAssets int
+}
+
+// Streak is a run of consecutive days with an entry.
+type Streak struct {
+	Days       int
+	Start, End string
+}
+
+// MonthStat is the number of entries and their words in one month.
+type MonthStat struct {
+	Month   string
+	Entries int
+	Words   int
+}
+
+// TagMonthStat is the number of entries carrying a tag in one month.
+type TagMonthStat struct {
+	Month string
+	Tag   string
+	Count int
+}
+
+// DayWords is the word count of the entry of one day.
+type DayWords struct {
+	Date  string
+	Words int
 }
 
 //nolint:interfacebloat // keep a single storage interface for simplicity
@@ -59,21 +195,93 @@ type Storage interface {
 	// Toggling backfill off->on resets AITaggingBackfillDone, re-arming the
 	// one-time backfill pass.
 	SetFamilyAISettings(familyID uuid.UUID, enabled, backfill, auto, useImages, useVideo bool) error
+	// SetFamilyAISummariesEnabled opts a family in or out of AI summaries and
+	// recaps.
+	SetFamilyAISummariesEnabled(familyID uuid.UUID, enabled bool) error
+	// SetFamilyAISearchEnabled opts a family in or out of semantic search
+	// (embedding its entries).
+	SetFamilyAISearchEnabled(familyID uuid.UUID, enabled bool) error
+	// SetFamilyAICaptionsEnabled opts a family in or out of AI image captions.
+	SetFamilyAICaptionsEnabled(familyID uuid.UUID, enabled bool) error
+	// SetFamilyAITranscriptionEnabled opts a family in or out of voice memo
+	// transcription.
+	SetFamilyAITranscriptionEnabled(familyID uuid.UUID, enabled bool) error
+	// SetFamilyTaggingPolicy stores the family's tag suggestion guidance:
+	// custom instructions, closed vocabulary and max tags per entry.
+	SetFamilyTaggingPolicy(familyID uuid.UUID, instructions string, closedVocabulary bool, maxTags int) error
 	// SetFamilyBackfillDone marks whether the one-time backfill has exhausted
 	// the family's pre-existing entries.
 	SetFamilyBackfillDone(familyID uuid.UUID, done bool) error
+	// SetFamilyMemoriesDigestEnabled opts a family in or out of the daily
+	// memories digest.
+	SetFamilyMemoriesDigestEnabled(familyID uuid.UUID, enabled bool) error
+	// SetFamilyMemoriesDigestSent records the day (YYYY-MM-DD) the family's
+	// memories digest was last assembled.
+	SetFamilyMemoriesDigestSent(familyID uuid.UUID, date string) error
 
 	// GetDistinctTags returns the family's existing tag vocabulary (deduplicated,
 	// sorted) — used for tag autocomplete and as AI suggestion context.
 	GetDistinctTags(familyID uuid.UUID) ([]string, error)
-	// GetTagStats returns the family's distinct tags with per-tag usage counts,
-	// sorted by count descending then name ascending.
+	// GetTagStats returns the family's distinct tags and their ancestors with
+	// rolled-up usage counts, sorted by count descending then name ascending.
 	GetTagStats(familyID uuid.UUID) ([]TagStat, error)
 	// RenameTag renames a tag across every entry of the family, merging into the
-	// target name where an entry already carries it. Atomic (single transaction).
-	RenameTag(familyID uuid.UUID, oldName, newName string) error
-	// DeleteTag removes a tag from every entry of the family. Atomic.
-	DeleteTag(familyID uuid.UUID, name string) error
+	// target name where an entry already carries it. With subtree, descendants
+	// move along ("travel/italy" becomes "trips/italy"). Tag metadata moves
+	// with the name. Atomic (single transaction).
+	RenameTag(familyID uuid.UUID, oldName, newName string, subtree bool) error
+	// MergeTags applies several renames (old name -> new name) across every
+	// entry of the family in one transaction, merging collisions, with one
+	// change record per entry touched. Tag metadata moves with the names.
+	// Returns the number of entries changed.
+	MergeTags(familyID uuid.UUID, renames map[string]string) (int, error)
+	// DeleteTag removes a tag, and with subtree its descendants, from every
+	// entry of the family, together with their metadata. Atomic.
+	DeleteTag(familyID uuid.UUID, name string, subtree bool) error
+	// GetTagMetadata returns the family's tag metadata, sorted by name.
+	GetTagMetadata(familyID uuid.UUID) ([]models.Tag, error)
+	// UpdateTagMetadata loads (or starts) the metadata of the family's tag
+	// name, applies update and saves it; metadata left empty is deleted.
+	// Entries carrying one of the tag's aliases are re-tagged with the tag, in
+	// the same transaction. ErrTagConflict if the name or an alias is already
+	// the name or an alias of another tag.
+	UpdateTagMetadata(familyID uuid.UUID, name string, update func(tag *models.Tag)) (*models.Tag, error)
+
+	// GetCustomFields returns the family's custom fields, sorted by position
+	// then key.
+	GetCustomFields(familyID uuid.UUID) ([]models.CustomField, error)
+	// CreateCustomField saves a new custom field. ErrFieldConflict if the
+	// family already has a field with its key.
+	CreateCustomField(field *models.CustomField) error
+	// UpdateCustomField loads the family's field key, applies update and saves
+	// it. ErrNotFound if there is no such field.
+	UpdateCustomField(familyID uuid.UUID, key string, update func(field *models.CustomField)) (*models.CustomField, error)
+	// DeleteCustomField deletes the family's field key and removes its values
+	// from every entry, with a change record per entry touched. Atomic.
+	// ErrNotFound if there is no such field.
+	DeleteCustomField(familyID uuid.UUID, key string) error
+	// GetFieldStats aggregates the values of field over the family's entries,
+	// optionally limited to an inclusive YYYY-MM-DD range (either bound may be
+	// empty).
+	GetFieldStats(familyID uuid.UUID, field models.CustomField, from, to string) (*FieldStats, error)
+	// GetPlaces groups the family's entries with a place by name and position
+	// (rounded to about a kilometre), most visited first, optionally limited
+	// to an inclusive YYYY-MM-DD range (either bound may be empty).
+	GetPlaces(familyID uuid.UUID, from, to string) ([]PlaceStat, error)
+	// GetWritingStats aggregates the family's entries, optionally limited to
+	// an inclusive YYYY-MM-DD range (either bound may be empty). today
+	// (YYYY-MM-DD) anchors the current streak.
+	GetWritingStats(familyID uuid.UUID, from, to, today string) (*WritingStats, error)
+	// GetDayWordCounts returns the word count of each of the family's entries,
+	// oldest first, optionally limited to an inclusive YYYY-MM-DD range.
+	GetDayWordCounts(familyID uuid.UUID, from, to string) ([]DayWords, error)
+	// GetBacklinks returns the family's entries that link to the day date
+	// with [[YYYY-MM-DD]], oldest first. An entry linking to itself is not
+	// its own backlink.
+	GetBacklinks(familyID uuid.UUID, date string) ([]*models.Item, error)
+	// GetBrokenLinks returns the family's links to days without an entry,
+	// ordered by linking entry and target.
+	GetBrokenLinks(familyID uuid.UUID) ([]models.ItemLink, error)
 
 	GetItem(familyID uuid.UUID, date string) (*models.Item, error)
 	GetItems(familyID uuid.UUID, searchParams SearchParams) ([]*models.Item, int, error)
@@ -103,6 +311,131 @@ type Storage interface {
 	AddIgnoredOrphan(familyID uuid.UUID, filename string) error
 	RemoveIgnoredOrphan(familyID uuid.UUID, filename string) error
 
+	// Personal access tokens
+	CreateAccessToken(token *models.AccessToken) error
+	// GetAccessTokens lists a user's active (non-revoked) tokens, newest first.
+	GetAccessTokens(userID uuid.UUID) ([]models.AccessToken, error)
+	// GetAccessTokenByHash resolves a presented token; revoked tokens are not found.
+	GetAccessTokenByHash(hash string) (*models.AccessToken, error)
+	// RevokeAccessToken soft-deletes one of the user's tokens; ErrNotFound if
+	// the token does not exist or belongs to someone else.
+	RevokeAccessToken(userID, tokenID uuid.UUID) error
+	TouchAccessToken(tokenID uuid.UUID, usedAt time.Time) error
+
+	// Sign-in sessions (metadata attached to kin-core refresh tokens)
+	CreateSession(session *models.Session) error
+	// GetSessionByRefreshToken finds the session a refresh token belongs to,
+	// whether or not the token is still valid.
+	GetSessionByRefreshToken(token string) (*models.Session, error)
+	// GetSessionByAccessToken finds the session an access token was issued to.
+	GetSessionByAccessToken(accessToken string) (*models.Session, error)
+	// RotateSession moves a session onto a newly issued token pair and records
+	// where it was last seen.
+	RotateSession(sessionID, refreshTokenID uuid.UUID, accessToken, userAgent, ipAddress string, seenAt time.Time) error
+	// GetActiveSessions lists the user's sessions whose refresh token is still
+	// valid, most recently seen first.
+	GetActiveSessions(userID uuid.UUID) ([]models.Session, error)
+	// RevokeSession revokes the session's refresh token and current access
+	// token and forgets it; ErrNotFound if the session does not exist or
+	// belongs to someone else.
+	RevokeSession(userID, sessionID uuid.UUID) error
+	// RevokeOtherSessions revokes every refresh token of the user except the
+	// one behind keepSessionID (uuid.Nil keeps none), along with the current
+	// access tokens of the revoked sessions, and returns how many refresh
+	// tokens were revoked.
+	RevokeOtherSessions(userID, keepSessionID uuid.UUID) (int, error)
+	// IsSessionTokenRevoked reports whether accessToken belonged to a session
+	// that has been signed out. It answers from an in-memory copy of the
+	// revocations, so one made by another process sharing the database may
+	// take up to 30 seconds to apply.
+	IsSessionTokenRevoked(accessToken string) (bool, error)
+	// DeleteInactiveSessions forgets sessions whose refresh token was revoked,
+	// expired or cleaned up, and revoked access tokens that have expired.
+	DeleteInactiveSessions() error
+
+	// CreateAuditEvent appends an event to the security audit log.
+	CreateAuditEvent(event *models.AuditEvent) error
+	// GetAuditEvents returns audit events matching the filter, newest first.
+	GetAuditEvents(filter AuditFilter) ([]models.AuditEvent, error)
+
+	// GetLoginLockout returns the failed-login record for an account name.
+	GetLoginLockout(username string) (*models.LoginLockout, error)
+	// UpdateLoginLockout loads (or starts) the failed-login record for an
+	// account name, applies update and saves it in one transaction.
+	UpdateLoginLockout(username string, update func(lockout *models.LoginLockout)) (*models.LoginLockout, error)
+	// ClearLoginLockout forgets failed logins for an account name, lifting any
+	// lockout; ErrNotFound if there were none.
+	ClearLoginLockout(username string) error
+
+	// GetRecap returns the family's recap of the given period starting at
+	// startDate; ErrNotFound if it has not been written.
+	GetRecap(familyID uuid.UUID, period, startDate string) (*models.Recap, error)
+	// GetRecaps returns the family's recaps, newest period first. An empty
+	// period returns weekly and monthly recaps alike.
+	GetRecaps(familyID uuid.UUID, period string) ([]models.Recap, error)
+	// CreateRecap stores a new recap; a recap for the same family, period and
+	// start date must not exist yet.
+	CreateRecap(recap *models.Recap) error
+
+	// GetItemsWithStaleEmbeddings returns up to limit of the family's items,
+	// newest first, that have no embedding for model or whose content changed
+	// since it was computed.
+	GetItemsWithStaleEmbeddings(familyID uuid.UUID, model string, limit int) ([]*models.Item, error)
+	// PutItemEmbedding creates or replaces the embedding of an entry.
+	PutItemEmbedding(embedding *models.ItemEmbedding) error
+	// GetItemEmbeddings returns the family's non-empty embeddings of model.
+	GetItemEmbeddings(familyID uuid.UUID, model string) ([]models.ItemEmbedding, error)
+
+	// GetAssetCaptions returns every caption row of the family, including the
+	// empty ones of images the model could not describe.
+	GetAssetCaptions(familyID uuid.UUID) ([]models.AssetCaption, error)
+	// PutAssetCaption creates or replaces the caption of an asset.
+	PutAssetCaption(caption *models.AssetCaption) error
+	// GetAssetTranscript returns the transcript recorded for an asset, or
+	// ErrNotFound if it has not been transcribed.
+	GetAssetTranscript(familyID uuid.UUID, filename string) (*models.AssetTranscript, error)
+	// PutAssetTranscript creates or replaces the transcript of an asset.
+	PutAssetTranscript(transcript *models.AssetTranscript) error
+
+	// AddAIUsage adds usage's counters to the family's row of usage.Month,
+	// creating it on first use. Atomic, so concurrent calls do not lose counts.
+	AddAIUsage(usage *models.AIUsage) error
+	// GetAIUsage returns the family's usage of month ("YYYY-MM"), with zero
+	// counters when nothing was recorded.
+	GetAIUsage(familyID uuid.UUID, month string) (*models.AIUsage, error)
+	// GetAIUsageHistory returns the family's recorded months, newest first.
+	GetAIUsageHistory(familyID uuid.UUID) ([]models.AIUsage, error)
+
+	// GetSuggestionCache returns the cached tag suggestions stored under key
+	// no earlier than notBefore, or nil when there are none.
+	GetSuggestionCache(key string, notBefore time.Time) (*models.SuggestionCacheEntry, error)
+	// PutSuggestionCache stores (or replaces) a cached tag suggestion answer.
+	PutSuggestionCache(entry *models.SuggestionCacheEntry) error
+	// ClearSuggestionCache deletes cached suggestions, only the family's when
+	// familyID is set and only those created before createdBefore unless it is
+	// zero. It returns the number of entries deleted.
+	ClearSuggestionCache(familyID *uuid.UUID, createdBefore time.Time) (int64, error)
+
+	// EnqueueAIJob queues a job of kind for the family's day unless one is
+	// already queued or running; it reports whether a job was added.
+	EnqueueAIJob(familyID uuid.UUID, kind, date string) (bool, error)
+	// ClaimAIJob marks the oldest due queued job as running (counting an
+	// attempt) and returns it, skipping families that already run
+	// perFamilyLimit jobs. It returns nil when there is nothing to run or
+	// another process claimed the job first.
+	ClaimAIJob(now time.Time, perFamilyLimit int) (*models.AIJob, error)
+	// UpdateAIJob saves a job's state after an attempt.
+	UpdateAIJob(job *models.AIJob) error
+	// GetAIJobs returns the family's most recently updated jobs first.
+	GetAIJobs(familyID uuid.UUID, limit int) ([]models.AIJob, error)
+	// CountAIJobs returns the number of the family's jobs per status.
+	CountAIJobs(familyID uuid.UUID) (map[string]int64, error)
+	// RequeueRunningAIJobs puts jobs left running by a previous process back
+	// in the queue.
+	RequeueRunningAIJobs() (int64, error)
+	// DeleteFinishedAIJobs deletes done and failed jobs finished before before.
+	DeleteFinishedAIJobs(before time.Time) (int64, error)
+
 	// GetDB returns the underlying gorm.DB for use with authdb helpers.
 	GetDB() *gorm.DB
 }
@@ -111,6 +444,8 @@ type storage struct {
 	log *slog.Logger
 	cfg *config.Config
 	db  *gorm.DB
+
+	revokedTokens revokedTokenCache
 }
 
 func NewStorage(logger *slog.Logger, cfg *config.Config) Storage {
@@ -169,6 +504,26 @@ func (s *storage) Open() error {
 		// non-fatal: proceed with startup
 	}
 
+	if err := rebuildItemTags(s.log, s.db); err != nil {
+		s.log.Error("failed to rebuild item tag index", "error", err)
+		panic("failed to rebuild item tag index")
+	}
+
+	if err := rebuildItemLinks(s.log, s.db); err != nil {
+		s.log.Error("failed to rebuild item link index", "error", err)
+		panic("failed to rebuild item link index")
+	}
+
+	if err := rebuildItemAssets(s.log, s.db); err != nil {
+		s.log.Error("failed to rebuild item asset index", "error", err)
+		panic("failed to rebuild item asset index")
+	}
+
+	if err := backfillBodyCounts(s.log, s.db); err != nil {
+		s.log.Error("failed to backfill word counts", "error", err)
+		// non-fatal: statistics count such entries as empty until their next save
+	}
+
 	return nil
 }
 
@@ -323,6 +678,71 @@ func (s *storage) SetFamilyAISettings(familyID uuid.UUID, enabled, backfill, aut
 	return nil
 }
 
+func (s *storage) SetFamilyAISummariesEnabled(familyID uuid.UUID, enabled bool) error {
+	res := s.db.Model(&models.Family{}).Where("id = ?", familyID).
+		Update("ai_summaries_enabled", enabled)
+	if res.Error != nil {
+		return fmt.Errorf(StorageError, res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *storage) SetFamilyAISearchEnabled(familyID uuid.UUID, enabled bool) error {
+	res := s.db.Model(&models.Family{}).Where("id = ?", familyID).
+		Update("ai_search_enabled", enabled)
+	if res.Error != nil {
+		return fmt.Errorf(StorageError, res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *storage) SetFamilyAICaptionsEnabled(familyID uuid.UUID, enabled bool) error {
+	res := s.db.Model(&models.Family{}).Where("id = ?", familyID).
+		Update("ai_captions_enabled", enabled)
+	if res.Error != nil {
+		return fmt.Errorf(StorageError, res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *storage) SetFamilyAITranscriptionEnabled(familyID uuid.UUID, enabled bool) error {
+	res := s.db.Model(&models.Family{}).Where("id = ?", familyID).
+		Update("ai_transcription_enabled", enabled)
+	if res.Error != nil {
+		return fmt.Errorf(StorageError, res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *storage) SetFamilyTaggingPolicy(
+	familyID uuid.UUID, instructions string, closedVocabulary bool, maxTags int,
+) error {
+	res := s.db.Model(&models.Family{}).Where("id = ?", familyID).Updates(map[string]any{
+		"ai_tagging_instructions":      instructions,
+		"ai_tagging_closed_vocabulary": closedVocabulary,
+		"ai_tagging_max_tags":          maxTags,
+	})
+	if res.Error != nil {
+		return fmt.Errorf(StorageError, res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
 // SetFamilyBackfillDone marks whether the family's one-time backfill has
 // exhausted its pre-existing entries.
 func (s *storage) SetFamilyBackfillDone(familyID uuid.UUID, done bool) error {
@@ -333,77 +753,137 @@ func (s *storage) SetFamilyBackfillDone(familyID uuid.UUID, done bool) error {
 	return nil
 }
 
-func (s *storage) GetDistinctTags(familyID uuid.UUID) ([]string, error) {
-	var items []*models.Item
-	if err := s.db.Select("tags").Where("family_id = ?", familyID).Find(&items).Error; err != nil {
-		return nil, fmt.Errorf(StorageError, err)
+func (s *storage) SetFamilyMemoriesDigestEnabled(familyID uuid.UUID, enabled bool) error {
+	res := s.db.Model(&models.Family{}).Where("id = ?", familyID).
+		Update("memories_digest_enabled", enabled)
+	if res.Error != nil {
+		return fmt.Errorf(StorageError, res.Error)
 	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
 
-	seen := map[string]struct{}{}
+func (s *storage) SetFamilyMemoriesDigestSent(familyID uuid.UUID, date string) error {
+	if res := s.db.Model(&models.Family{}).Where("id = ?", familyID).
+		Update("memories_digest_sent_on", date); res.Error != nil {
+		return fmt.Errorf(StorageError, res.Error)
+	}
+	return nil
+}
+
+func (s *storage) GetDistinctTags(familyID uuid.UUID) ([]string, error) {
 	var tags []string
-	for _, item := range items {
-		for _, t := range item.Tags {
-			t = strings.TrimSpace(t)
-			if t == "" {
-				continue
-			}
-			if _, ok := seen[t]; ok {
-				continue
-			}
-			seen[t] = struct{}{}
-			tags = append(tags, t)
-		}
+	if err := s.db.Model(&models.ItemTag{}).Where("family_id = ?", familyID).
+		Distinct("tag").Order("tag").Pluck("tag", &tags).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
 	}
-	sort.Strings(tags)
 	return tags, nil
 }
 
+// tagStatsQuery counts the entries under every tag and ancestor of the family.
+// names pairs each entry with its tags and, recursively, their parents (the
+// name up to its last separator); UNION drops the repeats, so an ancestor
+// counts once per entry however many of its descendants the entry carries.
+// own_count counts the entries carrying the tag itself.
+const tagStatsQuery = `WITH RECURSIVE names(date, name) AS (
+	SELECT date, tag FROM item_tags WHERE family_id = @family
+	UNION
+	SELECT date, rtrim(rtrim(name, replace(name, '/', '')), '/') FROM names WHERE instr(name, '/') > 0
+)
+SELECT n.name AS name, COUNT(*) AS count, COALESCE(MAX(o.own_count), 0) AS own_count
+FROM names n
+LEFT JOIN (
+	SELECT tag, COUNT(*) AS own_count FROM item_tags WHERE family_id = @family GROUP BY tag
+) o ON o.tag = n.name
+WHERE n.name <> ''
+GROUP BY n.name
+ORDER BY count DESC, n.name`
+
 func (s *storage) GetTagStats(familyID uuid.UUID) ([]TagStat, error) {
-	var items []*models.Item
-	if err := s.db.Select("tags").Where("family_id = ?", familyID).Find(&items).Error; err != nil {
+	stats := []TagStat{}
+	if err := s.db.Raw(tagStatsQuery, sql.Named("family", familyID)).Scan(&stats).Error; err != nil {
 		return nil, fmt.Errorf(StorageError, err)
 	}
+	return stats, nil
+}
 
-	counts := map[string]int{}
-	for _, item := range items {
-		// Count each distinct tag at most once per entry.
-		seen := map[string]struct{}{}
-		for _, t := range item.Tags {
-			t = strings.TrimSpace(t)
-			if t == "" {
-				continue
-			}
-			if _, ok := seen[t]; ok {
-				continue
-			}
-			seen[t] = struct{}{}
-			counts[t]++
+// tagMatch selects the entries a tag operation can touch: those carrying one
+// of names, ignoring case, or with subtree one of their descendants.
+type tagMatch struct {
+	names   []string
+	subtree bool
+}
+
+// where restricts query, over items, to the entries matching m. With no
+// names nothing matches.
+func (m tagMatch) where(query *gorm.DB) *gorm.DB {
+	names := make([]string, 0, len(m.names))
+	for _, name := range m.names {
+		if name != "" {
+			names = append(names, name)
 		}
 	}
+	if len(names) == 0 {
+		return query.Where("0")
+	}
+	// The collation goes on the column: SQLite rewrites an OR of
+	// "tag = ? COLLATE NOCASE" terms into a lookup on the binary primary key.
+	conds := []string{"t.tag COLLATE NOCASE IN ?"}
+	args := []any{names}
+	if m.subtree {
+		// The descendants of "travel" sort from "travel/" up to "travel0"
+		// ('0' follows '/'), a range the NOCASE index serves.
+		for _, name := range names {
+			conds = append(conds, "(t.tag COLLATE NOCASE >= ? AND t.tag COLLATE NOCASE < ?)")
+			args = append(args, name+utils.TagSeparator, name+"0")
+		}
+	}
+	return query.Where("EXISTS (SELECT 1 FROM item_tags t WHERE t.family_id = items.family_id "+
+		"AND t.date = items.date AND ("+strings.Join(conds, " OR ")+"))", args...)
+}
 
-	stats := make([]TagStat, 0, len(counts))
-	for name, count := range counts {
-		stats = append(stats, TagStat{Name: name, Count: count})
+// writeItemTags replaces the item_tags rows of item with its current tags.
+// Callers run it in the transaction saving the item.
+func writeItemTags(tx *gorm.DB, item *models.Item) error {
+	if err := tx.Where("family_id = ? AND date = ?", item.FamilyID, item.Date).
+		Delete(&models.ItemTag{}).Error; err != nil {
+		return err
 	}
-	sort.Slice(stats, func(i, j int) bool {
-		if stats[i].Count != stats[j].Count {
-			return stats[i].Count > stats[j].Count
+	rows := make([]models.ItemTag, 0, len(item.Tags))
+	seen := map[string]struct{}{}
+	for _, t := range item.Tags {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
 		}
-		return stats[i].Name < stats[j].Name
-	})
-	return stats, nil
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		rows = append(rows, models.ItemTag{FamilyID: item.FamilyID, Date: item.Date, Tag: t})
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return tx.Create(&rows).Error
 }
 
-// mutateFamilyTags walks every entry of the family inside a single transaction,
-// applies mutate to each entry's tags, and re-saves (recording a change for sync)
-// only those entries whose tags actually changed. A failure rolls back the whole
-// operation so no entry is left partially updated.
+// mutateFamilyTags walks the entries of the family that match inside a single
+// transaction, applies mutate to each entry's tags, and re-saves (recording a
+// change for sync) only those entries whose tags actually changed. inTx, when
+// set, runs first in the same transaction (for the matching tag metadata
+// changes) and may still fill in match, which is read after it. A failure
+// rolls back the whole operation so no entry is left partially updated.
+// Returns the number of entries changed.
 func (s *storage) mutateFamilyTags(
-	familyID uuid.UUID, mutate func(models.StringList) (models.StringList, bool),
-) error {
+	familyID uuid.UUID, match *tagMatch,
+	mutate func(models.StringList) (models.StringList, bool), inTx func(tx *gorm.DB) error,
+) (int, error) {
 	tx := s.db.Begin()
 	if tx.Error != nil {
-		return fmt.Errorf(StorageError, tx.Error)
+		return 0, fmt.Errorf(StorageError, tx.Error)
 	}
 	defer func() {
 		if r := recover(); r != nil {
@@ -411,12 +891,20 @@ func (s *storage) mutateFamilyTags(
 		}
 	}()
 
+	if inTx != nil {
+		if err := inTx(tx); err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf(StorageError, err)
+		}
+	}
+
 	var items []*models.Item
-	if err := tx.Where("family_id = ?", familyID).Find(&items).Error; err != nil {
+	if err := match.where(tx.Where("family_id = ?", familyID)).Find(&items).Error; err != nil {
 		tx.Rollback()
-		return fmt.Errorf(StorageError, err)
+		return 0, fmt.Errorf(StorageError, err)
 	}
 
+	changedItems := 0
 	for _, item := range items {
 		newTags, changed := mutate(item.Tags)
 		if !changed {
@@ -425,79 +913,313 @@ func (s *storage) mutateFamilyTags(
 		item.Tags = newTags
 		if err := tx.Save(item).Error; err != nil {
 			tx.Rollback()
-			return fmt.Errorf(StorageError, err)
+			return 0, fmt.Errorf(StorageError, err)
+		}
+		if err := writeItemTags(tx, item); err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf(StorageError, err)
 		}
 		if err := s.createChangeRecordInTx(
 			tx, familyID, item.Date, models.OperationTypeUpdated, item, nil,
 		); err != nil {
 			tx.Rollback()
-			return fmt.Errorf("failed to create change record: %w", err)
+			return 0, fmt.Errorf("failed to create change record: %w", err)
 		}
+		changedItems++
 	}
 	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf(StorageError, err)
+		return 0, fmt.Errorf(StorageError, err)
 	}
-	return nil
+	return changedItems, nil
 }
 
-func (s *storage) RenameTag(familyID uuid.UUID, oldName, newName string) error {
+func (s *storage) RenameTag(familyID uuid.UUID, oldName, newName string, subtree bool) error {
+	if !subtree {
+		_, err := s.MergeTags(familyID, map[string]string{oldName: newName})
+		return err
+	}
 	oldName = strings.TrimSpace(oldName)
 	newName = strings.TrimSpace(newName)
 	if oldName == "" || newName == "" || oldName == newName {
 		return nil
 	}
-	return s.mutateFamilyTags(familyID, func(tags models.StringList) (models.StringList, bool) {
-		if !containsTag(tags, oldName) {
-			return tags, false
+	_, err := s.renameFamilyTags(familyID, tagMatch{names: []string{oldName}, subtree: true}, func(t string) (string, bool) {
+		return utils.MoveTagSubtree(t, oldName, newName)
+	})
+	return err
+}
+
+func (s *storage) MergeTags(familyID uuid.UUID, renames map[string]string) (int, error) {
+	clean := make(map[string]string, len(renames))
+	for oldName, newName := range renames {
+		oldName = strings.TrimSpace(oldName)
+		newName = strings.TrimSpace(newName)
+		if oldName == "" || newName == "" || oldName == newName {
+			continue
+		}
+		clean[oldName] = newName
+	}
+	if len(clean) == 0 {
+		return 0, nil
+	}
+	oldNames := make([]string, 0, len(clean))
+	for oldName := range clean {
+		oldNames = append(oldNames, oldName)
+	}
+	return s.renameFamilyTags(familyID, tagMatch{names: oldNames}, func(t string) (string, bool) {
+		newName, ok := clean[t]
+		return newName, ok
+	})
+}
+
+// renameFamilyTags replaces every tag rename maps to a new name, preserving
+// order and dropping any duplicate the renames create (merge-on-collision).
+// Only the entries match selects are considered.
+func (s *storage) renameFamilyTags(
+	familyID uuid.UUID, match tagMatch, rename func(string) (string, bool),
+) (int, error) {
+	return s.mutateFamilyTags(familyID, &match, func(tags models.StringList) (models.StringList, bool) {
+		return renameTags(tags, rename)
+	}, func(tx *gorm.DB) error {
+		return renameTagMetadata(tx, familyID, rename)
+	})
+}
+
+// renameTags applies rename to every tag, preserving order and dropping any
+// duplicate the renames create. It reports whether anything was renamed.
+func renameTags(tags models.StringList, rename func(string) (string, bool)) (models.StringList, bool) {
+	changed := false
+	out := make(models.StringList, 0, len(tags))
+	seen := map[string]struct{}{}
+	for _, t := range tags {
+		if newName, ok := rename(t); ok {
+			t = newName
+			changed = true
 		}
-		// Replace oldName with newName, preserving order and dropping any
-		// duplicate that the rename creates (merge-on-collision).
-		out := make(models.StringList, 0, len(tags))
-		seen := map[string]struct{}{}
-		for _, t := range tags {
-			if t == oldName {
-				t = newName
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		out = append(out, t)
+	}
+	if !changed {
+		return tags, false
+	}
+	return out, true
+}
+
+// renameTagMetadata moves the metadata of renamed tags to their new names.
+// Where a new name already has metadata the two merge: a tag that keeps its
+// name keeps its colour and description, blanks are filled in from the moved
+// tag and the aliases are combined.
+func renameTagMetadata(tx *gorm.DB, familyID uuid.UUID, rename func(string) (string, bool)) error {
+	var rows []models.Tag
+	if err := tx.Where("family_id = ?", familyID).Order("name").Find(&rows).Error; err != nil {
+		return err
+	}
+	merged := map[string]*models.Tag{}
+	var names []string
+	moved := false
+	// Tags that keep their name go first so their metadata wins.
+	for _, pass := range []bool{false, true} {
+		for _, row := range rows {
+			newName, ok := rename(row.Name)
+			ok = ok && newName != row.Name
+			if ok != pass {
+				continue
 			}
-			if _, ok := seen[t]; ok {
+			if ok {
+				moved = true
+				row.Name = newName
+			}
+			if into, exists := merged[row.Name]; exists {
+				mergeTagMetadata(into, row)
 				continue
 			}
-			seen[t] = struct{}{}
-			out = append(out, t)
+			row.Aliases = withoutTagName(row.Aliases, row.Name)
+			merged[row.Name] = &row
+			names = append(names, row.Name)
 		}
-		return out, true
-	})
+	}
+	if !moved {
+		return nil
+	}
+	// Rebuild the family's rows: renaming in place could collide on the
+	// unique (family, name) index halfway through.
+	if err := tx.Where("family_id = ?", familyID).Delete(&models.Tag{}).Error; err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := tx.Create(merged[name]).Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (s *storage) DeleteTag(familyID uuid.UUID, name string) error {
+// mergeTagMetadata fills in into's blank colour and description from from and
+// adds from's aliases.
+func mergeTagMetadata(into *models.Tag, from models.Tag) {
+	if into.Color == "" {
+		into.Color = from.Color
+	}
+	if into.Description == "" {
+		into.Description = from.Description
+	}
+	into.Aliases = withoutTagName(mergeTags(into.Aliases, from.Aliases), into.Name)
+}
+
+// withoutTagName drops aliases equal to the tag's own name (ignoring case).
+func withoutTagName(aliases models.StringList, name string) models.StringList {
+	out := make(models.StringList, 0, len(aliases))
+	for _, a := range aliases {
+		if !strings.EqualFold(a, name) {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+func (s *storage) DeleteTag(familyID uuid.UUID, name string, subtree bool) error {
 	name = strings.TrimSpace(name)
 	if name == "" {
 		return nil
 	}
-	return s.mutateFamilyTags(familyID, func(tags models.StringList) (models.StringList, bool) {
-		if !containsTag(tags, name) {
-			return tags, false
-		}
+	matches := func(t string) bool {
+		return t == name || (subtree && utils.TagInSubtree(t, name))
+	}
+	match := tagMatch{names: []string{name}, subtree: subtree}
+	_, err := s.mutateFamilyTags(familyID, &match, func(tags models.StringList) (models.StringList, bool) {
 		out := make(models.StringList, 0, len(tags))
 		for _, t := range tags {
-			if t == name {
-				continue
+			if !matches(t) {
+				out = append(out, t)
+			}
+		}
+		return out, len(out) != len(tags)
+	}, func(tx *gorm.DB) error {
+		var rows []models.Tag
+		if err := tx.Where("family_id = ?", familyID).Find(&rows).Error; err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if matches(row.Name) {
+				if err := tx.Delete(&row).Error; err != nil {
+					return err
+				}
 			}
-			out = append(out, t)
 		}
-		return out, true
+		return nil
 	})
+	return err
 }
 
-// containsTag reports whether tags contains an exact match for name.
-func containsTag(tags models.StringList, name string) bool {
-	for _, t := range tags {
-		if t == name {
+func (s *storage) GetTagMetadata(familyID uuid.UUID) ([]models.Tag, error) {
+	var tags []models.Tag
+	if err := s.db.Where("family_id = ?", familyID).Order("name").Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return tags, nil
+}
+
+func (s *storage) UpdateTagMetadata(
+	familyID uuid.UUID, name string, update func(tag *models.Tag),
+) (*models.Tag, error) {
+	tag := models.Tag{FamilyID: familyID, Name: strings.TrimSpace(name)}
+	var aliases map[string]string
+	// Only entries carrying one of the aliases change; the metadata step,
+	// which runs first, sets both aliases and the match.
+	var match tagMatch
+	_, err := s.mutateFamilyTags(familyID, &match, func(tags models.StringList) (models.StringList, bool) {
+		return resolveTagAliases(tags, aliases)
+	}, func(tx *gorm.DB) error {
+		var rows []models.Tag
+		if err := tx.Where("family_id = ?", familyID).Find(&rows).Error; err != nil {
+			return err
+		}
+		var others []models.Tag
+		for _, row := range rows {
+			if row.Name == tag.Name {
+				tag = row
+			} else {
+				others = append(others, row)
+			}
+		}
+		update(&tag)
+		tag.Aliases = withoutTagName(mergeTags(nil, tag.Aliases), tag.Name)
+		if tagConflicts(tag, others) {
+			return ErrTagConflict
+		}
+		aliases = tagAliasMap([]models.Tag{tag})
+		match.names = tag.Aliases
+
+		if tag.IsEmpty() {
+			if tag.ID == uuid.Nil {
+				return nil
+			}
+			return tx.Delete(&tag).Error
+		}
+		if tag.ID == uuid.Nil {
+			tag.ID = uuid.New()
+		}
+		return tx.Save(&tag).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// tagConflicts reports whether tag's name or one of its aliases is the name or
+// an alias of one of others, ignoring case.
+func tagConflicts(tag models.Tag, others []models.Tag) bool {
+	taken := map[string]struct{}{}
+	for _, o := range others {
+		taken[strings.ToLower(o.Name)] = struct{}{}
+		for _, a := range o.Aliases {
+			taken[strings.ToLower(a)] = struct{}{}
+		}
+	}
+	for _, name := range append([]string{tag.Name}, tag.Aliases...) {
+		if _, ok := taken[strings.ToLower(name)]; ok {
 			return true
 		}
 	}
 	return false
 }
 
+// tagAliasMap maps the lower-cased aliases of tags to the tag names.
+func tagAliasMap(tags []models.Tag) map[string]string {
+	aliases := map[string]string{}
+	for _, t := range tags {
+		for _, a := range t.Aliases {
+			aliases[strings.ToLower(a)] = t.Name
+		}
+	}
+	return aliases
+}
+
+// familyTagAliases loads tagAliasMap of the family's tags.
+func familyTagAliases(tx *gorm.DB, familyID uuid.UUID) (map[string]string, error) {
+	var tags []models.Tag
+	if err := tx.Select("name", "aliases").Where("family_id = ?", familyID).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	return tagAliasMap(tags), nil
+}
+
+// resolveTagAliases replaces every alias in tags with its tag, dropping the
+// duplicates that creates. It reports whether anything was replaced.
+func resolveTagAliases(tags models.StringList, aliases map[string]string) (models.StringList, bool) {
+	if len(aliases) == 0 {
+		return tags, false
+	}
+	return renameTags(tags, func(t string) (string, bool) {
+		name, ok := aliases[strings.ToLower(t)]
+		return name, ok
+	})
+}
+
 // #endregion Family
 
 // #region Item
@@ -521,27 +1243,48 @@ func (s *storage) GetItems(familyID uuid.UUID, searchParams SearchParams) ([]*mo
 	if searchParams.Date != "" {
 		query = query.Where("date = ?", searchParams.Date)
 	}
+	if searchParams.DateFrom != "" {
+		query = query.Where("date >= ?", searchParams.DateFrom)
+	}
+	if searchParams.DateTo != "" {
+		query = query.Where("date <= ?", searchParams.DateTo)
+	}
+	if len(searchParams.Dates) > 0 {
+		query = query.Where("date IN ?", searchParams.Dates)
+	}
 
-	// Apply text search filter if specified
+	// Apply text search filter if specified. Besides title and body, an entry
+	// matches when the AI caption of an image it embeds (per item_assets) does.
 	if searchParams.SearchText != "" {
 		searchPattern := "%" + searchParams.SearchText + "%"
-		query = query.Where("title LIKE ? OR body LIKE ?", searchPattern, searchPattern)
+		query = query.Where("title LIKE ? OR body LIKE ? OR EXISTS ("+
+			"SELECT 1 FROM item_assets a JOIN asset_captions c "+
+			"ON c.family_id = a.family_id AND c.filename = a.filename "+
+			"WHERE a.family_id = items.family_id AND a.date = items.date AND c.caption LIKE ?)",
+			searchPattern, searchPattern, searchPattern)
 	}
 
-	// Apply tag filters if specified (OR across the requested tags). Match the
-	// raw JSON-text column directly rather than via JSON_EXTRACT: tags are stored
-	// as a JSON array string (e.g. `["a","b"]`), so a LIKE on the quoted name
-	// finds membership without parsing. JSON_EXTRACT would raise "malformed JSON"
-	// and fail the whole query if any row has a NULL/legacy non-JSON tags value.
+	// Apply tag filters if specified (OR across the requested tags, ignoring
+	// case), looked up in the item_tags index. A tag also matches its
+	// descendants: "travel" finds "travel/italy".
 	if len(searchParams.Tags) > 0 {
-		tagConditions := make([]string, len(searchParams.Tags))
-		tagArgs := make([]any, len(searchParams.Tags))
-		for i, tag := range searchParams.Tags {
-			tagConditions[i] = "tags LIKE ?"
-			tagArgs[i] = "%\"" + tag + "\"%"
+		query = tagMatch{names: searchParams.Tags, subtree: true}.where(query)
+	}
+
+	// Apply custom field conditions; all must hold.
+	for _, f := range searchParams.Fields {
+		if _, ok := fieldFilterOps[f.Op]; !ok {
+			return nil, 0, fmt.Errorf("storage error: unknown field operator %q", f.Op)
 		}
-		tagQuery := strings.Join(tagConditions, " OR ")
-		query = query.Where(tagQuery, tagArgs...)
+		value := f.Value
+		if b, ok := value.(bool); ok {
+			// JSON booleans come out of json_extract as 1 and 0.
+			value = 0
+			if b {
+				value = 1
+			}
+		}
+		query = query.Where(fieldValueSQL+" "+f.Op+" ?", fieldPath(f.Key), value)
 	}
 
 	// Get total count for pagination
@@ -579,6 +1322,16 @@ func (s *storage) PutItem(familyID uuid.UUID, item *models.Item) error {
 	// Preserve existing ID on update
 	if isUpdate {
 		item.ID = existingItem.ID
+		// Custom field values left out (nil, unlike an empty set) are kept.
+		if item.Fields == nil {
+			item.Fields = existingItem.Fields
+		}
+		if item.Place == nil {
+			item.Place = existingItem.Place
+		}
+		if item.Weather == nil {
+			item.Weather = existingItem.Weather
+		}
 		// Suggestions are server-managed and not carried on the save request; keep
 		// any existing pending suggestions unless the caller explicitly set them.
 		if len(item.PendingTags) == 0 {
@@ -588,9 +1341,19 @@ func (s *storage) PutItem(familyID uuid.UUID, item *models.Item) error {
 		item.ID = uuid.New()
 	}
 
-	// Always refresh the staleness hash from the saved content.
-	item.TagsSourceHash = utils.ComputeTagsSourceHash(item.Title, item.Body)
-	// Keep pending and confirmed tags disjoint: never suggest a tag the user has confirmed.
+	// Store tags under their canonical names.
+	aliases, err := familyTagAliases(tx, familyID)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, err)
+	}
+	item.Tags, _ = resolveTagAliases(item.Tags, aliases)
+
+	// Always refresh the staleness hash from the saved content.
+	item.TagsSourceHash = utils.ComputeTagsSourceHash(item.Title, item.Body)
+	item.WordCount = utils.CountWords(item.Body)
+	item.AssetCount = len(utils.GetAssetsFromMarkdown(item.Body))
+	// Keep pending and confirmed tags disjoint: never suggest a tag the user has confirmed.
 	item.PendingTags = prunePendingTags(item.PendingTags, item.Tags)
 
 	// Save the item
@@ -598,6 +1361,18 @@ func (s *storage) PutItem(familyID uuid.UUID, item *models.Item) error {
 		tx.Rollback()
 		return fmt.Errorf(StorageError, err)
 	}
+	if err := writeItemTags(tx, item); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, err)
+	}
+	if err := writeItemLinks(tx, item); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, err)
+	}
+	if err := writeItemAssets(tx, item); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, err)
+	}
 
 	// Create change record
 	operationType := models.OperationTypeCreated
@@ -705,7 +1480,12 @@ func (s *storage) AddConfirmedTags(familyID uuid.UUID, date string, names []stri
 		return fmt.Errorf(StorageError, err)
 	}
 
-	item.Tags = mergeTags(item.Tags, names)
+	aliases, err := familyTagAliases(tx, familyID)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, err)
+	}
+	item.Tags, _ = resolveTagAliases(mergeTags(item.Tags, names), aliases)
 	item.PendingTags = prunePendingTags(item.PendingTags, item.Tags)
 	item.TagsSourceHash = utils.ComputeTagsSourceHash(item.Title, item.Body)
 
@@ -713,6 +1493,10 @@ func (s *storage) AddConfirmedTags(familyID uuid.UUID, date string, names []stri
 		tx.Rollback()
 		return fmt.Errorf(StorageError, err)
 	}
+	if err := writeItemTags(tx, &item); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, err)
+	}
 	if err := s.createChangeRecordInTx(tx, familyID, date, models.OperationTypeUpdated, &item, nil); err != nil {
 		tx.Rollback()
 		return fmt.Errorf("failed to create change record: %w", err)
@@ -750,6 +1534,22 @@ func (s *storage) DeleteItem(familyID uuid.UUID, date string) error {
 		tx.Rollback()
 		return fmt.Errorf(StorageError, err)
 	}
+	if err := tx.Where("family_id = ? AND date = ?", familyID, date).Delete(&models.ItemEmbedding{}).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, err)
+	}
+	if err := tx.Where("family_id = ? AND date = ?", familyID, date).Delete(&models.ItemTag{}).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, err)
+	}
+	if err := tx.Where("family_id = ? AND date = ?", familyID, date).Delete(&models.ItemLink{}).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, err)
+	}
+	if err := tx.Where("family_id = ? AND date = ?", familyID, date).Delete(&models.ItemAsset{}).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, err)
+	}
 
 	// Create change record for deletion
 	if err := s.createChangeRecordInTx(tx, familyID, date, models.OperationTypeDeleted, &item, nil); err != nil {
@@ -767,6 +1567,374 @@ func (s *storage) DeleteItem(familyID uuid.UUID, date string) error {
 
 // #endregion Item
 
+// #region Custom fields
+
+// fieldValueSQL extracts the value at a custom field path (the single
+// parameter) from an entry's fields, tolerating a malformed column.
+const fieldValueSQL = "(CASE WHEN json_valid(items.fields) THEN json_extract(items.fields, ?) END)"
+
+// fieldPath is the JSON path of a custom field's value in Item.Fields.
+func fieldPath(key string) string {
+	return `$."` + key + `"`
+}
+
+func (s *storage) GetCustomFields(familyID uuid.UUID) ([]models.CustomField, error) {
+	var fields []models.CustomField
+	if err := s.db.Where("family_id = ?", familyID).Order("position, key").Find(&fields).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return fields, nil
+}
+
+func (s *storage) CreateCustomField(field *models.CustomField) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.CustomField{}).
+			Where("family_id = ? AND key = ?", field.FamilyID, field.Key).Count(&count).Error; err != nil {
+			return fmt.Errorf(StorageError, err)
+		}
+		if count > 0 {
+			return ErrFieldConflict
+		}
+		if field.ID == uuid.Nil {
+			field.ID = uuid.New()
+		}
+		if err := tx.Create(field).Error; err != nil {
+			return fmt.Errorf(StorageError, err)
+		}
+		return nil
+	})
+}
+
+func (s *storage) UpdateCustomField(
+	familyID uuid.UUID, key string, update func(field *models.CustomField),
+) (*models.CustomField, error) {
+	var field models.CustomField
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("family_id = ? AND key = ?", familyID, key).First(&field).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNotFound
+			}
+			return fmt.Errorf(StorageError, err)
+		}
+		update(&field)
+		if err := tx.Save(&field).Error; err != nil {
+			return fmt.Errorf(StorageError, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &field, nil
+}
+
+func (s *storage) DeleteCustomField(familyID uuid.UUID, key string) error {
+	tx := s.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf(StorageError, tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	res := tx.Where("family_id = ? AND key = ?", familyID, key).Delete(&models.CustomField{})
+	if res.Error != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, res.Error)
+	}
+	if res.RowsAffected == 0 {
+		tx.Rollback()
+		return ErrNotFound
+	}
+
+	var items []*models.Item
+	if err := tx.Where("family_id = ?", familyID).
+		Where(fieldValueSQL+" IS NOT NULL", fieldPath(key)).Find(&items).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf(StorageError, err)
+	}
+	for _, item := range items {
+		delete(item.Fields, key)
+		if err := tx.Save(item).Error; err != nil {
+			tx.Rollback()
+			return fmt.Errorf(StorageError, err)
+		}
+		if err := s.createChangeRecordInTx(
+			tx, familyID, item.Date, models.OperationTypeUpdated, item, nil,
+		); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to create change record: %w", err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+// fieldValuesCTE selects the date and value of every entry of a family with a
+// value of one field, within an optional date range. JSON booleans come out
+// as 1 and 0, numbers as numbers and strings as text.
+const fieldValuesCTE = `WITH v AS (
+	SELECT date, value FROM (
+		SELECT date, CASE WHEN json_valid(fields) THEN json_extract(fields, @path) END AS value
+		FROM items
+		WHERE family_id = @family AND deleted_at IS NULL
+			AND (@from = '' OR date >= @from) AND (@to = '' OR date <= @to)
+	) WHERE value IS NOT NULL
+)
+`
+
+func (s *storage) GetFieldStats(
+	familyID uuid.UUID, field models.CustomField, from, to string,
+) (*FieldStats, error) {
+	args := []any{
+		sql.Named("family", familyID), sql.Named("path", fieldPath(field.Key)),
+		sql.Named("from", from), sql.Named("to", to),
+	}
+	numeric := field.IsNumeric()
+
+	var totals struct {
+		Count         int
+		Min, Max, Avg *float64
+		Sum           *float64
+	}
+	if err := s.db.Raw(fieldValuesCTE+
+		"SELECT COUNT(*) AS count, MIN(value) AS min, MAX(value) AS max, AVG(value) AS avg, SUM(value) AS sum FROM v",
+		args...).Scan(&totals).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	stats := &FieldStats{Count: totals.Count, Values: []FieldValueCount{}, Months: []FieldMonthStat{}}
+	if numeric {
+		stats.Min, stats.Max, stats.Avg, stats.Sum = totals.Min, totals.Max, totals.Avg, totals.Sum
+	}
+
+	if field.Type == models.FieldTypeScale || field.Type == models.FieldTypeBoolean || field.Type == models.FieldTypeEnum {
+		if err := s.db.Raw(fieldValuesCTE+
+			"SELECT CAST(value AS TEXT) AS value, COUNT(*) AS count FROM v GROUP BY value ORDER BY count DESC, value",
+			args...).Scan(&stats.Values).Error; err != nil {
+			return nil, fmt.Errorf(StorageError, err)
+		}
+		if field.Type == models.FieldTypeBoolean {
+			for i := range stats.Values {
+				stats.Values[i].Value = strconv.FormatBool(stats.Values[i].Value == "1")
+			}
+		}
+	}
+
+	if err := s.db.Raw(fieldValuesCTE+
+		"SELECT substr(date, 1, 7) AS month, COUNT(*) AS count, AVG(value) AS avg FROM v GROUP BY month ORDER BY month",
+		args...).Scan(&stats.Months).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	if !numeric {
+		for i := range stats.Months {
+			stats.Months[i].Avg = nil
+		}
+	}
+	return stats, nil
+}
+
+// #endregion Custom fields
+
+// #region Places
+
+func (s *storage) GetPlaces(familyID uuid.UUID, from, to string) ([]PlaceStat, error) {
+	places := []PlaceStat{}
+	err := s.db.Raw(`SELECT MAX(place_name) AS name,
+			AVG(place_latitude) AS latitude, AVG(place_longitude) AS longitude,
+			COUNT(*) AS count, MIN(date) AS first_date, MAX(date) AS last_date
+		FROM items
+		WHERE family_id = @family AND deleted_at IS NULL
+			AND (place_name <> '' OR place_latitude IS NOT NULL)
+			AND (@from = '' OR date >= @from) AND (@to = '' OR date <= @to)
+		GROUP BY lower(place_name), round(place_latitude, 2), round(place_longitude, 2)
+		ORDER BY count DESC, last_date DESC, name`,
+		sql.Named("family", familyID), sql.Named("from", from), sql.Named("to", to)).
+		Scan(&places).Error
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return places, nil
+}
+
+// #endregion Places
+
+// #region Stats
+
+// statsScope selects the family's entries in the optional range; the
+// statistics queries share it.
+const statsScope = `family_id = @family AND deleted_at IS NULL
+	AND (@from = '' OR date >= @from) AND (@to = '' OR date <= @to)`
+
+// streaksCTE numbers the runs of consecutive days: a run's days share
+// julianday(date) - row_number.
+const streaksCTE = `WITH runs AS (
+		SELECT date, julianday(date) - ROW_NUMBER() OVER (ORDER BY date) AS run
+		FROM items WHERE ` + statsScope + `
+	), streaks AS (
+		SELECT COUNT(*) AS days, MIN(date) AS start, MAX(date) AS end FROM runs GROUP BY run
+	)`
+
+func (s *storage) GetWritingStats(familyID uuid.UUID, from, to, today string) (*WritingStats, error) {
+	stats := &WritingStats{Months: []MonthStat{}, TagMonths: []TagMonthStat{}}
+	args := []any{sql.Named("family", familyID), sql.Named("from", from), sql.Named("to", to)}
+
+	var totals struct {
+		Entries           int
+		Words             int
+		AssetRefs         int
+		EntriesWithAssets int
+	}
+	err := s.db.Raw(`SELECT COUNT(*) AS entries, COALESCE(SUM(MAX(word_count, 0)), 0) AS words,
+			COALESCE(SUM(MAX(asset_count, 0)), 0) AS asset_refs,
+			COUNT(CASE WHEN asset_count > 0 THEN 1 END) AS entries_with_assets
+		FROM items WHERE `+statsScope, args...).Scan(&totals).Error
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	stats.Entries, stats.Words = totals.Entries, totals.Words
+	stats.AssetRefs, stats.EntriesWithAssets = totals.AssetRefs, totals.EntriesWithAssets
+
+	yesterday := ""
+	if t, err := time.Parse("2006-01-02", today); err == nil {
+		yesterday = t.AddDate(0, 0, -1).Format("2006-01-02")
+	}
+	var streaks []Streak
+	err = s.db.Raw(streaksCTE+`
+		SELECT days, start, end FROM streaks
+		WHERE days = (SELECT MAX(days) FROM streaks) OR end IN (@today, @yesterday)
+		ORDER BY end DESC`,
+		append(args, sql.Named("today", today), sql.Named("yesterday", yesterday))...).
+		Scan(&streaks).Error
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	for _, streak := range streaks {
+		if streak.End == today || streak.End == yesterday {
+			stats.CurrentStreak = streak
+		}
+		if streak.Days > stats.LongestStreak.Days {
+			stats.LongestStreak = streak
+		}
+	}
+
+	err = s.db.Raw(`SELECT substr(date, 1, 7) AS month, COUNT(*) AS entries,
+			SUM(MAX(word_count, 0)) AS words
+		FROM items WHERE `+statsScope+`
+		GROUP BY month ORDER BY month`, args...).Scan(&stats.Months).Error
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	err = s.db.Raw(`SELECT substr(t.date, 1, 7) AS month, t.tag AS tag, COUNT(*) AS count
+		FROM item_tags t
+		WHERE t.family_id = @family AND t.date IN (SELECT date FROM items WHERE `+statsScope+`)
+		GROUP BY month, t.tag ORDER BY month, count DESC, t.tag`, args...).Scan(&stats.TagMonths).Error
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return stats, nil
+}
+
+func (s *storage) GetDayWordCounts(familyID uuid.UUID, from, to string) ([]DayWords, error) {
+	days := []DayWords{}
+	err := s.db.Raw(`SELECT date, MAX(word_count, 0) AS words FROM items WHERE `+statsScope+` ORDER BY date`,
+		sql.Named("family", familyID), sql.Named("from", from), sql.Named("to", to)).
+		Scan(&days).Error
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return days, nil
+}
+
+// #endregion Stats
+
+// #region Links
+
+// writeItemLinks replaces the item_links rows of item with the day links of
+// its body. Callers run it in the transaction saving the item.
+func writeItemLinks(tx *gorm.DB, item *models.Item) error {
+	if err := tx.Where("family_id = ? AND date = ?", item.FamilyID, item.Date).
+		Delete(&models.ItemLink{}).Error; err != nil {
+		return err
+	}
+	rows := itemLinkRows(item)
+	if len(rows) == 0 {
+		return nil
+	}
+	return tx.Create(&rows).Error
+}
+
+// itemLinkRows returns the item_links rows of item's body.
+func itemLinkRows(item *models.Item) []models.ItemLink {
+	targets := utils.GetDateLinksFromMarkdown(item.Body)
+	rows := make([]models.ItemLink, 0, len(targets))
+	for _, target := range targets {
+		rows = append(rows, models.ItemLink{FamilyID: item.FamilyID, Date: item.Date, Target: target})
+	}
+	return rows
+}
+
+// writeItemAssets replaces the item_assets rows of item with the assets its
+// body embeds. Callers run it in the transaction saving the item.
+func writeItemAssets(tx *gorm.DB, item *models.Item) error {
+	if err := tx.Where("family_id = ? AND date = ?", item.FamilyID, item.Date).
+		Delete(&models.ItemAsset{}).Error; err != nil {
+		return err
+	}
+	rows := itemAssetRows(item)
+	if len(rows) == 0 {
+		return nil
+	}
+	return tx.Create(&rows).Error
+}
+
+// itemAssetRows returns the item_assets rows of item's body, one per
+// distinct path.
+func itemAssetRows(item *models.Item) []models.ItemAsset {
+	names := utils.GetAssetsFromMarkdown(item.Body)
+	rows := make([]models.ItemAsset, 0, len(names))
+	seen := map[string]struct{}{}
+	for _, name := range names {
+		if _, ok := seen[name]; ok || name == "" {
+			continue
+		}
+		seen[name] = struct{}{}
+		rows = append(rows, models.ItemAsset{FamilyID: item.FamilyID, Date: item.Date, Filename: name})
+	}
+	return rows
+}
+
+func (s *storage) GetBacklinks(familyID uuid.UUID, date string) ([]*models.Item, error) {
+	var items []*models.Item
+	err := s.db.Where("family_id = ? AND date <> ? AND date IN (?)", familyID, date,
+		s.db.Model(&models.ItemLink{}).Select("date").Where("family_id = ? AND target = ?", familyID, date)).
+		Order("date").Find(&items).Error
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return items, nil
+}
+
+func (s *storage) GetBrokenLinks(familyID uuid.UUID) ([]models.ItemLink, error) {
+	links := []models.ItemLink{}
+	err := s.db.Raw(`SELECT l.family_id, l.date, l.target FROM item_links l
+		WHERE l.family_id = @family AND NOT EXISTS (SELECT 1 FROM items i
+			WHERE i.family_id = l.family_id AND i.date = l.target AND i.deleted_at IS NULL)
+		ORDER BY l.date, l.target`, sql.Named("family", familyID)).
+		Scan(&links).Error
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return links, nil
+}
+
+// #endregion Links
+
 // #region Dates
 
 func (s *storage) GetPreviousDate(familyID uuid.UUID, date string) (string, error) {
@@ -901,3 +2069,652 @@ func (s *storage) RemoveIgnoredOrphan(familyID uuid.UUID, filename string) error
 }
 
 // #endregion Orphan Ignore
+
+// #region Access Tokens
+
+func (s *storage) CreateAccessToken(token *models.AccessToken) error {
+	if token.ID == uuid.Nil {
+		token.ID = uuid.New()
+	}
+	if err := s.db.Create(token).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+func (s *storage) GetAccessTokens(userID uuid.UUID) ([]models.AccessToken, error) {
+	var tokens []models.AccessToken
+	if err := s.db.Where("user_id = ?", userID).Order("created_at DESC").
+		Find(&tokens).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return tokens, nil
+}
+
+func (s *storage) GetAccessTokenByHash(hash string) (*models.AccessToken, error) {
+	var token models.AccessToken
+	if err := s.db.Where("token_hash = ?", hash).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return &token, nil
+}
+
+func (s *storage) RevokeAccessToken(userID, tokenID uuid.UUID) error {
+	res := s.db.Where("id = ? AND user_id = ?", tokenID, userID).Delete(&models.AccessToken{})
+	if res.Error != nil {
+		return fmt.Errorf(StorageError, res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *storage) TouchAccessToken(tokenID uuid.UUID, usedAt time.Time) error {
+	if err := s.db.Model(&models.AccessToken{}).Where("id = ?", tokenID).
+		Update("last_used_at", usedAt).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+// #endregion Access Tokens
+
+// #region Sessions
+
+// activeRefreshTokenIDs selects the IDs of refresh tokens that can still be used.
+func (s *storage) activeRefreshTokenIDs(now time.Time) *gorm.DB {
+	return s.db.Model(&authdb.RefreshToken{}).Select("id").
+		Where("is_revoked = ? AND expires_at > ?", false, now)
+}
+
+func (s *storage) CreateSession(session *models.Session) error {
+	if session.ID == uuid.Nil {
+		session.ID = uuid.New()
+	}
+	if err := s.db.Create(session).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+func (s *storage) GetSessionByRefreshToken(token string) (*models.Session, error) {
+	var session models.Session
+	err := s.db.Where("refresh_token_id IN (?)",
+		s.db.Model(&authdb.RefreshToken{}).Select("id").Where("token = ?", token)).
+		First(&session).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return &session, nil
+}
+
+func (s *storage) GetSessionByAccessToken(accessToken string) (*models.Session, error) {
+	var session models.Session
+	err := s.db.Where("access_token_hash = ?", models.HashSessionToken(accessToken)).First(&session).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return &session, nil
+}
+
+func (s *storage) RotateSession(
+	sessionID, refreshTokenID uuid.UUID, accessToken, userAgent, ipAddress string, seenAt time.Time,
+) error {
+	if err := s.db.Model(&models.Session{}).Where("id = ?", sessionID).Updates(map[string]any{
+		"refresh_token_id":  refreshTokenID,
+		"access_token_hash": models.HashSessionToken(accessToken),
+		"user_agent":        models.TruncateUserAgent(userAgent),
+		"ip_address":        ipAddress,
+		"last_seen_at":      seenAt,
+	}).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+func (s *storage) GetActiveSessions(userID uuid.UUID) ([]models.Session, error) {
+	var sessions []models.Session
+	if err := s.db.Where("user_id = ? AND refresh_token_id IN (?)", userID, s.activeRefreshTokenIDs(time.Now())).
+		Order("last_seen_at DESC").Find(&sessions).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return sessions, nil
+}
+
+func (s *storage) RevokeSession(userID, sessionID uuid.UUID) error {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var session models.Session
+		if err := tx.Where("id = ? AND user_id = ?", sessionID, userID).First(&session).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&authdb.RefreshToken{}).Where("id = ?", session.RefreshTokenID).
+			Update("is_revoked", true).Error; err != nil {
+			return err
+		}
+		if err := revokeSessionTokens(tx, []models.Session{session}); err != nil {
+			return err
+		}
+		return tx.Delete(&session).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf(StorageError, err)
+	}
+	s.revokedTokens.invalidate()
+	return nil
+}
+
+func (s *storage) RevokeOtherSessions(userID, keepSessionID uuid.UUID) (int, error) {
+	var revoked int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		keepTokenID := uuid.Nil
+		if keepSessionID != uuid.Nil {
+			var keep models.Session
+			if err := tx.Where("id = ? AND user_id = ?", keepSessionID, userID).First(&keep).Error; err == nil {
+				keepTokenID = keep.RefreshTokenID
+			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+		}
+		// Revoke by user rather than by session so tokens issued before
+		// sessions were tracked are signed out too.
+		res := tx.Model(&authdb.RefreshToken{}).
+			Where("user_id = ? AND is_revoked = ? AND id <> ?", userID, false, keepTokenID).
+			Update("is_revoked", true)
+		if res.Error != nil {
+			return res.Error
+		}
+		revoked = res.RowsAffected
+
+		var sessions []models.Session
+		if err := tx.Where("user_id = ? AND id <> ?", userID, keepSessionID).Find(&sessions).Error; err != nil {
+			return err
+		}
+		if err := revokeSessionTokens(tx, sessions); err != nil {
+			return err
+		}
+		return tx.Where("user_id = ? AND id <> ?", userID, keepSessionID).Delete(&models.Session{}).Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf(StorageError, err)
+	}
+	s.revokedTokens.invalidate()
+	return int(revoked), nil
+}
+
+// revokeSessionTokens blocks the current access tokens of sessions until they
+// expire. Sessions signed in before access tokens were tracked have none.
+func revokeSessionTokens(tx *gorm.DB, sessions []models.Session) error {
+	expiresAt := time.Now().Add(auth.AccessTokenTTL)
+	rows := make([]models.RevokedSessionToken, 0, len(sessions))
+	for _, session := range sessions {
+		if session.AccessTokenHash != "" {
+			rows = append(rows, models.RevokedSessionToken{AccessTokenHash: session.AccessTokenHash, ExpiresAt: expiresAt})
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error
+}
+
+// revokedTokensTTL bounds how long a revocation made by another process
+// sharing the database can take to reach this one.
+const revokedTokensTTL = 30 * time.Second
+
+// revokedTokenCache holds the revoked, unexpired access token hashes in
+// memory so IsSessionTokenRevoked does not query on every request. It is
+// reloaded after a revocation in this process and once it is older than
+// revokedTokensTTL.
+type revokedTokenCache struct {
+	mu       sync.Mutex
+	hashes   map[string]time.Time // hash -> expiry
+	loadedAt time.Time
+}
+
+func (c *revokedTokenCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loadedAt = time.Time{}
+}
+
+func (s *storage) IsSessionTokenRevoked(accessToken string) (bool, error) {
+	c := &s.revokedTokens
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.loadedAt) >= revokedTokensTTL {
+		var rows []models.RevokedSessionToken
+		if err := s.db.Where("expires_at > ?", now).Find(&rows).Error; err != nil {
+			return false, fmt.Errorf(StorageError, err)
+		}
+		c.hashes = make(map[string]time.Time, len(rows))
+		for _, row := range rows {
+			c.hashes[row.AccessTokenHash] = row.ExpiresAt
+		}
+		c.loadedAt = now
+	}
+	expiresAt, ok := c.hashes[models.HashSessionToken(accessToken)]
+	return ok && expiresAt.After(now), nil
+}
+
+func (s *storage) DeleteInactiveSessions() error {
+	now := time.Now()
+	if err := s.db.Where("refresh_token_id NOT IN (?)", s.activeRefreshTokenIDs(now)).
+		Delete(&models.Session{}).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	if err := s.db.Where("expires_at <= ?", now).Delete(&models.RevokedSessionToken{}).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+// #endregion Sessions
+
+// #region Audit
+
+func (s *storage) CreateAuditEvent(event *models.AuditEvent) error {
+	if err := s.db.Create(event).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+func (s *storage) GetAuditEvents(filter AuditFilter) ([]models.AuditEvent, error) {
+	query := s.db.Model(&models.AuditEvent{})
+	if filter.FamilyID != nil {
+		query = query.Where("family_id = ?", *filter.FamilyID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.Before > 0 {
+		query = query.Where("id < ?", filter.Before)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var events []models.AuditEvent
+	if err := query.Order("id DESC").Find(&events).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return events, nil
+}
+
+// #endregion Audit
+
+// #region Login lockouts
+
+func (s *storage) GetLoginLockout(username string) (*models.LoginLockout, error) {
+	var lockout models.LoginLockout
+	if err := s.db.Where("username = ?", username).First(&lockout).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return &lockout, nil
+}
+
+func (s *storage) UpdateLoginLockout(
+	username string, update func(lockout *models.LoginLockout),
+) (*models.LoginLockout, error) {
+	lockout := models.LoginLockout{Username: username}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("username = ?", username).First(&lockout).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		update(&lockout)
+		return tx.Save(&lockout).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return &lockout, nil
+}
+
+func (s *storage) ClearLoginLockout(username string) error {
+	res := s.db.Where("username = ?", username).Delete(&models.LoginLockout{})
+	if res.Error != nil {
+		return fmt.Errorf(StorageError, res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// #endregion Login lockouts
+
+// #region Recaps
+
+func (s *storage) GetRecap(familyID uuid.UUID, period, startDate string) (*models.Recap, error) {
+	var recap models.Recap
+	if err := s.db.Where("family_id = ? AND period = ? AND start_date = ?", familyID, period, startDate).
+		First(&recap).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return &recap, nil
+}
+
+func (s *storage) GetRecaps(familyID uuid.UUID, period string) ([]models.Recap, error) {
+	query := s.db.Where("family_id = ?", familyID)
+	if period != "" {
+		query = query.Where("period = ?", period)
+	}
+	var recaps []models.Recap
+	if err := query.Order("start_date DESC, period").Find(&recaps).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return recaps, nil
+}
+
+func (s *storage) CreateRecap(recap *models.Recap) error {
+	if recap.ID == uuid.Nil {
+		recap.ID = uuid.New()
+	}
+	if err := s.db.Create(recap).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+// #endregion Recaps
+
+// #region Embeddings
+
+func (s *storage) GetItemsWithStaleEmbeddings(familyID uuid.UUID, model string, limit int) ([]*models.Item, error) {
+	var items []*models.Item
+	err := s.db.Table("items").Select("items.*").
+		Joins("LEFT JOIN item_embeddings e ON e.family_id = items.family_id AND e.date = items.date").
+		Where("items.family_id = ? AND items.deleted_at IS NULL", familyID).
+		Where("e.date IS NULL OR e.model <> ? OR e.source_hash <> items.tags_source_hash", model).
+		Order("items.date DESC").Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return items, nil
+}
+
+func (s *storage) PutItemEmbedding(embedding *models.ItemEmbedding) error {
+	if err := s.db.Save(embedding).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+func (s *storage) GetItemEmbeddings(familyID uuid.UUID, model string) ([]models.ItemEmbedding, error) {
+	var embeddings []models.ItemEmbedding
+	if err := s.db.Where("family_id = ? AND model = ? AND length(vector) > 0", familyID, model).
+		Find(&embeddings).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return embeddings, nil
+}
+
+// #endregion Embeddings
+
+// #region Asset captions
+
+func (s *storage) GetAssetCaptions(familyID uuid.UUID) ([]models.AssetCaption, error) {
+	var captions []models.AssetCaption
+	if err := s.db.Where("family_id = ?", familyID).Order("filename").Find(&captions).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return captions, nil
+}
+
+func (s *storage) PutAssetCaption(caption *models.AssetCaption) error {
+	if err := s.db.Save(caption).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+func (s *storage) GetAssetTranscript(familyID uuid.UUID, filename string) (*models.AssetTranscript, error) {
+	var transcript models.AssetTranscript
+	if err := s.db.Where("family_id = ? AND filename = ?", familyID, filename).First(&transcript).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return &transcript, nil
+}
+
+func (s *storage) PutAssetTranscript(transcript *models.AssetTranscript) error {
+	if err := s.db.Save(transcript).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+// #endregion Asset captions
+
+// #region AI usage
+
+func (s *storage) AddAIUsage(usage *models.AIUsage) error {
+	err := s.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "family_id"}, {Name: "month"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"requests":      gorm.Expr("requests + excluded.requests"),
+			"input_tokens":  gorm.Expr("input_tokens + excluded.input_tokens"),
+			"output_tokens": gorm.Expr("output_tokens + excluded.output_tokens"),
+			"images":        gorm.Expr("images + excluded.images"),
+			"updated_at":    gorm.Expr("excluded.updated_at"),
+		}),
+	}).Create(usage).Error
+	if err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+func (s *storage) GetAIUsage(familyID uuid.UUID, month string) (*models.AIUsage, error) {
+	var usage models.AIUsage
+	err := s.db.Where("family_id = ? AND month = ?", familyID, month).First(&usage).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.AIUsage{FamilyID: familyID, Month: month}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return &usage, nil
+}
+
+func (s *storage) GetAIUsageHistory(familyID uuid.UUID) ([]models.AIUsage, error) {
+	var months []models.AIUsage
+	if err := s.db.Where("family_id = ?", familyID).Order("month DESC").Find(&months).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return months, nil
+}
+
+// #endregion AI usage
+
+// #region Suggestion cache
+
+func (s *storage) GetSuggestionCache(key string, notBefore time.Time) (*models.SuggestionCacheEntry, error) {
+	var entry models.SuggestionCacheEntry
+	err := s.db.Where("key = ? AND created_at >= ?", key, notBefore).First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return &entry, nil
+}
+
+func (s *storage) PutSuggestionCache(entry *models.SuggestionCacheEntry) error {
+	if err := s.db.Save(entry).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+func (s *storage) ClearSuggestionCache(familyID *uuid.UUID, createdBefore time.Time) (int64, error) {
+	q := s.db.Where("1 = 1")
+	if familyID != nil {
+		q = q.Where("family_id = ?", *familyID)
+	}
+	if !createdBefore.IsZero() {
+		q = q.Where("created_at < ?", createdBefore)
+	}
+	res := q.Delete(&models.SuggestionCacheEntry{})
+	if res.Error != nil {
+		return 0, fmt.Errorf(StorageError, res.Error)
+	}
+	return res.RowsAffected, nil
+}
+
+// #endregion Suggestion cache
+
+// #region AI jobs
+
+func (s *storage) EnqueueAIJob(familyID uuid.UUID, kind, date string) (bool, error) {
+	created := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var open int64
+		if err := tx.Model(&models.AIJob{}).
+			Where("family_id = ? AND kind = ? AND date = ? AND status IN ?",
+				familyID, kind, date, []string{models.AIJobQueued, models.AIJobRunning}).
+			Count(&open).Error; err != nil {
+			return err
+		}
+		if open > 0 {
+			return nil
+		}
+		now := time.Now()
+		created = true
+		return tx.Create(&models.AIJob{
+			ID:       uuid.New(),
+			FamilyID: familyID,
+			Kind:     kind,
+			Date:     date,
+			Status:   models.AIJobQueued,
+			RunAfter: now,
+		}).Error
+	})
+	if err != nil {
+		return false, fmt.Errorf(StorageError, err)
+	}
+	return created, nil
+}
+
+func (s *storage) ClaimAIJob(now time.Time, perFamilyLimit int) (*models.AIJob, error) {
+	var job models.AIJob
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("status = ? AND run_after <= ?", models.AIJobQueued, now).
+			Where("(SELECT COUNT(*) FROM ai_jobs r WHERE r.family_id = ai_jobs.family_id AND r.status = ?) < ?",
+				models.AIJobRunning, perFamilyLimit).
+			Order("run_after, created_at").First(&job).Error
+		if err != nil {
+			return err
+		}
+		claimed, err := claimQueuedAIJob(tx, &job)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return &job, nil
+}
+
+// claimQueuedAIJob marks job as running if it is still queued. The status
+// guard keeps two processes sharing the database from both claiming a job
+// they selected at the same time; the loser gets false.
+func claimQueuedAIJob(tx *gorm.DB, job *models.AIJob) (bool, error) {
+	res := tx.Model(&models.AIJob{}).Where("id = ? AND status = ?", job.ID, models.AIJobQueued).
+		Updates(map[string]any{"status": models.AIJobRunning, "attempts": job.Attempts + 1})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	job.Status = models.AIJobRunning
+	job.Attempts++
+	return true, nil
+}
+
+func (s *storage) UpdateAIJob(job *models.AIJob) error {
+	if err := s.db.Save(job).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
+func (s *storage) GetAIJobs(familyID uuid.UUID, limit int) ([]models.AIJob, error) {
+	var jobs []models.AIJob
+	if err := s.db.Where("family_id = ?", familyID).
+		Order("updated_at DESC, created_at DESC").Limit(limit).Find(&jobs).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return jobs, nil
+}
+
+func (s *storage) CountAIJobs(familyID uuid.UUID) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		N      int64
+	}
+	if err := s.db.Model(&models.AIJob{}).Select("status, COUNT(*) AS n").
+		Where("family_id = ?", familyID).Group("status").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	counts := make(map[string]int64, len(rows))
+	for _, r := range rows {
+		counts[r.Status] = r.N
+	}
+	return counts, nil
+}
+
+func (s *storage) RequeueRunningAIJobs() (int64, error) {
+	res := s.db.Model(&models.AIJob{}).Where("status = ?", models.AIJobRunning).
+		Update("status", models.AIJobQueued)
+	if res.Error != nil {
+		return 0, fmt.Errorf(StorageError, res.Error)
+	}
+	return res.RowsAffected, nil
+}
+
+func (s *storage) DeleteFinishedAIJobs(before time.Time) (int64, error) {
+	res := s.db.Where("status IN ? AND finished_at < ?", []string{models.AIJobDone, models.AIJobFailed}, before).
+		Delete(&models.AIJob{})
+	if res.Error != nil {
+		return 0, fmt.Errorf(StorageError, res.Error)
+	}
+	return res.RowsAffected, nil
+}
+
+// #endregion AI jobs
@@ -0,0 +1,114 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func TestAIJobsEnqueueDedupAndPerFamilyClaim(t *testing.T) {
+	s, fam := newTagStorage(t)
+	other, err := s.CreateFamily("other")
+	if err != nil {
+		t.Fatalf("create family: %v", err)
+	}
+
+	for _, d := range []string{"2024-01-01", "2024-01-02", "2024-01-01"} {
+		if _, err := s.EnqueueAIJob(fam.ID, models.AIJobTagDay, d); err != nil {
+			t.Fatalf("enqueue %s: %v", d, err)
+		}
+	}
+	if _, err := s.EnqueueAIJob(other.ID, models.AIJobTagDay, "2024-01-01"); err != nil {
+		t.Fatalf("enqueue other: %v", err)
+	}
+	if counts, _ := s.CountAIJobs(fam.ID); counts[models.AIJobQueued] != 2 {
+		t.Fatalf("an open job for the same day must not be queued twice, counts = %v", counts)
+	}
+
+	later := time.Now().Add(time.Minute)
+	first, err := s.ClaimAIJob(later, 1)
+	if err != nil || first == nil {
+		t.Fatalf("first claim = %+v, %v", first, err)
+	}
+	if first.Status != models.AIJobRunning || first.Attempts != 1 {
+		t.Fatalf("claimed job = %+v", first)
+	}
+	second, err := s.ClaimAIJob(later, 1)
+	if err != nil || second == nil || second.FamilyID == first.FamilyID {
+		t.Fatalf("second claim must come from the other family, got %+v, %v", second, err)
+	}
+	if third, err := s.ClaimAIJob(later, 1); err != nil || third != nil {
+		t.Fatalf("both families are at their limit, got %+v, %v", third, err)
+	}
+
+	// A job left running by a crashed process is queued again on startup.
+	if n, err := s.RequeueRunningAIJobs(); err != nil || n != 2 {
+		t.Fatalf("requeued %d, %v", n, err)
+	}
+
+	// Retries scheduled in the future are not due yet.
+	job, _ := s.ClaimAIJob(later, 1)
+	job.Status = models.AIJobQueued
+	job.RunAfter = later.Add(time.Hour)
+	if err := s.UpdateAIJob(job); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	for {
+		j, err := s.ClaimAIJob(later, 5)
+		if err != nil {
+			t.Fatalf("claim: %v", err)
+		}
+		if j == nil {
+			break
+		}
+		if j.ID == job.ID {
+			t.Fatal("a job scheduled for later must not be claimed early")
+		}
+		finished := later
+		j.Status = models.AIJobDone
+		j.FinishedAt = &finished
+		if err := s.UpdateAIJob(j); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+	}
+
+	jobs, err := s.GetAIJobs(fam.ID, 10)
+	if err != nil || len(jobs) != 2 {
+		t.Fatalf("GetAIJobs = %d jobs, %v", len(jobs), err)
+	}
+	if n, err := s.DeleteFinishedAIJobs(later.Add(time.Second)); err != nil || n != 2 {
+		t.Fatalf("deleted %d finished jobs, %v", n, err)
+	}
+	if counts, _ := s.CountAIJobs(fam.ID); counts[models.AIJobQueued] != 1 || counts[models.AIJobDone] != 0 {
+		t.Fatalf("after pruning, counts = %v", counts)
+	}
+}
+
+func TestClaimQueuedAIJobLosesToAnEarlierClaim(t *testing.T) {
+	s, fam := newTagStorage(t)
+	if _, err := s.EnqueueAIJob(fam.ID, models.AIJobTagDay, "2024-01-01"); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	db := s.(*storage).db
+	var selected models.AIJob
+	if err := db.First(&selected).Error; err != nil {
+		t.Fatalf("load job: %v", err)
+	}
+
+	// Another process claims the job after this one selected it.
+	if _, err := s.ClaimAIJob(time.Now().Add(time.Minute), 1); err != nil {
+		t.Fatalf("claim: %v", err)
+	}
+	claimed, err := claimQueuedAIJob(db, &selected)
+	if err != nil || claimed {
+		t.Fatalf("a job claimed elsewhere must not be claimed again, got %v, %v", claimed, err)
+	}
+	if selected.Status != models.AIJobQueued || selected.Attempts != 0 {
+		t.Fatalf("lost claim changed the job: %+v", selected)
+	}
+	var stored models.AIJob
+	if err := db.First(&stored, "id = ?", selected.ID).Error; err != nil || stored.Attempts != 1 {
+		t.Fatalf("stored job = %+v, %v", stored, err)
+	}
+}
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func TestAddAIUsageAccumulatesPerMonth(t *testing.T) {
+	s, fam := newTagStorage(t)
+
+	for _, u := range []*models.AIUsage{
+		{FamilyID: fam.ID, Month: "2024-05", Requests: 1, InputTokens: 100, OutputTokens: 10, Images: 2},
+		{FamilyID: fam.ID, Month: "2024-05", Requests: 1, InputTokens: 50, OutputTokens: 5},
+		{FamilyID: fam.ID, Month: "2024-06", Requests: 1, InputTokens: 7, OutputTokens: 1},
+	} {
+		if err := s.AddAIUsage(u); err != nil {
+			t.Fatalf("AddAIUsage: %v", err)
+		}
+	}
+
+	may, err := s.GetAIUsage(fam.ID, "2024-05")
+	if err != nil {
+		t.Fatalf("GetAIUsage: %v", err)
+	}
+	if may.Requests != 2 || may.InputTokens != 150 || may.OutputTokens != 15 || may.Images != 2 {
+		t.Fatalf("counters not accumulated: %+v", may)
+	}
+
+	empty, err := s.GetAIUsage(fam.ID, "2023-01")
+	if err != nil || empty.Requests != 0 || empty.Month != "2023-01" {
+		t.Fatalf("unused month = %+v, %v", empty, err)
+	}
+
+	history, err := s.GetAIUsageHistory(fam.ID)
+	if err != nil || len(history) != 2 || history[0].Month != "2024-06" {
+		t.Fatalf("history = %+v, %v", history, err)
+	}
+}
@@ -0,0 +1,49 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func TestGetAuditEventsFiltersAndPages(t *testing.T) {
+	s, _ := newTagStorage(t)
+	familyID, otherFamilyID := uuid.New(), uuid.New()
+	actor := models.AuditActor{Name: "alice", IPAddress: "192.0.2.1"}
+
+	for _, e := range []*models.AuditEvent{
+		models.NewAuditEvent(familyID, actor, models.AuditLoginSucceeded, "api"),
+		models.NewAuditEvent(otherFamilyID, actor, models.AuditLoginSucceeded, "web"),
+		models.NewAuditEvent(uuid.Nil, models.AuditActor{IPAddress: "198.51.100.7"}, models.AuditRateLimited, "/v1/authorize"),
+		models.NewAuditEvent(familyID, actor, models.AuditTagRenamed, "a → b"),
+		models.NewAuditEvent(familyID, actor, models.AuditTagDeleted, "b"),
+	} {
+		if err := s.CreateAuditEvent(e); err != nil {
+			t.Fatalf("CreateAuditEvent: %v", err)
+		}
+	}
+
+	all, err := s.GetAuditEvents(AuditFilter{})
+	if err != nil || len(all) != 5 {
+		t.Fatalf("want all 5 events, got %d, %v", len(all), err)
+	}
+	if all[0].Action != models.AuditTagDeleted {
+		t.Fatalf("want newest first, got %q", all[0].Action)
+	}
+
+	page, err := s.GetAuditEvents(AuditFilter{FamilyID: &familyID, Limit: 2})
+	if err != nil || len(page) != 2 || page[0].Action != models.AuditTagDeleted || page[1].Action != models.AuditTagRenamed {
+		t.Fatalf("unexpected first page: %+v, %v", page, err)
+	}
+	rest, err := s.GetAuditEvents(AuditFilter{FamilyID: &familyID, Before: page[1].ID})
+	if err != nil || len(rest) != 1 || rest[0].Target != "api" {
+		t.Fatalf("unexpected second page: %+v, %v", rest, err)
+	}
+
+	limited, err := s.GetAuditEvents(AuditFilter{Action: models.AuditRateLimited})
+	if err != nil || len(limited) != 1 || limited[0].FamilyID != uuid.Nil {
+		t.Fatalf("action filter: %+v, %v", limited, err)
+	}
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func TestSearchTextMatchesReferencedImageCaptions(t *testing.T) {
+	s, fam := newTagStorage(t)
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-03-01", Title: "Beach", Body: "Sunny.\n\n![](dog.jpg)"},
+		&models.Item{Date: "2024-03-02", Title: "Home", Body: "Rain."},
+	)
+	for _, c := range []*models.AssetCaption{
+		{FamilyID: fam.ID, Filename: "dog.jpg", Caption: "A golden retriever chasing a ball", Model: "m"},
+		{FamilyID: fam.ID, Filename: "unused.jpg", Caption: "A retriever asleep", Model: "m"},
+	} {
+		if err := s.PutAssetCaption(c); err != nil {
+			t.Fatalf("PutAssetCaption: %v", err)
+		}
+	}
+
+	items, _, err := s.GetItems(fam.ID, SearchParams{SearchText: "retriever"})
+	if err != nil {
+		t.Fatalf("GetItems: %v", err)
+	}
+	if len(items) != 1 || items[0].Date != "2024-03-01" {
+		t.Fatalf("want only the entry referencing the captioned image, got %d items", len(items))
+	}
+
+	other, err := s.CreateFamily("other")
+	if err != nil {
+		t.Fatalf("CreateFamily: %v", err)
+	}
+	putItems(t, s, other.ID, &models.Item{Date: "2024-03-01", Body: "![](dog.jpg)"})
+	if items, _, _ := s.GetItems(other.ID, SearchParams{SearchText: "retriever"}); len(items) != 0 {
+		t.Fatalf("captions must not leak across families, got %d items", len(items))
+	}
+
+	captions, err := s.GetAssetCaptions(fam.ID)
+	if err != nil || len(captions) != 2 || captions[0].Filename != "dog.jpg" {
+		t.Fatalf("GetAssetCaptions = %v, %v", captions, err)
+	}
+}
+
+func TestSearchTextMatchesCaptionsByWholeAssetPath(t *testing.T) {
+	s, fam := newTagStorage(t)
+	putItems(t, s, fam.ID, &models.Item{Date: "2024-03-01", Title: "Chart", Body: "![](data.jpg)"})
+	if err := s.PutAssetCaption(&models.AssetCaption{
+		FamilyID: fam.ID, Filename: "a.jpg", Caption: "A retriever puppy", Model: "m",
+	}); err != nil {
+		t.Fatalf("PutAssetCaption: %v", err)
+	}
+
+	items, _, err := s.GetItems(fam.ID, SearchParams{SearchText: "retriever"})
+	if err != nil {
+		t.Fatalf("GetItems: %v", err)
+	}
+	if len(items) != 0 {
+		t.Fatalf("a caption of a.jpg must not match an entry embedding data.jpg, got %d items", len(items))
+	}
+}
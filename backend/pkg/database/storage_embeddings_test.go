@@ -0,0 +1,71 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func staleDates(t *testing.T, s Storage, fam *models.Family, model string) []string {
+	t.Helper()
+	items, err := s.GetItemsWithStaleEmbeddings(fam.ID, model, 100)
+	if err != nil {
+		t.Fatalf("GetItemsWithStaleEmbeddings: %v", err)
+	}
+	dates := make([]string, len(items))
+	for i, it := range items {
+		dates[i] = it.Date
+	}
+	return dates
+}
+
+func TestEmbeddingStalenessFollowsSourceHash(t *testing.T) {
+	s, fam := newTagStorage(t)
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-03-01", Title: "Hike", Body: "trail"},
+		&models.Item{Date: "2024-03-02", Title: "Work"},
+	)
+	if got := staleDates(t, s, fam, "m1"); len(got) != 2 || got[0] != "2024-03-02" {
+		t.Fatalf("new entries must be stale newest first, got %v", got)
+	}
+
+	embed := func(date string, vec []float32) {
+		item, err := s.GetItem(fam.ID, date)
+		if err != nil {
+			t.Fatalf("get item: %v", err)
+		}
+		e := &models.ItemEmbedding{FamilyID: fam.ID, Date: date, Model: "m1", SourceHash: item.TagsSourceHash}
+		e.SetVector(vec)
+		if err := s.PutItemEmbedding(e); err != nil {
+			t.Fatalf("PutItemEmbedding: %v", err)
+		}
+	}
+	embed("2024-03-01", []float32{0.6, 0.8})
+	embed("2024-03-02", nil)
+	if got := staleDates(t, s, fam, "m1"); len(got) != 0 {
+		t.Fatalf("embedded entries must not be stale, got %v", got)
+	}
+	if got := staleDates(t, s, fam, "m2"); len(got) != 2 {
+		t.Fatalf("a new model makes every entry stale, got %v", got)
+	}
+
+	vectors, err := s.GetItemEmbeddings(fam.ID, "m1")
+	if err != nil || len(vectors) != 1 || vectors[0].Values()[1] != 0.8 {
+		t.Fatalf("want only the non-empty vector back, got %+v, %v", vectors, err)
+	}
+
+	putItems(t, s, fam.ID, &models.Item{Date: "2024-03-01", Title: "Hike", Body: "trail, edited"})
+	if got := staleDates(t, s, fam, "m1"); len(got) != 1 || got[0] != "2024-03-01" {
+		t.Fatalf("an edit must make the entry stale, got %v", got)
+	}
+
+	if err := s.DeleteItem(fam.ID, "2024-03-01"); err != nil {
+		t.Fatalf("DeleteItem: %v", err)
+	}
+	if vectors, _ := s.GetItemEmbeddings(fam.ID, "m1"); len(vectors) != 0 {
+		t.Fatalf("deleting an entry must drop its vector, got %+v", vectors)
+	}
+	if got := staleDates(t, s, fam, "m1"); len(got) != 0 {
+		t.Fatalf("deleted entries must not be stale, got %v", got)
+	}
+}
@@ -0,0 +1,130 @@
+package database
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func createFields(t *testing.T, s Storage, familyID uuid.UUID, fields ...models.CustomField) {
+	t.Helper()
+	for i := range fields {
+		fields[i].FamilyID = familyID
+		if err := s.CreateCustomField(&fields[i]); err != nil {
+			t.Fatalf("create field %s: %v", fields[i].Key, err)
+		}
+	}
+}
+
+func TestCustomFields(t *testing.T) {
+	s, fam := newTagStorage(t)
+
+	lowest, highest := 1.0, 5.0
+	createFields(t, s, fam.ID,
+		models.CustomField{Key: "rating", Name: "Rating", Type: models.FieldTypeScale, Min: &lowest, Max: &highest},
+		models.CustomField{Key: "sick", Name: "Sick", Type: models.FieldTypeBoolean, Position: 1},
+	)
+	err := s.CreateCustomField(&models.CustomField{FamilyID: fam.ID, Key: "rating", Name: "Again", Type: "number"})
+	if !errors.Is(err, ErrFieldConflict) {
+		t.Fatalf("expected ErrFieldConflict, got %v", err)
+	}
+	other, _ := s.CreateFamily("other")
+	createFields(t, s, other.ID, models.CustomField{Key: "rating", Name: "Rating", Type: models.FieldTypeNumber})
+
+	fields, err := s.GetCustomFields(fam.ID)
+	if err != nil || len(fields) != 2 || fields[0].Key != "rating" || fields[1].Key != "sick" {
+		t.Fatalf("GetCustomFields got %+v err %v", fields, err)
+	}
+
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-01-01", Title: "a", Fields: models.FieldValues{"rating": 4.0, "sick": false}},
+		&models.Item{Date: "2024-01-02", Title: "b", Fields: models.FieldValues{"rating": 2.0, "sick": true}},
+		&models.Item{Date: "2024-02-01", Title: "c", Fields: models.FieldValues{"rating": 4.0}},
+		&models.Item{Date: "2024-02-02", Title: "d"},
+	)
+
+	// Saving without values keeps them; an empty set clears them.
+	putItems(t, s, fam.ID, &models.Item{Date: "2024-01-01", Title: "a2"})
+	if item, _ := s.GetItem(fam.ID, "2024-01-01"); item.Fields["rating"] != 4.0 {
+		t.Fatalf("values lost on save without fields: %v", item.Fields)
+	}
+	putItems(t, s, fam.ID, &models.Item{Date: "2024-02-02", Title: "d", Fields: models.FieldValues{}})
+
+	dates := func(filters ...FieldFilter) []string {
+		t.Helper()
+		items, _, err := s.GetItems(fam.ID, SearchParams{Fields: filters})
+		if err != nil {
+			t.Fatalf("GetItems %v: %v", filters, err)
+		}
+		out := make([]string, len(items))
+		for i, it := range items {
+			out[i] = it.Date
+		}
+		sort.Strings(out)
+		return out
+	}
+	if got := dates(FieldFilter{Key: "rating", Op: ">=", Value: 4.0}); !equalTags(got, []string{"2024-01-01", "2024-02-01"}) {
+		t.Fatalf("rating>=4 got %v", got)
+	}
+	if got := dates(FieldFilter{Key: "sick", Op: "=", Value: true}); !equalTags(got, []string{"2024-01-02"}) {
+		t.Fatalf("sick=true got %v", got)
+	}
+	got := dates(FieldFilter{Key: "rating", Op: ">", Value: 1.0}, FieldFilter{Key: "sick", Op: "!=", Value: true})
+	if !equalTags(got, []string{"2024-01-01"}) {
+		t.Fatalf("combined filter got %v (entries without a value must not match)", got)
+	}
+	if _, _, err := s.GetItems(fam.ID, SearchParams{Fields: []FieldFilter{{Key: "rating", Op: "; DROP", Value: 1.0}}}); err == nil {
+		t.Fatalf("expected an error for an unknown operator")
+	}
+
+	stats, err := s.GetFieldStats(fam.ID, fields[0], "", "")
+	if err != nil {
+		t.Fatalf("GetFieldStats: %v", err)
+	}
+	if stats.Count != 3 || *stats.Min != 2 || *stats.Max != 4 || *stats.Sum != 10 {
+		t.Fatalf("rating stats got %+v", stats)
+	}
+	if len(stats.Values) != 2 || stats.Values[0] != (FieldValueCount{Value: "4", Count: 2}) {
+		t.Fatalf("rating values got %+v", stats.Values)
+	}
+	if len(stats.Months) != 2 || stats.Months[0].Month != "2024-01" || *stats.Months[0].Avg != 3 {
+		t.Fatalf("rating months got %+v", stats.Months)
+	}
+	stats, err = s.GetFieldStats(fam.ID, fields[1], "2024-01-02", "2024-12-31")
+	if err != nil {
+		t.Fatalf("GetFieldStats: %v", err)
+	}
+	if stats.Count != 1 || len(stats.Values) != 1 || stats.Values[0] != (FieldValueCount{Value: "true", Count: 1}) {
+		t.Fatalf("sick stats in range got %+v", stats)
+	}
+
+	updated, err := s.UpdateCustomField(fam.ID, "rating", func(f *models.CustomField) { f.Name = "Day rating" })
+	if err != nil || updated.Name != "Day rating" {
+		t.Fatalf("UpdateCustomField got %+v err %v", updated, err)
+	}
+	if _, err := s.UpdateCustomField(fam.ID, "ghost", func(*models.CustomField) {}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	// Deleting a field drops its values, recording a change per entry touched.
+	before, _ := s.GetChangesSince(fam.ID, 0, 100)
+	if err := s.DeleteCustomField(fam.ID, "rating"); err != nil {
+		t.Fatalf("DeleteCustomField: %v", err)
+	}
+	after, _ := s.GetChangesSince(fam.ID, 0, 100)
+	if len(after)-len(before) != 3 {
+		t.Fatalf("expected 3 change records, got %d", len(after)-len(before))
+	}
+	if item, _ := s.GetItem(fam.ID, "2024-01-02"); len(item.Fields) != 1 || item.Fields["sick"] != true {
+		t.Fatalf("values after delete got %v", item.Fields)
+	}
+	if err := s.DeleteCustomField(fam.ID, "rating"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if fields, _ := s.GetCustomFields(other.ID); len(fields) != 1 {
+		t.Fatalf("other family's field affected: %+v", fields)
+	}
+}
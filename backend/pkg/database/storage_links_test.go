@@ -0,0 +1,86 @@
+package database
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func TestBacklinksAndBrokenLinks(t *testing.T) {
+	s, fam := newTagStorage(t)
+	other, _ := s.CreateFamily("other")
+
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-05-01", Title: "start"},
+		&models.Item{Date: "2024-05-03", Title: "b", Body: "Like [[2024-05-01]] and [[2024-04-01]]."},
+		&models.Item{Date: "2024-05-02", Title: "a", Body: "After [[2024-05-01]], again [[2024-05-01]]; self [[2024-05-02]]"},
+		&models.Item{Date: "2024-05-04", Title: "c", Body: "`[[2024-05-01]]` [[2024-02-30]]"},
+	)
+	putItems(t, s, other.ID, &models.Item{Date: "2024-05-09", Title: "x", Body: "[[2024-05-01]] [[2023-01-01]]"})
+
+	backlinks, err := s.GetBacklinks(fam.ID, "2024-05-01")
+	if err != nil {
+		t.Fatalf("GetBacklinks: %v", err)
+	}
+	if len(backlinks) != 2 || backlinks[0].Date != "2024-05-02" || backlinks[1].Date != "2024-05-03" {
+		t.Fatalf("backlinks got %+v", backlinks)
+	}
+	if backlinks, _ := s.GetBacklinks(fam.ID, "2024-05-02"); len(backlinks) != 0 {
+		t.Fatalf("self link got %+v", backlinks)
+	}
+
+	broken, err := s.GetBrokenLinks(fam.ID)
+	if err != nil || len(broken) != 1 || broken[0].Date != "2024-05-03" || broken[0].Target != "2024-04-01" {
+		t.Fatalf("broken links got %+v err %v", broken, err)
+	}
+
+	// Editing the body replaces the entry's links; deleting a day breaks the
+	// links to it and drops its own.
+	putItems(t, s, fam.ID, &models.Item{Date: "2024-05-03", Title: "b", Body: "Nothing to see"})
+	if err := s.DeleteItem(fam.ID, "2024-05-01"); err != nil {
+		t.Fatalf("DeleteItem: %v", err)
+	}
+	if err := s.DeleteItem(fam.ID, "2024-05-04"); err != nil {
+		t.Fatalf("DeleteItem: %v", err)
+	}
+	broken, _ = s.GetBrokenLinks(fam.ID)
+	if len(broken) != 1 || broken[0].Date != "2024-05-02" || broken[0].Target != "2024-05-01" {
+		t.Fatalf("broken links after edits got %+v", broken)
+	}
+	if backlinks, _ := s.GetBacklinks(fam.ID, "2024-05-01"); len(backlinks) != 1 {
+		t.Fatalf("backlinks to a deleted day got %+v", backlinks)
+	}
+}
+
+func TestRebuildItemLinks(t *testing.T) {
+	s, fam := newTagStorage(t)
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-05-01", Title: "a", Body: "see [[2024-05-02]]"},
+		&models.Item{Date: "2024-05-02", Title: "b", Body: "see [[2024-05-01]]"},
+	)
+	if err := s.DeleteItem(fam.ID, "2024-05-02"); err != nil {
+		t.Fatalf("DeleteItem: %v", err)
+	}
+	// Rows of an older version: lost and stale ones.
+	db := s.GetDB()
+	if err := db.Exec("DELETE FROM item_links").Error; err != nil {
+		t.Fatalf("clear links: %v", err)
+	}
+	stale := models.ItemLink{FamilyID: fam.ID, Date: "2024-05-07", Target: "2024-05-01"}
+	if err := db.Create(&stale).Error; err != nil {
+		t.Fatalf("seed stale row: %v", err)
+	}
+
+	if err := rebuildItemLinks(slog.Default(), db); err != nil {
+		t.Fatalf("rebuildItemLinks: %v", err)
+	}
+	var rows []models.ItemLink
+	if err := db.Order("date, target").Find(&rows).Error; err != nil {
+		t.Fatalf("fetch item links: %v", err)
+	}
+	want := models.ItemLink{FamilyID: fam.ID, Date: "2024-05-01", Target: "2024-05-02"}
+	if len(rows) != 1 || rows[0] != want {
+		t.Fatalf("item links: got %v, want %v", rows, want)
+	}
+}
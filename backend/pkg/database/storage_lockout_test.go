@@ -0,0 +1,45 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func TestLoginLockoutLifecycle(t *testing.T) {
+	s, _ := newTagStorage(t)
+	now := time.Now()
+
+	if _, err := s.GetLoginLockout("alice"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("no failures yet: want ErrNotFound, got %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := s.UpdateLoginLockout("alice", func(l *models.LoginLockout) {
+			l.Failures++
+			l.LastFailureAt = now
+			if l.Failures == 3 {
+				l.LockedUntil = now.Add(time.Minute)
+			}
+		}); err != nil {
+			t.Fatalf("UpdateLoginLockout: %v", err)
+		}
+	}
+
+	lockout, err := s.GetLoginLockout("alice")
+	if err != nil || lockout.Failures != 3 || !lockout.Locked(now) {
+		t.Fatalf("want 3 failures and a lock, got %+v, %v", lockout, err)
+	}
+	if lockout.Locked(now.Add(2 * time.Minute)) {
+		t.Fatal("lock must expire")
+	}
+
+	if err := s.ClearLoginLockout("alice"); err != nil {
+		t.Fatalf("ClearLoginLockout: %v", err)
+	}
+	if err := s.ClearLoginLockout("alice"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("second clear: want ErrNotFound, got %v", err)
+	}
+}
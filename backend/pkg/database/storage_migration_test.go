@@ -234,3 +234,65 @@ func TestNormalizeTagColumns(t *testing.T) {
 		}
 	}
 }
+
+// TestRebuildItemTags verifies that the item_tags index is rebuilt from the
+// tags column: existing entries are converted, stale rows dropped, and blank,
+// duplicate or non-JSON tags and deleted entries skipped.
+func TestRebuildItemTags(t *testing.T) {
+	logger := slog.Default()
+	db, err := openSqlite(logger, ":memory:", false)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	if err := autoMigrateModels(db); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	familyID := uuid.New()
+	now := time.Now().UTC().Format(time.RFC3339)
+	for date, tags := range map[string]string{
+		"2024-03-01": `["travel/italy"," work ","work",""]`,
+		"2024-03-02": `garbage`,
+		"2024-03-03": `[]`,
+	} {
+		if err := db.Exec(
+			`INSERT INTO items (id, family_id, date, title, tags, pending_tags, created_at, updated_at)
+			 VALUES (?, ?, ?, 't', ?, '[]', ?, ?)`,
+			uuid.New().String(), familyID, date, tags, now, now,
+		).Error; err != nil {
+			t.Fatalf("seed %s: %v", date, err)
+		}
+	}
+	if err := db.Exec(
+		`INSERT INTO items (id, family_id, date, title, tags, pending_tags, created_at, updated_at, deleted_at)
+		 VALUES (?, ?, '2024-03-04', 't', '["deleted"]', '[]', ?, ?, ?)`,
+		uuid.New().String(), familyID, now, now, now,
+	).Error; err != nil {
+		t.Fatalf("seed deleted: %v", err)
+	}
+	stale := models.ItemTag{FamilyID: familyID, Date: "2024-03-03", Tag: "gone"}
+	if err := db.Create(&stale).Error; err != nil {
+		t.Fatalf("seed stale row: %v", err)
+	}
+
+	if err := rebuildItemTags(logger, db); err != nil {
+		t.Fatalf("rebuildItemTags: %v", err)
+	}
+
+	var rows []models.ItemTag
+	if err := db.Order("date, tag").Find(&rows).Error; err != nil {
+		t.Fatalf("fetch item tags: %v", err)
+	}
+	want := []models.ItemTag{
+		{FamilyID: familyID, Date: "2024-03-01", Tag: "travel/italy"},
+		{FamilyID: familyID, Date: "2024-03-01", Tag: "work"},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("item tags: got %v, want %v", rows, want)
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Errorf("item tags[%d]: got %v, want %v", i, rows[i], want[i])
+		}
+	}
+}
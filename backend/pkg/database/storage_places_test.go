@@ -0,0 +1,88 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func at(name string, lat, lon float64) *models.Place {
+	return &models.Place{Name: name, Latitude: &lat, Longitude: &lon, Source: models.PlaceSourceManual}
+}
+
+func TestItemPlaceAndWeather(t *testing.T) {
+	s, fam := newTagStorage(t)
+
+	sunny := &models.Weather{Condition: "clear", TempMinC: 12, TempMaxC: 24.5, Provider: "fake"}
+	putItems(t, s, fam.ID, &models.Item{Date: "2024-07-01", Title: "a", Place: at("Como", 45.81, 9.08), Weather: sunny})
+
+	// Saving without place and weather keeps them.
+	putItems(t, s, fam.ID, &models.Item{Date: "2024-07-01", Title: "a2"})
+	item, err := s.GetItem(fam.ID, "2024-07-01")
+	if err != nil {
+		t.Fatalf("GetItem: %v", err)
+	}
+	if item.Place.Name != "Como" || !item.Place.HasCoordinates() || *item.Place.Latitude != 45.81 {
+		t.Fatalf("place got %+v", item.Place)
+	}
+	if *item.Weather != *sunny {
+		t.Fatalf("weather got %+v", item.Weather)
+	}
+
+	changes, err := s.GetChangesSince(fam.ID, 0, 10)
+	if err != nil || len(changes) != 2 {
+		t.Fatalf("changes got %d err %v", len(changes), err)
+	}
+	snapshot := changes[1].ToSyncResponse().ItemSnapshot
+	if snapshot.Place == nil || snapshot.Place.Name != "Como" || snapshot.Weather == nil ||
+		snapshot.Weather.TempMaxC != 24.5 {
+		t.Fatalf("sync snapshot got place %+v weather %+v", snapshot.Place, snapshot.Weather)
+	}
+
+	// Empty ones clear them.
+	putItems(t, s, fam.ID, &models.Item{Date: "2024-07-01", Title: "a3", Place: &models.Place{}, Weather: &models.Weather{}})
+	item, _ = s.GetItem(fam.ID, "2024-07-01")
+	if !item.Place.IsZero() || !item.Weather.IsZero() || item.Place.FromDB() != nil || item.Weather.FromDB() != nil {
+		t.Fatalf("cleared place %+v weather %+v", item.Place, item.Weather)
+	}
+}
+
+func TestGetPlaces(t *testing.T) {
+	s, fam := newTagStorage(t)
+	other, _ := s.CreateFamily("other")
+
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-01-05", Title: "a", Place: at("Home", 50.0810, 14.4270)},
+		&models.Item{Date: "2024-02-05", Title: "b", Place: at("home", 50.0830, 14.4250)},
+		&models.Item{Date: "2024-03-05", Title: "c", Place: at("Home", 50.0810, 14.4270)},
+		&models.Item{Date: "2024-03-06", Title: "d", Place: at("Home", 48.8566, 2.3522)},
+		&models.Item{Date: "2024-04-01", Title: "e", Place: &models.Place{Name: "Grandma's", Source: models.PlaceSourceManual}},
+		&models.Item{Date: "2024-04-02", Title: "f", Place: at("", 45.81, 9.08)},
+		&models.Item{Date: "2024-04-03", Title: "g"},
+	)
+	putItems(t, s, other.ID, &models.Item{Date: "2024-01-05", Title: "x", Place: at("Home", 50.0810, 14.4270)})
+	if err := s.DeleteItem(fam.ID, "2024-04-02"); err != nil {
+		t.Fatalf("DeleteItem: %v", err)
+	}
+
+	places, err := s.GetPlaces(fam.ID, "", "")
+	if err != nil {
+		t.Fatalf("GetPlaces: %v", err)
+	}
+	if len(places) != 3 {
+		t.Fatalf("expected 3 places, got %+v", places)
+	}
+	home := places[0]
+	if home.Count != 3 || home.FirstDate != "2024-01-05" || home.LastDate != "2024-03-05" ||
+		home.Latitude == nil || *home.Latitude < 50.08 || *home.Latitude > 50.083 {
+		t.Fatalf("home got %+v", home)
+	}
+	if places[1].Name != "Grandma's" || places[1].Latitude != nil || places[2].Count != 1 {
+		t.Fatalf("places got %+v", places)
+	}
+
+	places, err = s.GetPlaces(fam.ID, "2024-03-01", "2024-03-31")
+	if err != nil || len(places) != 2 || places[0].Count != 1 {
+		t.Fatalf("places in March got %+v err %v", places, err)
+	}
+}
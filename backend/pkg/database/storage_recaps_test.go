@@ -0,0 +1,73 @@
+package database
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func TestRecapsStoredPerFamilyAndPeriod(t *testing.T) {
+	s, fam := newTagStorage(t)
+	other, err := s.CreateFamily("other")
+	if err != nil {
+		t.Fatalf("create family: %v", err)
+	}
+
+	recaps := []*models.Recap{
+		{FamilyID: fam.ID, Period: models.RecapPeriodWeek, StartDate: "2024-03-04", EndDate: "2024-03-10", Summary: "w1"},
+		{FamilyID: fam.ID, Period: models.RecapPeriodWeek, StartDate: "2024-03-11", EndDate: "2024-03-17", Summary: "w2"},
+		{FamilyID: fam.ID, Period: models.RecapPeriodMonth, StartDate: "2024-03-01", EndDate: "2024-03-31", Summary: "m"},
+		{FamilyID: other.ID, Period: models.RecapPeriodWeek, StartDate: "2024-03-04", EndDate: "2024-03-10", Summary: "x"},
+	}
+	for _, r := range recaps {
+		if err := s.CreateRecap(r); err != nil {
+			t.Fatalf("CreateRecap: %v", err)
+		}
+	}
+
+	dup := &models.Recap{FamilyID: fam.ID, Period: models.RecapPeriodWeek, StartDate: "2024-03-04", EndDate: "2024-03-10"}
+	if err := s.CreateRecap(dup); err == nil {
+		t.Fatal("a second recap of the same period must be rejected")
+	}
+
+	got, err := s.GetRecap(fam.ID, models.RecapPeriodWeek, "2024-03-04")
+	if err != nil || got.Summary != "w1" {
+		t.Fatalf("GetRecap: %+v, %v", got, err)
+	}
+	if _, err := s.GetRecap(fam.ID, models.RecapPeriodMonth, "2024-02-01"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("missing recap: want ErrNotFound, got %v", err)
+	}
+
+	all, err := s.GetRecaps(fam.ID, "")
+	if err != nil {
+		t.Fatalf("GetRecaps: %v", err)
+	}
+	var summaries []string
+	for _, r := range all {
+		summaries = append(summaries, r.Summary)
+	}
+	if len(summaries) != 3 || summaries[0] != "w2" || summaries[1] != "w1" || summaries[2] != "m" {
+		t.Fatalf("want [w2 w1 m], got %v", summaries)
+	}
+
+	weeks, err := s.GetRecaps(fam.ID, models.RecapPeriodWeek)
+	if err != nil || len(weeks) != 2 {
+		t.Fatalf("weekly recaps: %d, %v", len(weeks), err)
+	}
+}
+
+func TestGetItemsDateRange(t *testing.T) {
+	s, fam := newTagStorage(t)
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-02-29", Title: "before"},
+		&models.Item{Date: "2024-03-01", Title: "first"},
+		&models.Item{Date: "2024-03-31", Title: "last"},
+		&models.Item{Date: "2024-04-01", Title: "after"},
+	)
+
+	items, total, err := s.GetItems(fam.ID, SearchParams{DateFrom: "2024-03-01", DateTo: "2024-03-31"})
+	if err != nil || total != 2 || items[0].Title != "last" || items[1].Title != "first" {
+		t.Fatalf("want [last first], got %d items (%v)", total, err)
+	}
+}
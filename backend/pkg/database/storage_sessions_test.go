@@ -0,0 +1,195 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/kin-core/authdb"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+// startSession issues a refresh token and records a session for it, the way
+// the login handlers do.
+func startSession(t *testing.T, s Storage, userID uuid.UUID, userAgent string) (*models.Session, *authdb.RefreshToken) {
+	t.Helper()
+	rt, err := authdb.CreateRefreshToken(s.GetDB(), userID, time.Hour)
+	if err != nil {
+		t.Fatalf("create refresh token: %v", err)
+	}
+	session := models.NewSession(userID, rt.ID, "access-"+rt.Token, userAgent, "192.0.2.1")
+	if err := s.CreateSession(session); err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+	return session, rt
+}
+
+func sessionIDs(t *testing.T, s Storage, userID uuid.UUID) []uuid.UUID {
+	t.Helper()
+	sessions, err := s.GetActiveSessions(userID)
+	if err != nil {
+		t.Fatalf("GetActiveSessions: %v", err)
+	}
+	ids := make([]uuid.UUID, len(sessions))
+	for i, session := range sessions {
+		ids[i] = session.ID
+	}
+	return ids
+}
+
+func TestSessionFollowsRotatedRefreshToken(t *testing.T) {
+	s, _ := newTagStorage(t)
+	userID := uuid.New()
+	session, rt := startSession(t, s, userID, "Firefox")
+
+	found, err := s.GetSessionByRefreshToken(rt.Token)
+	if err != nil || found.ID != session.ID {
+		t.Fatalf("lookup by refresh token: %+v, %v", found, err)
+	}
+	found, err = s.GetSessionByAccessToken("access-" + rt.Token)
+	if err != nil || found.ID != session.ID {
+		t.Fatalf("lookup by access token: %+v, %v", found, err)
+	}
+
+	newRT, err := authdb.RotateRefreshToken(s.GetDB(), rt.Token, time.Hour)
+	if err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	if err := s.RotateSession(session.ID, newRT.ID, "rotated-access", "Firefox 2", "198.51.100.7", time.Now()); err != nil {
+		t.Fatalf("RotateSession: %v", err)
+	}
+
+	sessions, err := s.GetActiveSessions(userID)
+	if err != nil || len(sessions) != 1 {
+		t.Fatalf("want the one rotated session, got %+v, %v", sessions, err)
+	}
+	if sessions[0].ID != session.ID || sessions[0].IPAddress != "198.51.100.7" || sessions[0].UserAgent != "Firefox 2" {
+		t.Fatalf("session metadata not updated: %+v", sessions[0])
+	}
+	if found, err := s.GetSessionByAccessToken("rotated-access"); err != nil || found.ID != session.ID {
+		t.Fatalf("lookup by rotated access token: %+v, %v", found, err)
+	}
+	if _, err := s.GetSessionByRefreshToken("unknown"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("unknown token: want ErrNotFound, got %v", err)
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	s, _ := newTagStorage(t)
+	userID := uuid.New()
+	first, firstRT := startSession(t, s, userID, "phone")
+	second, secondRT := startSession(t, s, userID, "laptop")
+
+	if err := s.RevokeSession(uuid.New(), first.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("revoking another user's session: want ErrNotFound, got %v", err)
+	}
+	if err := s.RevokeSession(userID, first.ID); err != nil {
+		t.Fatalf("RevokeSession: %v", err)
+	}
+
+	if ids := sessionIDs(t, s, userID); len(ids) != 1 || ids[0] != second.ID {
+		t.Fatalf("want only the second session left, got %v", ids)
+	}
+	if _, err := authdb.RotateRefreshToken(s.GetDB(), firstRT.Token, time.Hour); err == nil {
+		t.Fatal("a revoked session's refresh token must not rotate")
+	}
+	assertSessionTokenRevoked(t, s, "access-"+firstRT.Token, true)
+	assertSessionTokenRevoked(t, s, "access-"+secondRT.Token, false)
+}
+
+func assertSessionTokenRevoked(t *testing.T, s Storage, accessToken string, want bool) {
+	t.Helper()
+	revoked, err := s.IsSessionTokenRevoked(accessToken)
+	if err != nil {
+		t.Fatalf("IsSessionTokenRevoked: %v", err)
+	}
+	if revoked != want {
+		t.Fatalf("access token %q: revoked = %v, want %v", accessToken, revoked, want)
+	}
+}
+
+func TestIsSessionTokenRevokedCachesRevocations(t *testing.T) {
+	s, _ := newTagStorage(t)
+	assertSessionTokenRevoked(t, s, "access-token", false)
+
+	// A revocation written by another process is not seen until the cached
+	// copy is stale.
+	row := models.RevokedSessionToken{
+		AccessTokenHash: models.HashSessionToken("access-token"),
+		ExpiresAt:       time.Now().Add(time.Minute),
+	}
+	if err := s.GetDB().Create(&row).Error; err != nil {
+		t.Fatalf("create revocation: %v", err)
+	}
+	assertSessionTokenRevoked(t, s, "access-token", false)
+
+	s.(*storage).revokedTokens.loadedAt = time.Now().Add(-revokedTokensTTL)
+	assertSessionTokenRevoked(t, s, "access-token", true)
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	s, _ := newTagStorage(t)
+	userID := uuid.New()
+	keep, keepRT := startSession(t, s, userID, "current")
+	_, otherRT := startSession(t, s, userID, "other")
+	// A refresh token issued before sessions were tracked has no session row.
+	untracked, err := authdb.CreateRefreshToken(s.GetDB(), userID, time.Hour)
+	if err != nil {
+		t.Fatalf("create refresh token: %v", err)
+	}
+	stranger, strangerRT := startSession(t, s, uuid.New(), "someone else")
+
+	revoked, err := s.RevokeOtherSessions(userID, keep.ID)
+	if err != nil {
+		t.Fatalf("RevokeOtherSessions: %v", err)
+	}
+	if revoked != 2 {
+		t.Fatalf("want 2 revoked tokens, got %d", revoked)
+	}
+	if ids := sessionIDs(t, s, userID); len(ids) != 1 || ids[0] != keep.ID {
+		t.Fatalf("want only the kept session, got %v", ids)
+	}
+	if _, err := authdb.RotateRefreshToken(s.GetDB(), untracked.Token, time.Hour); err == nil {
+		t.Fatal("untracked refresh token must be revoked too")
+	}
+	if ids := sessionIDs(t, s, stranger.UserID); len(ids) != 1 {
+		t.Fatalf("other users' sessions must survive, got %v", ids)
+	}
+	assertSessionTokenRevoked(t, s, "access-"+otherRT.Token, true)
+	assertSessionTokenRevoked(t, s, "access-"+keepRT.Token, false)
+	assertSessionTokenRevoked(t, s, "access-"+strangerRT.Token, false)
+
+	// Without a current session everything is signed out.
+	if _, err := s.RevokeOtherSessions(userID, uuid.Nil); err != nil {
+		t.Fatalf("RevokeOtherSessions(nil): %v", err)
+	}
+	if ids := sessionIDs(t, s, userID); len(ids) != 0 {
+		t.Fatalf("want no sessions left, got %v", ids)
+	}
+}
+
+func TestDeleteInactiveSessions(t *testing.T) {
+	s, _ := newTagStorage(t)
+	userID := uuid.New()
+	active, _ := startSession(t, s, userID, "active")
+	_, revokedRT := startSession(t, s, userID, "revoked")
+	if err := authdb.RevokeRefreshToken(s.GetDB(), revokedRT.Token); err != nil {
+		t.Fatalf("revoke: %v", err)
+	}
+
+	if err := s.DeleteInactiveSessions(); err != nil {
+		t.Fatalf("DeleteInactiveSessions: %v", err)
+	}
+	var count int64
+	if err := s.GetDB().Model(&models.Session{}).Count(&count).Error; err != nil {
+		t.Fatalf("count: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("want only the active session kept, got %d rows", count)
+	}
+	if ids := sessionIDs(t, s, userID); len(ids) != 1 || ids[0] != active.ID {
+		t.Fatalf("active session lost: %v", ids)
+	}
+}
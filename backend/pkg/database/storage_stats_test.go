@@ -0,0 +1,90 @@
+package database
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func TestGetWritingStats(t *testing.T) {
+	s, fam := newTagStorage(t)
+	other, _ := s.CreateFamily("other")
+
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-01-30", Title: "a", Body: "one two three", Tags: []string{"work"}},
+		&models.Item{Date: "2024-01-31", Title: "b", Body: "four ![](a.jpg) ![](b.mp4)", Tags: []string{"work", "home"}},
+		&models.Item{Date: "2024-02-01", Title: "c", Body: "five six", Tags: []string{"home"}},
+		&models.Item{Date: "2024-02-03", Title: "d", Body: "seven `![](x.png)` \\![](y.png)"},
+		&models.Item{Date: "2024-02-04", Title: "e", Body: "![](c.png)", Tags: []string{"home"}},
+		&models.Item{Date: "2024-02-06", Title: "f", Body: "gone tomorrow"},
+	)
+	if err := s.DeleteItem(fam.ID, "2024-02-06"); err != nil {
+		t.Fatalf("DeleteItem: %v", err)
+	}
+	putItems(t, s, other.ID, &models.Item{Date: "2024-02-05", Title: "x", Body: "not ours at all"})
+
+	stats, err := s.GetWritingStats(fam.ID, "", "", "2024-02-05")
+	if err != nil {
+		t.Fatalf("GetWritingStats: %v", err)
+	}
+	if stats.Entries != 5 || stats.Words != 8 || stats.AssetRefs != 3 || stats.EntriesWithAssets != 2 {
+		t.Fatalf("totals got %+v", stats)
+	}
+	if stats.LongestStreak != (Streak{Days: 3, Start: "2024-01-30", End: "2024-02-01"}) {
+		t.Fatalf("longest streak got %+v", stats.LongestStreak)
+	}
+	// Not written today yet: the streak ending yesterday is still current.
+	if stats.CurrentStreak != (Streak{Days: 2, Start: "2024-02-03", End: "2024-02-04"}) {
+		t.Fatalf("current streak got %+v", stats.CurrentStreak)
+	}
+	if len(stats.Months) != 2 || stats.Months[0] != (MonthStat{Month: "2024-01", Entries: 2, Words: 4}) ||
+		stats.Months[1] != (MonthStat{Month: "2024-02", Entries: 3, Words: 4}) {
+		t.Fatalf("months got %+v", stats.Months)
+	}
+	wantTags := []TagMonthStat{
+		{Month: "2024-01", Tag: "work", Count: 2},
+		{Month: "2024-01", Tag: "home", Count: 1},
+		{Month: "2024-02", Tag: "home", Count: 2},
+	}
+	if len(stats.TagMonths) != len(wantTags) {
+		t.Fatalf("tag months got %+v", stats.TagMonths)
+	}
+	for i := range wantTags {
+		if stats.TagMonths[i] != wantTags[i] {
+			t.Fatalf("tag months got %+v", stats.TagMonths)
+		}
+	}
+
+	stats, err = s.GetWritingStats(fam.ID, "2024-02-01", "2024-02-03", "2024-02-07")
+	if err != nil || stats.Entries != 2 || stats.CurrentStreak.Days != 0 || stats.LongestStreak.Days != 1 ||
+		stats.LongestStreak.End != "2024-02-03" || len(stats.TagMonths) != 1 {
+		t.Fatalf("stats in range got %+v err %v", stats, err)
+	}
+
+	days, err := s.GetDayWordCounts(fam.ID, "2024-01-31", "2024-02-03")
+	if err != nil || len(days) != 3 || days[0] != (DayWords{Date: "2024-01-31", Words: 1}) || days[2].Words != 2 {
+		t.Fatalf("day word counts got %+v err %v", days, err)
+	}
+}
+
+func TestBackfillBodyCounts(t *testing.T) {
+	s, fam := newTagStorage(t)
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-05-01", Title: "a", Body: "three little words"},
+		&models.Item{Date: "2024-05-02", Title: "b", Body: "two words ![](a.jpg)"},
+	)
+	db := s.GetDB()
+	if err := db.Exec("UPDATE items SET word_count = -1, asset_count = -1").Error; err != nil {
+		t.Fatalf("reset word counts: %v", err)
+	}
+	if err := backfillBodyCounts(slog.Default(), db); err != nil {
+		t.Fatalf("backfillBodyCounts: %v", err)
+	}
+	for date, want := range map[string][2]int{"2024-05-01": {3, 0}, "2024-05-02": {2, 1}} {
+		item, _ := s.GetItem(fam.ID, date)
+		if item.WordCount != want[0] || item.AssetCount != want[1] {
+			t.Fatalf("%s: counts got %d words, %d assets; want %v", date, item.WordCount, item.AssetCount, want)
+		}
+	}
+}
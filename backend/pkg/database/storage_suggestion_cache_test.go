@@ -0,0 +1,47 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func TestSuggestionCacheTTLAndClear(t *testing.T) {
+	s, fam := newTagStorage(t)
+	other := uuid.New()
+	now := time.Now()
+
+	for _, e := range []*models.SuggestionCacheEntry{
+		{Key: "fresh", FamilyID: fam.ID, Suggestions: `[]`, CreatedAt: now},
+		{Key: "old", FamilyID: fam.ID, Suggestions: `[]`, CreatedAt: now.Add(-48 * time.Hour)},
+		{Key: "other", FamilyID: other, Suggestions: `[]`, CreatedAt: now},
+	} {
+		if err := s.PutSuggestionCache(e); err != nil {
+			t.Fatalf("PutSuggestionCache: %v", err)
+		}
+	}
+
+	dayAgo := now.Add(-24 * time.Hour)
+	if e, err := s.GetSuggestionCache("fresh", dayAgo); err != nil || e == nil || e.Suggestions != "[]" {
+		t.Fatalf("fresh entry = %+v, %v", e, err)
+	}
+	if e, err := s.GetSuggestionCache("old", dayAgo); err != nil || e != nil {
+		t.Fatalf("expired entry must read as a miss, got %+v, %v", e, err)
+	}
+	if e, err := s.GetSuggestionCache("missing", dayAgo); err != nil || e != nil {
+		t.Fatalf("missing entry = %+v, %v", e, err)
+	}
+
+	if n, err := s.ClearSuggestionCache(nil, dayAgo); err != nil || n != 1 {
+		t.Fatalf("clearing expired deleted %d, %v", n, err)
+	}
+	if n, err := s.ClearSuggestionCache(&fam.ID, time.Time{}); err != nil || n != 1 {
+		t.Fatalf("clearing family deleted %d, %v", n, err)
+	}
+	if e, _ := s.GetSuggestionCache("other", dayAgo); e == nil {
+		t.Fatal("another family's entry must survive")
+	}
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"errors"
 	"log/slog"
 	"os"
 	"sort"
@@ -130,7 +131,7 @@ func TestGetTagStats(t *testing.T) {
 		t.Fatalf("GetTagStats: %v", err)
 	}
 	// Sorted by count desc then name asc: family(3), travel(1), work(1).
-	want := []TagStat{{"family", 3}, {"travel", 1}, {"work", 1}}
+	want := []TagStat{{"family", 3, 3}, {"travel", 1, 1}, {"work", 1, 1}}
 	if len(stats) != len(want) {
 		t.Fatalf("got %v want %v", stats, want)
 	}
@@ -147,6 +148,83 @@ func TestGetTagStats(t *testing.T) {
 	}
 }
 
+func TestHierarchicalTags(t *testing.T) {
+	s, fam := newTagStorage(t)
+
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-01-01", Title: "a", Tags: models.StringList{"travel/italy/rome", "travel/italy/milan"}},
+		&models.Item{Date: "2024-01-02", Title: "b", Tags: models.StringList{"travel/france", "travel"}},
+		&models.Item{Date: "2024-01-03", Title: "c", Tags: models.StringList{"travels", "work"}},
+	)
+
+	// Counts roll up, each entry counted once per ancestor.
+	stats, err := s.GetTagStats(fam.ID)
+	if err != nil {
+		t.Fatalf("GetTagStats: %v", err)
+	}
+	got := map[string]TagStat{}
+	for _, st := range stats {
+		got[st.Name] = st
+	}
+	for _, want := range []TagStat{
+		{"travel", 2, 1}, {"travel/italy", 1, 0}, {"travel/italy/rome", 1, 1}, {"travel/france", 1, 1}, {"travels", 1, 1},
+	} {
+		if got[want.Name] != want {
+			t.Fatalf("stat %s = %+v, want %+v", want.Name, got[want.Name], want)
+		}
+	}
+
+	// A filter matches descendants by whole levels only.
+	dates := func(tags ...string) []string {
+		items, _, err := s.GetItems(fam.ID, SearchParams{Tags: tags})
+		if err != nil {
+			t.Fatalf("GetItems: %v", err)
+		}
+		var out []string
+		for _, it := range items {
+			out = append(out, it.Date)
+		}
+		return out
+	}
+	if got := dates("travel"); !equalTags(got, []string{"2024-01-02", "2024-01-01"}) {
+		t.Fatalf("travel matched %v", got)
+	}
+	if got := dates("travel/italy"); !equalTags(got, []string{"2024-01-01"}) {
+		t.Fatalf("travel/italy matched %v", got)
+	}
+	if got := dates("work"); !equalTags(got, []string{"2024-01-03"}) {
+		t.Fatalf("flat tag matched %v", got)
+	}
+
+	// Renaming a subtree moves descendants; without subtree only the tag itself.
+	if err := s.RenameTag(fam.ID, "travel/italy", "trips/italy", true); err != nil {
+		t.Fatalf("RenameTag subtree: %v", err)
+	}
+	if got := tagsOf(t, s, fam.ID, "2024-01-01"); !equalTags(got, []string{"trips/italy/rome", "trips/italy/milan"}) {
+		t.Fatalf("01-01 after subtree rename got %v", got)
+	}
+	if err := s.RenameTag(fam.ID, "travel", "trips", false); err != nil {
+		t.Fatalf("RenameTag: %v", err)
+	}
+	if got := tagsOf(t, s, fam.ID, "2024-01-02"); !equalTags(got, []string{"travel/france", "trips"}) {
+		t.Fatalf("01-02 after flat rename got %v", got)
+	}
+
+	// Deleting a subtree removes descendants but not look-alikes.
+	if err := s.DeleteTag(fam.ID, "trips", true); err != nil {
+		t.Fatalf("DeleteTag subtree: %v", err)
+	}
+	if got := tagsOf(t, s, fam.ID, "2024-01-01"); len(got) != 0 {
+		t.Fatalf("01-01 after subtree delete got %v", got)
+	}
+	if got := tagsOf(t, s, fam.ID, "2024-01-02"); !equalTags(got, []string{"travel/france"}) {
+		t.Fatalf("01-02 after subtree delete got %v", got)
+	}
+	if got := tagsOf(t, s, fam.ID, "2024-01-03"); !equalTags(got, []string{"travels", "work"}) {
+		t.Fatalf("01-03 after subtree delete got %v", got)
+	}
+}
+
 func TestRenameTag(t *testing.T) {
 	s, fam := newTagStorage(t)
 
@@ -158,7 +236,7 @@ func TestRenameTag(t *testing.T) {
 		&models.Item{Date: "2024-01-04", Title: "d", Tags: models.StringList{"unrelated"}},
 	)
 
-	if err := s.RenameTag(fam.ID, "vacaiton", "vacation"); err != nil {
+	if err := s.RenameTag(fam.ID, "vacaiton", "vacation", false); err != nil {
 		t.Fatalf("RenameTag: %v", err)
 	}
 
@@ -178,7 +256,7 @@ func TestRenameTag(t *testing.T) {
 	}
 
 	// Renaming a non-existent tag changes nothing (no error).
-	if err := s.RenameTag(fam.ID, "ghost", "spirit"); err != nil {
+	if err := s.RenameTag(fam.ID, "ghost", "spirit", false); err != nil {
 		t.Fatalf("RenameTag non-existent: %v", err)
 	}
 	if got := tagsOf(t, s, fam.ID, "2024-01-04"); !equalTags(got, []string{"unrelated"}) {
@@ -188,7 +266,7 @@ func TestRenameTag(t *testing.T) {
 	// Family scoping: another family with the same tag is untouched.
 	other, _ := s.CreateFamily("other")
 	putItems(t, s, other.ID, &models.Item{Date: "2024-01-01", Title: "x", Tags: models.StringList{"vacation"}})
-	if err := s.RenameTag(fam.ID, "vacation", "holiday"); err != nil {
+	if err := s.RenameTag(fam.ID, "vacation", "holiday", false); err != nil {
 		t.Fatalf("RenameTag: %v", err)
 	}
 	if got := tagsOf(t, s, other.ID, "2024-01-01"); !equalTags(got, []string{"vacation"}) {
@@ -196,6 +274,47 @@ func TestRenameTag(t *testing.T) {
 	}
 }
 
+func TestMergeTags(t *testing.T) {
+	s, fam := newTagStorage(t)
+
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-01-01", Title: "a", Tags: models.StringList{"Runs", "running", "work"}},
+		&models.Item{Date: "2024-01-02", Title: "b", Tags: models.StringList{"run", "road-trip"}},
+		&models.Item{Date: "2024-01-03", Title: "c", Tags: models.StringList{"Road trip"}},
+		&models.Item{Date: "2024-01-04", Title: "d", Tags: models.StringList{"unrelated"}},
+	)
+	before, err := s.GetChangesSince(fam.ID, 0, 100)
+	if err != nil {
+		t.Fatalf("GetChangesSince: %v", err)
+	}
+
+	n, err := s.MergeTags(fam.ID, map[string]string{
+		"Runs":      "run",
+		"running":   "run",
+		"Road trip": "road-trip",
+		" ":         "blank",
+		"same":      "same",
+	})
+	if err != nil || n != 2 {
+		t.Fatalf("MergeTags = %d, %v; want 01-01 and 01-03 changed", n, err)
+	}
+	if got := tagsOf(t, s, fam.ID, "2024-01-01"); !equalTags(got, []string{"run", "work"}) {
+		t.Fatalf("01-01 got %v", got)
+	}
+	if got := tagsOf(t, s, fam.ID, "2024-01-02"); !equalTags(got, []string{"run", "road-trip"}) {
+		t.Fatalf("01-02 got %v", got)
+	}
+	if got := tagsOf(t, s, fam.ID, "2024-01-03"); !equalTags(got, []string{"road-trip"}) {
+		t.Fatalf("01-03 got %v", got)
+	}
+
+	// One change record per entry touched, however many renames hit it.
+	after, _ := s.GetChangesSince(fam.ID, 0, 100)
+	if got := len(after) - len(before); got != 2 {
+		t.Fatalf("want one change record each for 01-01 and 01-03, got %d", got)
+	}
+}
+
 func TestDeleteTag(t *testing.T) {
 	s, fam := newTagStorage(t)
 
@@ -205,7 +324,7 @@ func TestDeleteTag(t *testing.T) {
 		&models.Item{Date: "2024-01-03", Title: "c", Tags: models.StringList{"work"}},
 	)
 
-	if err := s.DeleteTag(fam.ID, "misc"); err != nil {
+	if err := s.DeleteTag(fam.ID, "misc", false); err != nil {
 		t.Fatalf("DeleteTag: %v", err)
 	}
 	if got := tagsOf(t, s, fam.ID, "2024-01-01"); !equalTags(got, []string{"work"}) {
@@ -220,11 +339,103 @@ func TestDeleteTag(t *testing.T) {
 	}
 
 	// Deleting a non-existent tag is a no-op.
-	if err := s.DeleteTag(fam.ID, "ghost"); err != nil {
+	if err := s.DeleteTag(fam.ID, "ghost", false); err != nil {
 		t.Fatalf("DeleteTag non-existent: %v", err)
 	}
 }
 
+func TestTagMetadata(t *testing.T) {
+	s, fam := newTagStorage(t)
+
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-01-01", Title: "a", Tags: models.StringList{"NYC", "work"}},
+	)
+	setAliases := func(name string, aliases ...string) error {
+		_, err := s.UpdateTagMetadata(fam.ID, name, func(tag *models.Tag) {
+			tag.Color = "#ff0000"
+			tag.Aliases = aliases
+		})
+		return err
+	}
+
+	// Adding an alias re-tags the entries already using it.
+	if err := setAliases("travel/new-york", "nyc", "big apple"); err != nil {
+		t.Fatalf("UpdateTagMetadata: %v", err)
+	}
+	if got := tagsOf(t, s, fam.ID, "2024-01-01"); !equalTags(got, []string{"travel/new-york", "work"}) {
+		t.Fatalf("alias in use not re-tagged: %v", got)
+	}
+
+	// Aliases resolve on save, ignoring case, without duplicating the tag.
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-01-02", Title: "b", Tags: models.StringList{"Big Apple", "travel/new-york"}},
+	)
+	if got := tagsOf(t, s, fam.ID, "2024-01-02"); !equalTags(got, []string{"travel/new-york"}) {
+		t.Fatalf("PutItem kept alias: %v", got)
+	}
+	if err := s.AddConfirmedTags(fam.ID, "2024-01-01", []string{"nyc", "food"}); err != nil {
+		t.Fatalf("AddConfirmedTags: %v", err)
+	}
+	if got := tagsOf(t, s, fam.ID, "2024-01-01"); !equalTags(got, []string{"travel/new-york", "work", "food"}) {
+		t.Fatalf("AddConfirmedTags kept alias: %v", got)
+	}
+
+	// A name or alias of another tag is a conflict and changes nothing.
+	if err := setAliases("city", "NYC"); !errors.Is(err, ErrTagConflict) {
+		t.Fatalf("alias conflict err = %v", err)
+	}
+	if err := setAliases("nyc"); !errors.Is(err, ErrTagConflict) {
+		t.Fatalf("name conflict err = %v", err)
+	}
+
+	// Metadata follows a subtree rename; merging keeps the target's colour.
+	if _, err := s.UpdateTagMetadata(fam.ID, "trips", func(tag *models.Tag) {
+		tag.Color = "#00ff00"
+		tag.Aliases = models.StringList{"journeys"}
+	}); err != nil {
+		t.Fatalf("UpdateTagMetadata: %v", err)
+	}
+	if _, err := s.UpdateTagMetadata(fam.ID, "travel", func(tag *models.Tag) {
+		tag.Color = "#0000ff"
+		tag.Description = "Away from home"
+	}); err != nil {
+		t.Fatalf("UpdateTagMetadata: %v", err)
+	}
+	if err := s.RenameTag(fam.ID, "travel", "trips", true); err != nil {
+		t.Fatalf("RenameTag: %v", err)
+	}
+	meta, err := s.GetTagMetadata(fam.ID)
+	if err != nil {
+		t.Fatalf("GetTagMetadata: %v", err)
+	}
+	if len(meta) != 2 || meta[0].Name != "trips" || meta[1].Name != "trips/new-york" {
+		t.Fatalf("metadata after rename = %+v", meta)
+	}
+	if meta[0].Color != "#00ff00" || meta[0].Description != "Away from home" ||
+		!equalTags(meta[0].Aliases, []string{"journeys"}) {
+		t.Fatalf("merged metadata = %+v", meta[0])
+	}
+	if !equalTags(meta[1].Aliases, []string{"nyc", "big apple"}) {
+		t.Fatalf("moved metadata = %+v", meta[1])
+	}
+
+	// Clearing every field drops the row; deleting a subtree drops its rows.
+	if _, err := s.UpdateTagMetadata(fam.ID, "trips", func(tag *models.Tag) {
+		*tag = models.Tag{ID: tag.ID, FamilyID: tag.FamilyID, Name: tag.Name}
+	}); err != nil {
+		t.Fatalf("UpdateTagMetadata: %v", err)
+	}
+	if meta, _ := s.GetTagMetadata(fam.ID); len(meta) != 1 {
+		t.Fatalf("cleared metadata kept: %+v", meta)
+	}
+	if err := s.DeleteTag(fam.ID, "trips", true); err != nil {
+		t.Fatalf("DeleteTag: %v", err)
+	}
+	if meta, _ := s.GetTagMetadata(fam.ID); len(meta) != 0 {
+		t.Fatalf("metadata after subtree delete: %+v", meta)
+	}
+}
+
 // TestGetItemsTagFilterToleratesMalformedTags reproduces the browse-by-tag 500:
 // a legacy row whose tags column holds a non-JSON value (e.g. an empty string)
 // must not break a tag-only filter. The JSON_EXTRACT-based query raised
@@ -243,6 +454,11 @@ func TestGetItemsTagFilterToleratesMalformedTags(t *testing.T) {
 	).Error; err != nil {
 		t.Fatalf("force empty tags: %v", err)
 	}
+	// Startup rebuilds the tag index from the column, as it would after such
+	// data was written by an older version.
+	if err := rebuildItemTags(slog.Default(), s.GetDB()); err != nil {
+		t.Fatalf("rebuild item tags: %v", err)
+	}
 
 	// A tag-only filter (no date, no search) must not error and must find the
 	// well-formed tagged row.
@@ -306,6 +522,71 @@ func TestGetItemsCombinedTextAndTagFilter(t *testing.T) {
 	}
 }
 
+// TestItemTagIndex verifies that the item_tags index follows every write that
+// changes an entry's tags, and that tag filters ignore case.
+func TestItemTagIndex(t *testing.T) {
+	s, fam := newTagStorage(t)
+
+	putItems(t, s, fam.ID,
+		&models.Item{Date: "2024-07-01", Title: "a", Tags: models.StringList{"Travel/Italy", "work"}},
+		&models.Item{Date: "2024-07-02", Title: "b", Tags: models.StringList{"work"}},
+	)
+	datesTagged := func(tags ...string) []string {
+		t.Helper()
+		items, _, err := s.GetItems(fam.ID, SearchParams{Tags: tags})
+		if err != nil {
+			t.Fatalf("GetItems %v: %v", tags, err)
+		}
+		out := make([]string, len(items))
+		for i, it := range items {
+			out[i] = it.Date
+		}
+		sort.Strings(out)
+		return out
+	}
+	distinct := func() []string {
+		t.Helper()
+		tags, err := s.GetDistinctTags(fam.ID)
+		if err != nil {
+			t.Fatalf("GetDistinctTags: %v", err)
+		}
+		return tags
+	}
+
+	if got := datesTagged("travel"); !equalTags(got, []string{"2024-07-01"}) {
+		t.Fatalf("case-insensitive subtree filter got %v", got)
+	}
+	if got := datesTagged("WORK"); !equalTags(got, []string{"2024-07-01", "2024-07-02"}) {
+		t.Fatalf("case-insensitive filter got %v", got)
+	}
+
+	// Re-saving replaces the entry's rows.
+	putItems(t, s, fam.ID, &models.Item{Date: "2024-07-02", Title: "b", Tags: models.StringList{"home"}})
+	if got := datesTagged("work"); !equalTags(got, []string{"2024-07-01"}) {
+		t.Fatalf("after re-save got %v", got)
+	}
+	if err := s.AddConfirmedTags(fam.ID, "2024-07-02", []string{"garden"}); err != nil {
+		t.Fatalf("AddConfirmedTags: %v", err)
+	}
+	if got := datesTagged("garden"); !equalTags(got, []string{"2024-07-02"}) {
+		t.Fatalf("after AddConfirmedTags got %v", got)
+	}
+
+	if err := s.RenameTag(fam.ID, "Travel", "trips", true); err != nil {
+		t.Fatalf("RenameTag: %v", err)
+	}
+	if got := distinct(); !equalTags(got, []string{"garden", "home", "trips/Italy", "work"}) {
+		t.Fatalf("after rename got %v", got)
+	}
+
+	if err := s.DeleteItem(fam.ID, "2024-07-01"); err != nil {
+		t.Fatalf("DeleteItem: %v", err)
+	}
+	if got := distinct(); !equalTags(got, []string{"garden", "home"}) {
+		t.Fatalf("after delete got %v", got)
+	}
+}
+
 func equalTags(got, want []string) bool {
 	if len(got) != len(want) {
 		return false
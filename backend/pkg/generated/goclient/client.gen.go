@@ -22,6 +22,129 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AIJobKind.
+const (
+	TagDay AIJobKind = "tag_day"
+)
+
+// Valid indicates whether the value is a known member of the AIJobKind enum.
+func (e AIJobKind) Valid() bool {
+	switch e {
+	case TagDay:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AIJobStatus.
+const (
+	Done    AIJobStatus = "done"
+	Failed  AIJobStatus = "failed"
+	Queued  AIJobStatus = "queued"
+	Running AIJobStatus = "running"
+)
+
+// Valid indicates whether the value is a known member of the AIJobStatus enum.
+func (e AIJobStatus) Valid() bool {
+	switch e {
+	case Done:
+		return true
+	case Failed:
+		return true
+	case Queued:
+		return true
+	case Running:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AccessTokenScope.
+const (
+	AssetsWrite AccessTokenScope = "assets:write"
+	ItemsRead   AccessTokenScope = "items:read"
+	ItemsWrite  AccessTokenScope = "items:write"
+)
+
+// Valid indicates whether the value is a known member of the AccessTokenScope enum.
+func (e AccessTokenScope) Valid() bool {
+	switch e {
+	case AssetsWrite:
+		return true
+	case ItemsRead:
+		return true
+	case ItemsWrite:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CustomFieldType.
+const (
+	Boolean CustomFieldType = "boolean"
+	Enum    CustomFieldType = "enum"
+	Number  CustomFieldType = "number"
+	Scale   CustomFieldType = "scale"
+	Text    CustomFieldType = "text"
+)
+
+// Valid indicates whether the value is a known member of the CustomFieldType enum.
+func (e CustomFieldType) Valid() bool {
+	switch e {
+	case Boolean:
+		return true
+	case Enum:
+		return true
+	case Number:
+		return true
+	case Scale:
+		return true
+	case Text:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PlaceSource.
+const (
+	Manual PlaceSource = "manual"
+	Photo  PlaceSource = "photo"
+)
+
+// Valid indicates whether the value is a known member of the PlaceSource enum.
+func (e PlaceSource) Valid() bool {
+	switch e {
+	case Manual:
+		return true
+	case Photo:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RecapPeriod.
+const (
+	RecapPeriodMonth RecapPeriod = "month"
+	RecapPeriodWeek  RecapPeriod = "week"
+)
+
+// Valid indicates whether the value is a known member of the RecapPeriod enum.
+func (e RecapPeriod) Valid() bool {
+	switch e {
+	case RecapPeriodMonth:
+		return true
+	case RecapPeriodWeek:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for SyncChangeResponseOperationType.
 const (
 	Created SyncChangeResponseOperationType = "created"
@@ -43,6 +166,199 @@ func (e SyncChangeResponseOperationType) Valid() bool {
 	}
 }
 
+// Defines values for TagClusterReasons.
+const (
+	Ai   TagClusterReasons = "ai"
+	Case TagClusterReasons = "case"
+	Edit TagClusterReasons = "edit"
+	Stem TagClusterReasons = "stem"
+)
+
+// Valid indicates whether the value is a known member of the TagClusterReasons enum.
+func (e TagClusterReasons) Valid() bool {
+	switch e {
+	case Ai:
+		return true
+	case Case:
+		return true
+	case Edit:
+		return true
+	case Stem:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for WeatherCondition.
+const (
+	Clear        WeatherCondition = "clear"
+	Cloudy       WeatherCondition = "cloudy"
+	Drizzle      WeatherCondition = "drizzle"
+	Fog          WeatherCondition = "fog"
+	PartlyCloudy WeatherCondition = "partly-cloudy"
+	Rain         WeatherCondition = "rain"
+	Showers      WeatherCondition = "showers"
+	Snow         WeatherCondition = "snow"
+	Thunderstorm WeatherCondition = "thunderstorm"
+)
+
+// Valid indicates whether the value is a known member of the WeatherCondition enum.
+func (e WeatherCondition) Valid() bool {
+	switch e {
+	case Clear:
+		return true
+	case Cloudy:
+		return true
+	case Drizzle:
+		return true
+	case Fog:
+		return true
+	case PartlyCloudy:
+		return true
+	case Rain:
+		return true
+	case Showers:
+		return true
+	case Snow:
+		return true
+	case Thunderstorm:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetRecapsParamsPeriod.
+const (
+	GetRecapsParamsPeriodMonth GetRecapsParamsPeriod = "month"
+	GetRecapsParamsPeriodWeek  GetRecapsParamsPeriod = "week"
+)
+
+// Valid indicates whether the value is a known member of the GetRecapsParamsPeriod enum.
+func (e GetRecapsParamsPeriod) Valid() bool {
+	switch e {
+	case GetRecapsParamsPeriodMonth:
+		return true
+	case GetRecapsParamsPeriodWeek:
+		return true
+	default:
+		return false
+	}
+}
+
+// AIJob defines model for AIJob.
+type AIJob struct {
+	// Attempts number of times the job was started
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Date day the job works on
+	Date       openapi_types.Date `json:"date"`
+	FinishedAt *time.Time         `json:"finishedAt,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// Kind tag_day: suggest tags for one day's entry (the AI tagging backfill)
+	Kind AIJobKind `json:"kind"`
+
+	// LastError error of the last failed attempt
+	LastError *string `json:"lastError,omitempty"`
+
+	// RunAfter earliest time a queued job runs (later than createdAt when retrying)
+	RunAfter time.Time   `json:"runAfter"`
+	Status   AIJobStatus `json:"status"`
+}
+
+// AIJobKind tag_day: suggest tags for one day's entry (the AI tagging backfill)
+type AIJobKind string
+
+// AIJobStatus defines model for AIJob.Status.
+type AIJobStatus string
+
+// AIUsageMonth defines model for AIUsageMonth.
+type AIUsageMonth struct {
+	// Images Images and video frames sent to the model
+	Images int64 `json:"images"`
+
+	// InputTokens Prompt tokens reported by the provider
+	InputTokens int64 `json:"inputTokens"`
+
+	// Month Calendar month (UTC) in YYYY-MM format
+	Month string `json:"month"`
+
+	// OutputTokens Response tokens reported by the provider
+	OutputTokens int64 `json:"outputTokens"`
+
+	// Requests Number of answered model calls
+	Requests int64 `json:"requests"`
+}
+
+// AIUsageResponse defines model for AIUsageResponse.
+type AIUsageResponse struct {
+	// BudgetExhausted True when the current month's budget is spent and the family's model calls are refused
+	BudgetExhausted bool `json:"budgetExhausted"`
+
+	// Month Current calendar month (UTC) in YYYY-MM format
+	Month string `json:"month"`
+
+	// MonthlyTokenBudget Tokens (input + output) the family may spend per month; 0 means unlimited
+	MonthlyTokenBudget int64 `json:"monthlyTokenBudget"`
+
+	// Months Usage per month, newest first
+	Months []AIUsageMonth `json:"months"`
+}
+
+// AccessToken defines model for AccessToken.
+type AccessToken struct {
+	CreatedAt  time.Time          `json:"createdAt"`
+	ExpiresAt  *time.Time         `json:"expiresAt,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+	LastUsedAt *time.Time         `json:"lastUsedAt,omitempty"`
+	Name       string             `json:"name"`
+
+	// Prefix leading characters of the secret, for recognising a token
+	Prefix string             `json:"prefix"`
+	Scopes []AccessTokenScope `json:"scopes"`
+}
+
+// AccessTokenScope defines model for AccessTokenScope.
+type AccessTokenScope string
+
+// AskCitation defines model for AskCitation.
+type AskCitation struct {
+	Date  openapi_types.Date `json:"date"`
+	Title string             `json:"title"`
+}
+
+// AskRequest defines model for AskRequest.
+type AskRequest struct {
+	Question string `json:"question"`
+}
+
+// AskResponse defines model for AskResponse.
+type AskResponse struct {
+	// Answer the answer; empty when no relevant entries were found
+	Answer string `json:"answer"`
+
+	// Citations entries the answer refers to, in order of first mention
+	Citations []AskCitation `json:"citations"`
+}
+
+// AssetInfo defines model for AssetInfo.
+type AssetInfo struct {
+	// Caption AI-written description of the image; absent until the caption task has described it
+	Caption *string `json:"caption,omitempty"`
+
+	// Filename File name in the family's asset directory (the markdown image path)
+	Filename string `json:"filename"`
+
+	// ModifiedAt Last modification time of the file
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	// Size File size in bytes
+	Size int64 `json:"size"`
+}
+
 // AssetsBatchFile defines model for AssetsBatchFile.
 type AssetsBatchFile struct {
 	// ContentType MIME type detected for the file
@@ -71,12 +387,166 @@ type AttachOrphanRequest struct {
 	Date string `json:"date"`
 }
 
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	// Action e.g. login.succeeded, login.failed, tag.renamed, orphan.deleted
+	Action string `json:"action"`
+
+	// Actor username that performed the action, empty if unknown
+	Actor     string `json:"actor"`
+	Id        int32  `json:"id"`
+	IpAddress string `json:"ipAddress"`
+
+	// Target what the action applied to (tag, file, checks, login method)
+	Target    string    `json:"target"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// AuditLogResponse defines model for AuditLogResponse.
+type AuditLogResponse struct {
+	Events []AuditEvent `json:"events"`
+
+	// HasMore whether older events are available
+	HasMore bool `json:"hasMore"`
+
+	// NextBefore value for the before parameter of the next page (if hasMore is true)
+	NextBefore *int32 `json:"nextBefore,omitempty"`
+}
+
 // AuthData defines model for AuthData.
 type AuthData struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
+// Backlink defines model for Backlink.
+type Backlink struct {
+	// Date date of the linking entry
+	Date openapi_types.Date `json:"date"`
+
+	// Snippet the line of the linking entry's body holding the link
+	Snippet string `json:"snippet"`
+	Title   string `json:"title"`
+}
+
+// BacklinksResponse defines model for BacklinksResponse.
+type BacklinksResponse struct {
+	Backlinks []Backlink `json:"backlinks"`
+}
+
+// CreateAccessTokenRequest defines model for CreateAccessTokenRequest.
+type CreateAccessTokenRequest struct {
+	// ExpiresAt optional expiry; tokens without one live until revoked
+	ExpiresAt *time.Time         `json:"expiresAt,omitempty"`
+	Name      string             `json:"name"`
+	Scopes    []AccessTokenScope `json:"scopes"`
+}
+
+// CreatedAccessToken defines model for CreatedAccessToken.
+type CreatedAccessToken struct {
+	// Secret the bearer value; store it now, it cannot be retrieved later
+	Secret string      `json:"secret"`
+	Token  AccessToken `json:"token"`
+}
+
+// CustomField defines model for CustomField.
+type CustomField struct {
+	// Key Names the field in entries, filters and stats; never changes
+	Key  string   `json:"key"`
+	Max  *float64 `json:"max,omitempty"`
+	Min  *float64 `json:"min,omitempty"`
+	Name string   `json:"name"`
+
+	// Options Allowed values of an enum field
+	Options []string `json:"options"`
+
+	// Position Display order
+	Position int `json:"position"`
+
+	// Type number: any number; scale: a whole number from min to max (1–5 unless set); boolean: true or false; text: free text; enum: one of options
+	Type CustomFieldType `json:"type"`
+	Unit *string         `json:"unit,omitempty"`
+}
+
+// CustomFieldCreateRequest defines model for CustomFieldCreateRequest.
+type CustomFieldCreateRequest struct {
+	Key  string   `json:"key"`
+	Max  *float64 `json:"max,omitempty"`
+	Min  *float64 `json:"min,omitempty"`
+	Name string   `json:"name"`
+
+	// Options Allowed values; required for enum fields
+	Options  *[]string `json:"options,omitempty"`
+	Position *int      `json:"position,omitempty"`
+
+	// Type number: any number; scale: a whole number from min to max (1–5 unless set); boolean: true or false; text: free text; enum: one of options
+	Type CustomFieldType `json:"type"`
+	Unit *string         `json:"unit,omitempty"`
+}
+
+// CustomFieldMonthStat defines model for CustomFieldMonthStat.
+type CustomFieldMonthStat struct {
+	// Avg Average value (numeric fields only)
+	Avg   *float64 `json:"avg,omitempty"`
+	Count int      `json:"count"`
+	Month string   `json:"month"`
+}
+
+// CustomFieldStats defines model for CustomFieldStats.
+type CustomFieldStats struct {
+	Avg *float64 `json:"avg,omitempty"`
+
+	// Count Number of entries with a value
+	Count int      `json:"count"`
+	Key   string   `json:"key"`
+	Max   *float64 `json:"max,omitempty"`
+
+	// Min Numeric fields only; booleans count as 1 and 0
+	Min *float64 `json:"min,omitempty"`
+
+	// Months Entries with a value per month, oldest first
+	Months []CustomFieldMonthStat `json:"months"`
+	Sum    *float64               `json:"sum,omitempty"`
+
+	// Type number: any number; scale: a whole number from min to max (1–5 unless set); boolean: true or false; text: free text; enum: one of options
+	Type CustomFieldType `json:"type"`
+
+	// Values Entries per value, most frequent first (scale, boolean and enum fields; empty otherwise)
+	Values []CustomFieldValueCount `json:"values"`
+}
+
+// CustomFieldType number: any number; scale: a whole number from min to max (1–5 unless set); boolean: true or false; text: free text; enum: one of options
+type CustomFieldType string
+
+// CustomFieldUpdateRequest defines model for CustomFieldUpdateRequest.
+type CustomFieldUpdateRequest struct {
+	Max      *float64  `json:"max,omitempty"`
+	Min      *float64  `json:"min,omitempty"`
+	Name     *string   `json:"name,omitempty"`
+	Options  *[]string `json:"options,omitempty"`
+	Position *int      `json:"position,omitempty"`
+
+	// Unit Empty clears the unit
+	Unit *string `json:"unit,omitempty"`
+}
+
+// CustomFieldValueCount defines model for CustomFieldValueCount.
+type CustomFieldValueCount struct {
+	Count int    `json:"count"`
+	Value string `json:"value"`
+}
+
+// CustomFieldsResponse defines model for CustomFieldsResponse.
+type CustomFieldsResponse struct {
+	Fields []CustomField `json:"fields"`
+}
+
+// DayWordCount defines model for DayWordCount.
+type DayWordCount struct {
+	Date  openapi_types.Date `json:"date"`
+	Words int                `json:"words"`
+}
+
 // DismissTagRequest defines model for DismissTagRequest.
 type DismissTagRequest struct {
 	Date openapi_types.Date `json:"date"`
@@ -95,32 +565,66 @@ type FamilyMember struct {
 
 // FamilyResponse defines model for FamilyResponse.
 type FamilyResponse struct {
+	// AiCaptionsEnabled Enable AI image captions (referenced image assets are sent to the model)
+	AiCaptionsEnabled *bool `json:"aiCaptionsEnabled,omitempty"`
+
+	// AiSearchEnabled Enable semantic search (entry text is sent to the embedding provider)
+	AiSearchEnabled *bool `json:"aiSearchEnabled,omitempty"`
+
+	// AiSummariesEnabled Enable AI entry summaries, the scheduled weekly/monthly recaps and questions about the diary
+	AiSummariesEnabled *bool `json:"aiSummariesEnabled,omitempty"`
+
 	// AiTaggingAuto Auto-apply confident suggestions to untagged days on unattended triggers
 	AiTaggingAuto *bool `json:"aiTaggingAuto,omitempty"`
 
 	// AiTaggingBackfill Enable the background untagged-days backfill check
 	AiTaggingBackfill *bool `json:"aiTaggingBackfill,omitempty"`
 
+	// AiTaggingClosedVocabulary Only suggest tags the family already uses; never coin new ones
+	AiTaggingClosedVocabulary *bool `json:"aiTaggingClosedVocabulary,omitempty"`
+
 	// AiTaggingEnabled Per-family master switch for AI tag suggestion
 	AiTaggingEnabled *bool `json:"aiTaggingEnabled,omitempty"`
 
+	// AiTaggingInstructions The family's own guidance for tag suggestion, added to the prompt
+	AiTaggingInstructions *string `json:"aiTaggingInstructions,omitempty"`
+
+	// AiTaggingMaxTags Maximum number of tags suggested per entry
+	AiTaggingMaxTags *int `json:"aiTaggingMaxTags,omitempty"`
+
 	// AiTaggingUseImages Include referenced image assets in tag suggestion requests (images are sent to Gemini)
 	AiTaggingUseImages *bool `json:"aiTaggingUseImages,omitempty"`
 
 	// AiTaggingUseVideo Include keyframes extracted from referenced video assets in tag suggestion requests (frames are sent to Gemini; requires ffmpeg)
-	AiTaggingUseVideo *bool              `json:"aiTaggingUseVideo,omitempty"`
-	Id                openapi_types.UUID `json:"id"`
-	Members           []FamilyMember     `json:"members"`
-	Name              string             `json:"name"`
+	AiTaggingUseVideo *bool `json:"aiTaggingUseVideo,omitempty"`
+
+	// AiTranscriptionEnabled Enable voice memo transcription (memos sent for transcription go to the transcription backend)
+	AiTranscriptionEnabled *bool              `json:"aiTranscriptionEnabled,omitempty"`
+	Id                     openapi_types.UUID `json:"id"`
+	Members                []FamilyMember     `json:"members"`
+
+	// MemoriesDigestEnabled Send the daily "on this day" memories digest through the server's notifier
+	MemoriesDigestEnabled *bool  `json:"memoriesDigestEnabled,omitempty"`
+	Name                  string `json:"name"`
 }
 
 // FamilySettingsRequest defines model for FamilySettingsRequest.
 type FamilySettingsRequest struct {
-	AiTaggingAuto      *bool `json:"aiTaggingAuto,omitempty"`
-	AiTaggingBackfill  *bool `json:"aiTaggingBackfill,omitempty"`
-	AiTaggingEnabled   *bool `json:"aiTaggingEnabled,omitempty"`
-	AiTaggingUseImages *bool `json:"aiTaggingUseImages,omitempty"`
-	AiTaggingUseVideo  *bool `json:"aiTaggingUseVideo,omitempty"`
+	AiCaptionsEnabled         *bool `json:"aiCaptionsEnabled,omitempty"`
+	AiSearchEnabled           *bool `json:"aiSearchEnabled,omitempty"`
+	AiSummariesEnabled        *bool `json:"aiSummariesEnabled,omitempty"`
+	AiTaggingAuto             *bool `json:"aiTaggingAuto,omitempty"`
+	AiTaggingBackfill         *bool `json:"aiTaggingBackfill,omitempty"`
+	AiTaggingClosedVocabulary *bool `json:"aiTaggingClosedVocabulary,omitempty"`
+	AiTaggingEnabled          *bool `json:"aiTaggingEnabled,omitempty"`
+
+	// AiTaggingInstructions Guidance for tag suggestion (at most 1000 characters); empty clears it
+	AiTaggingInstructions  *string `json:"aiTaggingInstructions,omitempty"`
+	AiTaggingMaxTags       *int    `json:"aiTaggingMaxTags,omitempty"`
+	AiTaggingUseImages     *bool   `json:"aiTaggingUseImages,omitempty"`
+	AiTaggingUseVideo      *bool   `json:"aiTaggingUseVideo,omitempty"`
+	AiTranscriptionEnabled *bool   `json:"aiTranscriptionEnabled,omitempty"`
+	MemoriesDigestEnabled  *bool   `json:"memoriesDigestEnabled,omitempty"`
 }
 
 // HealthFixRequest defines model for HealthFixRequest.
@@ -156,6 +660,14 @@ type HealthIssuesResponse struct {
 	LastChecked *time.Time `json:"lastChecked,omitempty"`
 }
 
+// ItemSummary defines model for ItemSummary.
+type ItemSummary struct {
+	Date openapi_types.Date `json:"date"`
+
+	// Summary one-paragraph summary; empty when the model had nothing to say
+	Summary string `json:"summary"`
+}
+
 // ItemsListResponse defines model for ItemsListResponse.
 type ItemsListResponse struct {
 	// Items List of diary items matching the search criteria
@@ -167,69 +679,266 @@ type ItemsListResponse struct {
 
 // ItemsRequest defines model for ItemsRequest.
 type ItemsRequest struct {
-	Body  *string            `json:"body,omitempty"`
-	Date  openapi_types.Date `json:"date"`
-	Tags  *[]string          `json:"tags,omitempty"`
-	Title string             `json:"title"`
+	Body *string            `json:"body,omitempty"`
+	Date openapi_types.Date `json:"date"`
+
+	// Fields Custom field values by field key: a number for number and scale fields, true or false for boolean fields, a string for text and enum fields. Left out, the entry keeps its values; sent, they replace them (null values are dropped, so {} clears them all).
+	Fields *map[string]interface{} `json:"fields,omitempty"`
+
+	// Place Where the entry happened. Left out, the entry keeps its place, or takes the GPS position of its first geotagged photo when it has none; sent, it replaces it, and a place without name or coordinates clears it.
+	Place *PlaceRequest `json:"place,omitempty"`
+	Tags  *[]string     `json:"tags,omitempty"`
+	Title string        `json:"title"`
 }
 
 // ItemsResponse defines model for ItemsResponse.
 type ItemsResponse struct {
-	Body     *string             `json:"body,omitempty"`
-	Date     openapi_types.Date  `json:"date"`
-	NextDate *openapi_types.Date `json:"nextDate,omitempty"`
+	Body *string            `json:"body,omitempty"`
+	Date openapi_types.Date `json:"date"`
+
+	// Fields Custom field values by field key: a number for number and scale fields, true or false for boolean fields, a string for text and enum fields.
+	Fields   *map[string]interface{} `json:"fields,omitempty"`
+	NextDate *openapi_types.Date     `json:"nextDate,omitempty"`
 
 	// PendingTags AI-suggested tags awaiting user acceptance (disjoint from tags)
 	PendingTags  *[]string           `json:"pendingTags,omitempty"`
+	Place        *Place              `json:"place,omitempty"`
 	PreviousDate *openapi_types.Date `json:"previousDate,omitempty"`
 	Tags         *[]string           `json:"tags,omitempty"`
 	Title        string              `json:"title"`
-}
 
-// RenameTagRequest defines model for RenameTagRequest.
-type RenameTagRequest struct {
-	// NewName New name for the tag; merges into an existing tag where an entry already carries it
-	NewName string `json:"newName"`
+	// Weather Weather of the entry's day at its place, looked up when the place gets coordinates
+	Weather *Weather `json:"weather,omitempty"`
 }
 
-// SuggestTagsRequest defines model for SuggestTagsRequest.
-type SuggestTagsRequest struct {
-	Body  *string            `json:"body,omitempty"`
-	Date  openapi_types.Date `json:"date"`
-	Title string             `json:"title"`
+// JobCounts defines model for JobCounts.
+type JobCounts struct {
+	Done    int64 `json:"done"`
+	Failed  int64 `json:"failed"`
+	Queued  int64 `json:"queued"`
+	Running int64 `json:"running"`
 }
 
-// SuggestTagsResponse defines model for SuggestTagsResponse.
-type SuggestTagsResponse struct {
-	Tags []TagSuggestion `json:"tags"`
+// JobsResponse defines model for JobsResponse.
+type JobsResponse struct {
+	// BackfillDone true once the one-time backfill has analyzed every day
+	BackfillDone bool `json:"backfillDone"`
+
+	// BackfillRemainingDays days the AI tagging backfill still has to analyze (0 when it is off or complete)
+	BackfillRemainingDays int       `json:"backfillRemainingDays"`
+	Counts                JobCounts `json:"counts"`
+
+	// Jobs most recently active jobs first
+	Jobs []AIJob `json:"jobs"`
 }
 
-// SyncChangeResponse defines model for SyncChangeResponse.
-type SyncChangeResponse struct {
-	// Date Date of the diary entry that was changed
+// MemoriesResponse defines model for MemoriesResponse.
+type MemoriesResponse struct {
+	// Date the day the memories are for
 	Date openapi_types.Date `json:"date"`
 
-	// Id Unique change ID
-	Id int32 `json:"id"`
+	// MonthAgo entries of the week a month before the day, oldest first
+	MonthAgo []Memory `json:"monthAgo"`
 
-	// ItemSnapshot Current state of the item (null for deleted items)
-	ItemSnapshot *ItemsResponse `json:"itemSnapshot,omitempty"`
+	// MonthAgoFrom Monday of the week a month before the day
+	MonthAgoFrom openapi_types.Date `json:"monthAgoFrom"`
 
-	// Metadata Additional metadata about the change
-	Metadata *[]string `json:"metadata,omitempty"`
+	// MonthAgoTo Sunday of the week a month before the day
+	MonthAgoTo openapi_types.Date `json:"monthAgoTo"`
 
-	// OperationType Type of operation performed
-	OperationType SyncChangeResponseOperationType `json:"operationType"`
+	// OnThisDay entries of the same calendar day in previous years, newest first
+	OnThisDay []Memory `json:"onThisDay"`
+}
 
-	// Timestamp When the change occurred
-	Timestamp time.Time `json:"timestamp"`
+// Memory defines model for Memory.
+type Memory struct {
+	Date openapi_types.Date `json:"date"`
 
-	// UserId User ID who made the change
-	UserId string `json:"userId"`
+	// Image the first image asset the entry references, if any
+	Image *string `json:"image,omitempty"`
+	Title string  `json:"title"`
+
+	// YearsAgo whole years between the entry and the requested day
+	YearsAgo int `json:"yearsAgo"`
 }
 
-// SyncChangeResponseOperationType Type of operation performed
-type SyncChangeResponseOperationType string
+// MonthEntryStat defines model for MonthEntryStat.
+type MonthEntryStat struct {
+	Entries int    `json:"entries"`
+	Month   string `json:"month"`
+	Words   int    `json:"words"`
+}
+
+// Place defines model for Place.
+type Place struct {
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	Name      string   `json:"name"`
+
+	// Source Set by the user, or taken from a photo's EXIF GPS position
+	Source PlaceSource `json:"source"`
+}
+
+// PlaceSource Set by the user, or taken from a photo's EXIF GPS position
+type PlaceSource string
+
+// PlaceRequest Where the entry happened. Left out, the entry keeps its place, or takes the GPS position of its first geotagged photo when it has none; sent, it replaces it, and a place without name or coordinates clears it.
+type PlaceRequest struct {
+	// Latitude Sent together with longitude
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	Name      *string  `json:"name,omitempty"`
+}
+
+// PlaceStat defines model for PlaceStat.
+type PlaceStat struct {
+	// Count Number of entries at the place
+	Count     int                `json:"count"`
+	FirstDate openapi_types.Date `json:"firstDate"`
+	LastDate  openapi_types.Date `json:"lastDate"`
+
+	// Latitude Average position of the place's entries
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Name Empty for places known only by coordinates
+	Name string `json:"name"`
+}
+
+// PlacesResponse defines model for PlacesResponse.
+type PlacesResponse struct {
+	// Places Entries grouped by place name and position (about a kilometre apart), most visited first
+	Places []PlaceStat `json:"places"`
+}
+
+// Recap defines model for Recap.
+type Recap struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EndDate last day of the period (inclusive)
+	EndDate openapi_types.Date `json:"endDate"`
+
+	// EntryCount number of entries the recap was written from
+	EntryCount int                `json:"entryCount"`
+	Id         openapi_types.UUID `json:"id"`
+	Period     RecapPeriod        `json:"period"`
+	StartDate  openapi_types.Date `json:"startDate"`
+	Summary    string             `json:"summary"`
+}
+
+// RecapPeriod defines model for Recap.Period.
+type RecapPeriod string
+
+// RecoveryCodesResponse defines model for RecoveryCodesResponse.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// RevokedSessions defines model for RevokedSessions.
+type RevokedSessions struct {
+	// Revoked number of sessions signed out
+	Revoked int `json:"revoked"`
+}
+
+// SemanticMatch defines model for SemanticMatch.
+type SemanticMatch struct {
+	Item ItemsResponse `json:"item"`
+
+	// Score cosine similarity between the query and the entry (-1..1)
+	Score float64 `json:"score"`
+}
+
+// SemanticSearchResponse defines model for SemanticSearchResponse.
+type SemanticSearchResponse struct {
+	Matches []SemanticMatch `json:"matches"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current true for the session making this request
+	Current    bool               `json:"current"`
+	Id         openapi_types.UUID `json:"id"`
+	IpAddress  string             `json:"ipAddress"`
+	LastSeenAt time.Time          `json:"lastSeenAt"`
+	UserAgent  string             `json:"userAgent"`
+}
+
+// StatsHeatmap defines model for StatsHeatmap.
+type StatsHeatmap struct {
+	// Words Words written on each day of the year, January 1 first; 0 for days without an entry
+	Words []int `json:"words"`
+	Year  int   `json:"year"`
+}
+
+// StatsResponse defines model for StatsResponse.
+type StatsResponse struct {
+	// Assets assets embedded in the entries
+	Assets        int    `json:"assets"`
+	CurrentStreak Streak `json:"currentStreak"`
+
+	// Days word count of each entry, oldest first
+	Days              []DayWordCount `json:"days"`
+	Entries           int            `json:"entries"`
+	EntriesWithAssets int            `json:"entriesWithAssets"`
+	Heatmap           StatsHeatmap   `json:"heatmap"`
+	LongestStreak     Streak         `json:"longestStreak"`
+
+	// Months entries and words per month, oldest first
+	Months []MonthEntryStat `json:"months"`
+
+	// Tags tag usage per month, oldest month first and most used tag first
+	Tags  []TagMonthStat `json:"tags"`
+	Words int            `json:"words"`
+}
+
+// Streak defines model for Streak.
+type Streak struct {
+	// Days number of consecutive days with an entry; 0 without a streak
+	Days  int                 `json:"days"`
+	End   *openapi_types.Date `json:"end,omitempty"`
+	Start *openapi_types.Date `json:"start,omitempty"`
+}
+
+// SuggestTagsRequest defines model for SuggestTagsRequest.
+type SuggestTagsRequest struct {
+	Body  *string            `json:"body,omitempty"`
+	Date  openapi_types.Date `json:"date"`
+	Title string             `json:"title"`
+}
+
+// SuggestTagsResponse defines model for SuggestTagsResponse.
+type SuggestTagsResponse struct {
+	Tags []TagSuggestion `json:"tags"`
+}
+
+// SyncChangeResponse defines model for SyncChangeResponse.
+type SyncChangeResponse struct {
+	// Date Date of the diary entry that was changed
+	Date openapi_types.Date `json:"date"`
+
+	// Id Unique change ID
+	Id int32 `json:"id"`
+
+	// ItemSnapshot Current state of the item (null for deleted items)
+	ItemSnapshot *ItemsResponse `json:"itemSnapshot,omitempty"`
+
+	// Metadata Additional metadata about the change
+	Metadata *[]string `json:"metadata,omitempty"`
+
+	// OperationType Type of operation performed
+	OperationType SyncChangeResponseOperationType `json:"operationType"`
+
+	// Timestamp When the change occurred
+	Timestamp time.Time `json:"timestamp"`
+
+	// UserId User ID who made the change
+	UserId string `json:"userId"`
+}
+
+// SyncChangeResponseOperationType Type of operation performed
+type SyncChangeResponseOperationType string
 
 // SyncResponse defines model for SyncResponse.
 type SyncResponse struct {
@@ -243,11 +952,77 @@ type SyncResponse struct {
 	NextId *int32 `json:"nextId,omitempty"`
 }
 
+// TagCluster defines model for TagCluster.
+type TagCluster struct {
+	// Canonical The most used tag of the cluster, the suggested merge target
+	Canonical string `json:"canonical"`
+
+	// Reasons Why the tags were grouped: case/spacing, inflection, typo, or the AI provider
+	Reasons []TagClusterReasons `json:"reasons"`
+
+	// Tags Tags of the cluster with usage counts, most used first
+	Tags []TagStat `json:"tags"`
+}
+
+// TagClusterReasons defines model for TagCluster.Reasons.
+type TagClusterReasons string
+
+// TagConsolidationResponse defines model for TagConsolidationResponse.
+type TagConsolidationResponse struct {
+	// AiUsed Whether the AI provider contributed to the clusters
+	AiUsed bool `json:"aiUsed"`
+
+	// Clusters Clusters of two or more tags, the most used first
+	Clusters []TagCluster `json:"clusters"`
+}
+
+// TagMerge defines model for TagMerge.
+type TagMerge struct {
+	// From Tags to merge away
+	From []string `json:"from"`
+
+	// To Tag they become
+	To string `json:"to"`
+}
+
+// TagMergeRequest defines model for TagMergeRequest.
+type TagMergeRequest struct {
+	Merges []TagMerge `json:"merges"`
+}
+
+// TagMergeResponse defines model for TagMergeResponse.
+type TagMergeResponse struct {
+	// UpdatedEntries Number of entries whose tags changed
+	UpdatedEntries int `json:"updatedEntries"`
+}
+
+// TagMetadata defines model for TagMetadata.
+type TagMetadata struct {
+	// Aliases Alternative names; saving an entry with an alias stores the tag instead
+	Aliases []string `json:"aliases"`
+
+	// Color Display colour as #RRGGBB
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// TagMonthStat defines model for TagMonthStat.
+type TagMonthStat struct {
+	// Count entries carrying the tag in the month
+	Count int    `json:"count"`
+	Month string `json:"month"`
+	Tag   string `json:"tag"`
+}
+
 // TagStat defines model for TagStat.
 type TagStat struct {
-	// Count Number of entries using this tag
+	// Count Number of entries using this tag or, for hierarchical tags, any of its descendants
 	Count int    `json:"count"`
 	Name  string `json:"name"`
+
+	// OwnCount Number of entries using exactly this tag (0 for an ancestor used only through descendants)
+	OwnCount int `json:"ownCount"`
 }
 
 // TagStatsResponse defines model for TagStatsResponse.
@@ -262,19 +1037,121 @@ type TagSuggestion struct {
 	Name       string  `json:"name"`
 }
 
+// TagTreeNode defines model for TagTreeNode.
+type TagTreeNode struct {
+	// Children Child tags, sorted by name
+	Children []TagTreeNode `json:"children"`
+
+	// Count Number of entries using this tag or any descendant
+	Count int `json:"count"`
+
+	// Name Last level of the tag
+	Name string `json:"name"`
+
+	// OwnCount Number of entries using exactly this tag
+	OwnCount int `json:"ownCount"`
+
+	// Path Full tag
+	Path string `json:"path"`
+}
+
+// TagTreeResponse defines model for TagTreeResponse.
+type TagTreeResponse struct {
+	// Roots Top-level tags, sorted by name; flat tags are leaves
+	Roots []TagTreeNode `json:"roots"`
+}
+
+// TagUpdateRequest defines model for TagUpdateRequest.
+type TagUpdateRequest struct {
+	// Aliases Replaces the tag's aliases (at most 20); empty clears them
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Color Display colour as #RRGGBB; empty clears it
+	Color *string `json:"color,omitempty"`
+
+	// Description At most 500 characters; empty clears it
+	Description *string `json:"description,omitempty"`
+
+	// NewName New name for the tag; merges into an existing tag where an entry already carries it
+	NewName *string `json:"newName,omitempty"`
+
+	// Subtree Also rename descendants: travel/italy becomes trips/italy when travel becomes trips
+	Subtree *bool `json:"subtree,omitempty"`
+}
+
 // TagsResponse defines model for TagsResponse.
 type TagsResponse struct {
+	// Metadata Metadata of the family's tags that have any, sorted by name
+	Metadata []TagMetadata `json:"metadata"`
+
 	// Tags Family's distinct existing tags, deduplicated and sorted
 	Tags []string `json:"tags"`
 }
 
+// TranscribeRequest defines model for TranscribeRequest.
+type TranscribeRequest struct {
+	// Asset file name of an audio asset referenced in the entry body
+	Asset string `json:"asset"`
+}
+
+// TranscribeResponse defines model for TranscribeResponse.
+type TranscribeResponse struct {
+	Item ItemsResponse `json:"item"`
+
+	// Transcript the text appended to the entry; empty when no speech was recognized (the entry is then unchanged)
+	Transcript string `json:"transcript"`
+}
+
+// TwoFactorChallenge defines model for TwoFactorChallenge.
+type TwoFactorChallenge struct {
+	// MfaToken short-lived token to send with the second factor
+	MfaToken string `json:"mfaToken"`
+}
+
+// TwoFactorCodeRequest defines model for TwoFactorCodeRequest.
+type TwoFactorCodeRequest struct {
+	// Code 6-digit TOTP code or, where accepted, a recovery code
+	Code string `json:"code"`
+}
+
+// TwoFactorEnrollment defines model for TwoFactorEnrollment.
+type TwoFactorEnrollment struct {
+	// ProvisioningUri otpauth:// URI to render as a QR code
+	ProvisioningUri string `json:"provisioningUri"`
+
+	// Secret base32 TOTP secret for manual entry
+	Secret string `json:"secret"`
+}
+
+// TwoFactorLoginRequest defines model for TwoFactorLoginRequest.
+type TwoFactorLoginRequest struct {
+	// Code 6-digit TOTP code or a recovery code
+	Code     string `json:"code"`
+	MfaToken string `json:"mfaToken"`
+}
+
 // User defines model for User.
 type User struct {
-	Email     string             `json:"email"`
-	Id        openapi_types.UUID `json:"id"`
-	StartDate time.Time          `json:"startDate"`
+	Email            string             `json:"email"`
+	Id               openapi_types.UUID `json:"id"`
+	StartDate        time.Time          `json:"startDate"`
+	TwoFactorEnabled *bool              `json:"twoFactorEnabled,omitempty"`
+}
+
+// Weather Weather of the entry's day at its place, looked up when the place gets coordinates
+type Weather struct {
+	Condition       WeatherCondition `json:"condition"`
+	PrecipitationMm float64          `json:"precipitationMm"`
+
+	// Provider Weather provider the snapshot came from
+	Provider string  `json:"provider"`
+	TempMaxC float64 `json:"tempMaxC"`
+	TempMinC float64 `json:"tempMinC"`
 }
 
+// WeatherCondition defines model for Weather.Condition.
+type WeatherCondition string
+
 // GetAssetParams defines parameters for GetAsset.
 type GetAssetParams struct {
 	// Path relative path to asset file
@@ -287,6 +1164,30 @@ type UploadAssetsBatchMultipartBody struct {
 	Assets []openapi_types.File `json:"assets"`
 }
 
+// ListAssetsParams defines parameters for ListAssets.
+type ListAssetsParams struct {
+	// Date only list the assets referenced by the entry of this date (optional)
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// GetAuditEventsParams defines parameters for GetAuditEvents.
+type GetAuditEventsParams struct {
+	// Before return events older than this event ID (exclusive)
+	Before *int32 `form:"before,omitempty" json:"before,omitempty"`
+
+	// Limit maximum number of events to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetCustomFieldStatsParams defines parameters for GetCustomFieldStats.
+type GetCustomFieldStatsParams struct {
+	// From first day to include (optional)
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To last day to include (optional)
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // GetItemsParams defines parameters for GetItems.
 type GetItemsParams struct {
 	// Date filter items by date (optional)
@@ -295,8 +1196,59 @@ type GetItemsParams struct {
 	// Search search text to filter items by title and body content
 	Search *string `form:"search,omitempty" json:"search,omitempty"`
 
-	// Tags comma-separated list of tags to filter items
+	// Tags comma-separated list of tags to filter items; a hierarchical tag also matches its descendants ("travel" finds "travel/italy/rome")
 	Tags *string `form:"tags,omitempty" json:"tags,omitempty"`
+
+	// Fields comma-separated custom field conditions, all of which must hold, as key, operator and value: "rating>=4,sick=true,mood=calm". Operators are =, != and, for numbers and scales, <, <=, > and >=. Entries without a value for the field never match.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// SemanticSearchItemsParams defines parameters for SemanticSearchItems.
+type SemanticSearchItemsParams struct {
+	// Q free-text query
+	Q string `form:"q" json:"q"`
+
+	// Limit maximum number of entries to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetJobsParams defines parameters for GetJobs.
+type GetJobsParams struct {
+	// Limit maximum number of recent jobs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetMemoriesParams defines parameters for GetMemories.
+type GetMemoriesParams struct {
+	// Date the day to remember from (default today)
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// GetPlacesParams defines parameters for GetPlaces.
+type GetPlacesParams struct {
+	// From first day to include (optional)
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To last day to include (optional)
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetRecapsParams defines parameters for GetRecaps.
+type GetRecapsParams struct {
+	// Period only return recaps of this period
+	Period *GetRecapsParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+}
+
+// GetRecapsParamsPeriod defines parameters for GetRecaps.
+type GetRecapsParamsPeriod string
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	// From first day to include (optional)
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To last day to include (optional)
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
 }
 
 // GetChangesParams defines parameters for GetChanges.
@@ -308,15 +1260,39 @@ type GetChangesParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetTagConsolidationParams defines parameters for GetTagConsolidation.
+type GetTagConsolidationParams struct {
+	// Ai also ask the AI provider for clusters
+	Ai *bool `form:"ai,omitempty" json:"ai,omitempty"`
+}
+
+// DeleteTagParams defines parameters for DeleteTag.
+type DeleteTagParams struct {
+	// Subtree also delete the tag's descendants
+	Subtree *bool `form:"subtree,omitempty" json:"subtree,omitempty"`
+}
+
+// AskDiaryJSONRequestBody defines body for AskDiary for application/json ContentType.
+type AskDiaryJSONRequestBody = AskRequest
+
 // UploadAssetsBatchMultipartRequestBody defines body for UploadAssetsBatch for multipart/form-data ContentType.
 type UploadAssetsBatchMultipartRequestBody UploadAssetsBatchMultipartBody
 
 // AuthorizeJSONRequestBody defines body for Authorize for application/json ContentType.
 type AuthorizeJSONRequestBody = AuthData
 
+// AuthorizeTwoFactorJSONRequestBody defines body for AuthorizeTwoFactor for application/json ContentType.
+type AuthorizeTwoFactorJSONRequestBody = TwoFactorLoginRequest
+
 // UpdateFamilySettingsJSONRequestBody defines body for UpdateFamilySettings for application/json ContentType.
 type UpdateFamilySettingsJSONRequestBody = FamilySettingsRequest
 
+// CreateCustomFieldJSONRequestBody defines body for CreateCustomField for application/json ContentType.
+type CreateCustomFieldJSONRequestBody = CustomFieldCreateRequest
+
+// UpdateCustomFieldJSONRequestBody defines body for UpdateCustomField for application/json ContentType.
+type UpdateCustomFieldJSONRequestBody = CustomFieldUpdateRequest
+
 // FixHealthIssuesJSONRequestBody defines body for FixHealthIssues for application/json ContentType.
 type FixHealthIssuesJSONRequestBody = HealthFixRequest
 
@@ -335,8 +1311,26 @@ type DismissItemTagJSONRequestBody = DismissTagRequest
 // SuggestItemTagsJSONRequestBody defines body for SuggestItemTags for application/json ContentType.
 type SuggestItemTagsJSONRequestBody = SuggestTagsRequest
 
-// RenameTagJSONRequestBody defines body for RenameTag for application/json ContentType.
-type RenameTagJSONRequestBody = RenameTagRequest
+// TranscribeItemAssetJSONRequestBody defines body for TranscribeItemAsset for application/json ContentType.
+type TranscribeItemAssetJSONRequestBody = TranscribeRequest
+
+// MergeTagsJSONRequestBody defines body for MergeTags for application/json ContentType.
+type MergeTagsJSONRequestBody = TagMergeRequest
+
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = TagUpdateRequest
+
+// CreateAccessTokenJSONRequestBody defines body for CreateAccessToken for application/json ContentType.
+type CreateAccessTokenJSONRequestBody = CreateAccessTokenRequest
+
+// ConfirmTwoFactorJSONRequestBody defines body for ConfirmTwoFactor for application/json ContentType.
+type ConfirmTwoFactorJSONRequestBody = TwoFactorCodeRequest
+
+// DisableTwoFactorJSONRequestBody defines body for DisableTwoFactor for application/json ContentType.
+type DisableTwoFactorJSONRequestBody = TwoFactorCodeRequest
+
+// RegenerateRecoveryCodesJSONRequestBody defines body for RegenerateRecoveryCodes for application/json ContentType.
+type RegenerateRecoveryCodesJSONRequestBody = TwoFactorCodeRequest
 
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
@@ -411,17 +1405,33 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// AskDiaryWithBody request with any body
+	AskDiaryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AskDiary(ctx context.Context, body AskDiaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAsset request
 	GetAsset(ctx context.Context, params *GetAssetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UploadAssetsBatchWithBody request with any body
 	UploadAssetsBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListAssets request
+	ListAssets(ctx context.Context, params *ListAssetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuditEvents request
+	GetAuditEvents(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AuthorizeWithBody request with any body
 	AuthorizeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	Authorize(ctx context.Context, body AuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AuthorizeTwoFactorWithBody request with any body
+	AuthorizeTwoFactorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthorizeTwoFactor(ctx context.Context, body AuthorizeTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetFamily request
 	GetFamily(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -430,6 +1440,28 @@ type ClientInterface interface {
 
 	UpdateFamilySettings(ctx context.Context, body UpdateFamilySettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetFamilyAIUsage request
+	GetFamilyAIUsage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCustomFields request
+	GetCustomFields(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCustomFieldWithBody request with any body
+	CreateCustomFieldWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCustomField(ctx context.Context, body CreateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCustomField request
+	DeleteCustomField(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCustomFieldWithBody request with any body
+	UpdateCustomFieldWithBody(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCustomField(ctx context.Context, key string, body UpdateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCustomFieldStats request
+	GetCustomFieldStats(ctx context.Context, key string, params *GetCustomFieldStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FixHealthIssuesWithBody request with any body
 	FixHealthIssuesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -470,34 +1502,112 @@ type ClientInterface interface {
 
 	DismissItemTag(ctx context.Context, body DismissItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SemanticSearchItems request
+	SemanticSearchItems(ctx context.Context, params *SemanticSearchItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SuggestItemTagsWithBody request with any body
 	SuggestItemTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	SuggestItemTags(ctx context.Context, body SuggestItemTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetItemBacklinks request
+	GetItemBacklinks(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SummarizeItem request
+	SummarizeItem(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TranscribeItemAssetWithBody request with any body
+	TranscribeItemAssetWithBody(ctx context.Context, date openapi_types.Date, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TranscribeItemAsset(ctx context.Context, date openapi_types.Date, body TranscribeItemAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJobs request
+	GetJobs(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMemories request
+	GetMemories(ctx context.Context, params *GetMemoriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPlaces request
+	GetPlaces(ctx context.Context, params *GetPlacesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecaps request
+	GetRecaps(ctx context.Context, params *GetRecapsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessions request
+	GetSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeOtherSessions request
+	RevokeOtherSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeSession request
+	RevokeSession(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStats request
+	GetStats(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetChanges request
 	GetChanges(ctx context.Context, params *GetChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetTags request
 	GetTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetTagConsolidation request
+	GetTagConsolidation(ctx context.Context, params *GetTagConsolidationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MergeTagsWithBody request with any body
+	MergeTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MergeTags(ctx context.Context, body MergeTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTagStats request
 	GetTagStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetTagTree request
+	GetTagTree(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteTag request
-	DeleteTag(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteTag(ctx context.Context, name string, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTagWithBody request with any body
+	UpdateTagWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTag(ctx context.Context, name string, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAccessTokens request
+	GetAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// RenameTagWithBody request with any body
-	RenameTagWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// CreateAccessTokenWithBody request with any body
+	CreateAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	RenameTag(ctx context.Context, name string, body RenameTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateAccessToken(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeAccessToken request
+	RevokeAccessToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetUser request
 	GetUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmTwoFactorWithBody request with any body
+	ConfirmTwoFactorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmTwoFactor(ctx context.Context, body ConfirmTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisableTwoFactorWithBody request with any body
+	DisableTwoFactorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DisableTwoFactor(ctx context.Context, body DisableTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnrollTwoFactor request
+	EnrollTwoFactor(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegenerateRecoveryCodesWithBody request with any body
+	RegenerateRecoveryCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegenerateRecoveryCodes(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetAsset(ctx context.Context, params *GetAssetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAssetRequest(c.Server, params)
+func (c *Client) AskDiaryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAskDiaryRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -508,8 +1618,8 @@ func (c *Client) GetAsset(ctx context.Context, params *GetAssetParams, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) UploadAssetsBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUploadAssetsBatchRequestWithBody(c.Server, contentType, body)
+func (c *Client) AskDiary(ctx context.Context, body AskDiaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAskDiaryRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -520,8 +1630,8 @@ func (c *Client) UploadAssetsBatchWithBody(ctx context.Context, contentType stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) AuthorizeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAuthorizeRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAsset(ctx context.Context, params *GetAssetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAssetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -532,8 +1642,8 @@ func (c *Client) AuthorizeWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) Authorize(ctx context.Context, body AuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAuthorizeRequest(c.Server, body)
+func (c *Client) UploadAssetsBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadAssetsBatchRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -544,8 +1654,8 @@ func (c *Client) Authorize(ctx context.Context, body AuthorizeJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetFamily(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFamilyRequest(c.Server)
+func (c *Client) ListAssets(ctx context.Context, params *ListAssetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAssetsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -556,8 +1666,8 @@ func (c *Client) GetFamily(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateFamilySettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFamilySettingsRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAuditEvents(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -568,8 +1678,8 @@ func (c *Client) UpdateFamilySettingsWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateFamilySettings(ctx context.Context, body UpdateFamilySettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFamilySettingsRequest(c.Server, body)
+func (c *Client) AuthorizeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthorizeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -580,8 +1690,8 @@ func (c *Client) UpdateFamilySettings(ctx context.Context, body UpdateFamilySett
 	return c.Client.Do(req)
 }
 
-func (c *Client) FixHealthIssuesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFixHealthIssuesRequestWithBody(c.Server, contentType, body)
+func (c *Client) Authorize(ctx context.Context, body AuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthorizeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -592,8 +1702,8 @@ func (c *Client) FixHealthIssuesWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) FixHealthIssues(ctx context.Context, body FixHealthIssuesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFixHealthIssuesRequest(c.Server, body)
+func (c *Client) AuthorizeTwoFactorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthorizeTwoFactorRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -604,8 +1714,8 @@ func (c *Client) FixHealthIssues(ctx context.Context, body FixHealthIssuesJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetHealthIssues(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetHealthIssuesRequest(c.Server)
+func (c *Client) AuthorizeTwoFactor(ctx context.Context, body AuthorizeTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthorizeTwoFactorRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -616,8 +1726,8 @@ func (c *Client) GetHealthIssues(ctx context.Context, reqEditors ...RequestEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteOrphan(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteOrphanRequest(c.Server, filename)
+func (c *Client) GetFamily(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFamilyRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -628,8 +1738,8 @@ func (c *Client) DeleteOrphan(ctx context.Context, filename string, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) AttachOrphanWithBody(ctx context.Context, filename string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAttachOrphanRequestWithBody(c.Server, filename, contentType, body)
+func (c *Client) UpdateFamilySettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFamilySettingsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -640,8 +1750,8 @@ func (c *Client) AttachOrphanWithBody(ctx context.Context, filename string, cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) AttachOrphan(ctx context.Context, filename string, body AttachOrphanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAttachOrphanRequest(c.Server, filename, body)
+func (c *Client) UpdateFamilySettings(ctx context.Context, body UpdateFamilySettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFamilySettingsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -652,8 +1762,8 @@ func (c *Client) AttachOrphan(ctx context.Context, filename string, body AttachO
 	return c.Client.Do(req)
 }
 
-func (c *Client) UnignoreOrphan(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUnignoreOrphanRequest(c.Server, filename)
+func (c *Client) GetFamilyAIUsage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFamilyAIUsageRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -664,8 +1774,8 @@ func (c *Client) UnignoreOrphan(ctx context.Context, filename string, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) IgnoreOrphan(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewIgnoreOrphanRequest(c.Server, filename)
+func (c *Client) GetCustomFields(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCustomFieldsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -676,8 +1786,8 @@ func (c *Client) IgnoreOrphan(ctx context.Context, filename string, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetItems(ctx context.Context, params *GetItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetItemsRequest(c.Server, params)
+func (c *Client) CreateCustomFieldWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCustomFieldRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -688,8 +1798,8 @@ func (c *Client) GetItems(ctx context.Context, params *GetItemsParams, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutItemsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutItemsRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateCustomField(ctx context.Context, body CreateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCustomFieldRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -700,8 +1810,8 @@ func (c *Client) PutItemsWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutItems(ctx context.Context, body PutItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutItemsRequest(c.Server, body)
+func (c *Client) DeleteCustomField(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCustomFieldRequest(c.Server, key)
 	if err != nil {
 		return nil, err
 	}
@@ -712,8 +1822,8 @@ func (c *Client) PutItems(ctx context.Context, body PutItemsJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) AcceptItemTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAcceptItemTagRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateCustomFieldWithBody(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCustomFieldRequestWithBody(c.Server, key, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -724,8 +1834,8 @@ func (c *Client) AcceptItemTagWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) AcceptItemTag(ctx context.Context, body AcceptItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAcceptItemTagRequest(c.Server, body)
+func (c *Client) UpdateCustomField(ctx context.Context, key string, body UpdateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCustomFieldRequest(c.Server, key, body)
 	if err != nil {
 		return nil, err
 	}
@@ -736,8 +1846,8 @@ func (c *Client) AcceptItemTag(ctx context.Context, body AcceptItemTagJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) DismissItemTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDismissItemTagRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetCustomFieldStats(ctx context.Context, key string, params *GetCustomFieldStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCustomFieldStatsRequest(c.Server, key, params)
 	if err != nil {
 		return nil, err
 	}
@@ -748,8 +1858,8 @@ func (c *Client) DismissItemTagWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DismissItemTag(ctx context.Context, body DismissItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDismissItemTagRequest(c.Server, body)
+func (c *Client) FixHealthIssuesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFixHealthIssuesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -760,8 +1870,8 @@ func (c *Client) DismissItemTag(ctx context.Context, body DismissItemTagJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) SuggestItemTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSuggestItemTagsRequestWithBody(c.Server, contentType, body)
+func (c *Client) FixHealthIssues(ctx context.Context, body FixHealthIssuesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFixHealthIssuesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -772,8 +1882,8 @@ func (c *Client) SuggestItemTagsWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) SuggestItemTags(ctx context.Context, body SuggestItemTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSuggestItemTagsRequest(c.Server, body)
+func (c *Client) GetHealthIssues(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthIssuesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -784,8 +1894,8 @@ func (c *Client) SuggestItemTags(ctx context.Context, body SuggestItemTagsJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetChanges(ctx context.Context, params *GetChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetChangesRequest(c.Server, params)
+func (c *Client) DeleteOrphan(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrphanRequest(c.Server, filename)
 	if err != nil {
 		return nil, err
 	}
@@ -796,8 +1906,8 @@ func (c *Client) GetChanges(ctx context.Context, params *GetChangesParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTagsRequest(c.Server)
+func (c *Client) AttachOrphanWithBody(ctx context.Context, filename string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachOrphanRequestWithBody(c.Server, filename, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -808,8 +1918,8 @@ func (c *Client) GetTags(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTagStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTagStatsRequest(c.Server)
+func (c *Client) AttachOrphan(ctx context.Context, filename string, body AttachOrphanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachOrphanRequest(c.Server, filename, body)
 	if err != nil {
 		return nil, err
 	}
@@ -820,8 +1930,8 @@ func (c *Client) GetTagStats(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTag(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTagRequest(c.Server, name)
+func (c *Client) UnignoreOrphan(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnignoreOrphanRequest(c.Server, filename)
 	if err != nil {
 		return nil, err
 	}
@@ -832,8 +1942,8 @@ func (c *Client) DeleteTag(ctx context.Context, name string, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) RenameTagWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRenameTagRequestWithBody(c.Server, name, contentType, body)
+func (c *Client) IgnoreOrphan(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIgnoreOrphanRequest(c.Server, filename)
 	if err != nil {
 		return nil, err
 	}
@@ -844,8 +1954,8 @@ func (c *Client) RenameTagWithBody(ctx context.Context, name string, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) RenameTag(ctx context.Context, name string, body RenameTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRenameTagRequest(c.Server, name, body)
+func (c *Client) GetItems(ctx context.Context, params *GetItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetItemsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -856,8 +1966,8 @@ func (c *Client) RenameTag(ctx context.Context, name string, body RenameTagJSONR
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserRequest(c.Server)
+func (c *Client) PutItemsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutItemsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -868,491 +1978,523 @@ func (c *Client) GetUser(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	return c.Client.Do(req)
 }
 
-// NewGetAssetRequest generates requests for GetAsset
-func NewGetAssetRequest(server string, params *GetAssetParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutItems(ctx context.Context, body PutItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutItemsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/assets")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AcceptItemTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcceptItemTagRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUploadAssetsBatchRequestWithBody generates requests for UploadAssetsBatch with any type of body
-func NewUploadAssetsBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AcceptItemTag(ctx context.Context, body AcceptItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcceptItemTagRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/assets/batch")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DismissItemTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDismissItemTagRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAuthorizeRequest calls the generic Authorize builder with application/json body
-func NewAuthorizeRequest(server string, body AuthorizeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DismissItemTag(ctx context.Context, body DismissItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDismissItemTagRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAuthorizeRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAuthorizeRequestWithBody generates requests for Authorize with any type of body
-func NewAuthorizeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SemanticSearchItems(ctx context.Context, params *SemanticSearchItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSemanticSearchItemsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/authorize")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SuggestItemTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSuggestItemTagsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetFamilyRequest generates requests for GetFamily
-func NewGetFamilyRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SuggestItemTags(ctx context.Context, body SuggestItemTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSuggestItemTagsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/family")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetItemBacklinks(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetItemBacklinksRequest(c.Server, date)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateFamilySettingsRequest calls the generic UpdateFamilySettings builder with application/json body
-func NewUpdateFamilySettingsRequest(server string, body UpdateFamilySettingsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) SummarizeItem(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSummarizeItemRequest(c.Server, date)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateFamilySettingsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateFamilySettingsRequestWithBody generates requests for UpdateFamilySettings with any type of body
-func NewUpdateFamilySettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) TranscribeItemAssetWithBody(ctx context.Context, date openapi_types.Date, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTranscribeItemAssetRequestWithBody(c.Server, date, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/family")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) TranscribeItemAsset(ctx context.Context, date openapi_types.Date, body TranscribeItemAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTranscribeItemAssetRequest(c.Server, date, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewFixHealthIssuesRequest calls the generic FixHealthIssues builder with application/json body
-func NewFixHealthIssuesRequest(server string, body FixHealthIssuesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetJobs(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewFixHealthIssuesRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewFixHealthIssuesRequestWithBody generates requests for FixHealthIssues with any type of body
-func NewFixHealthIssuesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetMemories(ctx context.Context, params *GetMemoriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMemoriesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/health/fix")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetPlaces(ctx context.Context, params *GetPlacesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPlacesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetHealthIssuesRequest generates requests for GetHealthIssues
-func NewGetHealthIssuesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetRecaps(ctx context.Context, params *GetRecapsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecapsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/health/issues")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) RevokeOtherSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeOtherSessionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteOrphanRequest generates requests for DeleteOrphan
-func NewDeleteOrphanRequest(server string, filename string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "filename", filename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) RevokeSession(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeSessionRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetStats(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/health/orphans/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetChanges(ctx context.Context, params *GetChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetChangesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) GetTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTagsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAttachOrphanRequest calls the generic AttachOrphan builder with application/json body
-func NewAttachOrphanRequest(server string, filename string, body AttachOrphanJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetTagConsolidation(ctx context.Context, params *GetTagConsolidationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTagConsolidationRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAttachOrphanRequestWithBody(server, filename, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAttachOrphanRequestWithBody generates requests for AttachOrphan with any type of body
-func NewAttachOrphanRequestWithBody(server string, filename string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "filename", filename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) MergeTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeTagsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) MergeTags(ctx context.Context, body MergeTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeTagsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/health/orphans/%s/attach", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetTagStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTagStatsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetTagTree(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTagTreeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUnignoreOrphanRequest generates requests for UnignoreOrphan
-func NewUnignoreOrphanRequest(server string, filename string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "filename", filename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) DeleteTag(ctx context.Context, name string, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTagRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateTagWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequestWithBody(c.Server, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/health/orphans/%s/ignore", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateTag(ctx context.Context, name string, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequest(c.Server, name, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) GetAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccessTokensRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewIgnoreOrphanRequest generates requests for IgnoreOrphan
-func NewIgnoreOrphanRequest(server string, filename string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "filename", filename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) CreateAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAccessTokenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateAccessToken(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAccessTokenRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/health/orphans/%s/ignore", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RevokeAccessToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeAccessTokenRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) GetUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) ConfirmTwoFactorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTwoFactorRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetItemsRequest generates requests for GetItems
-func NewGetItemsRequest(server string, params *GetItemsParams) (*http.Request, error) {
-	var err error
+func (c *Client) ConfirmTwoFactor(ctx context.Context, body ConfirmTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTwoFactorRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DisableTwoFactorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTwoFactorRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v1/items")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) DisableTwoFactor(ctx context.Context, body DisableTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTwoFactorRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) EnrollTwoFactor(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollTwoFactorRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Date != nil {
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "date", *params.Date, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-		}
-
-		if params.Search != nil {
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search", *params.Search, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-		}
-
-		if params.Tags != nil {
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tags", *params.Tags, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+func (c *Client) RegenerateRecoveryCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) RegenerateRecoveryCodes(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutItemsRequest calls the generic PutItems builder with application/json body
-func NewPutItemsRequest(server string, body PutItemsJSONRequestBody) (*http.Request, error) {
+// NewAskDiaryRequest calls the generic AskDiary builder with application/json body
+func NewAskDiaryRequest(server string, body AskDiaryJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutItemsRequestWithBody(server, "application/json", bodyReader)
+	return NewAskDiaryRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPutItemsRequestWithBody generates requests for PutItems with any type of body
-func NewPutItemsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAskDiaryRequestWithBody generates requests for AskDiary with any type of body
+func NewAskDiaryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1360,7 +2502,7 @@ func NewPutItemsRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/items")
+	operationPath := fmt.Sprintf("/v1/ask")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1370,7 +2512,7 @@ func NewPutItemsRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1380,19 +2522,8 @@ func NewPutItemsRequestWithBody(server string, contentType string, body io.Reade
 	return req, nil
 }
 
-// NewAcceptItemTagRequest calls the generic AcceptItemTag builder with application/json body
-func NewAcceptItemTagRequest(server string, body AcceptItemTagJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAcceptItemTagRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewAcceptItemTagRequestWithBody generates requests for AcceptItemTag with any type of body
-func NewAcceptItemTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetAssetRequest generates requests for GetAsset
+func NewGetAssetRequest(server string, params *GetAssetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1400,7 +2531,7 @@ func NewAcceptItemTagRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/items/accept-tag")
+	operationPath := fmt.Sprintf("/v1/assets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1410,29 +2541,34 @@ func NewAcceptItemTagRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	return req, nil
-}
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-// NewDismissItemTagRequest calls the generic DismissItemTag builder with application/json body
-func NewDismissItemTagRequest(server string, body DismissItemTagJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDismissItemTagRequestWithBody(server, "application/json", bodyReader)
+
+	return req, nil
 }
 
-// NewDismissItemTagRequestWithBody generates requests for DismissItemTag with any type of body
-func NewDismissItemTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUploadAssetsBatchRequestWithBody generates requests for UploadAssetsBatch with any type of body
+func NewUploadAssetsBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1440,7 +2576,7 @@ func NewDismissItemTagRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/items/dismiss-tag")
+	operationPath := fmt.Sprintf("/v1/assets/batch")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1460,19 +2596,8 @@ func NewDismissItemTagRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewSuggestItemTagsRequest calls the generic SuggestItemTags builder with application/json body
-func NewSuggestItemTagsRequest(server string, body SuggestItemTagsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSuggestItemTagsRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewSuggestItemTagsRequestWithBody generates requests for SuggestItemTags with any type of body
-func NewSuggestItemTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewListAssetsRequest generates requests for ListAssets
+func NewListAssetsRequest(server string, params *ListAssetsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1480,7 +2605,7 @@ func NewSuggestItemTagsRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/items/suggest-tags")
+	operationPath := fmt.Sprintf("/v1/assets/list")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1490,18 +2615,36 @@ func NewSuggestItemTagsRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Date != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "date", *params.Date, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetChangesRequest generates requests for GetChanges
-func NewGetChangesRequest(server string, params *GetChangesParams) (*http.Request, error) {
+// NewGetAuditEventsRequest generates requests for GetAuditEvents
+func NewGetAuditEventsRequest(server string, params *GetAuditEventsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1509,7 +2652,7 @@ func NewGetChangesRequest(server string, params *GetChangesParams) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/sync/changes")
+	operationPath := fmt.Sprintf("/v1/audit")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1522,8 +2665,8 @@ func NewGetChangesRequest(server string, params *GetChangesParams) (*http.Reques
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Since != nil {
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "since", *params.Since, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+		if params.Before != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "before", *params.Before, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1561,8 +2704,19 @@ func NewGetChangesRequest(server string, params *GetChangesParams) (*http.Reques
 	return req, nil
 }
 
-// NewGetTagsRequest generates requests for GetTags
-func NewGetTagsRequest(server string) (*http.Request, error) {
+// NewAuthorizeRequest calls the generic Authorize builder with application/json body
+func NewAuthorizeRequest(server string, body AuthorizeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthorizeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthorizeRequestWithBody generates requests for Authorize with any type of body
+func NewAuthorizeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1570,7 +2724,7 @@ func NewGetTagsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/tags")
+	operationPath := fmt.Sprintf("/v1/authorize")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1580,16 +2734,29 @@ func NewGetTagsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetTagStatsRequest generates requests for GetTagStats
-func NewGetTagStatsRequest(server string) (*http.Request, error) {
+// NewAuthorizeTwoFactorRequest calls the generic AuthorizeTwoFactor builder with application/json body
+func NewAuthorizeTwoFactorRequest(server string, body AuthorizeTwoFactorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthorizeTwoFactorRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthorizeTwoFactorRequestWithBody generates requests for AuthorizeTwoFactor with any type of body
+func NewAuthorizeTwoFactorRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1597,7 +2764,7 @@ func NewGetTagStatsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/tags/stats")
+	operationPath := fmt.Sprintf("/v1/authorize/2fa")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1607,31 +2774,26 @@ func NewGetTagStatsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDeleteTagRequest generates requests for DeleteTag
-func NewDeleteTagRequest(server string, name string) (*http.Request, error) {
+// NewGetFamilyRequest generates requests for GetFamily
+func NewGetFamilyRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/tags/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/family")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1641,7 +2803,7 @@ func NewDeleteTagRequest(server string, name string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1649,34 +2811,27 @@ func NewDeleteTagRequest(server string, name string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewRenameTagRequest calls the generic RenameTag builder with application/json body
-func NewRenameTagRequest(server string, name string, body RenameTagJSONRequestBody) (*http.Request, error) {
+// NewUpdateFamilySettingsRequest calls the generic UpdateFamilySettings builder with application/json body
+func NewUpdateFamilySettingsRequest(server string, body UpdateFamilySettingsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewRenameTagRequestWithBody(server, name, "application/json", bodyReader)
+	return NewUpdateFamilySettingsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewRenameTagRequestWithBody generates requests for RenameTag with any type of body
-func NewRenameTagRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateFamilySettingsRequestWithBody generates requests for UpdateFamilySettings with any type of body
+func NewUpdateFamilySettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/tags/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/family")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1696,8 +2851,8 @@ func NewRenameTagRequestWithBody(server string, name string, contentType string,
 	return req, nil
 }
 
-// NewGetUserRequest generates requests for GetUser
-func NewGetUserRequest(server string) (*http.Request, error) {
+// NewGetFamilyAIUsageRequest generates requests for GetFamilyAIUsage
+func NewGetFamilyAIUsageRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1705,7 +2860,7 @@ func NewGetUserRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/user")
+	operationPath := fmt.Sprintf("/v1/family/ai-usage")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1723,919 +2878,4646 @@ func NewGetUserRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetCustomFieldsRequest generates requests for GetCustomFields
+func NewGetCustomFieldsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	operationPath := fmt.Sprintf("/v1/fields")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewCreateCustomFieldRequest calls the generic CreateCustomField builder with application/json body
+func NewCreateCustomFieldRequest(server string, body CreateCustomFieldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCustomFieldRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAssetWithResponse request
-	GetAssetWithResponse(ctx context.Context, params *GetAssetParams, reqEditors ...RequestEditorFn) (*GetAssetResponse, error)
+// NewCreateCustomFieldRequestWithBody generates requests for CreateCustomField with any type of body
+func NewCreateCustomFieldRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// UploadAssetsBatchWithBodyWithResponse request with any body
-	UploadAssetsBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAssetsBatchResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AuthorizeWithBodyWithResponse request with any body
-	AuthorizeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error)
+	operationPath := fmt.Sprintf("/v1/fields")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	AuthorizeWithResponse(ctx context.Context, body AuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetFamilyWithResponse request
-	GetFamilyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFamilyResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateFamilySettingsWithBodyWithResponse request with any body
-	UpdateFamilySettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFamilySettingsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	UpdateFamilySettingsWithResponse(ctx context.Context, body UpdateFamilySettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFamilySettingsResponse, error)
+	return req, nil
+}
 
-	// FixHealthIssuesWithBodyWithResponse request with any body
-	FixHealthIssuesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FixHealthIssuesResponse, error)
+// NewDeleteCustomFieldRequest generates requests for DeleteCustomField
+func NewDeleteCustomFieldRequest(server string, key string) (*http.Request, error) {
+	var err error
 
-	FixHealthIssuesWithResponse(ctx context.Context, body FixHealthIssuesJSONRequestBody, reqEditors ...RequestEditorFn) (*FixHealthIssuesResponse, error)
+	var pathParam0 string
 
-	// GetHealthIssuesWithResponse request
-	GetHealthIssuesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthIssuesResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "key", key, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteOrphanWithResponse request
-	DeleteOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*DeleteOrphanResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AttachOrphanWithBodyWithResponse request with any body
-	AttachOrphanWithBodyWithResponse(ctx context.Context, filename string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachOrphanResponse, error)
+	operationPath := fmt.Sprintf("/v1/fields/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	AttachOrphanWithResponse(ctx context.Context, filename string, body AttachOrphanJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachOrphanResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UnignoreOrphanWithResponse request
-	UnignoreOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*UnignoreOrphanResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// IgnoreOrphanWithResponse request
-	IgnoreOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*IgnoreOrphanResponse, error)
+	return req, nil
+}
 
-	// GetItemsWithResponse request
-	GetItemsWithResponse(ctx context.Context, params *GetItemsParams, reqEditors ...RequestEditorFn) (*GetItemsResponse, error)
+// NewUpdateCustomFieldRequest calls the generic UpdateCustomField builder with application/json body
+func NewUpdateCustomFieldRequest(server string, key string, body UpdateCustomFieldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCustomFieldRequestWithBody(server, key, "application/json", bodyReader)
+}
 
-	// PutItemsWithBodyWithResponse request with any body
-	PutItemsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutItemsResponse, error)
+// NewUpdateCustomFieldRequestWithBody generates requests for UpdateCustomField with any type of body
+func NewUpdateCustomFieldRequestWithBody(server string, key string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	PutItemsWithResponse(ctx context.Context, body PutItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutItemsResponse, error)
+	var pathParam0 string
 
-	// AcceptItemTagWithBodyWithResponse request with any body
-	AcceptItemTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcceptItemTagResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "key", key, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	AcceptItemTagWithResponse(ctx context.Context, body AcceptItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*AcceptItemTagResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DismissItemTagWithBodyWithResponse request with any body
-	DismissItemTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DismissItemTagResponse, error)
+	operationPath := fmt.Sprintf("/v1/fields/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	DismissItemTagWithResponse(ctx context.Context, body DismissItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*DismissItemTagResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// SuggestItemTagsWithBodyWithResponse request with any body
-	SuggestItemTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SuggestItemTagsResponse, error)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	SuggestItemTagsWithResponse(ctx context.Context, body SuggestItemTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SuggestItemTagsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetChangesWithResponse request
-	GetChangesWithResponse(ctx context.Context, params *GetChangesParams, reqEditors ...RequestEditorFn) (*GetChangesResponse, error)
+	return req, nil
+}
 
-	// GetTagsWithResponse request
-	GetTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagsResponse, error)
+// NewGetCustomFieldStatsRequest generates requests for GetCustomFieldStats
+func NewGetCustomFieldStatsRequest(server string, key string, params *GetCustomFieldStatsParams) (*http.Request, error) {
+	var err error
 
-	// GetTagStatsWithResponse request
-	GetTagStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagStatsResponse, error)
+	var pathParam0 string
 
-	// DeleteTagWithResponse request
-	DeleteTagWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "key", key, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// RenameTagWithBodyWithResponse request with any body
-	RenameTagWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenameTagResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	RenameTagWithResponse(ctx context.Context, name string, body RenameTagJSONRequestBody, reqEditors ...RequestEditorFn) (*RenameTagResponse, error)
+	operationPath := fmt.Sprintf("/v1/fields/%s/stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetUserWithResponse request
-	GetUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-type GetAssetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetAssetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.From != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.To != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAssetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UploadAssetsBatchResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AssetsBatchResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UploadAssetsBatchResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewFixHealthIssuesRequest calls the generic FixHealthIssues builder with application/json body
+func NewFixHealthIssuesRequest(server string, body FixHealthIssuesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewFixHealthIssuesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadAssetsBatchResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewFixHealthIssuesRequestWithBody generates requests for FixHealthIssues with any type of body
+func NewFixHealthIssuesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type AuthorizeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Token string `json:"token"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AuthorizeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/v1/health/fix")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AuthorizeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetFamilyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FamilyResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r GetFamilyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFamilyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	req.Header.Add("Content-Type", contentType)
 
-type UpdateFamilySettingsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FamilyResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateFamilySettingsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetHealthIssuesRequest generates requests for GetHealthIssues
+func NewGetHealthIssuesRequest(server string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateFamilySettingsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type FixHealthIssuesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HealthIssuesResponse
-}
+	operationPath := fmt.Sprintf("/v1/health/issues")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r FixHealthIssuesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FixHealthIssuesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetHealthIssuesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HealthIssuesResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetHealthIssuesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDeleteOrphanRequest generates requests for DeleteOrphan
+func NewDeleteOrphanRequest(server string, filename string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "filename", filename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthIssuesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteOrphanResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HealthIssuesResponse
-}
+	operationPath := fmt.Sprintf("/v1/health/orphans/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteOrphanResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteOrphanResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AttachOrphanResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HealthIssuesResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AttachOrphanResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewAttachOrphanRequest calls the generic AttachOrphan builder with application/json body
+func NewAttachOrphanRequest(server string, filename string, body AttachOrphanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewAttachOrphanRequestWithBody(server, filename, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AttachOrphanResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewAttachOrphanRequestWithBody generates requests for AttachOrphan with any type of body
+func NewAttachOrphanRequestWithBody(server string, filename string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type UnignoreOrphanResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HealthIssuesResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r UnignoreOrphanResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "filename", filename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UnignoreOrphanResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type IgnoreOrphanResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HealthIssuesResponse
-}
+	operationPath := fmt.Sprintf("/v1/health/orphans/%s/attach", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r IgnoreOrphanResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r IgnoreOrphanResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetItemsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemsListResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetItemsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetItemsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUnignoreOrphanRequest generates requests for UnignoreOrphan
+func NewUnignoreOrphanRequest(server string, filename string) (*http.Request, error) {
+	var err error
 
-type PutItemsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemsResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r PutItemsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "filename", filename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/health/orphans/%s/ignore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewIgnoreOrphanRequest generates requests for IgnoreOrphan
+func NewIgnoreOrphanRequest(server string, filename string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "filename", filename, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/health/orphans/%s/ignore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetItemsRequest generates requests for GetItems
+func NewGetItemsRequest(server string, params *GetItemsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Date != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "date", *params.Date, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Search != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search", *params.Search, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Tags != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tags", *params.Tags, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Fields != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "fields", *params.Fields, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutItemsRequest calls the generic PutItems builder with application/json body
+func NewPutItemsRequest(server string, body PutItemsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutItemsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutItemsRequestWithBody generates requests for PutItems with any type of body
+func NewPutItemsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAcceptItemTagRequest calls the generic AcceptItemTag builder with application/json body
+func NewAcceptItemTagRequest(server string, body AcceptItemTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAcceptItemTagRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAcceptItemTagRequestWithBody generates requests for AcceptItemTag with any type of body
+func NewAcceptItemTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/accept-tag")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDismissItemTagRequest calls the generic DismissItemTag builder with application/json body
+func NewDismissItemTagRequest(server string, body DismissItemTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDismissItemTagRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDismissItemTagRequestWithBody generates requests for DismissItemTag with any type of body
+func NewDismissItemTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/dismiss-tag")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSemanticSearchItemsRequest generates requests for SemanticSearchItems
+func NewSemanticSearchItemsRequest(server string, params *SemanticSearchItemsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/semantic")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "q", params.Q, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSuggestItemTagsRequest calls the generic SuggestItemTags builder with application/json body
+func NewSuggestItemTagsRequest(server string, body SuggestItemTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSuggestItemTagsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSuggestItemTagsRequestWithBody generates requests for SuggestItemTags with any type of body
+func NewSuggestItemTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/suggest-tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetItemBacklinksRequest generates requests for GetItemBacklinks
+func NewGetItemBacklinksRequest(server string, date openapi_types.Date) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "date", date, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "date"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s/backlinks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSummarizeItemRequest generates requests for SummarizeItem
+func NewSummarizeItemRequest(server string, date openapi_types.Date) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "date", date, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "date"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s/summary", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTranscribeItemAssetRequest calls the generic TranscribeItemAsset builder with application/json body
+func NewTranscribeItemAssetRequest(server string, date openapi_types.Date, body TranscribeItemAssetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTranscribeItemAssetRequestWithBody(server, date, "application/json", bodyReader)
+}
+
+// NewTranscribeItemAssetRequestWithBody generates requests for TranscribeItemAsset with any type of body
+func NewTranscribeItemAssetRequestWithBody(server string, date openapi_types.Date, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "date", date, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "date"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s/transcribe", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetJobsRequest generates requests for GetJobs
+func NewGetJobsRequest(server string, params *GetJobsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMemoriesRequest generates requests for GetMemories
+func NewGetMemoriesRequest(server string, params *GetMemoriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/memories")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Date != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "date", *params.Date, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPlacesRequest generates requests for GetPlaces
+func NewGetPlacesRequest(server string, params *GetPlacesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/places")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.To != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRecapsRequest generates requests for GetRecaps
+func NewGetRecapsRequest(server string, params *GetRecapsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/recaps")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Period != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "period", *params.Period, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSessionsRequest generates requests for GetSessions
+func NewGetSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeOtherSessionsRequest generates requests for RevokeOtherSessions
+func NewRevokeOtherSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions/revoke-others")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeSessionRequest generates requests for RevokeSession
+func NewRevokeSessionRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatsRequest generates requests for GetStats
+func NewGetStatsRequest(server string, params *GetStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.To != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetChangesRequest generates requests for GetChanges
+func NewGetChangesRequest(server string, params *GetChangesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sync/changes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Since != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "since", *params.Since, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		if params.Limit != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTagsRequest generates requests for GetTags
+func NewGetTagsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTagConsolidationRequest generates requests for GetTagConsolidation
+func NewGetTagConsolidationRequest(server string, params *GetTagConsolidationParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tags/consolidation")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Ai != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "ai", *params.Ai, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMergeTagsRequest calls the generic MergeTags builder with application/json body
+func NewMergeTagsRequest(server string, body MergeTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMergeTagsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewMergeTagsRequestWithBody generates requests for MergeTags with any type of body
+func NewMergeTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tags/merge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTagStatsRequest generates requests for GetTagStats
+func NewGetTagStatsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tags/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTagTreeRequest generates requests for GetTagTree
+func NewGetTagTreeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tags/tree")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteTagRequest generates requests for DeleteTag
+func NewDeleteTagRequest(server string, name string, params *DeleteTagParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Subtree != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "subtree", *params.Subtree, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTagRequest calls the generic UpdateTag builder with application/json body
+func NewUpdateTagRequest(server string, name string, body UpdateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTagRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewUpdateTagRequestWithBody generates requests for UpdateTag with any type of body
+func NewUpdateTagRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAccessTokensRequest generates requests for GetAccessTokens
+func NewGetAccessTokensRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateAccessTokenRequest calls the generic CreateAccessToken builder with application/json body
+func NewCreateAccessTokenRequest(server string, body CreateAccessTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAccessTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateAccessTokenRequestWithBody generates requests for CreateAccessToken with any type of body
+func NewCreateAccessTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeAccessTokenRequest generates requests for RevokeAccessToken
+func NewRevokeAccessTokenRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserRequest generates requests for GetUser
+func NewGetUserRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmTwoFactorRequest calls the generic ConfirmTwoFactor builder with application/json body
+func NewConfirmTwoFactorRequest(server string, body ConfirmTwoFactorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmTwoFactorRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConfirmTwoFactorRequestWithBody generates requests for ConfirmTwoFactor with any type of body
+func NewConfirmTwoFactorRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/2fa/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDisableTwoFactorRequest calls the generic DisableTwoFactor builder with application/json body
+func NewDisableTwoFactorRequest(server string, body DisableTwoFactorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDisableTwoFactorRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDisableTwoFactorRequestWithBody generates requests for DisableTwoFactor with any type of body
+func NewDisableTwoFactorRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/2fa/disable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEnrollTwoFactorRequest generates requests for EnrollTwoFactor
+func NewEnrollTwoFactorRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/2fa/enroll")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRegenerateRecoveryCodesRequest calls the generic RegenerateRecoveryCodes builder with application/json body
+func NewRegenerateRecoveryCodesRequest(server string, body RegenerateRecoveryCodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegenerateRecoveryCodesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegenerateRecoveryCodesRequestWithBody generates requests for RegenerateRecoveryCodes with any type of body
+func NewRegenerateRecoveryCodesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/2fa/recovery-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AskDiaryWithBodyWithResponse request with any body
+	AskDiaryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AskDiaryResponse, error)
+
+	AskDiaryWithResponse(ctx context.Context, body AskDiaryJSONRequestBody, reqEditors ...RequestEditorFn) (*AskDiaryResponse, error)
+
+	// GetAssetWithResponse request
+	GetAssetWithResponse(ctx context.Context, params *GetAssetParams, reqEditors ...RequestEditorFn) (*GetAssetResponse, error)
+
+	// UploadAssetsBatchWithBodyWithResponse request with any body
+	UploadAssetsBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAssetsBatchResponse, error)
+
+	// ListAssetsWithResponse request
+	ListAssetsWithResponse(ctx context.Context, params *ListAssetsParams, reqEditors ...RequestEditorFn) (*ListAssetsResponse, error)
+
+	// GetAuditEventsWithResponse request
+	GetAuditEventsWithResponse(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*GetAuditEventsResponse, error)
+
+	// AuthorizeWithBodyWithResponse request with any body
+	AuthorizeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error)
+
+	AuthorizeWithResponse(ctx context.Context, body AuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error)
+
+	// AuthorizeTwoFactorWithBodyWithResponse request with any body
+	AuthorizeTwoFactorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthorizeTwoFactorResponse, error)
+
+	AuthorizeTwoFactorWithResponse(ctx context.Context, body AuthorizeTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthorizeTwoFactorResponse, error)
+
+	// GetFamilyWithResponse request
+	GetFamilyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFamilyResponse, error)
+
+	// UpdateFamilySettingsWithBodyWithResponse request with any body
+	UpdateFamilySettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFamilySettingsResponse, error)
+
+	UpdateFamilySettingsWithResponse(ctx context.Context, body UpdateFamilySettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFamilySettingsResponse, error)
+
+	// GetFamilyAIUsageWithResponse request
+	GetFamilyAIUsageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFamilyAIUsageResponse, error)
+
+	// GetCustomFieldsWithResponse request
+	GetCustomFieldsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCustomFieldsResponse, error)
+
+	// CreateCustomFieldWithBodyWithResponse request with any body
+	CreateCustomFieldWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCustomFieldResponse, error)
+
+	CreateCustomFieldWithResponse(ctx context.Context, body CreateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCustomFieldResponse, error)
+
+	// DeleteCustomFieldWithResponse request
+	DeleteCustomFieldWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*DeleteCustomFieldResponse, error)
+
+	// UpdateCustomFieldWithBodyWithResponse request with any body
+	UpdateCustomFieldWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCustomFieldResponse, error)
+
+	UpdateCustomFieldWithResponse(ctx context.Context, key string, body UpdateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCustomFieldResponse, error)
+
+	// GetCustomFieldStatsWithResponse request
+	GetCustomFieldStatsWithResponse(ctx context.Context, key string, params *GetCustomFieldStatsParams, reqEditors ...RequestEditorFn) (*GetCustomFieldStatsResponse, error)
+
+	// FixHealthIssuesWithBodyWithResponse request with any body
+	FixHealthIssuesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FixHealthIssuesResponse, error)
+
+	FixHealthIssuesWithResponse(ctx context.Context, body FixHealthIssuesJSONRequestBody, reqEditors ...RequestEditorFn) (*FixHealthIssuesResponse, error)
+
+	// GetHealthIssuesWithResponse request
+	GetHealthIssuesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthIssuesResponse, error)
+
+	// DeleteOrphanWithResponse request
+	DeleteOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*DeleteOrphanResponse, error)
+
+	// AttachOrphanWithBodyWithResponse request with any body
+	AttachOrphanWithBodyWithResponse(ctx context.Context, filename string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachOrphanResponse, error)
+
+	AttachOrphanWithResponse(ctx context.Context, filename string, body AttachOrphanJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachOrphanResponse, error)
+
+	// UnignoreOrphanWithResponse request
+	UnignoreOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*UnignoreOrphanResponse, error)
+
+	// IgnoreOrphanWithResponse request
+	IgnoreOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*IgnoreOrphanResponse, error)
+
+	// GetItemsWithResponse request
+	GetItemsWithResponse(ctx context.Context, params *GetItemsParams, reqEditors ...RequestEditorFn) (*GetItemsResponse, error)
+
+	// PutItemsWithBodyWithResponse request with any body
+	PutItemsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutItemsResponse, error)
+
+	PutItemsWithResponse(ctx context.Context, body PutItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutItemsResponse, error)
+
+	// AcceptItemTagWithBodyWithResponse request with any body
+	AcceptItemTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcceptItemTagResponse, error)
+
+	AcceptItemTagWithResponse(ctx context.Context, body AcceptItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*AcceptItemTagResponse, error)
+
+	// DismissItemTagWithBodyWithResponse request with any body
+	DismissItemTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DismissItemTagResponse, error)
+
+	DismissItemTagWithResponse(ctx context.Context, body DismissItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*DismissItemTagResponse, error)
+
+	// SemanticSearchItemsWithResponse request
+	SemanticSearchItemsWithResponse(ctx context.Context, params *SemanticSearchItemsParams, reqEditors ...RequestEditorFn) (*SemanticSearchItemsResponse, error)
+
+	// SuggestItemTagsWithBodyWithResponse request with any body
+	SuggestItemTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SuggestItemTagsResponse, error)
+
+	SuggestItemTagsWithResponse(ctx context.Context, body SuggestItemTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SuggestItemTagsResponse, error)
+
+	// GetItemBacklinksWithResponse request
+	GetItemBacklinksWithResponse(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*GetItemBacklinksResponse, error)
+
+	// SummarizeItemWithResponse request
+	SummarizeItemWithResponse(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*SummarizeItemResponse, error)
+
+	// TranscribeItemAssetWithBodyWithResponse request with any body
+	TranscribeItemAssetWithBodyWithResponse(ctx context.Context, date openapi_types.Date, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TranscribeItemAssetResponse, error)
+
+	TranscribeItemAssetWithResponse(ctx context.Context, date openapi_types.Date, body TranscribeItemAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*TranscribeItemAssetResponse, error)
+
+	// GetJobsWithResponse request
+	GetJobsWithResponse(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*GetJobsResponse, error)
+
+	// GetMemoriesWithResponse request
+	GetMemoriesWithResponse(ctx context.Context, params *GetMemoriesParams, reqEditors ...RequestEditorFn) (*GetMemoriesResponse, error)
+
+	// GetPlacesWithResponse request
+	GetPlacesWithResponse(ctx context.Context, params *GetPlacesParams, reqEditors ...RequestEditorFn) (*GetPlacesResponse, error)
+
+	// GetRecapsWithResponse request
+	GetRecapsWithResponse(ctx context.Context, params *GetRecapsParams, reqEditors ...RequestEditorFn) (*GetRecapsResponse, error)
+
+	// GetSessionsWithResponse request
+	GetSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSessionsResponse, error)
+
+	// RevokeOtherSessionsWithResponse request
+	RevokeOtherSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RevokeOtherSessionsResponse, error)
+
+	// RevokeSessionWithResponse request
+	RevokeSessionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeSessionResponse, error)
+
+	// GetStatsWithResponse request
+	GetStatsWithResponse(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*GetStatsResponse, error)
+
+	// GetChangesWithResponse request
+	GetChangesWithResponse(ctx context.Context, params *GetChangesParams, reqEditors ...RequestEditorFn) (*GetChangesResponse, error)
+
+	// GetTagsWithResponse request
+	GetTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagsResponse, error)
+
+	// GetTagConsolidationWithResponse request
+	GetTagConsolidationWithResponse(ctx context.Context, params *GetTagConsolidationParams, reqEditors ...RequestEditorFn) (*GetTagConsolidationResponse, error)
+
+	// MergeTagsWithBodyWithResponse request with any body
+	MergeTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeTagsResponse, error)
+
+	MergeTagsWithResponse(ctx context.Context, body MergeTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeTagsResponse, error)
+
+	// GetTagStatsWithResponse request
+	GetTagStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagStatsResponse, error)
+
+	// GetTagTreeWithResponse request
+	GetTagTreeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagTreeResponse, error)
+
+	// DeleteTagWithResponse request
+	DeleteTagWithResponse(ctx context.Context, name string, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error)
+
+	// UpdateTagWithBodyWithResponse request with any body
+	UpdateTagWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	UpdateTagWithResponse(ctx context.Context, name string, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	// GetAccessTokensWithResponse request
+	GetAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccessTokensResponse, error)
+
+	// CreateAccessTokenWithBodyWithResponse request with any body
+	CreateAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error)
+
+	CreateAccessTokenWithResponse(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error)
+
+	// RevokeAccessTokenWithResponse request
+	RevokeAccessTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeAccessTokenResponse, error)
+
+	// GetUserWithResponse request
+	GetUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
+
+	// ConfirmTwoFactorWithBodyWithResponse request with any body
+	ConfirmTwoFactorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorResponse, error)
+
+	ConfirmTwoFactorWithResponse(ctx context.Context, body ConfirmTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorResponse, error)
+
+	// DisableTwoFactorWithBodyWithResponse request with any body
+	DisableTwoFactorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTwoFactorResponse, error)
+
+	DisableTwoFactorWithResponse(ctx context.Context, body DisableTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTwoFactorResponse, error)
+
+	// EnrollTwoFactorWithResponse request
+	EnrollTwoFactorWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnrollTwoFactorResponse, error)
+
+	// RegenerateRecoveryCodesWithBodyWithResponse request with any body
+	RegenerateRecoveryCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error)
+
+	RegenerateRecoveryCodesWithResponse(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error)
+}
+
+type AskDiaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AskResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AskDiaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AskDiaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAssetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAssetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAssetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadAssetsBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetsBatchResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadAssetsBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadAssetsBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAssetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AssetInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAssetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAssetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuditEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLogResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthorizeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Token string `json:"token"`
+	}
+	JSON202 *TwoFactorChallenge
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthorizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthorizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthorizeTwoFactorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Token string `json:"token"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthorizeTwoFactorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthorizeTwoFactorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFamilyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FamilyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFamilyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFamilyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateFamilySettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FamilyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateFamilySettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateFamilySettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFamilyAIUsageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AIUsageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFamilyAIUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFamilyAIUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCustomFieldsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomFieldsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCustomFieldsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCustomFieldsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCustomFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CustomField
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCustomFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCustomFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCustomFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCustomFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCustomFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCustomFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomField
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCustomFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCustomFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCustomFieldStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomFieldStats
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCustomFieldStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCustomFieldStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FixHealthIssuesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthIssuesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r FixHealthIssuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FixHealthIssuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthIssuesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthIssuesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthIssuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthIssuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteOrphanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthIssuesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrphanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrphanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AttachOrphanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthIssuesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachOrphanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachOrphanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnignoreOrphanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthIssuesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnignoreOrphanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnignoreOrphanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IgnoreOrphanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthIssuesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r IgnoreOrphanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IgnoreOrphanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemsListResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AcceptItemTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AcceptItemTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcceptItemTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DismissItemTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DismissItemTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DismissItemTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SemanticSearchItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SemanticSearchResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SemanticSearchItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SemanticSearchItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SuggestItemTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuggestTagsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SuggestItemTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SuggestItemTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetItemBacklinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BacklinksResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetItemBacklinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetItemBacklinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SummarizeItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemSummary
+}
+
+// Status returns HTTPResponse.Status
+func (r SummarizeItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SummarizeItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TranscribeItemAssetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TranscribeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TranscribeItemAssetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TranscribeItemAssetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMemoriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemoriesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMemoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMemoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPlacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PlacesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPlacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPlacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRecapsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Recap
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRecapsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecapsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Session
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeOtherSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RevokedSessions
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeOtherSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeOtherSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SyncResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTagConsolidationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagConsolidationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTagConsolidationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTagConsolidationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MergeTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagMergeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MergeTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MergeTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTagStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagStatsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTagStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTagStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTagTreeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagTreeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTagTreeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTagTreeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagMetadata
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccessTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AccessToken
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccessTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccessTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAccessTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedAccessToken
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeAccessTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmTwoFactorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmTwoFactorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmTwoFactorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisableTwoFactorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableTwoFactorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableTwoFactorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnrollTwoFactorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TwoFactorEnrollment
+}
+
+// Status returns HTTPResponse.Status
+func (r EnrollTwoFactorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnrollTwoFactorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegenerateRecoveryCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RegenerateRecoveryCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
 	return http.StatusText(0)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutItemsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegenerateRecoveryCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AskDiaryWithBodyWithResponse request with arbitrary body returning *AskDiaryResponse
+func (c *ClientWithResponses) AskDiaryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AskDiaryResponse, error) {
+	rsp, err := c.AskDiaryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAskDiaryResponse(rsp)
+}
+
+func (c *ClientWithResponses) AskDiaryWithResponse(ctx context.Context, body AskDiaryJSONRequestBody, reqEditors ...RequestEditorFn) (*AskDiaryResponse, error) {
+	rsp, err := c.AskDiary(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAskDiaryResponse(rsp)
+}
+
+// GetAssetWithResponse request returning *GetAssetResponse
+func (c *ClientWithResponses) GetAssetWithResponse(ctx context.Context, params *GetAssetParams, reqEditors ...RequestEditorFn) (*GetAssetResponse, error) {
+	rsp, err := c.GetAsset(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssetResponse(rsp)
+}
+
+// UploadAssetsBatchWithBodyWithResponse request with arbitrary body returning *UploadAssetsBatchResponse
+func (c *ClientWithResponses) UploadAssetsBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAssetsBatchResponse, error) {
+	rsp, err := c.UploadAssetsBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadAssetsBatchResponse(rsp)
+}
+
+// ListAssetsWithResponse request returning *ListAssetsResponse
+func (c *ClientWithResponses) ListAssetsWithResponse(ctx context.Context, params *ListAssetsParams, reqEditors ...RequestEditorFn) (*ListAssetsResponse, error) {
+	rsp, err := c.ListAssets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAssetsResponse(rsp)
+}
+
+// GetAuditEventsWithResponse request returning *GetAuditEventsResponse
+func (c *ClientWithResponses) GetAuditEventsWithResponse(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*GetAuditEventsResponse, error) {
+	rsp, err := c.GetAuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditEventsResponse(rsp)
+}
+
+// AuthorizeWithBodyWithResponse request with arbitrary body returning *AuthorizeResponse
+func (c *ClientWithResponses) AuthorizeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error) {
+	rsp, err := c.AuthorizeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthorizeResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthorizeWithResponse(ctx context.Context, body AuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error) {
+	rsp, err := c.Authorize(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthorizeResponse(rsp)
+}
+
+// AuthorizeTwoFactorWithBodyWithResponse request with arbitrary body returning *AuthorizeTwoFactorResponse
+func (c *ClientWithResponses) AuthorizeTwoFactorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthorizeTwoFactorResponse, error) {
+	rsp, err := c.AuthorizeTwoFactorWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthorizeTwoFactorResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthorizeTwoFactorWithResponse(ctx context.Context, body AuthorizeTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthorizeTwoFactorResponse, error) {
+	rsp, err := c.AuthorizeTwoFactor(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthorizeTwoFactorResponse(rsp)
+}
+
+// GetFamilyWithResponse request returning *GetFamilyResponse
+func (c *ClientWithResponses) GetFamilyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFamilyResponse, error) {
+	rsp, err := c.GetFamily(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFamilyResponse(rsp)
+}
+
+// UpdateFamilySettingsWithBodyWithResponse request with arbitrary body returning *UpdateFamilySettingsResponse
+func (c *ClientWithResponses) UpdateFamilySettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFamilySettingsResponse, error) {
+	rsp, err := c.UpdateFamilySettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFamilySettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateFamilySettingsWithResponse(ctx context.Context, body UpdateFamilySettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFamilySettingsResponse, error) {
+	rsp, err := c.UpdateFamilySettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFamilySettingsResponse(rsp)
+}
+
+// GetFamilyAIUsageWithResponse request returning *GetFamilyAIUsageResponse
+func (c *ClientWithResponses) GetFamilyAIUsageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFamilyAIUsageResponse, error) {
+	rsp, err := c.GetFamilyAIUsage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFamilyAIUsageResponse(rsp)
+}
+
+// GetCustomFieldsWithResponse request returning *GetCustomFieldsResponse
+func (c *ClientWithResponses) GetCustomFieldsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCustomFieldsResponse, error) {
+	rsp, err := c.GetCustomFields(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCustomFieldsResponse(rsp)
+}
+
+// CreateCustomFieldWithBodyWithResponse request with arbitrary body returning *CreateCustomFieldResponse
+func (c *ClientWithResponses) CreateCustomFieldWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCustomFieldResponse, error) {
+	rsp, err := c.CreateCustomFieldWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCustomFieldResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCustomFieldWithResponse(ctx context.Context, body CreateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCustomFieldResponse, error) {
+	rsp, err := c.CreateCustomField(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCustomFieldResponse(rsp)
+}
+
+// DeleteCustomFieldWithResponse request returning *DeleteCustomFieldResponse
+func (c *ClientWithResponses) DeleteCustomFieldWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*DeleteCustomFieldResponse, error) {
+	rsp, err := c.DeleteCustomField(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCustomFieldResponse(rsp)
+}
+
+// UpdateCustomFieldWithBodyWithResponse request with arbitrary body returning *UpdateCustomFieldResponse
+func (c *ClientWithResponses) UpdateCustomFieldWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCustomFieldResponse, error) {
+	rsp, err := c.UpdateCustomFieldWithBody(ctx, key, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCustomFieldResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCustomFieldWithResponse(ctx context.Context, key string, body UpdateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCustomFieldResponse, error) {
+	rsp, err := c.UpdateCustomField(ctx, key, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCustomFieldResponse(rsp)
+}
+
+// GetCustomFieldStatsWithResponse request returning *GetCustomFieldStatsResponse
+func (c *ClientWithResponses) GetCustomFieldStatsWithResponse(ctx context.Context, key string, params *GetCustomFieldStatsParams, reqEditors ...RequestEditorFn) (*GetCustomFieldStatsResponse, error) {
+	rsp, err := c.GetCustomFieldStats(ctx, key, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCustomFieldStatsResponse(rsp)
+}
+
+// FixHealthIssuesWithBodyWithResponse request with arbitrary body returning *FixHealthIssuesResponse
+func (c *ClientWithResponses) FixHealthIssuesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FixHealthIssuesResponse, error) {
+	rsp, err := c.FixHealthIssuesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFixHealthIssuesResponse(rsp)
+}
+
+func (c *ClientWithResponses) FixHealthIssuesWithResponse(ctx context.Context, body FixHealthIssuesJSONRequestBody, reqEditors ...RequestEditorFn) (*FixHealthIssuesResponse, error) {
+	rsp, err := c.FixHealthIssues(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFixHealthIssuesResponse(rsp)
+}
+
+// GetHealthIssuesWithResponse request returning *GetHealthIssuesResponse
+func (c *ClientWithResponses) GetHealthIssuesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthIssuesResponse, error) {
+	rsp, err := c.GetHealthIssues(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthIssuesResponse(rsp)
+}
+
+// DeleteOrphanWithResponse request returning *DeleteOrphanResponse
+func (c *ClientWithResponses) DeleteOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*DeleteOrphanResponse, error) {
+	rsp, err := c.DeleteOrphan(ctx, filename, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrphanResponse(rsp)
+}
+
+// AttachOrphanWithBodyWithResponse request with arbitrary body returning *AttachOrphanResponse
+func (c *ClientWithResponses) AttachOrphanWithBodyWithResponse(ctx context.Context, filename string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachOrphanResponse, error) {
+	rsp, err := c.AttachOrphanWithBody(ctx, filename, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachOrphanResponse(rsp)
+}
+
+func (c *ClientWithResponses) AttachOrphanWithResponse(ctx context.Context, filename string, body AttachOrphanJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachOrphanResponse, error) {
+	rsp, err := c.AttachOrphan(ctx, filename, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachOrphanResponse(rsp)
+}
+
+// UnignoreOrphanWithResponse request returning *UnignoreOrphanResponse
+func (c *ClientWithResponses) UnignoreOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*UnignoreOrphanResponse, error) {
+	rsp, err := c.UnignoreOrphan(ctx, filename, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnignoreOrphanResponse(rsp)
+}
+
+// IgnoreOrphanWithResponse request returning *IgnoreOrphanResponse
+func (c *ClientWithResponses) IgnoreOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*IgnoreOrphanResponse, error) {
+	rsp, err := c.IgnoreOrphan(ctx, filename, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIgnoreOrphanResponse(rsp)
+}
+
+// GetItemsWithResponse request returning *GetItemsResponse
+func (c *ClientWithResponses) GetItemsWithResponse(ctx context.Context, params *GetItemsParams, reqEditors ...RequestEditorFn) (*GetItemsResponse, error) {
+	rsp, err := c.GetItems(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetItemsResponse(rsp)
+}
+
+// PutItemsWithBodyWithResponse request with arbitrary body returning *PutItemsResponse
+func (c *ClientWithResponses) PutItemsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutItemsResponse, error) {
+	rsp, err := c.PutItemsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutItemsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutItemsWithResponse(ctx context.Context, body PutItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutItemsResponse, error) {
+	rsp, err := c.PutItems(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutItemsResponse(rsp)
+}
+
+// AcceptItemTagWithBodyWithResponse request with arbitrary body returning *AcceptItemTagResponse
+func (c *ClientWithResponses) AcceptItemTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcceptItemTagResponse, error) {
+	rsp, err := c.AcceptItemTagWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcceptItemTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) AcceptItemTagWithResponse(ctx context.Context, body AcceptItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*AcceptItemTagResponse, error) {
+	rsp, err := c.AcceptItemTag(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcceptItemTagResponse(rsp)
+}
+
+// DismissItemTagWithBodyWithResponse request with arbitrary body returning *DismissItemTagResponse
+func (c *ClientWithResponses) DismissItemTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DismissItemTagResponse, error) {
+	rsp, err := c.DismissItemTagWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDismissItemTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) DismissItemTagWithResponse(ctx context.Context, body DismissItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*DismissItemTagResponse, error) {
+	rsp, err := c.DismissItemTag(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDismissItemTagResponse(rsp)
+}
+
+// SemanticSearchItemsWithResponse request returning *SemanticSearchItemsResponse
+func (c *ClientWithResponses) SemanticSearchItemsWithResponse(ctx context.Context, params *SemanticSearchItemsParams, reqEditors ...RequestEditorFn) (*SemanticSearchItemsResponse, error) {
+	rsp, err := c.SemanticSearchItems(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSemanticSearchItemsResponse(rsp)
+}
+
+// SuggestItemTagsWithBodyWithResponse request with arbitrary body returning *SuggestItemTagsResponse
+func (c *ClientWithResponses) SuggestItemTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SuggestItemTagsResponse, error) {
+	rsp, err := c.SuggestItemTagsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSuggestItemTagsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SuggestItemTagsWithResponse(ctx context.Context, body SuggestItemTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SuggestItemTagsResponse, error) {
+	rsp, err := c.SuggestItemTags(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSuggestItemTagsResponse(rsp)
+}
+
+// GetItemBacklinksWithResponse request returning *GetItemBacklinksResponse
+func (c *ClientWithResponses) GetItemBacklinksWithResponse(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*GetItemBacklinksResponse, error) {
+	rsp, err := c.GetItemBacklinks(ctx, date, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetItemBacklinksResponse(rsp)
+}
+
+// SummarizeItemWithResponse request returning *SummarizeItemResponse
+func (c *ClientWithResponses) SummarizeItemWithResponse(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*SummarizeItemResponse, error) {
+	rsp, err := c.SummarizeItem(ctx, date, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSummarizeItemResponse(rsp)
+}
+
+// TranscribeItemAssetWithBodyWithResponse request with arbitrary body returning *TranscribeItemAssetResponse
+func (c *ClientWithResponses) TranscribeItemAssetWithBodyWithResponse(ctx context.Context, date openapi_types.Date, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TranscribeItemAssetResponse, error) {
+	rsp, err := c.TranscribeItemAssetWithBody(ctx, date, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTranscribeItemAssetResponse(rsp)
+}
+
+func (c *ClientWithResponses) TranscribeItemAssetWithResponse(ctx context.Context, date openapi_types.Date, body TranscribeItemAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*TranscribeItemAssetResponse, error) {
+	rsp, err := c.TranscribeItemAsset(ctx, date, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTranscribeItemAssetResponse(rsp)
+}
+
+// GetJobsWithResponse request returning *GetJobsResponse
+func (c *ClientWithResponses) GetJobsWithResponse(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*GetJobsResponse, error) {
+	rsp, err := c.GetJobs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobsResponse(rsp)
+}
+
+// GetMemoriesWithResponse request returning *GetMemoriesResponse
+func (c *ClientWithResponses) GetMemoriesWithResponse(ctx context.Context, params *GetMemoriesParams, reqEditors ...RequestEditorFn) (*GetMemoriesResponse, error) {
+	rsp, err := c.GetMemories(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMemoriesResponse(rsp)
+}
+
+// GetPlacesWithResponse request returning *GetPlacesResponse
+func (c *ClientWithResponses) GetPlacesWithResponse(ctx context.Context, params *GetPlacesParams, reqEditors ...RequestEditorFn) (*GetPlacesResponse, error) {
+	rsp, err := c.GetPlaces(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPlacesResponse(rsp)
+}
+
+// GetRecapsWithResponse request returning *GetRecapsResponse
+func (c *ClientWithResponses) GetRecapsWithResponse(ctx context.Context, params *GetRecapsParams, reqEditors ...RequestEditorFn) (*GetRecapsResponse, error) {
+	rsp, err := c.GetRecaps(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecapsResponse(rsp)
+}
+
+// GetSessionsWithResponse request returning *GetSessionsResponse
+func (c *ClientWithResponses) GetSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSessionsResponse, error) {
+	rsp, err := c.GetSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionsResponse(rsp)
+}
+
+// RevokeOtherSessionsWithResponse request returning *RevokeOtherSessionsResponse
+func (c *ClientWithResponses) RevokeOtherSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RevokeOtherSessionsResponse, error) {
+	rsp, err := c.RevokeOtherSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeOtherSessionsResponse(rsp)
+}
+
+// RevokeSessionWithResponse request returning *RevokeSessionResponse
+func (c *ClientWithResponses) RevokeSessionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeSessionResponse, error) {
+	rsp, err := c.RevokeSession(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeSessionResponse(rsp)
+}
+
+// GetStatsWithResponse request returning *GetStatsResponse
+func (c *ClientWithResponses) GetStatsWithResponse(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*GetStatsResponse, error) {
+	rsp, err := c.GetStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsResponse(rsp)
+}
+
+// GetChangesWithResponse request returning *GetChangesResponse
+func (c *ClientWithResponses) GetChangesWithResponse(ctx context.Context, params *GetChangesParams, reqEditors ...RequestEditorFn) (*GetChangesResponse, error) {
+	rsp, err := c.GetChanges(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetChangesResponse(rsp)
+}
+
+// GetTagsWithResponse request returning *GetTagsResponse
+func (c *ClientWithResponses) GetTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagsResponse, error) {
+	rsp, err := c.GetTags(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTagsResponse(rsp)
+}
+
+// GetTagConsolidationWithResponse request returning *GetTagConsolidationResponse
+func (c *ClientWithResponses) GetTagConsolidationWithResponse(ctx context.Context, params *GetTagConsolidationParams, reqEditors ...RequestEditorFn) (*GetTagConsolidationResponse, error) {
+	rsp, err := c.GetTagConsolidation(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTagConsolidationResponse(rsp)
+}
+
+// MergeTagsWithBodyWithResponse request with arbitrary body returning *MergeTagsResponse
+func (c *ClientWithResponses) MergeTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeTagsResponse, error) {
+	rsp, err := c.MergeTagsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergeTagsResponse(rsp)
+}
+
+func (c *ClientWithResponses) MergeTagsWithResponse(ctx context.Context, body MergeTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeTagsResponse, error) {
+	rsp, err := c.MergeTags(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergeTagsResponse(rsp)
+}
+
+// GetTagStatsWithResponse request returning *GetTagStatsResponse
+func (c *ClientWithResponses) GetTagStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagStatsResponse, error) {
+	rsp, err := c.GetTagStats(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTagStatsResponse(rsp)
+}
+
+// GetTagTreeWithResponse request returning *GetTagTreeResponse
+func (c *ClientWithResponses) GetTagTreeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagTreeResponse, error) {
+	rsp, err := c.GetTagTree(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTagTreeResponse(rsp)
+}
+
+// DeleteTagWithResponse request returning *DeleteTagResponse
+func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, name string, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error) {
+	rsp, err := c.DeleteTag(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTagResponse(rsp)
+}
+
+// UpdateTagWithBodyWithResponse request with arbitrary body returning *UpdateTagResponse
+func (c *ClientWithResponses) UpdateTagWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTagWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTagWithResponse(ctx context.Context, name string, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTag(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTagResponse(rsp)
+}
+
+// GetAccessTokensWithResponse request returning *GetAccessTokensResponse
+func (c *ClientWithResponses) GetAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccessTokensResponse, error) {
+	rsp, err := c.GetAccessTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccessTokensResponse(rsp)
+}
+
+// CreateAccessTokenWithBodyWithResponse request with arbitrary body returning *CreateAccessTokenResponse
+func (c *ClientWithResponses) CreateAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error) {
+	rsp, err := c.CreateAccessTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAccessTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAccessTokenWithResponse(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error) {
+	rsp, err := c.CreateAccessToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAccessTokenResponse(rsp)
+}
+
+// RevokeAccessTokenWithResponse request returning *RevokeAccessTokenResponse
+func (c *ClientWithResponses) RevokeAccessTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeAccessTokenResponse, error) {
+	rsp, err := c.RevokeAccessToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseRevokeAccessTokenResponse(rsp)
 }
 
-type AcceptItemTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemsResponse
+// GetUserWithResponse request returning *GetUserResponse
+func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
+	rsp, err := c.GetUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r AcceptItemTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ConfirmTwoFactorWithBodyWithResponse request with arbitrary body returning *ConfirmTwoFactorResponse
+func (c *ClientWithResponses) ConfirmTwoFactorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorResponse, error) {
+	rsp, err := c.ConfirmTwoFactorWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseConfirmTwoFactorResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmTwoFactorWithResponse(ctx context.Context, body ConfirmTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorResponse, error) {
+	rsp, err := c.ConfirmTwoFactor(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTwoFactorResponse(rsp)
+}
+
+// DisableTwoFactorWithBodyWithResponse request with arbitrary body returning *DisableTwoFactorResponse
+func (c *ClientWithResponses) DisableTwoFactorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTwoFactorResponse, error) {
+	rsp, err := c.DisableTwoFactorWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTwoFactorResponse(rsp)
+}
+
+func (c *ClientWithResponses) DisableTwoFactorWithResponse(ctx context.Context, body DisableTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTwoFactorResponse, error) {
+	rsp, err := c.DisableTwoFactor(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTwoFactorResponse(rsp)
+}
+
+// EnrollTwoFactorWithResponse request returning *EnrollTwoFactorResponse
+func (c *ClientWithResponses) EnrollTwoFactorWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnrollTwoFactorResponse, error) {
+	rsp, err := c.EnrollTwoFactor(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollTwoFactorResponse(rsp)
+}
+
+// RegenerateRecoveryCodesWithBodyWithResponse request with arbitrary body returning *RegenerateRecoveryCodesResponse
+func (c *ClientWithResponses) RegenerateRecoveryCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error) {
+	rsp, err := c.RegenerateRecoveryCodesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegenerateRecoveryCodesWithResponse(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error) {
+	rsp, err := c.RegenerateRecoveryCodes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesResponse(rsp)
+}
+
+// ParseAskDiaryResponse parses an HTTP response from a AskDiaryWithResponse call
+func ParseAskDiaryResponse(rsp *http.Response) (*AskDiaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AskDiaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AskResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseGetAssetResponse parses an HTTP response from a GetAssetWithResponse call
+func ParseGetAssetResponse(rsp *http.Response) (*GetAssetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAssetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUploadAssetsBatchResponse parses an HTTP response from a UploadAssetsBatchWithResponse call
+func ParseUploadAssetsBatchResponse(rsp *http.Response) (*UploadAssetsBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadAssetsBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AssetsBatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseListAssetsResponse parses an HTTP response from a ListAssetsWithResponse call
+func ParseListAssetsResponse(rsp *http.Response) (*ListAssetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAssetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AssetInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseGetAuditEventsResponse parses an HTTP response from a GetAuditEventsWithResponse call
+func ParseGetAuditEventsResponse(rsp *http.Response) (*GetAuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditLogResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseAuthorizeResponse parses an HTTP response from a AuthorizeWithResponse call
+func ParseAuthorizeResponse(rsp *http.Response) (*AuthorizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthorizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Token string `json:"token"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest TwoFactorChallenge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthorizeTwoFactorResponse parses an HTTP response from a AuthorizeTwoFactorWithResponse call
+func ParseAuthorizeTwoFactorResponse(rsp *http.Response) (*AuthorizeTwoFactorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthorizeTwoFactorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Token string `json:"token"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseGetFamilyResponse parses an HTTP response from a GetFamilyWithResponse call
+func ParseGetFamilyResponse(rsp *http.Response) (*GetFamilyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFamilyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FamilyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AcceptItemTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateFamilySettingsResponse parses an HTTP response from a UpdateFamilySettingsWithResponse call
+func ParseUpdateFamilySettingsResponse(rsp *http.Response) (*UpdateFamilySettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateFamilySettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FamilyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return 0
-}
 
-type DismissItemTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemsResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DismissItemTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetFamilyAIUsageResponse parses an HTTP response from a GetFamilyAIUsageWithResponse call
+func ParseGetFamilyAIUsageResponse(rsp *http.Response) (*GetFamilyAIUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DismissItemTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetFamilyAIUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
-
-type SuggestItemTagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuggestTagsResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r SuggestItemTagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AIUsageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SuggestItemTagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type GetChangesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SyncResponse
-}
+// ParseGetCustomFieldsResponse parses an HTTP response from a GetCustomFieldsWithResponse call
+func ParseGetCustomFieldsResponse(rsp *http.Response) (*GetCustomFieldsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetChangesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetCustomFieldsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetChangesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomFieldsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return 0
-}
 
-type GetTagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TagsResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateCustomFieldResponse parses an HTTP response from a CreateCustomFieldWithResponse call
+func ParseCreateCustomFieldResponse(rsp *http.Response) (*CreateCustomFieldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateCustomFieldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetTagStatsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TagStatsResponse
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CustomField
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTagStatsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteCustomFieldResponse parses an HTTP response from a DeleteCustomFieldWithResponse call
+func ParseDeleteCustomFieldResponse(rsp *http.Response) (*DeleteCustomFieldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTagStatsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteCustomFieldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateCustomFieldResponse parses an HTTP response from a UpdateCustomFieldWithResponse call
+func ParseUpdateCustomFieldResponse(rsp *http.Response) (*UpdateCustomFieldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateCustomFieldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type RenameTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomField
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r RenameTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetCustomFieldStatsResponse parses an HTTP response from a GetCustomFieldStatsWithResponse call
+func ParseGetCustomFieldStatsResponse(rsp *http.Response) (*GetCustomFieldStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RenameTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetCustomFieldStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomFieldStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFixHealthIssuesResponse parses an HTTP response from a FixHealthIssuesWithResponse call
+func ParseFixHealthIssuesResponse(rsp *http.Response) (*FixHealthIssuesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FixHealthIssuesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthIssuesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
 }
 
-// GetAssetWithResponse request returning *GetAssetResponse
-func (c *ClientWithResponses) GetAssetWithResponse(ctx context.Context, params *GetAssetParams, reqEditors ...RequestEditorFn) (*GetAssetResponse, error) {
-	rsp, err := c.GetAsset(ctx, params, reqEditors...)
+// ParseGetHealthIssuesResponse parses an HTTP response from a GetHealthIssuesWithResponse call
+func ParseGetHealthIssuesResponse(rsp *http.Response) (*GetHealthIssuesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAssetResponse(rsp)
+
+	response := &GetHealthIssuesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthIssuesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
 }
 
-// UploadAssetsBatchWithBodyWithResponse request with arbitrary body returning *UploadAssetsBatchResponse
-func (c *ClientWithResponses) UploadAssetsBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAssetsBatchResponse, error) {
-	rsp, err := c.UploadAssetsBatchWithBody(ctx, contentType, body, reqEditors...)
+// ParseDeleteOrphanResponse parses an HTTP response from a DeleteOrphanWithResponse call
+func ParseDeleteOrphanResponse(rsp *http.Response) (*DeleteOrphanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUploadAssetsBatchResponse(rsp)
-}
 
-// AuthorizeWithBodyWithResponse request with arbitrary body returning *AuthorizeResponse
-func (c *ClientWithResponses) AuthorizeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error) {
-	rsp, err := c.AuthorizeWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteOrphanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAuthorizeResponse(rsp)
-}
 
-func (c *ClientWithResponses) AuthorizeWithResponse(ctx context.Context, body AuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error) {
-	rsp, err := c.Authorize(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthIssuesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return ParseAuthorizeResponse(rsp)
+
+	return response, nil
 }
 
-// GetFamilyWithResponse request returning *GetFamilyResponse
-func (c *ClientWithResponses) GetFamilyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFamilyResponse, error) {
-	rsp, err := c.GetFamily(ctx, reqEditors...)
+// ParseAttachOrphanResponse parses an HTTP response from a AttachOrphanWithResponse call
+func ParseAttachOrphanResponse(rsp *http.Response) (*AttachOrphanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetFamilyResponse(rsp)
-}
 
-// UpdateFamilySettingsWithBodyWithResponse request with arbitrary body returning *UpdateFamilySettingsResponse
-func (c *ClientWithResponses) UpdateFamilySettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFamilySettingsResponse, error) {
-	rsp, err := c.UpdateFamilySettingsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AttachOrphanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateFamilySettingsResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateFamilySettingsWithResponse(ctx context.Context, body UpdateFamilySettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFamilySettingsResponse, error) {
-	rsp, err := c.UpdateFamilySettings(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthIssuesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return ParseUpdateFamilySettingsResponse(rsp)
+
+	return response, nil
 }
 
-// FixHealthIssuesWithBodyWithResponse request with arbitrary body returning *FixHealthIssuesResponse
-func (c *ClientWithResponses) FixHealthIssuesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FixHealthIssuesResponse, error) {
-	rsp, err := c.FixHealthIssuesWithBody(ctx, contentType, body, reqEditors...)
+// ParseUnignoreOrphanResponse parses an HTTP response from a UnignoreOrphanWithResponse call
+func ParseUnignoreOrphanResponse(rsp *http.Response) (*UnignoreOrphanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFixHealthIssuesResponse(rsp)
-}
 
-func (c *ClientWithResponses) FixHealthIssuesWithResponse(ctx context.Context, body FixHealthIssuesJSONRequestBody, reqEditors ...RequestEditorFn) (*FixHealthIssuesResponse, error) {
-	rsp, err := c.FixHealthIssues(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UnignoreOrphanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseFixHealthIssuesResponse(rsp)
-}
 
-// GetHealthIssuesWithResponse request returning *GetHealthIssuesResponse
-func (c *ClientWithResponses) GetHealthIssuesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthIssuesResponse, error) {
-	rsp, err := c.GetHealthIssues(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthIssuesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return ParseGetHealthIssuesResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteOrphanWithResponse request returning *DeleteOrphanResponse
-func (c *ClientWithResponses) DeleteOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*DeleteOrphanResponse, error) {
-	rsp, err := c.DeleteOrphan(ctx, filename, reqEditors...)
+// ParseIgnoreOrphanResponse parses an HTTP response from a IgnoreOrphanWithResponse call
+func ParseIgnoreOrphanResponse(rsp *http.Response) (*IgnoreOrphanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteOrphanResponse(rsp)
-}
 
-// AttachOrphanWithBodyWithResponse request with arbitrary body returning *AttachOrphanResponse
-func (c *ClientWithResponses) AttachOrphanWithBodyWithResponse(ctx context.Context, filename string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachOrphanResponse, error) {
-	rsp, err := c.AttachOrphanWithBody(ctx, filename, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &IgnoreOrphanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAttachOrphanResponse(rsp)
-}
 
-func (c *ClientWithResponses) AttachOrphanWithResponse(ctx context.Context, filename string, body AttachOrphanJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachOrphanResponse, error) {
-	rsp, err := c.AttachOrphan(ctx, filename, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthIssuesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return ParseAttachOrphanResponse(rsp)
+
+	return response, nil
 }
 
-// UnignoreOrphanWithResponse request returning *UnignoreOrphanResponse
-func (c *ClientWithResponses) UnignoreOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*UnignoreOrphanResponse, error) {
-	rsp, err := c.UnignoreOrphan(ctx, filename, reqEditors...)
+// ParseGetItemsResponse parses an HTTP response from a GetItemsWithResponse call
+func ParseGetItemsResponse(rsp *http.Response) (*GetItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUnignoreOrphanResponse(rsp)
-}
 
-// IgnoreOrphanWithResponse request returning *IgnoreOrphanResponse
-func (c *ClientWithResponses) IgnoreOrphanWithResponse(ctx context.Context, filename string, reqEditors ...RequestEditorFn) (*IgnoreOrphanResponse, error) {
-	rsp, err := c.IgnoreOrphan(ctx, filename, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseIgnoreOrphanResponse(rsp)
-}
 
-// GetItemsWithResponse request returning *GetItemsResponse
-func (c *ClientWithResponses) GetItemsWithResponse(ctx context.Context, params *GetItemsParams, reqEditors ...RequestEditorFn) (*GetItemsResponse, error) {
-	rsp, err := c.GetItems(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemsListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return ParseGetItemsResponse(rsp)
+
+	return response, nil
 }
 
-// PutItemsWithBodyWithResponse request with arbitrary body returning *PutItemsResponse
-func (c *ClientWithResponses) PutItemsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutItemsResponse, error) {
-	rsp, err := c.PutItemsWithBody(ctx, contentType, body, reqEditors...)
+// ParsePutItemsResponse parses an HTTP response from a PutItemsWithResponse call
+func ParsePutItemsResponse(rsp *http.Response) (*PutItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutItemsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutItemsWithResponse(ctx context.Context, body PutItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutItemsResponse, error) {
-	rsp, err := c.PutItems(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutItemsResponse(rsp)
-}
 
-// AcceptItemTagWithBodyWithResponse request with arbitrary body returning *AcceptItemTagResponse
-func (c *ClientWithResponses) AcceptItemTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcceptItemTagResponse, error) {
-	rsp, err := c.AcceptItemTagWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return ParseAcceptItemTagResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) AcceptItemTagWithResponse(ctx context.Context, body AcceptItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*AcceptItemTagResponse, error) {
-	rsp, err := c.AcceptItemTag(ctx, body, reqEditors...)
+// ParseAcceptItemTagResponse parses an HTTP response from a AcceptItemTagWithResponse call
+func ParseAcceptItemTagResponse(rsp *http.Response) (*AcceptItemTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAcceptItemTagResponse(rsp)
-}
 
-// DismissItemTagWithBodyWithResponse request with arbitrary body returning *DismissItemTagResponse
-func (c *ClientWithResponses) DismissItemTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DismissItemTagResponse, error) {
-	rsp, err := c.DismissItemTagWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AcceptItemTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDismissItemTagResponse(rsp)
-}
 
-func (c *ClientWithResponses) DismissItemTagWithResponse(ctx context.Context, body DismissItemTagJSONRequestBody, reqEditors ...RequestEditorFn) (*DismissItemTagResponse, error) {
-	rsp, err := c.DismissItemTag(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return ParseDismissItemTagResponse(rsp)
+
+	return response, nil
 }
 
-// SuggestItemTagsWithBodyWithResponse request with arbitrary body returning *SuggestItemTagsResponse
-func (c *ClientWithResponses) SuggestItemTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SuggestItemTagsResponse, error) {
-	rsp, err := c.SuggestItemTagsWithBody(ctx, contentType, body, reqEditors...)
+// ParseDismissItemTagResponse parses an HTTP response from a DismissItemTagWithResponse call
+func ParseDismissItemTagResponse(rsp *http.Response) (*DismissItemTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSuggestItemTagsResponse(rsp)
+
+	response := &DismissItemTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) SuggestItemTagsWithResponse(ctx context.Context, body SuggestItemTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SuggestItemTagsResponse, error) {
-	rsp, err := c.SuggestItemTags(ctx, body, reqEditors...)
+// ParseSemanticSearchItemsResponse parses an HTTP response from a SemanticSearchItemsWithResponse call
+func ParseSemanticSearchItemsResponse(rsp *http.Response) (*SemanticSearchItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSuggestItemTagsResponse(rsp)
-}
 
-// GetChangesWithResponse request returning *GetChangesResponse
-func (c *ClientWithResponses) GetChangesWithResponse(ctx context.Context, params *GetChangesParams, reqEditors ...RequestEditorFn) (*GetChangesResponse, error) {
-	rsp, err := c.GetChanges(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SemanticSearchItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetChangesResponse(rsp)
-}
 
-// GetTagsWithResponse request returning *GetTagsResponse
-func (c *ClientWithResponses) GetTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagsResponse, error) {
-	rsp, err := c.GetTags(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SemanticSearchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return ParseGetTagsResponse(rsp)
+
+	return response, nil
 }
 
-// GetTagStatsWithResponse request returning *GetTagStatsResponse
-func (c *ClientWithResponses) GetTagStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagStatsResponse, error) {
-	rsp, err := c.GetTagStats(ctx, reqEditors...)
+// ParseSuggestItemTagsResponse parses an HTTP response from a SuggestItemTagsWithResponse call
+func ParseSuggestItemTagsResponse(rsp *http.Response) (*SuggestItemTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTagStatsResponse(rsp)
-}
 
-// DeleteTagWithResponse request returning *DeleteTagResponse
-func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error) {
-	rsp, err := c.DeleteTag(ctx, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SuggestItemTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteTagResponse(rsp)
-}
 
-// RenameTagWithBodyWithResponse request with arbitrary body returning *RenameTagResponse
-func (c *ClientWithResponses) RenameTagWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenameTagResponse, error) {
-	rsp, err := c.RenameTagWithBody(ctx, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuggestTagsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 	}
-	return ParseRenameTagResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) RenameTagWithResponse(ctx context.Context, name string, body RenameTagJSONRequestBody, reqEditors ...RequestEditorFn) (*RenameTagResponse, error) {
-	rsp, err := c.RenameTag(ctx, name, body, reqEditors...)
+// ParseGetItemBacklinksResponse parses an HTTP response from a GetItemBacklinksWithResponse call
+func ParseGetItemBacklinksResponse(rsp *http.Response) (*GetItemBacklinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRenameTagResponse(rsp)
+
+	response := &GetItemBacklinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BacklinksResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
 }
 
-// GetUserWithResponse request returning *GetUserResponse
-func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
-	rsp, err := c.GetUser(ctx, reqEditors...)
+// ParseSummarizeItemResponse parses an HTTP response from a SummarizeItemWithResponse call
+func ParseSummarizeItemResponse(rsp *http.Response) (*SummarizeItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUserResponse(rsp)
+
+	response := &SummarizeItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
+	return response, nil
 }
 
-// ParseGetAssetResponse parses an HTTP response from a GetAssetWithResponse call
-func ParseGetAssetResponse(rsp *http.Response) (*GetAssetResponse, error) {
+// ParseTranscribeItemAssetResponse parses an HTTP response from a TranscribeItemAssetWithResponse call
+func ParseTranscribeItemAssetResponse(rsp *http.Response) (*TranscribeItemAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAssetResponse{
+	response := &TranscribeItemAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TranscribeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
 	return response, nil
 }
 
-// ParseUploadAssetsBatchResponse parses an HTTP response from a UploadAssetsBatchWithResponse call
-func ParseUploadAssetsBatchResponse(rsp *http.Response) (*UploadAssetsBatchResponse, error) {
+// ParseGetJobsResponse parses an HTTP response from a GetJobsWithResponse call
+func ParseGetJobsResponse(rsp *http.Response) (*GetJobsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UploadAssetsBatchResponse{
+	response := &GetJobsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AssetsBatchResponse
+		var dest JobsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2645,24 +7527,22 @@ func ParseUploadAssetsBatchResponse(rsp *http.Response) (*UploadAssetsBatchRespo
 	return response, nil
 }
 
-// ParseAuthorizeResponse parses an HTTP response from a AuthorizeWithResponse call
-func ParseAuthorizeResponse(rsp *http.Response) (*AuthorizeResponse, error) {
+// ParseGetMemoriesResponse parses an HTTP response from a GetMemoriesWithResponse call
+func ParseGetMemoriesResponse(rsp *http.Response) (*GetMemoriesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AuthorizeResponse{
+	response := &GetMemoriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Token string `json:"token"`
-		}
+		var dest MemoriesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2672,22 +7552,22 @@ func ParseAuthorizeResponse(rsp *http.Response) (*AuthorizeResponse, error) {
 	return response, nil
 }
 
-// ParseGetFamilyResponse parses an HTTP response from a GetFamilyWithResponse call
-func ParseGetFamilyResponse(rsp *http.Response) (*GetFamilyResponse, error) {
+// ParseGetPlacesResponse parses an HTTP response from a GetPlacesWithResponse call
+func ParseGetPlacesResponse(rsp *http.Response) (*GetPlacesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFamilyResponse{
+	response := &GetPlacesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FamilyResponse
+		var dest PlacesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2697,22 +7577,22 @@ func ParseGetFamilyResponse(rsp *http.Response) (*GetFamilyResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateFamilySettingsResponse parses an HTTP response from a UpdateFamilySettingsWithResponse call
-func ParseUpdateFamilySettingsResponse(rsp *http.Response) (*UpdateFamilySettingsResponse, error) {
+// ParseGetRecapsResponse parses an HTTP response from a GetRecapsWithResponse call
+func ParseGetRecapsResponse(rsp *http.Response) (*GetRecapsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateFamilySettingsResponse{
+	response := &GetRecapsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FamilyResponse
+		var dest []Recap
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2722,22 +7602,22 @@ func ParseUpdateFamilySettingsResponse(rsp *http.Response) (*UpdateFamilySetting
 	return response, nil
 }
 
-// ParseFixHealthIssuesResponse parses an HTTP response from a FixHealthIssuesWithResponse call
-func ParseFixHealthIssuesResponse(rsp *http.Response) (*FixHealthIssuesResponse, error) {
+// ParseGetSessionsResponse parses an HTTP response from a GetSessionsWithResponse call
+func ParseGetSessionsResponse(rsp *http.Response) (*GetSessionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FixHealthIssuesResponse{
+	response := &GetSessionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthIssuesResponse
+		var dest []Session
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2747,22 +7627,22 @@ func ParseFixHealthIssuesResponse(rsp *http.Response) (*FixHealthIssuesResponse,
 	return response, nil
 }
 
-// ParseGetHealthIssuesResponse parses an HTTP response from a GetHealthIssuesWithResponse call
-func ParseGetHealthIssuesResponse(rsp *http.Response) (*GetHealthIssuesResponse, error) {
+// ParseRevokeOtherSessionsResponse parses an HTTP response from a RevokeOtherSessionsWithResponse call
+func ParseRevokeOtherSessionsResponse(rsp *http.Response) (*RevokeOtherSessionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetHealthIssuesResponse{
+	response := &RevokeOtherSessionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthIssuesResponse
+		var dest RevokedSessions
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2772,22 +7652,38 @@ func ParseGetHealthIssuesResponse(rsp *http.Response) (*GetHealthIssuesResponse,
 	return response, nil
 }
 
-// ParseDeleteOrphanResponse parses an HTTP response from a DeleteOrphanWithResponse call
-func ParseDeleteOrphanResponse(rsp *http.Response) (*DeleteOrphanResponse, error) {
+// ParseRevokeSessionResponse parses an HTTP response from a RevokeSessionWithResponse call
+func ParseRevokeSessionResponse(rsp *http.Response) (*RevokeSessionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteOrphanResponse{
+	response := &RevokeSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsResponse parses an HTTP response from a GetStatsWithResponse call
+func ParseGetStatsResponse(rsp *http.Response) (*GetStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthIssuesResponse
+		var dest StatsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2797,22 +7693,22 @@ func ParseDeleteOrphanResponse(rsp *http.Response) (*DeleteOrphanResponse, error
 	return response, nil
 }
 
-// ParseAttachOrphanResponse parses an HTTP response from a AttachOrphanWithResponse call
-func ParseAttachOrphanResponse(rsp *http.Response) (*AttachOrphanResponse, error) {
+// ParseGetChangesResponse parses an HTTP response from a GetChangesWithResponse call
+func ParseGetChangesResponse(rsp *http.Response) (*GetChangesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AttachOrphanResponse{
+	response := &GetChangesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthIssuesResponse
+		var dest SyncResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2822,22 +7718,22 @@ func ParseAttachOrphanResponse(rsp *http.Response) (*AttachOrphanResponse, error
 	return response, nil
 }
 
-// ParseUnignoreOrphanResponse parses an HTTP response from a UnignoreOrphanWithResponse call
-func ParseUnignoreOrphanResponse(rsp *http.Response) (*UnignoreOrphanResponse, error) {
+// ParseGetTagsResponse parses an HTTP response from a GetTagsWithResponse call
+func ParseGetTagsResponse(rsp *http.Response) (*GetTagsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnignoreOrphanResponse{
+	response := &GetTagsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthIssuesResponse
+		var dest TagsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2847,22 +7743,22 @@ func ParseUnignoreOrphanResponse(rsp *http.Response) (*UnignoreOrphanResponse, e
 	return response, nil
 }
 
-// ParseIgnoreOrphanResponse parses an HTTP response from a IgnoreOrphanWithResponse call
-func ParseIgnoreOrphanResponse(rsp *http.Response) (*IgnoreOrphanResponse, error) {
+// ParseGetTagConsolidationResponse parses an HTTP response from a GetTagConsolidationWithResponse call
+func ParseGetTagConsolidationResponse(rsp *http.Response) (*GetTagConsolidationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &IgnoreOrphanResponse{
+	response := &GetTagConsolidationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthIssuesResponse
+		var dest TagConsolidationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2872,22 +7768,22 @@ func ParseIgnoreOrphanResponse(rsp *http.Response) (*IgnoreOrphanResponse, error
 	return response, nil
 }
 
-// ParseGetItemsResponse parses an HTTP response from a GetItemsWithResponse call
-func ParseGetItemsResponse(rsp *http.Response) (*GetItemsResponse, error) {
+// ParseMergeTagsResponse parses an HTTP response from a MergeTagsWithResponse call
+func ParseMergeTagsResponse(rsp *http.Response) (*MergeTagsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetItemsResponse{
+	response := &MergeTagsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemsListResponse
+		var dest TagMergeResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2897,22 +7793,22 @@ func ParseGetItemsResponse(rsp *http.Response) (*GetItemsResponse, error) {
 	return response, nil
 }
 
-// ParsePutItemsResponse parses an HTTP response from a PutItemsWithResponse call
-func ParsePutItemsResponse(rsp *http.Response) (*PutItemsResponse, error) {
+// ParseGetTagStatsResponse parses an HTTP response from a GetTagStatsWithResponse call
+func ParseGetTagStatsResponse(rsp *http.Response) (*GetTagStatsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutItemsResponse{
+	response := &GetTagStatsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemsResponse
+		var dest TagStatsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2922,22 +7818,22 @@ func ParsePutItemsResponse(rsp *http.Response) (*PutItemsResponse, error) {
 	return response, nil
 }
 
-// ParseAcceptItemTagResponse parses an HTTP response from a AcceptItemTagWithResponse call
-func ParseAcceptItemTagResponse(rsp *http.Response) (*AcceptItemTagResponse, error) {
+// ParseGetTagTreeResponse parses an HTTP response from a GetTagTreeWithResponse call
+func ParseGetTagTreeResponse(rsp *http.Response) (*GetTagTreeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AcceptItemTagResponse{
+	response := &GetTagTreeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemsResponse
+		var dest TagTreeResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2947,22 +7843,38 @@ func ParseAcceptItemTagResponse(rsp *http.Response) (*AcceptItemTagResponse, err
 	return response, nil
 }
 
-// ParseDismissItemTagResponse parses an HTTP response from a DismissItemTagWithResponse call
-func ParseDismissItemTagResponse(rsp *http.Response) (*DismissItemTagResponse, error) {
+// ParseDeleteTagResponse parses an HTTP response from a DeleteTagWithResponse call
+func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DismissItemTagResponse{
+	response := &DeleteTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTagResponse parses an HTTP response from a UpdateTagWithResponse call
+func ParseUpdateTagResponse(rsp *http.Response) (*UpdateTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemsResponse
+		var dest TagMetadata
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2972,22 +7884,22 @@ func ParseDismissItemTagResponse(rsp *http.Response) (*DismissItemTagResponse, e
 	return response, nil
 }
 
-// ParseSuggestItemTagsResponse parses an HTTP response from a SuggestItemTagsWithResponse call
-func ParseSuggestItemTagsResponse(rsp *http.Response) (*SuggestItemTagsResponse, error) {
+// ParseGetAccessTokensResponse parses an HTTP response from a GetAccessTokensWithResponse call
+func ParseGetAccessTokensResponse(rsp *http.Response) (*GetAccessTokensResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SuggestItemTagsResponse{
+	response := &GetAccessTokensResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuggestTagsResponse
+		var dest []AccessToken
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2997,47 +7909,63 @@ func ParseSuggestItemTagsResponse(rsp *http.Response) (*SuggestItemTagsResponse,
 	return response, nil
 }
 
-// ParseGetChangesResponse parses an HTTP response from a GetChangesWithResponse call
-func ParseGetChangesResponse(rsp *http.Response) (*GetChangesResponse, error) {
+// ParseCreateAccessTokenResponse parses an HTTP response from a CreateAccessTokenWithResponse call
+func ParseCreateAccessTokenResponse(rsp *http.Response) (*CreateAccessTokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetChangesResponse{
+	response := &CreateAccessTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SyncResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedAccessToken
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 	}
 
 	return response, nil
 }
 
-// ParseGetTagsResponse parses an HTTP response from a GetTagsWithResponse call
-func ParseGetTagsResponse(rsp *http.Response) (*GetTagsResponse, error) {
+// ParseRevokeAccessTokenResponse parses an HTTP response from a RevokeAccessTokenWithResponse call
+func ParseRevokeAccessTokenResponse(rsp *http.Response) (*RevokeAccessTokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTagsResponse{
+	response := &RevokeAccessTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetUserResponse parses an HTTP response from a GetUserWithResponse call
+func ParseGetUserResponse(rsp *http.Response) (*GetUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagsResponse
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3047,22 +7975,22 @@ func ParseGetTagsResponse(rsp *http.Response) (*GetTagsResponse, error) {
 	return response, nil
 }
 
-// ParseGetTagStatsResponse parses an HTTP response from a GetTagStatsWithResponse call
-func ParseGetTagStatsResponse(rsp *http.Response) (*GetTagStatsResponse, error) {
+// ParseConfirmTwoFactorResponse parses an HTTP response from a ConfirmTwoFactorWithResponse call
+func ParseConfirmTwoFactorResponse(rsp *http.Response) (*ConfirmTwoFactorResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTagStatsResponse{
+	response := &ConfirmTwoFactorResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagStatsResponse
+		var dest RecoveryCodesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3072,15 +8000,15 @@ func ParseGetTagStatsResponse(rsp *http.Response) (*GetTagStatsResponse, error)
 	return response, nil
 }
 
-// ParseDeleteTagResponse parses an HTTP response from a DeleteTagWithResponse call
-func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
+// ParseDisableTwoFactorResponse parses an HTTP response from a DisableTwoFactorWithResponse call
+func ParseDisableTwoFactorResponse(rsp *http.Response) (*DisableTwoFactorResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteTagResponse{
+	response := &DisableTwoFactorResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3088,38 +8016,47 @@ func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
 	return response, nil
 }
 
-// ParseRenameTagResponse parses an HTTP response from a RenameTagWithResponse call
-func ParseRenameTagResponse(rsp *http.Response) (*RenameTagResponse, error) {
+// ParseEnrollTwoFactorResponse parses an HTTP response from a EnrollTwoFactorWithResponse call
+func ParseEnrollTwoFactorResponse(rsp *http.Response) (*EnrollTwoFactorResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RenameTagResponse{
+	response := &EnrollTwoFactorResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TwoFactorEnrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+	}
+
 	return response, nil
 }
 
-// ParseGetUserResponse parses an HTTP response from a GetUserWithResponse call
-func ParseGetUserResponse(rsp *http.Response) (*GetUserResponse, error) {
+// ParseRegenerateRecoveryCodesResponse parses an HTTP response from a RegenerateRecoveryCodesWithResponse call
+func ParseRegenerateRecoveryCodesResponse(rsp *http.Response) (*RegenerateRecoveryCodesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUserResponse{
+	response := &RegenerateRecoveryCodesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
+		var dest RecoveryCodesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
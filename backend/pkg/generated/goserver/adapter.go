@@ -15,25 +15,37 @@ import (
 // StrictServerImpl implements StrictServerInterface by delegating to individual
 // service implementations.
 type StrictServerImpl struct {
-	assets AssetsAPIService
-	auth   AuthAPIService
-	family FamilyAPIService
-	health HealthAPIService
-	items  ItemsAPIService
-	sync   SyncAPIService
-	user   UserAPIService
+	assets   AssetsAPIService
+	audit    AuditAPIService
+	auth     AuthAPIService
+	family   FamilyAPIService
+	health   HealthAPIService
+	items    ItemsAPIService
+	jobs     JobsAPIService
+	recaps   RecapsAPIService
+	search   SearchAPIService
+	sessions SessionsAPIService
+	sync     SyncAPIService
+	tokens   TokensAPIService
+	user     UserAPIService
 }
 
 // newStrictServerImpl creates a StrictServerImpl from a CustomControllers value.
 func newStrictServerImpl(c CustomControllers) *StrictServerImpl {
 	return &StrictServerImpl{
-		assets: c.AssetsAPIService,
-		auth:   c.AuthAPIService,
-		family: c.FamilyAPIService,
-		health: c.HealthAPIService,
-		items:  c.ItemsAPIService,
-		sync:   c.SyncAPIService,
-		user:   c.UserAPIService,
+		assets:   c.AssetsAPIService,
+		audit:    c.AuditAPIService,
+		auth:     c.AuthAPIService,
+		family:   c.FamilyAPIService,
+		health:   c.HealthAPIService,
+		items:    c.ItemsAPIService,
+		jobs:     c.JobsAPIService,
+		recaps:   c.RecapsAPIService,
+		search:   c.SearchAPIService,
+		sessions: c.SessionsAPIService,
+		sync:     c.SyncAPIService,
+		tokens:   c.TokensAPIService,
+		user:     c.UserAPIService,
 	}
 }
 
@@ -66,6 +78,31 @@ func (s *StrictServerImpl) GetAsset(ctx context.Context, req GetAssetRequestObje
 	}
 }
 
+// --- ListAssets ---
+
+func (s *StrictServerImpl) ListAssets(ctx context.Context, req ListAssetsRequestObject) (ListAssetsResponseObject, error) {
+	date := ""
+	if req.Params.Date != nil {
+		date = req.Params.Date.Time.Format("2006-01-02")
+	}
+	resp, err := s.assets.ListAssets(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.([]AssetInfo)
+		if !ok {
+			return nil, fmt.Errorf("ListAssets: unexpected body type %T", resp.Body)
+		}
+		return ListAssets200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return ListAssets401Response{}, nil
+	default:
+		return nil, fmt.Errorf("ListAssets: unexpected status %d", resp.Code)
+	}
+}
+
 // --- UploadAssetsBatch ---
 
 func (s *StrictServerImpl) UploadAssetsBatch(_ context.Context, _ UploadAssetsBatchRequestObject) (UploadAssetsBatchResponseObject, error) {
@@ -73,6 +110,36 @@ func (s *StrictServerImpl) UploadAssetsBatch(_ context.Context, _ UploadAssetsBa
 	return UploadAssetsBatch501Response{}, nil
 }
 
+// --- GetAuditEvents ---
+
+func (s *StrictServerImpl) GetAuditEvents(ctx context.Context, req GetAuditEventsRequestObject) (GetAuditEventsResponseObject, error) {
+	var before, limit int32
+	if req.Params.Before != nil {
+		before = *req.Params.Before
+	}
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+	}
+	resp, err := s.audit.GetAuditEvents(ctx, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(AuditLogResponse)
+		if !ok {
+			return nil, fmt.Errorf("GetAuditEvents: unexpected body type %T", resp.Body)
+		}
+		return GetAuditEvents200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return GetAuditEvents400Response{}, nil
+	case http.StatusUnauthorized:
+		return GetAuditEvents401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetAuditEvents: unexpected status %d", resp.Code)
+	}
+}
+
 // --- Authorize ---
 
 func (s *StrictServerImpl) Authorize(ctx context.Context, req AuthorizeRequestObject) (AuthorizeResponseObject, error) {
@@ -90,13 +157,47 @@ func (s *StrictServerImpl) Authorize(ctx context.Context, req AuthorizeRequestOb
 			return nil, fmt.Errorf("Authorize: unexpected body type %T", resp.Body)
 		}
 		return body, nil
+	case http.StatusAccepted:
+		body, ok := resp.Body.(TwoFactorChallenge)
+		if !ok {
+			return nil, fmt.Errorf("Authorize: unexpected body type %T", resp.Body)
+		}
+		return Authorize202JSONResponse(body), nil
 	case http.StatusUnauthorized:
 		return Authorize401Response{}, nil
+	case http.StatusTooManyRequests:
+		return Authorize429Response{}, nil
 	default:
 		return nil, fmt.Errorf("Authorize: unexpected status %d", resp.Code)
 	}
 }
 
+// --- AuthorizeTwoFactor ---
+
+func (s *StrictServerImpl) AuthorizeTwoFactor(ctx context.Context, req AuthorizeTwoFactorRequestObject) (AuthorizeTwoFactorResponseObject, error) {
+	if req.Body == nil {
+		return AuthorizeTwoFactor401Response{}, nil
+	}
+	resp, err := s.auth.AuthorizeTwoFactor(ctx, *req.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(Authorize200JSONResponse)
+		if !ok {
+			return nil, fmt.Errorf("AuthorizeTwoFactor: unexpected body type %T", resp.Body)
+		}
+		return AuthorizeTwoFactor200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return AuthorizeTwoFactor401Response{}, nil
+	case http.StatusTooManyRequests:
+		return AuthorizeTwoFactor429Response{}, nil
+	default:
+		return nil, fmt.Errorf("AuthorizeTwoFactor: unexpected status %d", resp.Code)
+	}
+}
+
 // --- FixHealthIssues ---
 
 func (s *StrictServerImpl) FixHealthIssues(ctx context.Context, req FixHealthIssuesRequestObject) (FixHealthIssuesResponseObject, error) {
@@ -266,7 +367,11 @@ func (s *StrictServerImpl) GetItems(ctx context.Context, req GetItemsRequestObje
 	if req.Params.Tags != nil {
 		tags = *req.Params.Tags
 	}
-	resp, err := s.items.GetItems(ctx, date, search, tags)
+	fields := ""
+	if req.Params.Fields != nil {
+		fields = *req.Params.Fields
+	}
+	resp, err := s.items.GetItems(ctx, date, search, tags, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -354,32 +459,110 @@ func (s *StrictServerImpl) GetTagStats(ctx context.Context, _ GetTagStatsRequest
 	}
 }
 
-// --- RenameTag ---
+// --- GetTagTree ---
+
+func (s *StrictServerImpl) GetTagTree(ctx context.Context, _ GetTagTreeRequestObject) (GetTagTreeResponseObject, error) {
+	resp, err := s.items.GetTagTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(TagTreeResponse)
+		if !ok {
+			return nil, fmt.Errorf("GetTagTree: unexpected body type %T", resp.Body)
+		}
+		return GetTagTree200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return GetTagTree401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetTagTree: unexpected status %d", resp.Code)
+	}
+}
+
+// --- GetTagConsolidation ---
 
-func (s *StrictServerImpl) RenameTag(ctx context.Context, req RenameTagRequestObject) (RenameTagResponseObject, error) {
+func (s *StrictServerImpl) GetTagConsolidation(
+	ctx context.Context, req GetTagConsolidationRequestObject,
+) (GetTagConsolidationResponseObject, error) {
+	useAI := req.Params.Ai != nil && *req.Params.Ai
+	resp, err := s.items.GetTagConsolidation(ctx, useAI)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(TagConsolidationResponse)
+		if !ok {
+			return nil, fmt.Errorf("GetTagConsolidation: unexpected body type %T", resp.Body)
+		}
+		return GetTagConsolidation200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return GetTagConsolidation401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetTagConsolidation: unexpected status %d", resp.Code)
+	}
+}
+
+// --- MergeTags ---
+
+func (s *StrictServerImpl) MergeTags(ctx context.Context, req MergeTagsRequestObject) (MergeTagsResponseObject, error) {
 	if req.Body == nil {
-		return RenameTag400Response{}, nil
+		return MergeTags400Response{}, nil
 	}
-	resp, err := s.items.RenameTag(ctx, req.Name, *req.Body)
+	resp, err := s.items.MergeTags(ctx, *req.Body)
 	if err != nil {
 		return nil, err
 	}
 	switch resp.Code {
 	case http.StatusOK:
-		return RenameTag200Response{}, nil
+		body, ok := resp.Body.(TagMergeResponse)
+		if !ok {
+			return nil, fmt.Errorf("MergeTags: unexpected body type %T", resp.Body)
+		}
+		return MergeTags200JSONResponse(body), nil
 	case http.StatusBadRequest:
-		return RenameTag400Response{}, nil
+		return MergeTags400Response{}, nil
 	case http.StatusUnauthorized:
-		return RenameTag401Response{}, nil
+		return MergeTags401Response{}, nil
 	default:
-		return nil, fmt.Errorf("RenameTag: unexpected status %d", resp.Code)
+		return nil, fmt.Errorf("MergeTags: unexpected status %d", resp.Code)
+	}
+}
+
+// --- UpdateTag ---
+
+func (s *StrictServerImpl) UpdateTag(ctx context.Context, req UpdateTagRequestObject) (UpdateTagResponseObject, error) {
+	if req.Body == nil {
+		return UpdateTag400Response{}, nil
+	}
+	resp, err := s.items.UpdateTag(ctx, req.Name, *req.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(TagMetadata)
+		if !ok {
+			return nil, fmt.Errorf("UpdateTag: unexpected body type %T", resp.Body)
+		}
+		return UpdateTag200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return UpdateTag400Response{}, nil
+	case http.StatusUnauthorized:
+		return UpdateTag401Response{}, nil
+	case http.StatusConflict:
+		return UpdateTag409Response{}, nil
+	default:
+		return nil, fmt.Errorf("UpdateTag: unexpected status %d", resp.Code)
 	}
 }
 
 // --- DeleteTag ---
 
 func (s *StrictServerImpl) DeleteTag(ctx context.Context, req DeleteTagRequestObject) (DeleteTagResponseObject, error) {
-	resp, err := s.items.DeleteTag(ctx, req.Name)
+	subtree := req.Params.Subtree != nil && *req.Params.Subtree
+	resp, err := s.items.DeleteTag(ctx, req.Name, subtree)
 	if err != nil {
 		return nil, err
 	}
@@ -393,6 +576,425 @@ func (s *StrictServerImpl) DeleteTag(ctx context.Context, req DeleteTagRequestOb
 	}
 }
 
+// --- GetCustomFields ---
+
+func (s *StrictServerImpl) GetCustomFields(ctx context.Context, _ GetCustomFieldsRequestObject) (GetCustomFieldsResponseObject, error) {
+	resp, err := s.items.GetCustomFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(CustomFieldsResponse)
+		if !ok {
+			return nil, fmt.Errorf("GetCustomFields: unexpected body type %T", resp.Body)
+		}
+		return GetCustomFields200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return GetCustomFields401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetCustomFields: unexpected status %d", resp.Code)
+	}
+}
+
+// --- CreateCustomField ---
+
+func (s *StrictServerImpl) CreateCustomField(ctx context.Context, req CreateCustomFieldRequestObject) (CreateCustomFieldResponseObject, error) {
+	if req.Body == nil {
+		return CreateCustomField400Response{}, nil
+	}
+	resp, err := s.items.CreateCustomField(ctx, *req.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusCreated:
+		body, ok := resp.Body.(CustomField)
+		if !ok {
+			return nil, fmt.Errorf("CreateCustomField: unexpected body type %T", resp.Body)
+		}
+		return CreateCustomField201JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return CreateCustomField400Response{}, nil
+	case http.StatusUnauthorized:
+		return CreateCustomField401Response{}, nil
+	case http.StatusConflict:
+		return CreateCustomField409Response{}, nil
+	default:
+		return nil, fmt.Errorf("CreateCustomField: unexpected status %d", resp.Code)
+	}
+}
+
+// --- UpdateCustomField ---
+
+func (s *StrictServerImpl) UpdateCustomField(ctx context.Context, req UpdateCustomFieldRequestObject) (UpdateCustomFieldResponseObject, error) {
+	if req.Body == nil {
+		return UpdateCustomField400Response{}, nil
+	}
+	resp, err := s.items.UpdateCustomField(ctx, req.Key, *req.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(CustomField)
+		if !ok {
+			return nil, fmt.Errorf("UpdateCustomField: unexpected body type %T", resp.Body)
+		}
+		return UpdateCustomField200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return UpdateCustomField400Response{}, nil
+	case http.StatusUnauthorized:
+		return UpdateCustomField401Response{}, nil
+	case http.StatusNotFound:
+		return UpdateCustomField404Response{}, nil
+	default:
+		return nil, fmt.Errorf("UpdateCustomField: unexpected status %d", resp.Code)
+	}
+}
+
+// --- DeleteCustomField ---
+
+func (s *StrictServerImpl) DeleteCustomField(ctx context.Context, req DeleteCustomFieldRequestObject) (DeleteCustomFieldResponseObject, error) {
+	resp, err := s.items.DeleteCustomField(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusNoContent, http.StatusOK:
+		return DeleteCustomField204Response{}, nil
+	case http.StatusUnauthorized:
+		return DeleteCustomField401Response{}, nil
+	case http.StatusNotFound:
+		return DeleteCustomField404Response{}, nil
+	default:
+		return nil, fmt.Errorf("DeleteCustomField: unexpected status %d", resp.Code)
+	}
+}
+
+// --- GetCustomFieldStats ---
+
+func (s *StrictServerImpl) GetCustomFieldStats(ctx context.Context, req GetCustomFieldStatsRequestObject) (GetCustomFieldStatsResponseObject, error) {
+	from := ""
+	if req.Params.From != nil {
+		from = req.Params.From.Time.Format("2006-01-02")
+	}
+	to := ""
+	if req.Params.To != nil {
+		to = req.Params.To.Time.Format("2006-01-02")
+	}
+	resp, err := s.items.GetCustomFieldStats(ctx, req.Key, from, to)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(CustomFieldStats)
+		if !ok {
+			return nil, fmt.Errorf("GetCustomFieldStats: unexpected body type %T", resp.Body)
+		}
+		return GetCustomFieldStats200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return GetCustomFieldStats400Response{}, nil
+	case http.StatusUnauthorized:
+		return GetCustomFieldStats401Response{}, nil
+	case http.StatusNotFound:
+		return GetCustomFieldStats404Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetCustomFieldStats: unexpected status %d", resp.Code)
+	}
+}
+
+// --- GetMemories ---
+
+func (s *StrictServerImpl) GetMemories(ctx context.Context, req GetMemoriesRequestObject) (GetMemoriesResponseObject, error) {
+	date := ""
+	if req.Params.Date != nil {
+		date = req.Params.Date.Time.Format("2006-01-02")
+	}
+	resp, err := s.items.GetMemories(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(MemoriesResponse)
+		if !ok {
+			return nil, fmt.Errorf("GetMemories: unexpected body type %T", resp.Body)
+		}
+		return GetMemories200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return GetMemories400Response{}, nil
+	case http.StatusUnauthorized:
+		return GetMemories401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetMemories: unexpected status %d", resp.Code)
+	}
+}
+
+// --- GetStats ---
+
+func (s *StrictServerImpl) GetStats(ctx context.Context, req GetStatsRequestObject) (GetStatsResponseObject, error) {
+	from := ""
+	if req.Params.From != nil {
+		from = req.Params.From.Time.Format("2006-01-02")
+	}
+	to := ""
+	if req.Params.To != nil {
+		to = req.Params.To.Time.Format("2006-01-02")
+	}
+	resp, err := s.items.GetStats(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(StatsResponse)
+		if !ok {
+			return nil, fmt.Errorf("GetStats: unexpected body type %T", resp.Body)
+		}
+		return GetStats200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return GetStats400Response{}, nil
+	case http.StatusUnauthorized:
+		return GetStats401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetStats: unexpected status %d", resp.Code)
+	}
+}
+
+// --- GetPlaces ---
+
+func (s *StrictServerImpl) GetPlaces(ctx context.Context, req GetPlacesRequestObject) (GetPlacesResponseObject, error) {
+	from := ""
+	if req.Params.From != nil {
+		from = req.Params.From.Time.Format("2006-01-02")
+	}
+	to := ""
+	if req.Params.To != nil {
+		to = req.Params.To.Time.Format("2006-01-02")
+	}
+	resp, err := s.items.GetPlaces(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(PlacesResponse)
+		if !ok {
+			return nil, fmt.Errorf("GetPlaces: unexpected body type %T", resp.Body)
+		}
+		return GetPlaces200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return GetPlaces400Response{}, nil
+	case http.StatusUnauthorized:
+		return GetPlaces401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetPlaces: unexpected status %d", resp.Code)
+	}
+}
+
+// --- SemanticSearchItems ---
+
+func (s *StrictServerImpl) SemanticSearchItems(ctx context.Context, req SemanticSearchItemsRequestObject) (SemanticSearchItemsResponseObject, error) {
+	var limit int32
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+	}
+	resp, err := s.search.SemanticSearchItems(ctx, req.Params.Q, limit)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(SemanticSearchResponse)
+		if !ok {
+			return nil, fmt.Errorf("SemanticSearchItems: unexpected body type %T", resp.Body)
+		}
+		return SemanticSearchItems200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return SemanticSearchItems400Response{}, nil
+	case http.StatusUnauthorized:
+		return SemanticSearchItems401Response{}, nil
+	case http.StatusTooManyRequests:
+		return SemanticSearchItems429Response{}, nil
+	case http.StatusServiceUnavailable:
+		return SemanticSearchItems503Response{}, nil
+	default:
+		return nil, fmt.Errorf("SemanticSearchItems: unexpected status %d", resp.Code)
+	}
+}
+
+// --- AskDiary ---
+
+func (s *StrictServerImpl) AskDiary(ctx context.Context, req AskDiaryRequestObject) (AskDiaryResponseObject, error) {
+	if req.Body == nil {
+		return AskDiary400Response{}, nil
+	}
+	resp, err := s.search.AskDiary(ctx, *req.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(AskResponse)
+		if !ok {
+			return nil, fmt.Errorf("AskDiary: unexpected body type %T", resp.Body)
+		}
+		return AskDiary200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return AskDiary400Response{}, nil
+	case http.StatusUnauthorized:
+		return AskDiary401Response{}, nil
+	case http.StatusTooManyRequests:
+		return AskDiary429Response{}, nil
+	case http.StatusServiceUnavailable:
+		return AskDiary503Response{}, nil
+	default:
+		return nil, fmt.Errorf("AskDiary: unexpected status %d", resp.Code)
+	}
+}
+
+// --- SummarizeItem ---
+
+func (s *StrictServerImpl) SummarizeItem(ctx context.Context, req SummarizeItemRequestObject) (SummarizeItemResponseObject, error) {
+	resp, err := s.items.SummarizeItem(ctx, req.Date.Time.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(ItemSummary)
+		if !ok {
+			return nil, fmt.Errorf("SummarizeItem: unexpected body type %T", resp.Body)
+		}
+		return SummarizeItem200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return SummarizeItem401Response{}, nil
+	case http.StatusNotFound:
+		return SummarizeItem404Response{}, nil
+	case http.StatusTooManyRequests:
+		return SummarizeItem429Response{}, nil
+	case http.StatusServiceUnavailable:
+		return SummarizeItem503Response{}, nil
+	default:
+		return nil, fmt.Errorf("SummarizeItem: unexpected status %d", resp.Code)
+	}
+}
+
+// --- GetItemBacklinks ---
+
+func (s *StrictServerImpl) GetItemBacklinks(
+	ctx context.Context, req GetItemBacklinksRequestObject,
+) (GetItemBacklinksResponseObject, error) {
+	resp, err := s.items.GetItemBacklinks(ctx, req.Date.Time.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(BacklinksResponse)
+		if !ok {
+			return nil, fmt.Errorf("GetItemBacklinks: unexpected body type %T", resp.Body)
+		}
+		return GetItemBacklinks200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return GetItemBacklinks401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetItemBacklinks: unexpected status %d", resp.Code)
+	}
+}
+
+// --- TranscribeItemAsset ---
+
+func (s *StrictServerImpl) TranscribeItemAsset(
+	ctx context.Context, req TranscribeItemAssetRequestObject,
+) (TranscribeItemAssetResponseObject, error) {
+	if req.Body == nil {
+		return TranscribeItemAsset400Response{}, nil
+	}
+	resp, err := s.items.TranscribeItemAsset(ctx, req.Date.Time.Format("2006-01-02"), *req.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(TranscribeResponse)
+		if !ok {
+			return nil, fmt.Errorf("TranscribeItemAsset: unexpected body type %T", resp.Body)
+		}
+		return TranscribeItemAsset200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return TranscribeItemAsset400Response{}, nil
+	case http.StatusUnauthorized:
+		return TranscribeItemAsset401Response{}, nil
+	case http.StatusNotFound:
+		return TranscribeItemAsset404Response{}, nil
+	case http.StatusConflict:
+		return TranscribeItemAsset409Response{}, nil
+	case http.StatusTooManyRequests:
+		return TranscribeItemAsset429Response{}, nil
+	case http.StatusServiceUnavailable:
+		return TranscribeItemAsset503Response{}, nil
+	default:
+		return nil, fmt.Errorf("TranscribeItemAsset: unexpected status %d", resp.Code)
+	}
+}
+
+// --- GetJobs ---
+
+func (s *StrictServerImpl) GetJobs(ctx context.Context, req GetJobsRequestObject) (GetJobsResponseObject, error) {
+	limit := 0
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+	}
+	resp, err := s.jobs.GetJobs(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(JobsResponse)
+		if !ok {
+			return nil, fmt.Errorf("GetJobs: unexpected body type %T", resp.Body)
+		}
+		return GetJobs200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return GetJobs400Response{}, nil
+	case http.StatusUnauthorized:
+		return GetJobs401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetJobs: unexpected status %d", resp.Code)
+	}
+}
+
+// --- GetRecaps ---
+
+func (s *StrictServerImpl) GetRecaps(ctx context.Context, req GetRecapsRequestObject) (GetRecapsResponseObject, error) {
+	period := ""
+	if req.Params.Period != nil {
+		period = string(*req.Params.Period)
+	}
+	resp, err := s.recaps.GetRecaps(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.([]Recap)
+		if !ok {
+			return nil, fmt.Errorf("GetRecaps: unexpected body type %T", resp.Body)
+		}
+		return GetRecaps200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return GetRecaps400Response{}, nil
+	case http.StatusUnauthorized:
+		return GetRecaps401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetRecaps: unexpected status %d", resp.Code)
+	}
+}
+
 // --- GetChanges ---
 
 func (s *StrictServerImpl) GetChanges(ctx context.Context, req GetChangesRequestObject) (GetChangesResponseObject, error) {
@@ -442,6 +1044,103 @@ func (s *StrictServerImpl) GetUser(ctx context.Context, _ GetUserRequestObject)
 	}
 }
 
+// --- EnrollTwoFactor ---
+
+func (s *StrictServerImpl) EnrollTwoFactor(ctx context.Context, _ EnrollTwoFactorRequestObject) (EnrollTwoFactorResponseObject, error) {
+	resp, err := s.user.EnrollTwoFactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(TwoFactorEnrollment)
+		if !ok {
+			return nil, fmt.Errorf("EnrollTwoFactor: unexpected body type %T", resp.Body)
+		}
+		return EnrollTwoFactor200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return EnrollTwoFactor401Response{}, nil
+	case http.StatusConflict:
+		return EnrollTwoFactor409Response{}, nil
+	default:
+		return nil, fmt.Errorf("EnrollTwoFactor: unexpected status %d", resp.Code)
+	}
+}
+
+// --- ConfirmTwoFactor ---
+
+func (s *StrictServerImpl) ConfirmTwoFactor(ctx context.Context, req ConfirmTwoFactorRequestObject) (ConfirmTwoFactorResponseObject, error) {
+	if req.Body == nil {
+		return ConfirmTwoFactor400Response{}, nil
+	}
+	resp, err := s.user.ConfirmTwoFactor(ctx, *req.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(RecoveryCodesResponse)
+		if !ok {
+			return nil, fmt.Errorf("ConfirmTwoFactor: unexpected body type %T", resp.Body)
+		}
+		return ConfirmTwoFactor200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return ConfirmTwoFactor400Response{}, nil
+	case http.StatusUnauthorized:
+		return ConfirmTwoFactor401Response{}, nil
+	default:
+		return nil, fmt.Errorf("ConfirmTwoFactor: unexpected status %d", resp.Code)
+	}
+}
+
+// --- DisableTwoFactor ---
+
+func (s *StrictServerImpl) DisableTwoFactor(ctx context.Context, req DisableTwoFactorRequestObject) (DisableTwoFactorResponseObject, error) {
+	if req.Body == nil {
+		return DisableTwoFactor400Response{}, nil
+	}
+	resp, err := s.user.DisableTwoFactor(ctx, *req.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusNoContent, http.StatusOK:
+		return DisableTwoFactor204Response{}, nil
+	case http.StatusBadRequest:
+		return DisableTwoFactor400Response{}, nil
+	case http.StatusUnauthorized:
+		return DisableTwoFactor401Response{}, nil
+	default:
+		return nil, fmt.Errorf("DisableTwoFactor: unexpected status %d", resp.Code)
+	}
+}
+
+// --- RegenerateRecoveryCodes ---
+
+func (s *StrictServerImpl) RegenerateRecoveryCodes(ctx context.Context, req RegenerateRecoveryCodesRequestObject) (RegenerateRecoveryCodesResponseObject, error) {
+	if req.Body == nil {
+		return RegenerateRecoveryCodes400Response{}, nil
+	}
+	resp, err := s.user.RegenerateRecoveryCodes(ctx, *req.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(RecoveryCodesResponse)
+		if !ok {
+			return nil, fmt.Errorf("RegenerateRecoveryCodes: unexpected body type %T", resp.Body)
+		}
+		return RegenerateRecoveryCodes200JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return RegenerateRecoveryCodes400Response{}, nil
+	case http.StatusUnauthorized:
+		return RegenerateRecoveryCodes401Response{}, nil
+	default:
+		return nil, fmt.Errorf("RegenerateRecoveryCodes: unexpected status %d", resp.Code)
+	}
+}
+
 // --- GetFamily ---
 
 func (s *StrictServerImpl) GetFamily(ctx context.Context, _ GetFamilyRequestObject) (GetFamilyResponseObject, error) {
@@ -493,6 +1192,29 @@ func (s *StrictServerImpl) UpdateFamilySettings(
 	}
 }
 
+// --- GetFamilyAIUsage ---
+
+func (s *StrictServerImpl) GetFamilyAIUsage(
+	ctx context.Context, _ GetFamilyAIUsageRequestObject,
+) (GetFamilyAIUsageResponseObject, error) {
+	resp, err := s.family.GetFamilyAIUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(AIUsageResponse)
+		if !ok {
+			return nil, fmt.Errorf("GetFamilyAIUsage: unexpected body type %T", resp.Body)
+		}
+		return GetFamilyAIUsage200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return GetFamilyAIUsage401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetFamilyAIUsage: unexpected status %d", resp.Code)
+	}
+}
+
 // --- SuggestItemTags ---
 
 func (s *StrictServerImpl) SuggestItemTags(
@@ -516,6 +1238,8 @@ func (s *StrictServerImpl) SuggestItemTags(
 		return SuggestItemTags400Response{}, nil
 	case http.StatusUnauthorized:
 		return SuggestItemTags401Response{}, nil
+	case http.StatusTooManyRequests:
+		return SuggestItemTags429Response{}, nil
 	case http.StatusServiceUnavailable:
 		return SuggestItemTags503Response{}, nil
 	default:
@@ -591,3 +1315,129 @@ func (response UploadAssetsBatch501Response) VisitUploadAssetsBatchResponse(w ht
 	return nil
 }
 
+// --- GetAccessTokens ---
+
+func (s *StrictServerImpl) GetAccessTokens(ctx context.Context, _ GetAccessTokensRequestObject) (GetAccessTokensResponseObject, error) {
+	resp, err := s.tokens.GetAccessTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.([]AccessToken)
+		if !ok {
+			return nil, fmt.Errorf("GetAccessTokens: unexpected body type %T", resp.Body)
+		}
+		return GetAccessTokens200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return GetAccessTokens401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetAccessTokens: unexpected status %d", resp.Code)
+	}
+}
+
+// --- CreateAccessToken ---
+
+func (s *StrictServerImpl) CreateAccessToken(ctx context.Context, req CreateAccessTokenRequestObject) (CreateAccessTokenResponseObject, error) {
+	if req.Body == nil {
+		return CreateAccessToken400Response{}, nil
+	}
+	resp, err := s.tokens.CreateAccessToken(ctx, *req.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusCreated:
+		body, ok := resp.Body.(CreatedAccessToken)
+		if !ok {
+			return nil, fmt.Errorf("CreateAccessToken: unexpected body type %T", resp.Body)
+		}
+		return CreateAccessToken201JSONResponse(body), nil
+	case http.StatusBadRequest:
+		return CreateAccessToken400Response{}, nil
+	case http.StatusUnauthorized:
+		return CreateAccessToken401Response{}, nil
+	default:
+		return nil, fmt.Errorf("CreateAccessToken: unexpected status %d", resp.Code)
+	}
+}
+
+// --- RevokeAccessToken ---
+
+func (s *StrictServerImpl) RevokeAccessToken(ctx context.Context, req RevokeAccessTokenRequestObject) (RevokeAccessTokenResponseObject, error) {
+	resp, err := s.tokens.RevokeAccessToken(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusNoContent, http.StatusOK:
+		return RevokeAccessToken204Response{}, nil
+	case http.StatusUnauthorized:
+		return RevokeAccessToken401Response{}, nil
+	case http.StatusNotFound:
+		return RevokeAccessToken404Response{}, nil
+	default:
+		return nil, fmt.Errorf("RevokeAccessToken: unexpected status %d", resp.Code)
+	}
+}
+
+// --- GetSessions ---
+
+func (s *StrictServerImpl) GetSessions(ctx context.Context, _ GetSessionsRequestObject) (GetSessionsResponseObject, error) {
+	resp, err := s.sessions.GetSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.([]Session)
+		if !ok {
+			return nil, fmt.Errorf("GetSessions: unexpected body type %T", resp.Body)
+		}
+		return GetSessions200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return GetSessions401Response{}, nil
+	default:
+		return nil, fmt.Errorf("GetSessions: unexpected status %d", resp.Code)
+	}
+}
+
+// --- RevokeSession ---
+
+func (s *StrictServerImpl) RevokeSession(ctx context.Context, req RevokeSessionRequestObject) (RevokeSessionResponseObject, error) {
+	resp, err := s.sessions.RevokeSession(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusNoContent, http.StatusOK:
+		return RevokeSession204Response{}, nil
+	case http.StatusUnauthorized:
+		return RevokeSession401Response{}, nil
+	case http.StatusNotFound:
+		return RevokeSession404Response{}, nil
+	default:
+		return nil, fmt.Errorf("RevokeSession: unexpected status %d", resp.Code)
+	}
+}
+
+// --- RevokeOtherSessions ---
+
+func (s *StrictServerImpl) RevokeOtherSessions(ctx context.Context, _ RevokeOtherSessionsRequestObject) (RevokeOtherSessionsResponseObject, error) {
+	resp, err := s.sessions.RevokeOtherSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case http.StatusOK:
+		body, ok := resp.Body.(RevokedSessions)
+		if !ok {
+			return nil, fmt.Errorf("RevokeOtherSessions: unexpected body type %T", resp.Body)
+		}
+		return RevokeOtherSessions200JSONResponse(body), nil
+	case http.StatusUnauthorized:
+		return RevokeOtherSessions401Response{}, nil
+	default:
+		return nil, fmt.Errorf("RevokeOtherSessions: unexpected status %d", resp.Code)
+	}
+}
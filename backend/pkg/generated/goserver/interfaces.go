@@ -7,17 +7,26 @@ package goserver
 import (
 	"context"
 	"os"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // AssetsAPIService defines the business logic for the Assets API.
 type AssetsAPIService interface {
 	GetAsset(ctx context.Context, path string) (ImplResponse, error)
+	ListAssets(ctx context.Context, date string) (ImplResponse, error)
 	UploadAssetsBatch(ctx context.Context, files []*os.File) (ImplResponse, error)
 }
 
+// AuditAPIService defines the business logic for the security Audit log API.
+type AuditAPIService interface {
+	GetAuditEvents(ctx context.Context, before int32, limit int32) (ImplResponse, error)
+}
+
 // AuthAPIService defines the business logic for the Auth API.
 type AuthAPIService interface {
 	Authorize(ctx context.Context, authData AuthData) (ImplResponse, error)
+	AuthorizeTwoFactor(ctx context.Context, req TwoFactorLoginRequest) (ImplResponse, error)
 }
 
 // AuthAPIServicer is an alias for backward compatibility with custom controllers.
@@ -35,15 +44,53 @@ type HealthAPIService interface {
 
 // ItemsAPIService defines the business logic for the Items API.
 type ItemsAPIService interface {
-	GetItems(ctx context.Context, date string, search string, tags string) (ImplResponse, error)
+	GetItems(ctx context.Context, date string, search string, tags string, fields string) (ImplResponse, error)
 	PutItems(ctx context.Context, itemsRequest ItemsRequest) (ImplResponse, error)
 	SuggestItemTags(ctx context.Context, req SuggestTagsRequest) (ImplResponse, error)
 	DismissItemTag(ctx context.Context, req DismissTagRequest) (ImplResponse, error)
 	AcceptItemTag(ctx context.Context, req DismissTagRequest) (ImplResponse, error)
 	GetTags(ctx context.Context) (ImplResponse, error)
 	GetTagStats(ctx context.Context) (ImplResponse, error)
-	RenameTag(ctx context.Context, name string, req RenameTagRequest) (ImplResponse, error)
-	DeleteTag(ctx context.Context, name string) (ImplResponse, error)
+	GetTagTree(ctx context.Context) (ImplResponse, error)
+	GetTagConsolidation(ctx context.Context, useAI bool) (ImplResponse, error)
+	MergeTags(ctx context.Context, req TagMergeRequest) (ImplResponse, error)
+	UpdateTag(ctx context.Context, name string, req TagUpdateRequest) (ImplResponse, error)
+	DeleteTag(ctx context.Context, name string, subtree bool) (ImplResponse, error)
+	GetCustomFields(ctx context.Context) (ImplResponse, error)
+	CreateCustomField(ctx context.Context, req CustomFieldCreateRequest) (ImplResponse, error)
+	UpdateCustomField(ctx context.Context, key string, req CustomFieldUpdateRequest) (ImplResponse, error)
+	DeleteCustomField(ctx context.Context, key string) (ImplResponse, error)
+	GetCustomFieldStats(ctx context.Context, key string, from string, to string) (ImplResponse, error)
+	GetPlaces(ctx context.Context, from string, to string) (ImplResponse, error)
+	GetMemories(ctx context.Context, date string) (ImplResponse, error)
+	GetStats(ctx context.Context, from string, to string) (ImplResponse, error)
+	SummarizeItem(ctx context.Context, date string) (ImplResponse, error)
+	GetItemBacklinks(ctx context.Context, date string) (ImplResponse, error)
+	TranscribeItemAsset(ctx context.Context, date string, req TranscribeRequest) (ImplResponse, error)
+}
+
+// JobsAPIService defines the business logic for the background AI Jobs API.
+type JobsAPIService interface {
+	GetJobs(ctx context.Context, limit int) (ImplResponse, error)
+}
+
+// RecapsAPIService defines the business logic for the AI-written Recaps API.
+type RecapsAPIService interface {
+	GetRecaps(ctx context.Context, period string) (ImplResponse, error)
+}
+
+// SearchAPIService defines the business logic for semantic search and
+// questions about the diary.
+type SearchAPIService interface {
+	SemanticSearchItems(ctx context.Context, q string, limit int32) (ImplResponse, error)
+	AskDiary(ctx context.Context, req AskRequest) (ImplResponse, error)
+}
+
+// SessionsAPIService defines the business logic for the sign-in Sessions API.
+type SessionsAPIService interface {
+	GetSessions(ctx context.Context) (ImplResponse, error)
+	RevokeSession(ctx context.Context, id openapi_types.UUID) (ImplResponse, error)
+	RevokeOtherSessions(ctx context.Context) (ImplResponse, error)
 }
 
 // SyncAPIService defines the business logic for the Sync API.
@@ -51,13 +98,25 @@ type SyncAPIService interface {
 	GetChanges(ctx context.Context, since int32, limit int32) (ImplResponse, error)
 }
 
+// TokensAPIService defines the business logic for the personal access Tokens API.
+type TokensAPIService interface {
+	GetAccessTokens(ctx context.Context) (ImplResponse, error)
+	CreateAccessToken(ctx context.Context, req CreateAccessTokenRequest) (ImplResponse, error)
+	RevokeAccessToken(ctx context.Context, id openapi_types.UUID) (ImplResponse, error)
+}
+
 // UserAPIService defines the business logic for the User API.
 type UserAPIService interface {
 	GetUser(ctx context.Context) (ImplResponse, error)
+	EnrollTwoFactor(ctx context.Context) (ImplResponse, error)
+	ConfirmTwoFactor(ctx context.Context, req TwoFactorCodeRequest) (ImplResponse, error)
+	DisableTwoFactor(ctx context.Context, req TwoFactorCodeRequest) (ImplResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, req TwoFactorCodeRequest) (ImplResponse, error)
 }
 
 // FamilyAPIService defines the business logic for the Family API.
 type FamilyAPIService interface {
 	GetFamily(ctx context.Context) (ImplResponse, error)
 	UpdateFamilySettings(ctx context.Context, req FamilySettingsRequest) (ImplResponse, error)
+	GetFamilyAIUsage(ctx context.Context) (ImplResponse, error)
 }
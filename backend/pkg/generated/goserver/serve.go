@@ -17,13 +17,19 @@ import (
 
 // CustomControllers holds the concrete service implementations.
 type CustomControllers struct {
-	AssetsAPIService AssetsAPIService
-	AuthAPIService   AuthAPIService
-	FamilyAPIService FamilyAPIService
-	HealthAPIService HealthAPIService
-	ItemsAPIService  ItemsAPIService
-	SyncAPIService   SyncAPIService
-	UserAPIService   UserAPIService
+	AssetsAPIService   AssetsAPIService
+	AuditAPIService    AuditAPIService
+	AuthAPIService     AuthAPIService
+	FamilyAPIService   FamilyAPIService
+	HealthAPIService   HealthAPIService
+	ItemsAPIService    ItemsAPIService
+	JobsAPIService     JobsAPIService
+	RecapsAPIService   RecapsAPIService
+	SearchAPIService   SearchAPIService
+	SessionsAPIService SessionsAPIService
+	SyncAPIService     SyncAPIService
+	TokensAPIService   TokensAPIService
+	UserAPIService     UserAPIService
 }
 
 // Serve starts the HTTP server and returns the listening address and a finish channel.
@@ -22,6 +22,129 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AIJobKind.
+const (
+	TagDay AIJobKind = "tag_day"
+)
+
+// Valid indicates whether the value is a known member of the AIJobKind enum.
+func (e AIJobKind) Valid() bool {
+	switch e {
+	case TagDay:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AIJobStatus.
+const (
+	Done    AIJobStatus = "done"
+	Failed  AIJobStatus = "failed"
+	Queued  AIJobStatus = "queued"
+	Running AIJobStatus = "running"
+)
+
+// Valid indicates whether the value is a known member of the AIJobStatus enum.
+func (e AIJobStatus) Valid() bool {
+	switch e {
+	case Done:
+		return true
+	case Failed:
+		return true
+	case Queued:
+		return true
+	case Running:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AccessTokenScope.
+const (
+	AssetsWrite AccessTokenScope = "assets:write"
+	ItemsRead   AccessTokenScope = "items:read"
+	ItemsWrite  AccessTokenScope = "items:write"
+)
+
+// Valid indicates whether the value is a known member of the AccessTokenScope enum.
+func (e AccessTokenScope) Valid() bool {
+	switch e {
+	case AssetsWrite:
+		return true
+	case ItemsRead:
+		return true
+	case ItemsWrite:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CustomFieldType.
+const (
+	Boolean CustomFieldType = "boolean"
+	Enum    CustomFieldType = "enum"
+	Number  CustomFieldType = "number"
+	Scale   CustomFieldType = "scale"
+	Text    CustomFieldType = "text"
+)
+
+// Valid indicates whether the value is a known member of the CustomFieldType enum.
+func (e CustomFieldType) Valid() bool {
+	switch e {
+	case Boolean:
+		return true
+	case Enum:
+		return true
+	case Number:
+		return true
+	case Scale:
+		return true
+	case Text:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PlaceSource.
+const (
+	Manual PlaceSource = "manual"
+	Photo  PlaceSource = "photo"
+)
+
+// Valid indicates whether the value is a known member of the PlaceSource enum.
+func (e PlaceSource) Valid() bool {
+	switch e {
+	case Manual:
+		return true
+	case Photo:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RecapPeriod.
+const (
+	RecapPeriodMonth RecapPeriod = "month"
+	RecapPeriodWeek  RecapPeriod = "week"
+)
+
+// Valid indicates whether the value is a known member of the RecapPeriod enum.
+func (e RecapPeriod) Valid() bool {
+	switch e {
+	case RecapPeriodMonth:
+		return true
+	case RecapPeriodWeek:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for SyncChangeResponseOperationType.
 const (
 	Created SyncChangeResponseOperationType = "created"
@@ -43,6 +166,199 @@ func (e SyncChangeResponseOperationType) Valid() bool {
 	}
 }
 
+// Defines values for TagClusterReasons.
+const (
+	Ai   TagClusterReasons = "ai"
+	Case TagClusterReasons = "case"
+	Edit TagClusterReasons = "edit"
+	Stem TagClusterReasons = "stem"
+)
+
+// Valid indicates whether the value is a known member of the TagClusterReasons enum.
+func (e TagClusterReasons) Valid() bool {
+	switch e {
+	case Ai:
+		return true
+	case Case:
+		return true
+	case Edit:
+		return true
+	case Stem:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for WeatherCondition.
+const (
+	Clear        WeatherCondition = "clear"
+	Cloudy       WeatherCondition = "cloudy"
+	Drizzle      WeatherCondition = "drizzle"
+	Fog          WeatherCondition = "fog"
+	PartlyCloudy WeatherCondition = "partly-cloudy"
+	Rain         WeatherCondition = "rain"
+	Showers      WeatherCondition = "showers"
+	Snow         WeatherCondition = "snow"
+	Thunderstorm WeatherCondition = "thunderstorm"
+)
+
+// Valid indicates whether the value is a known member of the WeatherCondition enum.
+func (e WeatherCondition) Valid() bool {
+	switch e {
+	case Clear:
+		return true
+	case Cloudy:
+		return true
+	case Drizzle:
+		return true
+	case Fog:
+		return true
+	case PartlyCloudy:
+		return true
+	case Rain:
+		return true
+	case Showers:
+		return true
+	case Snow:
+		return true
+	case Thunderstorm:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetRecapsParamsPeriod.
+const (
+	GetRecapsParamsPeriodMonth GetRecapsParamsPeriod = "month"
+	GetRecapsParamsPeriodWeek  GetRecapsParamsPeriod = "week"
+)
+
+// Valid indicates whether the value is a known member of the GetRecapsParamsPeriod enum.
+func (e GetRecapsParamsPeriod) Valid() bool {
+	switch e {
+	case GetRecapsParamsPeriodMonth:
+		return true
+	case GetRecapsParamsPeriodWeek:
+		return true
+	default:
+		return false
+	}
+}
+
+// AIJob defines model for AIJob.
+type AIJob struct {
+	// Attempts number of times the job was started
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Date day the job works on
+	Date       openapi_types.Date `json:"date"`
+	FinishedAt *time.Time         `json:"finishedAt,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// Kind tag_day: suggest tags for one day's entry (the AI tagging backfill)
+	Kind AIJobKind `json:"kind"`
+
+	// LastError error of the last failed attempt
+	LastError *string `json:"lastError,omitempty"`
+
+	// RunAfter earliest time a queued job runs (later than createdAt when retrying)
+	RunAfter time.Time   `json:"runAfter"`
+	Status   AIJobStatus `json:"status"`
+}
+
+// AIJobKind tag_day: suggest tags for one day's entry (the AI tagging backfill)
+type AIJobKind string
+
+// AIJobStatus defines model for AIJob.Status.
+type AIJobStatus string
+
+// AIUsageMonth defines model for AIUsageMonth.
+type AIUsageMonth struct {
+	// Images Images and video frames sent to the model
+	Images int64 `json:"images"`
+
+	// InputTokens Prompt tokens reported by the provider
+	InputTokens int64 `json:"inputTokens"`
+
+	// Month Calendar month (UTC) in YYYY-MM format
+	Month string `json:"month"`
+
+	// OutputTokens Response tokens reported by the provider
+	OutputTokens int64 `json:"outputTokens"`
+
+	// Requests Number of answered model calls
+	Requests int64 `json:"requests"`
+}
+
+// AIUsageResponse defines model for AIUsageResponse.
+type AIUsageResponse struct {
+	// BudgetExhausted True when the current month's budget is spent and the family's model calls are refused
+	BudgetExhausted bool `json:"budgetExhausted"`
+
+	// Month Current calendar month (UTC) in YYYY-MM format
+	Month string `json:"month"`
+
+	// MonthlyTokenBudget Tokens (input + output) the family may spend per month; 0 means unlimited
+	MonthlyTokenBudget int64 `json:"monthlyTokenBudget"`
+
+	// Months Usage per month, newest first
+	Months []AIUsageMonth `json:"months"`
+}
+
+// AccessToken defines model for AccessToken.
+type AccessToken struct {
+	CreatedAt  time.Time          `json:"createdAt"`
+	ExpiresAt  *time.Time         `json:"expiresAt,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+	LastUsedAt *time.Time         `json:"lastUsedAt,omitempty"`
+	Name       string             `json:"name"`
+
+	// Prefix leading characters of the secret, for recognising a token
+	Prefix string             `json:"prefix"`
+	Scopes []AccessTokenScope `json:"scopes"`
+}
+
+// AccessTokenScope defines model for AccessTokenScope.
+type AccessTokenScope string
+
+// AskCitation defines model for AskCitation.
+type AskCitation struct {
+	Date  openapi_types.Date `json:"date"`
+	Title string             `json:"title"`
+}
+
+// AskRequest defines model for AskRequest.
+type AskRequest struct {
+	Question string `json:"question"`
+}
+
+// AskResponse defines model for AskResponse.
+type AskResponse struct {
+	// Answer the answer; empty when no relevant entries were found
+	Answer string `json:"answer"`
+
+	// Citations entries the answer refers to, in order of first mention
+	Citations []AskCitation `json:"citations"`
+}
+
+// AssetInfo defines model for AssetInfo.
+type AssetInfo struct {
+	// Caption AI-written description of the image; absent until the caption task has described it
+	Caption *string `json:"caption,omitempty"`
+
+	// Filename File name in the family's asset directory (the markdown image path)
+	Filename string `json:"filename"`
+
+	// ModifiedAt Last modification time of the file
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	// Size File size in bytes
+	Size int64 `json:"size"`
+}
+
 // AssetsBatchFile defines model for AssetsBatchFile.
 type AssetsBatchFile struct {
 	// ContentType MIME type detected for the file
@@ -71,12 +387,166 @@ type AttachOrphanRequest struct {
 	Date string `json:"date"`
 }
 
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	// Action e.g. login.succeeded, login.failed, tag.renamed, orphan.deleted
+	Action string `json:"action"`
+
+	// Actor username that performed the action, empty if unknown
+	Actor     string `json:"actor"`
+	Id        int32  `json:"id"`
+	IpAddress string `json:"ipAddress"`
+
+	// Target what the action applied to (tag, file, checks, login method)
+	Target    string    `json:"target"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// AuditLogResponse defines model for AuditLogResponse.
+type AuditLogResponse struct {
+	Events []AuditEvent `json:"events"`
+
+	// HasMore whether older events are available
+	HasMore bool `json:"hasMore"`
+
+	// NextBefore value for the before parameter of the next page (if hasMore is true)
+	NextBefore *int32 `json:"nextBefore,omitempty"`
+}
+
 // AuthData defines model for AuthData.
 type AuthData struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
+// Backlink defines model for Backlink.
+type Backlink struct {
+	// Date date of the linking entry
+	Date openapi_types.Date `json:"date"`
+
+	// Snippet the line of the linking entry's body holding the link
+	Snippet string `json:"snippet"`
+	Title   string `json:"title"`
+}
+
+// BacklinksResponse defines model for BacklinksResponse.
+type BacklinksResponse struct {
+	Backlinks []Backlink `json:"backlinks"`
+}
+
+// CreateAccessTokenRequest defines model for CreateAccessTokenRequest.
+type CreateAccessTokenRequest struct {
+	// ExpiresAt optional expiry; tokens without one live until revoked
+	ExpiresAt *time.Time         `json:"expiresAt,omitempty"`
+	Name      string             `json:"name"`
+	Scopes    []AccessTokenScope `json:"scopes"`
+}
+
+// CreatedAccessToken defines model for CreatedAccessToken.
+type CreatedAccessToken struct {
+	// Secret the bearer value; store it now, it cannot be retrieved later
+	Secret string      `json:"secret"`
+	Token  AccessToken `json:"token"`
+}
+
+// CustomField defines model for CustomField.
+type CustomField struct {
+	// Key Names the field in entries, filters and stats; never changes
+	Key  string   `json:"key"`
+	Max  *float64 `json:"max,omitempty"`
+	Min  *float64 `json:"min,omitempty"`
+	Name string   `json:"name"`
+
+	// Options Allowed values of an enum field
+	Options []string `json:"options"`
+
+	// Position Display order
+	Position int `json:"position"`
+
+	// Type number: any number; scale: a whole number from min to max (1–5 unless set); boolean: true or false; text: free text; enum: one of options
+	Type CustomFieldType `json:"type"`
+	Unit *string         `json:"unit,omitempty"`
+}
+
+// CustomFieldCreateRequest defines model for CustomFieldCreateRequest.
+type CustomFieldCreateRequest struct {
+	Key  string   `json:"key"`
+	Max  *float64 `json:"max,omitempty"`
+	Min  *float64 `json:"min,omitempty"`
+	Name string   `json:"name"`
+
+	// Options Allowed values; required for enum fields
+	Options  *[]string `json:"options,omitempty"`
+	Position *int      `json:"position,omitempty"`
+
+	// Type number: any number; scale: a whole number from min to max (1–5 unless set); boolean: true or false; text: free text; enum: one of options
+	Type CustomFieldType `json:"type"`
+	Unit *string         `json:"unit,omitempty"`
+}
+
+// CustomFieldMonthStat defines model for CustomFieldMonthStat.
+type CustomFieldMonthStat struct {
+	// Avg Average value (numeric fields only)
+	Avg   *float64 `json:"avg,omitempty"`
+	Count int      `json:"count"`
+	Month string   `json:"month"`
+}
+
+// CustomFieldStats defines model for CustomFieldStats.
+type CustomFieldStats struct {
+	Avg *float64 `json:"avg,omitempty"`
+
+	// Count Number of entries with a value
+	Count int      `json:"count"`
+	Key   string   `json:"key"`
+	Max   *float64 `json:"max,omitempty"`
+
+	// Min Numeric fields only; booleans count as 1 and 0
+	Min *float64 `json:"min,omitempty"`
+
+	// Months Entries with a value per month, oldest first
+	Months []CustomFieldMonthStat `json:"months"`
+	Sum    *float64               `json:"sum,omitempty"`
+
+	// Type number: any number; scale: a whole number from min to max (1–5 unless set); boolean: true or false; text: free text; enum: one of options
+	Type CustomFieldType `json:"type"`
+
+	// Values Entries per value, most frequent first (scale, boolean and enum fields; empty otherwise)
+	Values []CustomFieldValueCount `json:"values"`
+}
+
+// CustomFieldType number: any number; scale: a whole number from min to max (1–5 unless set); boolean: true or false; text: free text; enum: one of options
+type CustomFieldType string
+
+// CustomFieldUpdateRequest defines model for CustomFieldUpdateRequest.
+type CustomFieldUpdateRequest struct {
+	Max      *float64  `json:"max,omitempty"`
+	Min      *float64  `json:"min,omitempty"`
+	Name     *string   `json:"name,omitempty"`
+	Options  *[]string `json:"options,omitempty"`
+	Position *int      `json:"position,omitempty"`
+
+	// Unit Empty clears the unit
+	Unit *string `json:"unit,omitempty"`
+}
+
+// CustomFieldValueCount defines model for CustomFieldValueCount.
+type CustomFieldValueCount struct {
+	Count int    `json:"count"`
+	Value string `json:"value"`
+}
+
+// CustomFieldsResponse defines model for CustomFieldsResponse.
+type CustomFieldsResponse struct {
+	Fields []CustomField `json:"fields"`
+}
+
+// DayWordCount defines model for DayWordCount.
+type DayWordCount struct {
+	Date  openapi_types.Date `json:"date"`
+	Words int                `json:"words"`
+}
+
 // DismissTagRequest defines model for DismissTagRequest.
 type DismissTagRequest struct {
 	Date openapi_types.Date `json:"date"`
@@ -95,32 +565,66 @@ type FamilyMember struct {
 
 // FamilyResponse defines model for FamilyResponse.
 type FamilyResponse struct {
+	// AiCaptionsEnabled Enable AI image captions (referenced image assets are sent to the model)
+	AiCaptionsEnabled *bool `json:"aiCaptionsEnabled,omitempty"`
+
+	// AiSearchEnabled Enable semantic search (entry text is sent to the embedding provider)
+	AiSearchEnabled *bool `json:"aiSearchEnabled,omitempty"`
+
+	// AiSummariesEnabled Enable AI entry summaries, the scheduled weekly/monthly recaps and questions about the diary
+	AiSummariesEnabled *bool `json:"aiSummariesEnabled,omitempty"`
+
 	// AiTaggingAuto Auto-apply confident suggestions to untagged days on unattended triggers
 	AiTaggingAuto *bool `json:"aiTaggingAuto,omitempty"`
 
 	// AiTaggingBackfill Enable the background untagged-days backfill check
 	AiTaggingBackfill *bool `json:"aiTaggingBackfill,omitempty"`
 
+	// AiTaggingClosedVocabulary Only suggest tags the family already uses; never coin new ones
+	AiTaggingClosedVocabulary *bool `json:"aiTaggingClosedVocabulary,omitempty"`
+
 	// AiTaggingEnabled Per-family master switch for AI tag suggestion
 	AiTaggingEnabled *bool `json:"aiTaggingEnabled,omitempty"`
 
+	// AiTaggingInstructions The family's own guidance for tag suggestion, added to the prompt
+	AiTaggingInstructions *string `json:"aiTaggingInstructions,omitempty"`
+
+	// AiTaggingMaxTags Maximum number of tags suggested per entry
+	AiTaggingMaxTags *int `json:"aiTaggingMaxTags,omitempty"`
+
 	// AiTaggingUseImages Include referenced image assets in tag suggestion requests (images are sent to Gemini)
 	AiTaggingUseImages *bool `json:"aiTaggingUseImages,omitempty"`
 
 	// AiTaggingUseVideo Include keyframes extracted from referenced video assets in tag suggestion requests (frames are sent to Gemini; requires ffmpeg)
-	AiTaggingUseVideo *bool              `json:"aiTaggingUseVideo,omitempty"`
-	Id                openapi_types.UUID `json:"id"`
-	Members           []FamilyMember     `json:"members"`
-	Name              string             `json:"name"`
+	AiTaggingUseVideo *bool `json:"aiTaggingUseVideo,omitempty"`
+
+	// AiTranscriptionEnabled Enable voice memo transcription (memos sent for transcription go to the transcription backend)
+	AiTranscriptionEnabled *bool              `json:"aiTranscriptionEnabled,omitempty"`
+	Id                     openapi_types.UUID `json:"id"`
+	Members                []FamilyMember     `json:"members"`
+
+	// MemoriesDigestEnabled Send the daily "on this day" memories digest through the server's notifier
+	MemoriesDigestEnabled *bool  `json:"memoriesDigestEnabled,omitempty"`
+	Name                  string `json:"name"`
 }
 
 // FamilySettingsRequest defines model for FamilySettingsRequest.
 type FamilySettingsRequest struct {
-	AiTaggingAuto      *bool `json:"aiTaggingAuto,omitempty"`
-	AiTaggingBackfill  *bool `json:"aiTaggingBackfill,omitempty"`
-	AiTaggingEnabled   *bool `json:"aiTaggingEnabled,omitempty"`
-	AiTaggingUseImages *bool `json:"aiTaggingUseImages,omitempty"`
-	AiTaggingUseVideo  *bool `json:"aiTaggingUseVideo,omitempty"`
+	AiCaptionsEnabled         *bool `json:"aiCaptionsEnabled,omitempty"`
+	AiSearchEnabled           *bool `json:"aiSearchEnabled,omitempty"`
+	AiSummariesEnabled        *bool `json:"aiSummariesEnabled,omitempty"`
+	AiTaggingAuto             *bool `json:"aiTaggingAuto,omitempty"`
+	AiTaggingBackfill         *bool `json:"aiTaggingBackfill,omitempty"`
+	AiTaggingClosedVocabulary *bool `json:"aiTaggingClosedVocabulary,omitempty"`
+	AiTaggingEnabled          *bool `json:"aiTaggingEnabled,omitempty"`
+
+	// AiTaggingInstructions Guidance for tag suggestion (at most 1000 characters); empty clears it
+	AiTaggingInstructions  *string `json:"aiTaggingInstructions,omitempty"`
+	AiTaggingMaxTags       *int    `json:"aiTaggingMaxTags,omitempty"`
+	AiTaggingUseImages     *bool   `json:"aiTaggingUseImages,omitempty"`
+	AiTaggingUseVideo      *bool   `json:"aiTaggingUseVideo,omitempty"`
+	AiTranscriptionEnabled *bool   `json:"aiTranscriptionEnabled,omitempty"`
+	MemoriesDigestEnabled  *bool   `json:"memoriesDigestEnabled,omitempty"`
 }
 
 // HealthFixRequest defines model for HealthFixRequest.
@@ -156,6 +660,14 @@ type HealthIssuesResponse struct {
 	LastChecked *time.Time `json:"lastChecked,omitempty"`
 }
 
+// ItemSummary defines model for ItemSummary.
+type ItemSummary struct {
+	Date openapi_types.Date `json:"date"`
+
+	// Summary one-paragraph summary; empty when the model had nothing to say
+	Summary string `json:"summary"`
+}
+
 // ItemsListResponse defines model for ItemsListResponse.
 type ItemsListResponse struct {
 	// Items List of diary items matching the search criteria
@@ -167,69 +679,266 @@ type ItemsListResponse struct {
 
 // ItemsRequest defines model for ItemsRequest.
 type ItemsRequest struct {
-	Body  *string            `json:"body,omitempty"`
-	Date  openapi_types.Date `json:"date"`
-	Tags  *[]string          `json:"tags,omitempty"`
-	Title string             `json:"title"`
+	Body *string            `json:"body,omitempty"`
+	Date openapi_types.Date `json:"date"`
+
+	// Fields Custom field values by field key: a number for number and scale fields, true or false for boolean fields, a string for text and enum fields. Left out, the entry keeps its values; sent, they replace them (null values are dropped, so {} clears them all).
+	Fields *map[string]interface{} `json:"fields,omitempty"`
+
+	// Place Where the entry happened. Left out, the entry keeps its place, or takes the GPS position of its first geotagged photo when it has none; sent, it replaces it, and a place without name or coordinates clears it.
+	Place *PlaceRequest `json:"place,omitempty"`
+	Tags  *[]string     `json:"tags,omitempty"`
+	Title string        `json:"title"`
 }
 
 // ItemsResponse defines model for ItemsResponse.
 type ItemsResponse struct {
-	Body     *string             `json:"body,omitempty"`
-	Date     openapi_types.Date  `json:"date"`
-	NextDate *openapi_types.Date `json:"nextDate,omitempty"`
+	Body *string            `json:"body,omitempty"`
+	Date openapi_types.Date `json:"date"`
+
+	// Fields Custom field values by field key: a number for number and scale fields, true or false for boolean fields, a string for text and enum fields.
+	Fields   *map[string]interface{} `json:"fields,omitempty"`
+	NextDate *openapi_types.Date     `json:"nextDate,omitempty"`
 
 	// PendingTags AI-suggested tags awaiting user acceptance (disjoint from tags)
 	PendingTags  *[]string           `json:"pendingTags,omitempty"`
+	Place        *Place              `json:"place,omitempty"`
 	PreviousDate *openapi_types.Date `json:"previousDate,omitempty"`
 	Tags         *[]string           `json:"tags,omitempty"`
 	Title        string              `json:"title"`
-}
 
-// RenameTagRequest defines model for RenameTagRequest.
-type RenameTagRequest struct {
-	// NewName New name for the tag; merges into an existing tag where an entry already carries it
-	NewName string `json:"newName"`
+	// Weather Weather of the entry's day at its place, looked up when the place gets coordinates
+	Weather *Weather `json:"weather,omitempty"`
 }
 
-// SuggestTagsRequest defines model for SuggestTagsRequest.
-type SuggestTagsRequest struct {
-	Body  *string            `json:"body,omitempty"`
-	Date  openapi_types.Date `json:"date"`
-	Title string             `json:"title"`
+// JobCounts defines model for JobCounts.
+type JobCounts struct {
+	Done    int64 `json:"done"`
+	Failed  int64 `json:"failed"`
+	Queued  int64 `json:"queued"`
+	Running int64 `json:"running"`
 }
 
-// SuggestTagsResponse defines model for SuggestTagsResponse.
-type SuggestTagsResponse struct {
-	Tags []TagSuggestion `json:"tags"`
+// JobsResponse defines model for JobsResponse.
+type JobsResponse struct {
+	// BackfillDone true once the one-time backfill has analyzed every day
+	BackfillDone bool `json:"backfillDone"`
+
+	// BackfillRemainingDays days the AI tagging backfill still has to analyze (0 when it is off or complete)
+	BackfillRemainingDays int       `json:"backfillRemainingDays"`
+	Counts                JobCounts `json:"counts"`
+
+	// Jobs most recently active jobs first
+	Jobs []AIJob `json:"jobs"`
 }
 
-// SyncChangeResponse defines model for SyncChangeResponse.
-type SyncChangeResponse struct {
-	// Date Date of the diary entry that was changed
+// MemoriesResponse defines model for MemoriesResponse.
+type MemoriesResponse struct {
+	// Date the day the memories are for
 	Date openapi_types.Date `json:"date"`
 
-	// Id Unique change ID
-	Id int32 `json:"id"`
+	// MonthAgo entries of the week a month before the day, oldest first
+	MonthAgo []Memory `json:"monthAgo"`
 
-	// ItemSnapshot Current state of the item (null for deleted items)
-	ItemSnapshot *ItemsResponse `json:"itemSnapshot,omitempty"`
+	// MonthAgoFrom Monday of the week a month before the day
+	MonthAgoFrom openapi_types.Date `json:"monthAgoFrom"`
 
-	// Metadata Additional metadata about the change
-	Metadata *[]string `json:"metadata,omitempty"`
+	// MonthAgoTo Sunday of the week a month before the day
+	MonthAgoTo openapi_types.Date `json:"monthAgoTo"`
 
-	// OperationType Type of operation performed
-	OperationType SyncChangeResponseOperationType `json:"operationType"`
+	// OnThisDay entries of the same calendar day in previous years, newest first
+	OnThisDay []Memory `json:"onThisDay"`
+}
 
-	// Timestamp When the change occurred
-	Timestamp time.Time `json:"timestamp"`
+// Memory defines model for Memory.
+type Memory struct {
+	Date openapi_types.Date `json:"date"`
 
-	// UserId User ID who made the change
-	UserId string `json:"userId"`
+	// Image the first image asset the entry references, if any
+	Image *string `json:"image,omitempty"`
+	Title string  `json:"title"`
+
+	// YearsAgo whole years between the entry and the requested day
+	YearsAgo int `json:"yearsAgo"`
 }
 
-// SyncChangeResponseOperationType Type of operation performed
-type SyncChangeResponseOperationType string
+// MonthEntryStat defines model for MonthEntryStat.
+type MonthEntryStat struct {
+	Entries int    `json:"entries"`
+	Month   string `json:"month"`
+	Words   int    `json:"words"`
+}
+
+// Place defines model for Place.
+type Place struct {
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	Name      string   `json:"name"`
+
+	// Source Set by the user, or taken from a photo's EXIF GPS position
+	Source PlaceSource `json:"source"`
+}
+
+// PlaceSource Set by the user, or taken from a photo's EXIF GPS position
+type PlaceSource string
+
+// PlaceRequest Where the entry happened. Left out, the entry keeps its place, or takes the GPS position of its first geotagged photo when it has none; sent, it replaces it, and a place without name or coordinates clears it.
+type PlaceRequest struct {
+	// Latitude Sent together with longitude
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	Name      *string  `json:"name,omitempty"`
+}
+
+// PlaceStat defines model for PlaceStat.
+type PlaceStat struct {
+	// Count Number of entries at the place
+	Count     int                `json:"count"`
+	FirstDate openapi_types.Date `json:"firstDate"`
+	LastDate  openapi_types.Date `json:"lastDate"`
+
+	// Latitude Average position of the place's entries
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Name Empty for places known only by coordinates
+	Name string `json:"name"`
+}
+
+// PlacesResponse defines model for PlacesResponse.
+type PlacesResponse struct {
+	// Places Entries grouped by place name and position (about a kilometre apart), most visited first
+	Places []PlaceStat `json:"places"`
+}
+
+// Recap defines model for Recap.
+type Recap struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EndDate last day of the period (inclusive)
+	EndDate openapi_types.Date `json:"endDate"`
+
+	// EntryCount number of entries the recap was written from
+	EntryCount int                `json:"entryCount"`
+	Id         openapi_types.UUID `json:"id"`
+	Period     RecapPeriod        `json:"period"`
+	StartDate  openapi_types.Date `json:"startDate"`
+	Summary    string             `json:"summary"`
+}
+
+// RecapPeriod defines model for Recap.Period.
+type RecapPeriod string
+
+// RecoveryCodesResponse defines model for RecoveryCodesResponse.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// RevokedSessions defines model for RevokedSessions.
+type RevokedSessions struct {
+	// Revoked number of sessions signed out
+	Revoked int `json:"revoked"`
+}
+
+// SemanticMatch defines model for SemanticMatch.
+type SemanticMatch struct {
+	Item ItemsResponse `json:"item"`
+
+	// Score cosine similarity between the query and the entry (-1..1)
+	Score float64 `json:"score"`
+}
+
+// SemanticSearchResponse defines model for SemanticSearchResponse.
+type SemanticSearchResponse struct {
+	Matches []SemanticMatch `json:"matches"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current true for the session making this request
+	Current    bool               `json:"current"`
+	Id         openapi_types.UUID `json:"id"`
+	IpAddress  string             `json:"ipAddress"`
+	LastSeenAt time.Time          `json:"lastSeenAt"`
+	UserAgent  string             `json:"userAgent"`
+}
+
+// StatsHeatmap defines model for StatsHeatmap.
+type StatsHeatmap struct {
+	// Words Words written on each day of the year, January 1 first; 0 for days without an entry
+	Words []int `json:"words"`
+	Year  int   `json:"year"`
+}
+
+// StatsResponse defines model for StatsResponse.
+type StatsResponse struct {
+	// Assets assets embedded in the entries
+	Assets        int    `json:"assets"`
+	CurrentStreak Streak `json:"currentStreak"`
+
+	// Days word count of each entry, oldest first
+	Days              []DayWordCount `json:"days"`
+	Entries           int            `json:"entries"`
+	EntriesWithAssets int            `json:"entriesWithAssets"`
+	Heatmap           StatsHeatmap   `json:"heatmap"`
+	LongestStreak     Streak         `json:"longestStreak"`
+
+	// Months entries and words per month, oldest first
+	Months []MonthEntryStat `json:"months"`
+
+	// Tags tag usage per month, oldest month first and most used tag first
+	Tags  []TagMonthStat `json:"tags"`
+	Words int            `json:"words"`
+}
+
+// Streak defines model for Streak.
+type Streak struct {
+	// Days number of consecutive days with an entry; 0 without a streak
+	Days  int                 `json:"days"`
+	End   *openapi_types.Date `json:"end,omitempty"`
+	Start *openapi_types.Date `json:"start,omitempty"`
+}
+
+// SuggestTagsRequest defines model for SuggestTagsRequest.
+type SuggestTagsRequest struct {
+	Body  *string            `json:"body,omitempty"`
+	Date  openapi_types.Date `json:"date"`
+	Title string             `json:"title"`
+}
+
+// SuggestTagsResponse defines model for SuggestTagsResponse.
+type SuggestTagsResponse struct {
+	Tags []TagSuggestion `json:"tags"`
+}
+
+// SyncChangeResponse defines model for SyncChangeResponse.
+type SyncChangeResponse struct {
+	// Date Date of the diary entry that was changed
+	Date openapi_types.Date `json:"date"`
+
+	// Id Unique change ID
+	Id int32 `json:"id"`
+
+	// ItemSnapshot Current state of the item (null for deleted items)
+	ItemSnapshot *ItemsResponse `json:"itemSnapshot,omitempty"`
+
+	// Metadata Additional metadata about the change
+	Metadata *[]string `json:"metadata,omitempty"`
+
+	// OperationType Type of operation performed
+	OperationType SyncChangeResponseOperationType `json:"operationType"`
+
+	// Timestamp When the change occurred
+	Timestamp time.Time `json:"timestamp"`
+
+	// UserId User ID who made the change
+	UserId string `json:"userId"`
+}
+
+// SyncChangeResponseOperationType Type of operation performed
+type SyncChangeResponseOperationType string
 
 // SyncResponse defines model for SyncResponse.
 type SyncResponse struct {
@@ -243,11 +952,77 @@ type SyncResponse struct {
 	NextId *int32 `json:"nextId,omitempty"`
 }
 
+// TagCluster defines model for TagCluster.
+type TagCluster struct {
+	// Canonical The most used tag of the cluster, the suggested merge target
+	Canonical string `json:"canonical"`
+
+	// Reasons Why the tags were grouped: case/spacing, inflection, typo, or the AI provider
+	Reasons []TagClusterReasons `json:"reasons"`
+
+	// Tags Tags of the cluster with usage counts, most used first
+	Tags []TagStat `json:"tags"`
+}
+
+// TagClusterReasons defines model for TagCluster.Reasons.
+type TagClusterReasons string
+
+// TagConsolidationResponse defines model for TagConsolidationResponse.
+type TagConsolidationResponse struct {
+	// AiUsed Whether the AI provider contributed to the clusters
+	AiUsed bool `json:"aiUsed"`
+
+	// Clusters Clusters of two or more tags, the most used first
+	Clusters []TagCluster `json:"clusters"`
+}
+
+// TagMerge defines model for TagMerge.
+type TagMerge struct {
+	// From Tags to merge away
+	From []string `json:"from"`
+
+	// To Tag they become
+	To string `json:"to"`
+}
+
+// TagMergeRequest defines model for TagMergeRequest.
+type TagMergeRequest struct {
+	Merges []TagMerge `json:"merges"`
+}
+
+// TagMergeResponse defines model for TagMergeResponse.
+type TagMergeResponse struct {
+	// UpdatedEntries Number of entries whose tags changed
+	UpdatedEntries int `json:"updatedEntries"`
+}
+
+// TagMetadata defines model for TagMetadata.
+type TagMetadata struct {
+	// Aliases Alternative names; saving an entry with an alias stores the tag instead
+	Aliases []string `json:"aliases"`
+
+	// Color Display colour as #RRGGBB
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// TagMonthStat defines model for TagMonthStat.
+type TagMonthStat struct {
+	// Count entries carrying the tag in the month
+	Count int    `json:"count"`
+	Month string `json:"month"`
+	Tag   string `json:"tag"`
+}
+
 // TagStat defines model for TagStat.
 type TagStat struct {
-	// Count Number of entries using this tag
+	// Count Number of entries using this tag or, for hierarchical tags, any of its descendants
 	Count int    `json:"count"`
 	Name  string `json:"name"`
+
+	// OwnCount Number of entries using exactly this tag (0 for an ancestor used only through descendants)
+	OwnCount int `json:"ownCount"`
 }
 
 // TagStatsResponse defines model for TagStatsResponse.
@@ -262,19 +1037,121 @@ type TagSuggestion struct {
 	Name       string  `json:"name"`
 }
 
+// TagTreeNode defines model for TagTreeNode.
+type TagTreeNode struct {
+	// Children Child tags, sorted by name
+	Children []TagTreeNode `json:"children"`
+
+	// Count Number of entries using this tag or any descendant
+	Count int `json:"count"`
+
+	// Name Last level of the tag
+	Name string `json:"name"`
+
+	// OwnCount Number of entries using exactly this tag
+	OwnCount int `json:"ownCount"`
+
+	// Path Full tag
+	Path string `json:"path"`
+}
+
+// TagTreeResponse defines model for TagTreeResponse.
+type TagTreeResponse struct {
+	// Roots Top-level tags, sorted by name; flat tags are leaves
+	Roots []TagTreeNode `json:"roots"`
+}
+
+// TagUpdateRequest defines model for TagUpdateRequest.
+type TagUpdateRequest struct {
+	// Aliases Replaces the tag's aliases (at most 20); empty clears them
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Color Display colour as #RRGGBB; empty clears it
+	Color *string `json:"color,omitempty"`
+
+	// Description At most 500 characters; empty clears it
+	Description *string `json:"description,omitempty"`
+
+	// NewName New name for the tag; merges into an existing tag where an entry already carries it
+	NewName *string `json:"newName,omitempty"`
+
+	// Subtree Also rename descendants: travel/italy becomes trips/italy when travel becomes trips
+	Subtree *bool `json:"subtree,omitempty"`
+}
+
 // TagsResponse defines model for TagsResponse.
 type TagsResponse struct {
+	// Metadata Metadata of the family's tags that have any, sorted by name
+	Metadata []TagMetadata `json:"metadata"`
+
 	// Tags Family's distinct existing tags, deduplicated and sorted
 	Tags []string `json:"tags"`
 }
 
+// TranscribeRequest defines model for TranscribeRequest.
+type TranscribeRequest struct {
+	// Asset file name of an audio asset referenced in the entry body
+	Asset string `json:"asset"`
+}
+
+// TranscribeResponse defines model for TranscribeResponse.
+type TranscribeResponse struct {
+	Item ItemsResponse `json:"item"`
+
+	// Transcript the text appended to the entry; empty when no speech was recognized (the entry is then unchanged)
+	Transcript string `json:"transcript"`
+}
+
+// TwoFactorChallenge defines model for TwoFactorChallenge.
+type TwoFactorChallenge struct {
+	// MfaToken short-lived token to send with the second factor
+	MfaToken string `json:"mfaToken"`
+}
+
+// TwoFactorCodeRequest defines model for TwoFactorCodeRequest.
+type TwoFactorCodeRequest struct {
+	// Code 6-digit TOTP code or, where accepted, a recovery code
+	Code string `json:"code"`
+}
+
+// TwoFactorEnrollment defines model for TwoFactorEnrollment.
+type TwoFactorEnrollment struct {
+	// ProvisioningUri otpauth:// URI to render as a QR code
+	ProvisioningUri string `json:"provisioningUri"`
+
+	// Secret base32 TOTP secret for manual entry
+	Secret string `json:"secret"`
+}
+
+// TwoFactorLoginRequest defines model for TwoFactorLoginRequest.
+type TwoFactorLoginRequest struct {
+	// Code 6-digit TOTP code or a recovery code
+	Code     string `json:"code"`
+	MfaToken string `json:"mfaToken"`
+}
+
 // User defines model for User.
 type User struct {
-	Email     string             `json:"email"`
-	Id        openapi_types.UUID `json:"id"`
-	StartDate time.Time          `json:"startDate"`
+	Email            string             `json:"email"`
+	Id               openapi_types.UUID `json:"id"`
+	StartDate        time.Time          `json:"startDate"`
+	TwoFactorEnabled *bool              `json:"twoFactorEnabled,omitempty"`
+}
+
+// Weather Weather of the entry's day at its place, looked up when the place gets coordinates
+type Weather struct {
+	Condition       WeatherCondition `json:"condition"`
+	PrecipitationMm float64          `json:"precipitationMm"`
+
+	// Provider Weather provider the snapshot came from
+	Provider string  `json:"provider"`
+	TempMaxC float64 `json:"tempMaxC"`
+	TempMinC float64 `json:"tempMinC"`
 }
 
+// WeatherCondition defines model for Weather.Condition.
+type WeatherCondition string
+
 // GetAssetParams defines parameters for GetAsset.
 type GetAssetParams struct {
 	// Path relative path to asset file
@@ -287,6 +1164,30 @@ type UploadAssetsBatchMultipartBody struct {
 	Assets []openapi_types.File `json:"assets"`
 }
 
+// ListAssetsParams defines parameters for ListAssets.
+type ListAssetsParams struct {
+	// Date only list the assets referenced by the entry of this date (optional)
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// GetAuditEventsParams defines parameters for GetAuditEvents.
+type GetAuditEventsParams struct {
+	// Before return events older than this event ID (exclusive)
+	Before *int32 `form:"before,omitempty" json:"before,omitempty"`
+
+	// Limit maximum number of events to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetCustomFieldStatsParams defines parameters for GetCustomFieldStats.
+type GetCustomFieldStatsParams struct {
+	// From first day to include (optional)
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To last day to include (optional)
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // GetItemsParams defines parameters for GetItems.
 type GetItemsParams struct {
 	// Date filter items by date (optional)
@@ -295,8 +1196,59 @@ type GetItemsParams struct {
 	// Search search text to filter items by title and body content
 	Search *string `form:"search,omitempty" json:"search,omitempty"`
 
-	// Tags comma-separated list of tags to filter items
+	// Tags comma-separated list of tags to filter items; a hierarchical tag also matches its descendants ("travel" finds "travel/italy/rome")
 	Tags *string `form:"tags,omitempty" json:"tags,omitempty"`
+
+	// Fields comma-separated custom field conditions, all of which must hold, as key, operator and value: "rating>=4,sick=true,mood=calm". Operators are =, != and, for numbers and scales, <, <=, > and >=. Entries without a value for the field never match.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// SemanticSearchItemsParams defines parameters for SemanticSearchItems.
+type SemanticSearchItemsParams struct {
+	// Q free-text query
+	Q string `form:"q" json:"q"`
+
+	// Limit maximum number of entries to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetJobsParams defines parameters for GetJobs.
+type GetJobsParams struct {
+	// Limit maximum number of recent jobs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetMemoriesParams defines parameters for GetMemories.
+type GetMemoriesParams struct {
+	// Date the day to remember from (default today)
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// GetPlacesParams defines parameters for GetPlaces.
+type GetPlacesParams struct {
+	// From first day to include (optional)
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To last day to include (optional)
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetRecapsParams defines parameters for GetRecaps.
+type GetRecapsParams struct {
+	// Period only return recaps of this period
+	Period *GetRecapsParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+}
+
+// GetRecapsParamsPeriod defines parameters for GetRecaps.
+type GetRecapsParamsPeriod string
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	// From first day to include (optional)
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To last day to include (optional)
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
 }
 
 // GetChangesParams defines parameters for GetChanges.
@@ -308,15 +1260,39 @@ type GetChangesParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetTagConsolidationParams defines parameters for GetTagConsolidation.
+type GetTagConsolidationParams struct {
+	// Ai also ask the AI provider for clusters
+	Ai *bool `form:"ai,omitempty" json:"ai,omitempty"`
+}
+
+// DeleteTagParams defines parameters for DeleteTag.
+type DeleteTagParams struct {
+	// Subtree also delete the tag's descendants
+	Subtree *bool `form:"subtree,omitempty" json:"subtree,omitempty"`
+}
+
+// AskDiaryJSONRequestBody defines body for AskDiary for application/json ContentType.
+type AskDiaryJSONRequestBody = AskRequest
+
 // UploadAssetsBatchMultipartRequestBody defines body for UploadAssetsBatch for multipart/form-data ContentType.
 type UploadAssetsBatchMultipartRequestBody UploadAssetsBatchMultipartBody
 
 // AuthorizeJSONRequestBody defines body for Authorize for application/json ContentType.
 type AuthorizeJSONRequestBody = AuthData
 
+// AuthorizeTwoFactorJSONRequestBody defines body for AuthorizeTwoFactor for application/json ContentType.
+type AuthorizeTwoFactorJSONRequestBody = TwoFactorLoginRequest
+
 // UpdateFamilySettingsJSONRequestBody defines body for UpdateFamilySettings for application/json ContentType.
 type UpdateFamilySettingsJSONRequestBody = FamilySettingsRequest
 
+// CreateCustomFieldJSONRequestBody defines body for CreateCustomField for application/json ContentType.
+type CreateCustomFieldJSONRequestBody = CustomFieldCreateRequest
+
+// UpdateCustomFieldJSONRequestBody defines body for UpdateCustomField for application/json ContentType.
+type UpdateCustomFieldJSONRequestBody = CustomFieldUpdateRequest
+
 // FixHealthIssuesJSONRequestBody defines body for FixHealthIssues for application/json ContentType.
 type FixHealthIssuesJSONRequestBody = HealthFixRequest
 
@@ -335,26 +1311,74 @@ type DismissItemTagJSONRequestBody = DismissTagRequest
 // SuggestItemTagsJSONRequestBody defines body for SuggestItemTags for application/json ContentType.
 type SuggestItemTagsJSONRequestBody = SuggestTagsRequest
 
-// RenameTagJSONRequestBody defines body for RenameTag for application/json ContentType.
-type RenameTagJSONRequestBody = RenameTagRequest
+// TranscribeItemAssetJSONRequestBody defines body for TranscribeItemAsset for application/json ContentType.
+type TranscribeItemAssetJSONRequestBody = TranscribeRequest
+
+// MergeTagsJSONRequestBody defines body for MergeTags for application/json ContentType.
+type MergeTagsJSONRequestBody = TagMergeRequest
+
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = TagUpdateRequest
+
+// CreateAccessTokenJSONRequestBody defines body for CreateAccessToken for application/json ContentType.
+type CreateAccessTokenJSONRequestBody = CreateAccessTokenRequest
+
+// ConfirmTwoFactorJSONRequestBody defines body for ConfirmTwoFactor for application/json ContentType.
+type ConfirmTwoFactorJSONRequestBody = TwoFactorCodeRequest
+
+// DisableTwoFactorJSONRequestBody defines body for DisableTwoFactor for application/json ContentType.
+type DisableTwoFactorJSONRequestBody = TwoFactorCodeRequest
+
+// RegenerateRecoveryCodesJSONRequestBody defines body for RegenerateRecoveryCodes for application/json ContentType.
+type RegenerateRecoveryCodesJSONRequestBody = TwoFactorCodeRequest
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// answer a question about the family's diary, citing the entries used
+	// (POST /v1/ask)
+	AskDiary(w http.ResponseWriter, r *http.Request)
 	// return asset by path
 	// (GET /v1/assets)
 	GetAsset(w http.ResponseWriter, r *http.Request, params GetAssetParams)
 	// upload multiple asset files
 	// (POST /v1/assets/batch)
 	UploadAssetsBatch(w http.ResponseWriter, r *http.Request)
+	// list the family's asset files with their AI captions
+	// (GET /v1/assets/list)
+	ListAssets(w http.ResponseWriter, r *http.Request, params ListAssetsParams)
+	// list the family's security audit log, newest first
+	// (GET /v1/audit)
+	GetAuditEvents(w http.ResponseWriter, r *http.Request, params GetAuditEventsParams)
 	// validate user/password and return token
 	// (POST /v1/authorize)
 	Authorize(w http.ResponseWriter, r *http.Request)
+	// complete a two-factor login with a TOTP or recovery code
+	// (POST /v1/authorize/2fa)
+	AuthorizeTwoFactor(w http.ResponseWriter, r *http.Request)
 	// return family info with members
 	// (GET /v1/family)
 	GetFamily(w http.ResponseWriter, r *http.Request)
 	// update family settings (e.g. AI tagging)
 	// (PATCH /v1/family)
 	UpdateFamilySettings(w http.ResponseWriter, r *http.Request)
+	// return the family's AI usage per month and the monthly token budget
+	// (GET /v1/family/ai-usage)
+	GetFamilyAIUsage(w http.ResponseWriter, r *http.Request)
+	// list the family's custom fields
+	// (GET /v1/fields)
+	GetCustomFields(w http.ResponseWriter, r *http.Request)
+	// define a custom field entries can carry
+	// (POST /v1/fields)
+	CreateCustomField(w http.ResponseWriter, r *http.Request)
+	// delete a custom field and its values from all of the family's entries
+	// (DELETE /v1/fields/{key})
+	DeleteCustomField(w http.ResponseWriter, r *http.Request, key string)
+	// edit a custom field
+	// (PATCH /v1/fields/{key})
+	UpdateCustomField(w http.ResponseWriter, r *http.Request, key string)
+	// aggregate a custom field's values over the family's entries
+	// (GET /v1/fields/{key}/stats)
+	GetCustomFieldStats(w http.ResponseWriter, r *http.Request, key string, params GetCustomFieldStatsParams)
 	// fix storage issues for current user
 	// (POST /v1/health/fix)
 	FixHealthIssues(w http.ResponseWriter, r *http.Request)
@@ -385,27 +1409,93 @@ type ServerInterface interface {
 	// dismiss a pending suggested tag for a day (removes it from pending)
 	// (POST /v1/items/dismiss-tag)
 	DismissItemTag(w http.ResponseWriter, r *http.Request)
+	// find the entries closest in meaning to a free-text query
+	// (GET /v1/items/semantic)
+	SemanticSearchItems(w http.ResponseWriter, r *http.Request, params SemanticSearchItemsParams)
 	// suggest tags for draft entry content (does not save)
 	// (POST /v1/items/suggest-tags)
 	SuggestItemTags(w http.ResponseWriter, r *http.Request)
+	// list the entries that link to a day with [[YYYY-MM-DD]]
+	// (GET /v1/items/{date}/backlinks)
+	GetItemBacklinks(w http.ResponseWriter, r *http.Request, date openapi_types.Date)
+	// summarize a day's entry in one paragraph (does not save)
+	// (POST /v1/items/{date}/summary)
+	SummarizeItem(w http.ResponseWriter, r *http.Request, date openapi_types.Date)
+	// transcribe a voice memo of the entry and append the text to its body
+	// (POST /v1/items/{date}/transcribe)
+	TranscribeItemAsset(w http.ResponseWriter, r *http.Request, date openapi_types.Date)
+	// return the family's background AI jobs and backfill progress
+	// (GET /v1/jobs)
+	GetJobs(w http.ResponseWriter, r *http.Request, params GetJobsParams)
+	// list the entries of the same day in previous years and of the same week a month ago
+	// (GET /v1/memories)
+	GetMemories(w http.ResponseWriter, r *http.Request, params GetMemoriesParams)
+	// list the places of the family's entries with how often each was visited
+	// (GET /v1/places)
+	GetPlaces(w http.ResponseWriter, r *http.Request, params GetPlacesParams)
+	// list the family's AI-written weekly and monthly recaps, newest first
+	// (GET /v1/recaps)
+	GetRecaps(w http.ResponseWriter, r *http.Request, params GetRecapsParams)
+	// list the user's active sign-in sessions
+	// (GET /v1/sessions)
+	GetSessions(w http.ResponseWriter, r *http.Request)
+	// sign out every session except the current one
+	// (POST /v1/sessions/revoke-others)
+	RevokeOtherSessions(w http.ResponseWriter, r *http.Request)
+	// sign out a session
+	// (DELETE /v1/sessions/{id})
+	RevokeSession(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// writing statistics of the family's entries
+	// (GET /v1/stats)
+	GetStats(w http.ResponseWriter, r *http.Request, params GetStatsParams)
 	// get changes for synchronization
 	// (GET /v1/sync/changes)
 	GetChanges(w http.ResponseWriter, r *http.Request, params GetChangesParams)
-	// list the family's distinct existing tags
+	// list the family's distinct existing tags and their metadata
 	// (GET /v1/tags)
 	GetTags(w http.ResponseWriter, r *http.Request)
+	// suggest clusters of near-duplicate tags to merge
+	// (GET /v1/tags/consolidation)
+	GetTagConsolidation(w http.ResponseWriter, r *http.Request, params GetTagConsolidationParams)
+	// merge several tags into others in one transaction
+	// (POST /v1/tags/merge)
+	MergeTags(w http.ResponseWriter, r *http.Request)
 	// list the family's distinct tags with per-tag usage counts
 	// (GET /v1/tags/stats)
 	GetTagStats(w http.ResponseWriter, r *http.Request)
+	// list the family's tags as a tree of hierarchical tags
+	// (GET /v1/tags/tree)
+	GetTagTree(w http.ResponseWriter, r *http.Request)
 	// delete a tag from all of the family's entries
 	// (DELETE /v1/tags/{name})
-	DeleteTag(w http.ResponseWriter, r *http.Request, name string)
-	// rename a tag across all of the family's entries
+	DeleteTag(w http.ResponseWriter, r *http.Request, name string, params DeleteTagParams)
+	// rename a tag across all of the family's entries and edit its metadata
 	// (PATCH /v1/tags/{name})
-	RenameTag(w http.ResponseWriter, r *http.Request, name string)
+	UpdateTag(w http.ResponseWriter, r *http.Request, name string)
+	// list the user's active personal access tokens
+	// (GET /v1/tokens)
+	GetAccessTokens(w http.ResponseWriter, r *http.Request)
+	// create a named, scoped personal access token
+	// (POST /v1/tokens)
+	CreateAccessToken(w http.ResponseWriter, r *http.Request)
+	// revoke a personal access token
+	// (DELETE /v1/tokens/{id})
+	RevokeAccessToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// return user object
 	// (GET /v1/user)
 	GetUser(w http.ResponseWriter, r *http.Request)
+	// confirm enrollment with a TOTP code and enable two-factor login
+	// (POST /v1/user/2fa/confirm)
+	ConfirmTwoFactor(w http.ResponseWriter, r *http.Request)
+	// disable two-factor login (requires a TOTP or recovery code)
+	// (POST /v1/user/2fa/disable)
+	DisableTwoFactor(w http.ResponseWriter, r *http.Request)
+	// start TOTP enrollment and return the provisioning URI
+	// (POST /v1/user/2fa/enroll)
+	EnrollTwoFactor(w http.ResponseWriter, r *http.Request)
+	// replace all recovery codes (requires a TOTP code)
+	// (POST /v1/user/2fa/recovery-codes)
+	RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -417,6 +1507,25 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// AskDiary operation middleware
+func (siw *ServerInterfaceWrapper) AskDiary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AskDiary(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetAsset operation middleware
 func (siw *ServerInterfaceWrapper) GetAsset(w http.ResponseWriter, r *http.Request) {
 	var err error
@@ -474,29 +1583,29 @@ func (siw *ServerInterfaceWrapper) UploadAssetsBatch(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// Authorize operation middleware
-func (siw *ServerInterfaceWrapper) Authorize(w http.ResponseWriter, r *http.Request) {
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Authorize(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
+// ListAssets operation middleware
+func (siw *ServerInterfaceWrapper) ListAssets(w http.ResponseWriter, r *http.Request) {
+	var err error
 
-// GetFamily operation middleware
-func (siw *ServerInterfaceWrapper) GetFamily(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAssetsParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "date", r.URL.Query(), &params.Date, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFamily(w, r)
+		siw.Handler.ListAssets(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -506,16 +1615,37 @@ func (siw *ServerInterfaceWrapper) GetFamily(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateFamilySettings operation middleware
-func (siw *ServerInterfaceWrapper) UpdateFamilySettings(w http.ResponseWriter, r *http.Request) {
+// GetAuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditEvents(w http.ResponseWriter, r *http.Request) {
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditEventsParams
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "before", r.URL.Query(), &params.Before, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateFamilySettings(w, r)
+		siw.Handler.GetAuditEvents(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -525,16 +1655,10 @@ func (siw *ServerInterfaceWrapper) UpdateFamilySettings(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// FixHealthIssues operation middleware
-func (siw *ServerInterfaceWrapper) FixHealthIssues(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
+// Authorize operation middleware
+func (siw *ServerInterfaceWrapper) Authorize(w http.ResponseWriter, r *http.Request) {
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FixHealthIssues(w, r)
+		siw.Handler.Authorize(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -544,7 +1668,243 @@ func (siw *ServerInterfaceWrapper) FixHealthIssues(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetHealthIssues operation middleware
+// AuthorizeTwoFactor operation middleware
+func (siw *ServerInterfaceWrapper) AuthorizeTwoFactor(w http.ResponseWriter, r *http.Request) {
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthorizeTwoFactor(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFamily operation middleware
+func (siw *ServerInterfaceWrapper) GetFamily(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFamily(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateFamilySettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFamilySettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateFamilySettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFamilyAIUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetFamilyAIUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFamilyAIUsage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCustomFields operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomFields(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCustomFields(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCustomField operation middleware
+func (siw *ServerInterfaceWrapper) CreateCustomField(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCustomField(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCustomField operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCustomField(w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", mux.Vars(r)["key"], &key, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCustomField(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCustomField operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCustomField(w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", mux.Vars(r)["key"], &key, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCustomField(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCustomFieldStats operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomFieldStats(w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", mux.Vars(r)["key"], &key, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCustomFieldStatsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCustomFieldStats(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FixHealthIssues operation middleware
+func (siw *ServerInterfaceWrapper) FixHealthIssues(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FixHealthIssues(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealthIssues operation middleware
 func (siw *ServerInterfaceWrapper) GetHealthIssues(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -720,6 +2080,14 @@ func (siw *ServerInterfaceWrapper) GetItems(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "fields", r.URL.Query(), &params.Fields, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetItems(w, r, params)
 	}))
@@ -788,27 +2156,8 @@ func (siw *ServerInterfaceWrapper) DismissItemTag(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// SuggestItemTags operation middleware
-func (siw *ServerInterfaceWrapper) SuggestItemTags(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SuggestItemTags(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// GetChanges operation middleware
-func (siw *ServerInterfaceWrapper) GetChanges(w http.ResponseWriter, r *http.Request) {
+// SemanticSearchItems operation middleware
+func (siw *ServerInterfaceWrapper) SemanticSearchItems(w http.ResponseWriter, r *http.Request) {
 	var err error
 
 	ctx := r.Context()
@@ -818,13 +2167,19 @@ func (siw *ServerInterfaceWrapper) GetChanges(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetChangesParams
+	var params SemanticSearchItemsParams
 
-	// ------------- Optional query parameter "since" -------------
+	// ------------- Required query parameter "q" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", r.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "q", r.URL.Query(), &params.Q, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
 		return
 	}
 
@@ -837,7 +2192,7 @@ func (siw *ServerInterfaceWrapper) GetChanges(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetChanges(w, r, params)
+		siw.Handler.SemanticSearchItems(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -847,8 +2202,8 @@ func (siw *ServerInterfaceWrapper) GetChanges(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetTags operation middleware
-func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Request) {
+// SuggestItemTags operation middleware
+func (siw *ServerInterfaceWrapper) SuggestItemTags(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -856,7 +2211,7 @@ func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Reques
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTags(w, r)
+		siw.Handler.SuggestItemTags(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -866,8 +2221,19 @@ func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// GetTagStats operation middleware
-func (siw *ServerInterfaceWrapper) GetTagStats(w http.ResponseWriter, r *http.Request) {
+// GetItemBacklinks operation middleware
+func (siw *ServerInterfaceWrapper) GetItemBacklinks(w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	err = runtime.BindStyledParameterWithOptions("simple", "date", mux.Vars(r)["date"], &date, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: "date"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -875,7 +2241,7 @@ func (siw *ServerInterfaceWrapper) GetTagStats(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTagStats(w, r)
+		siw.Handler.GetItemBacklinks(w, r, date)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -885,16 +2251,16 @@ func (siw *ServerInterfaceWrapper) GetTagStats(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTag operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Request) {
+// SummarizeItem operation middleware
+func (siw *ServerInterfaceWrapper) SummarizeItem(w http.ResponseWriter, r *http.Request) {
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "date", mux.Vars(r)["date"], &date, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: "date"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
 		return
 	}
 
@@ -905,7 +2271,7 @@ func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTag(w, r, name)
+		siw.Handler.SummarizeItem(w, r, date)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -915,16 +2281,16 @@ func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// RenameTag operation middleware
-func (siw *ServerInterfaceWrapper) RenameTag(w http.ResponseWriter, r *http.Request) {
+// TranscribeItemAsset operation middleware
+func (siw *ServerInterfaceWrapper) TranscribeItemAsset(w http.ResponseWriter, r *http.Request) {
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "date", mux.Vars(r)["date"], &date, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: "date"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
 		return
 	}
 
@@ -935,7 +2301,7 @@ func (siw *ServerInterfaceWrapper) RenameTag(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RenameTag(w, r, name)
+		siw.Handler.TranscribeItemAsset(w, r, date)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -945,16 +2311,29 @@ func (siw *ServerInterfaceWrapper) RenameTag(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetUser operation middleware
-func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+// GetJobs operation middleware
+func (siw *ServerInterfaceWrapper) GetJobs(w http.ResponseWriter, r *http.Request) {
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJobsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUser(w, r)
+		siw.Handler.GetJobs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -964,887 +2343,2611 @@ func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
-
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// GetMemories operation middleware
+func (siw *ServerInterfaceWrapper) GetMemories(w http.ResponseWriter, r *http.Request) {
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx := r.Context()
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	r = r.WithContext(ctx)
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMemoriesParams
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// ------------- Optional query parameter "date" -------------
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "date", r.URL.Query(), &params.Date, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMemories(w, r, params)
+	}))
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+// GetPlaces operation middleware
+func (siw *ServerInterfaceWrapper) GetPlaces(w http.ResponseWriter, r *http.Request) {
+	var err error
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	ctx := r.Context()
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	r = r.WithContext(ctx)
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPlacesParams
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{})
-}
+	// ------------- Optional query parameter "from" -------------
 
-type GorillaServerOptions struct {
-	BaseURL          string
-	BaseRouter       *mux.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseRouter: r,
-	})
-}
+	// ------------- Optional query parameter "to" -------------
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
-	r := options.BaseRouter
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlaces(w, r, params)
+	}))
 
-	if r == nil {
-		r = mux.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	r.HandleFunc(options.BaseURL+"/v1/assets", wrapper.GetAsset).Methods("GET")
-
-	r.HandleFunc(options.BaseURL+"/v1/assets/batch", wrapper.UploadAssetsBatch).Methods("POST")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/v1/authorize", wrapper.Authorize).Methods("POST")
+// GetRecaps operation middleware
+func (siw *ServerInterfaceWrapper) GetRecaps(w http.ResponseWriter, r *http.Request) {
+	var err error
 
-	r.HandleFunc(options.BaseURL+"/v1/family", wrapper.GetFamily).Methods("GET")
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/v1/family", wrapper.UpdateFamilySettings).Methods("PATCH")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/v1/health/fix", wrapper.FixHealthIssues).Methods("POST")
+	r = r.WithContext(ctx)
 
-	r.HandleFunc(options.BaseURL+"/v1/health/issues", wrapper.GetHealthIssues).Methods("GET")
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRecapsParams
 
-	r.HandleFunc(options.BaseURL+"/v1/health/orphans/{filename}", wrapper.DeleteOrphan).Methods("DELETE")
+	// ------------- Optional query parameter "period" -------------
 
-	r.HandleFunc(options.BaseURL+"/v1/health/orphans/{filename}/attach", wrapper.AttachOrphan).Methods("POST")
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "period", r.URL.Query(), &params.Period, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/v1/health/orphans/{filename}/ignore", wrapper.UnignoreOrphan).Methods("DELETE")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRecaps(w, r, params)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/v1/health/orphans/{filename}/ignore", wrapper.IgnoreOrphan).Methods("POST")
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/v1/items", wrapper.GetItems).Methods("GET")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/v1/items", wrapper.PutItems).Methods("PUT")
+// GetSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/v1/items/accept-tag", wrapper.AcceptItemTag).Methods("POST")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/v1/items/dismiss-tag", wrapper.DismissItemTag).Methods("POST")
+	r = r.WithContext(ctx)
 
-	r.HandleFunc(options.BaseURL+"/v1/items/suggest-tags", wrapper.SuggestItemTags).Methods("POST")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSessions(w, r)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/v1/sync/changes", wrapper.GetChanges).Methods("GET")
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/v1/tags", wrapper.GetTags).Methods("GET")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/v1/tags/stats", wrapper.GetTagStats).Methods("GET")
+// RevokeOtherSessions operation middleware
+func (siw *ServerInterfaceWrapper) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/v1/tags/{name}", wrapper.DeleteTag).Methods("DELETE")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/v1/tags/{name}", wrapper.RenameTag).Methods("PATCH")
+	r = r.WithContext(ctx)
 
-	r.HandleFunc(options.BaseURL+"/v1/user", wrapper.GetUser).Methods("GET")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeOtherSessions(w, r)
+	}))
 
-	return r
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetAssetRequestObject struct {
-	Params GetAssetParams
+	handler.ServeHTTP(w, r)
 }
 
-type GetAssetResponseObject interface {
-	VisitGetAssetResponse(w http.ResponseWriter) error
-}
+// RevokeSession operation middleware
+func (siw *ServerInterfaceWrapper) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	var err error
 
-type GetAsset200AsteriskResponse struct {
-	Body          io.Reader
-	ContentType   string
-	ContentLength int64
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (response GetAsset200AsteriskResponse) VisitGetAssetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", response.ContentType)
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
-	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
-}
+	ctx := r.Context()
 
-type GetAsset404Response struct{}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetAsset404Response) VisitGetAssetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type UploadAssetsBatchRequestObject struct {
-	Body *multipart.Reader
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeSession(w, r, id)
+	}))
 
-type UploadAssetsBatchResponseObject interface {
-	VisitUploadAssetsBatchResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type UploadAssetsBatch200JSONResponse AssetsBatchResponse
+// GetStats operation middleware
+func (siw *ServerInterfaceWrapper) GetStats(w http.ResponseWriter, r *http.Request) {
+	var err error
 
-func (response UploadAssetsBatch200JSONResponse) VisitUploadAssetsBatchResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UploadAssetsBatch400Response struct{}
+	r = r.WithContext(ctx)
 
-func (response UploadAssetsBatch400Response) VisitUploadAssetsBatchResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsParams
 
-type UploadAssetsBatch401Response struct{}
+	// ------------- Optional query parameter "from" -------------
 
-func (response UploadAssetsBatch401Response) VisitUploadAssetsBatchResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-type UploadAssetsBatch413Response struct{}
+	// ------------- Optional query parameter "to" -------------
 
-func (response UploadAssetsBatch413Response) VisitUploadAssetsBatchResponse(w http.ResponseWriter) error {
-	w.WriteHeader(413)
-	return nil
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-type UploadAssetsBatch500Response struct{}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStats(w, r, params)
+	}))
 
-func (response UploadAssetsBatch500Response) VisitUploadAssetsBatchResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type AuthorizeRequestObject struct {
-	Body *AuthorizeJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type AuthorizeResponseObject interface {
-	VisitAuthorizeResponse(w http.ResponseWriter) error
-}
+// GetChanges operation middleware
+func (siw *ServerInterfaceWrapper) GetChanges(w http.ResponseWriter, r *http.Request) {
+	var err error
 
-type Authorize200JSONResponse struct {
-	Token string `json:"token"`
-}
+	ctx := r.Context()
 
-func (response Authorize200JSONResponse) VisitAuthorizeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type Authorize401Response struct{}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChangesParams
 
-func (response Authorize401Response) VisitAuthorizeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// ------------- Optional query parameter "since" -------------
 
-type GetFamilyRequestObject struct{}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", r.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
 
-type GetFamilyResponseObject interface {
-	VisitGetFamilyResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetFamily200JSONResponse FamilyResponse
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response GetFamily200JSONResponse) VisitGetFamilyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChanges(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetFamily401Response struct{}
+// GetTags operation middleware
+func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetFamily401Response) VisitGetFamilyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetFamily404Response struct{}
+	r = r.WithContext(ctx)
 
-func (response GetFamily404Response) VisitGetFamilyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTags(w, r)
+	}))
 
-type UpdateFamilySettingsRequestObject struct {
-	Body *UpdateFamilySettingsJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateFamilySettingsResponseObject interface {
-	VisitUpdateFamilySettingsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateFamilySettings200JSONResponse FamilyResponse
+// GetTagConsolidation operation middleware
+func (siw *ServerInterfaceWrapper) GetTagConsolidation(w http.ResponseWriter, r *http.Request) {
+	var err error
 
-func (response UpdateFamilySettings200JSONResponse) VisitUpdateFamilySettingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UpdateFamilySettings400Response struct{}
+	r = r.WithContext(ctx)
 
-func (response UpdateFamilySettings400Response) VisitUpdateFamilySettingsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagConsolidationParams
 
-type UpdateFamilySettings401Response struct{}
+	// ------------- Optional query parameter "ai" -------------
 
-func (response UpdateFamilySettings401Response) VisitUpdateFamilySettingsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "ai", r.URL.Query(), &params.Ai, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ai", Err: err})
+		return
+	}
 
-type FixHealthIssuesRequestObject struct {
-	Body *FixHealthIssuesJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTagConsolidation(w, r, params)
+	}))
 
-type FixHealthIssuesResponseObject interface {
-	VisitFixHealthIssuesResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type FixHealthIssues200JSONResponse HealthIssuesResponse
+// MergeTags operation middleware
+func (siw *ServerInterfaceWrapper) MergeTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response FixHealthIssues200JSONResponse) VisitFixHealthIssuesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type FixHealthIssues400Response struct{}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergeTags(w, r)
+	}))
 
-func (response FixHealthIssues400Response) VisitFixHealthIssuesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type FixHealthIssues401Response struct{}
+// GetTagStats operation middleware
+func (siw *ServerInterfaceWrapper) GetTagStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response FixHealthIssues401Response) VisitFixHealthIssuesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type FixHealthIssues500Response struct{}
+	r = r.WithContext(ctx)
 
-func (response FixHealthIssues500Response) VisitFixHealthIssuesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTagStats(w, r)
+	}))
 
-type GetHealthIssuesRequestObject struct{}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetHealthIssuesResponseObject interface {
-	VisitGetHealthIssuesResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetHealthIssues200JSONResponse HealthIssuesResponse
+// GetTagTree operation middleware
+func (siw *ServerInterfaceWrapper) GetTagTree(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetHealthIssues200JSONResponse) VisitGetHealthIssuesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetHealthIssues401Response struct{}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTagTree(w, r)
+	}))
 
-func (response GetHealthIssues401Response) VisitGetHealthIssuesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteOrphanRequestObject struct {
-	Filename string `json:"filename"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteOrphanResponseObject interface {
-	VisitDeleteOrphanResponse(w http.ResponseWriter) error
-}
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	var err error
 
-type DeleteOrphan200JSONResponse HealthIssuesResponse
+	// ------------- Path parameter "name" -------------
+	var name string
 
-func (response DeleteOrphan200JSONResponse) VisitDeleteOrphanResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type DeleteOrphan400Response struct{}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response DeleteOrphan400Response) VisitDeleteOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type DeleteOrphan401Response struct{}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTagParams
 
-func (response DeleteOrphan401Response) VisitDeleteOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// ------------- Optional query parameter "subtree" -------------
 
-type DeleteOrphan404Response struct{}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "subtree", r.URL.Query(), &params.Subtree, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subtree", Err: err})
+		return
+	}
 
-func (response DeleteOrphan404Response) VisitDeleteOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTag(w, r, name, params)
+	}))
 
-type DeleteOrphan500Response struct{}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response DeleteOrphan500Response) VisitDeleteOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type AttachOrphanRequestObject struct {
-	Filename string `json:"filename"`
-	Body     *AttachOrphanJSONRequestBody
-}
+// UpdateTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	var err error
 
-type AttachOrphanResponseObject interface {
-	VisitAttachOrphanResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "name" -------------
+	var name string
 
-type AttachOrphan200JSONResponse HealthIssuesResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-func (response AttachOrphan200JSONResponse) VisitAttachOrphanResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type AttachOrphan400Response struct{}
+	r = r.WithContext(ctx)
 
-func (response AttachOrphan400Response) VisitAttachOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTag(w, r, name)
+	}))
 
-type AttachOrphan401Response struct{}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response AttachOrphan401Response) VisitAttachOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type AttachOrphan500Response struct{}
-
-func (response AttachOrphan500Response) VisitAttachOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+// GetAccessTokens operation middleware
+func (siw *ServerInterfaceWrapper) GetAccessTokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type UnignoreOrphanRequestObject struct {
-	Filename string `json:"filename"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UnignoreOrphanResponseObject interface {
-	VisitUnignoreOrphanResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type UnignoreOrphan200JSONResponse HealthIssuesResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAccessTokens(w, r)
+	}))
 
-func (response UnignoreOrphan200JSONResponse) VisitUnignoreOrphanResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UnignoreOrphan400Response struct{}
+// CreateAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateAccessToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response UnignoreOrphan400Response) VisitUnignoreOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UnignoreOrphan401Response struct{}
+	r = r.WithContext(ctx)
 
-func (response UnignoreOrphan401Response) VisitUnignoreOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAccessToken(w, r)
+	}))
 
-type UnignoreOrphan500Response struct{}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response UnignoreOrphan500Response) VisitUnignoreOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type IgnoreOrphanRequestObject struct {
-	Filename string `json:"filename"`
-}
+// RevokeAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAccessToken(w http.ResponseWriter, r *http.Request) {
+	var err error
 
-type IgnoreOrphanResponseObject interface {
-	VisitIgnoreOrphanResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type IgnoreOrphan200JSONResponse HealthIssuesResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response IgnoreOrphan200JSONResponse) VisitIgnoreOrphanResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type IgnoreOrphan400Response struct{}
+	r = r.WithContext(ctx)
 
-func (response IgnoreOrphan400Response) VisitIgnoreOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeAccessToken(w, r, id)
+	}))
 
-type IgnoreOrphan401Response struct{}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response IgnoreOrphan401Response) VisitIgnoreOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type IgnoreOrphan500Response struct{}
-
-func (response IgnoreOrphan500Response) VisitIgnoreOrphanResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetItemsRequestObject struct {
-	Params GetItemsParams
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetItemsResponseObject interface {
-	VisitGetItemsResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetItems200JSONResponse ItemsListResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUser(w, r)
+	}))
 
-func (response GetItems200JSONResponse) VisitGetItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetItems400Response struct{}
+// ConfirmTwoFactor operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetItems400Response) VisitGetItemsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetItems404Response struct{}
+	r = r.WithContext(ctx)
 
-func (response GetItems404Response) VisitGetItemsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfirmTwoFactor(w, r)
+	}))
 
-type PutItemsRequestObject struct {
-	Body *PutItemsJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PutItemsResponseObject interface {
-	VisitPutItemsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PutItems200JSONResponse ItemsResponse
-
-func (response PutItems200JSONResponse) VisitPutItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DisableTwoFactor operation middleware
+func (siw *ServerInterfaceWrapper) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PutItems400Response struct{}
+	r = r.WithContext(ctx)
 
-func (response PutItems400Response) VisitPutItemsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisableTwoFactor(w, r)
+	}))
 
-type PutItems401Response struct{}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response PutItems401Response) VisitPutItemsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type AcceptItemTagRequestObject struct {
-	Body *AcceptItemTagJSONRequestBody
-}
+// EnrollTwoFactor operation middleware
+func (siw *ServerInterfaceWrapper) EnrollTwoFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type AcceptItemTagResponseObject interface {
-	VisitAcceptItemTagResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type AcceptItemTag200JSONResponse ItemsResponse
+	r = r.WithContext(ctx)
 
-func (response AcceptItemTag200JSONResponse) VisitAcceptItemTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnrollTwoFactor(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type AcceptItemTag400Response struct{}
+// RegenerateRecoveryCodes operation middleware
+func (siw *ServerInterfaceWrapper) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response AcceptItemTag400Response) VisitAcceptItemTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type AcceptItemTag401Response struct{}
+	r = r.WithContext(ctx)
 
-func (response AcceptItemTag401Response) VisitAcceptItemTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegenerateRecoveryCodes(w, r)
+	}))
 
-type AcceptItemTag404Response struct{}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response AcceptItemTag404Response) VisitAcceptItemTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type DismissItemTagRequestObject struct {
-	Body *DismissItemTagJSONRequestBody
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type DismissItemTagResponseObject interface {
-	VisitDismissItemTagResponse(w http.ResponseWriter) error
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-type DismissItemTag200JSONResponse ItemsResponse
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
 
-func (response DismissItemTag200JSONResponse) VisitDismissItemTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type DismissItemTag400Response struct{}
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
 
-func (response DismissItemTag400Response) VisitDismissItemTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+type RequiredParamError struct {
+	ParamName string
 }
 
-type DismissItemTag401Response struct{}
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
 
-func (response DismissItemTag401Response) VisitDismissItemTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
 }
 
-type DismissItemTag404Response struct{}
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
 
-func (response DismissItemTag404Response) VisitDismissItemTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
 }
 
-type SuggestItemTagsRequestObject struct {
-	Body *SuggestItemTagsJSONRequestBody
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
 }
 
-type SuggestItemTagsResponseObject interface {
-	VisitSuggestItemTagsResponse(w http.ResponseWriter) error
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
 }
 
-type SuggestItemTags200JSONResponse SuggestTagsResponse
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
 
-func (response SuggestItemTags200JSONResponse) VisitSuggestItemTagsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
-type SuggestItemTags400Response struct{}
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{})
+}
 
-func (response SuggestItemTags400Response) VisitSuggestItemTagsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type SuggestItemTags401Response struct{}
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
+}
 
-func (response SuggestItemTags401Response) VisitSuggestItemTagsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
 }
 
-type SuggestItemTags503Response struct{}
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
 
-func (response SuggestItemTags503Response) VisitSuggestItemTagsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(503)
-	return nil
-}
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
 
-type GetChangesRequestObject struct {
-	Params GetChangesParams
-}
+	r.HandleFunc(options.BaseURL+"/v1/ask", wrapper.AskDiary).Methods("POST")
 
-type GetChangesResponseObject interface {
-	VisitGetChangesResponse(w http.ResponseWriter) error
-}
+	r.HandleFunc(options.BaseURL+"/v1/assets", wrapper.GetAsset).Methods("GET")
 
-type GetChanges200JSONResponse SyncResponse
+	r.HandleFunc(options.BaseURL+"/v1/assets/batch", wrapper.UploadAssetsBatch).Methods("POST")
 
-func (response GetChanges200JSONResponse) VisitGetChangesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r.HandleFunc(options.BaseURL+"/v1/assets/list", wrapper.ListAssets).Methods("GET")
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r.HandleFunc(options.BaseURL+"/v1/audit", wrapper.GetAuditEvents).Methods("GET")
 
-type GetChanges400Response struct{}
+	r.HandleFunc(options.BaseURL+"/v1/authorize", wrapper.Authorize).Methods("POST")
 
-func (response GetChanges400Response) VisitGetChangesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	r.HandleFunc(options.BaseURL+"/v1/authorize/2fa", wrapper.AuthorizeTwoFactor).Methods("POST")
 
-type GetChanges401Response struct{}
+	r.HandleFunc(options.BaseURL+"/v1/family", wrapper.GetFamily).Methods("GET")
 
-func (response GetChanges401Response) VisitGetChangesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r.HandleFunc(options.BaseURL+"/v1/family", wrapper.UpdateFamilySettings).Methods("PATCH")
 
-type GetTagsRequestObject struct{}
+	r.HandleFunc(options.BaseURL+"/v1/family/ai-usage", wrapper.GetFamilyAIUsage).Methods("GET")
 
-type GetTagsResponseObject interface {
-	VisitGetTagsResponse(w http.ResponseWriter) error
-}
+	r.HandleFunc(options.BaseURL+"/v1/fields", wrapper.GetCustomFields).Methods("GET")
 
-type GetTags200JSONResponse TagsResponse
+	r.HandleFunc(options.BaseURL+"/v1/fields", wrapper.CreateCustomField).Methods("POST")
 
-func (response GetTags200JSONResponse) VisitGetTagsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r.HandleFunc(options.BaseURL+"/v1/fields/{key}", wrapper.DeleteCustomField).Methods("DELETE")
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r.HandleFunc(options.BaseURL+"/v1/fields/{key}", wrapper.UpdateCustomField).Methods("PATCH")
 
-type GetTags401Response struct{}
+	r.HandleFunc(options.BaseURL+"/v1/fields/{key}/stats", wrapper.GetCustomFieldStats).Methods("GET")
 
-func (response GetTags401Response) VisitGetTagsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r.HandleFunc(options.BaseURL+"/v1/health/fix", wrapper.FixHealthIssues).Methods("POST")
 
-type GetTagStatsRequestObject struct{}
+	r.HandleFunc(options.BaseURL+"/v1/health/issues", wrapper.GetHealthIssues).Methods("GET")
 
-type GetTagStatsResponseObject interface {
-	VisitGetTagStatsResponse(w http.ResponseWriter) error
-}
+	r.HandleFunc(options.BaseURL+"/v1/health/orphans/{filename}", wrapper.DeleteOrphan).Methods("DELETE")
 
-type GetTagStats200JSONResponse TagStatsResponse
+	r.HandleFunc(options.BaseURL+"/v1/health/orphans/{filename}/attach", wrapper.AttachOrphan).Methods("POST")
 
-func (response GetTagStats200JSONResponse) VisitGetTagStatsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r.HandleFunc(options.BaseURL+"/v1/health/orphans/{filename}/ignore", wrapper.UnignoreOrphan).Methods("DELETE")
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r.HandleFunc(options.BaseURL+"/v1/health/orphans/{filename}/ignore", wrapper.IgnoreOrphan).Methods("POST")
 
-type GetTagStats401Response struct{}
+	r.HandleFunc(options.BaseURL+"/v1/items", wrapper.GetItems).Methods("GET")
 
-func (response GetTagStats401Response) VisitGetTagStatsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r.HandleFunc(options.BaseURL+"/v1/items", wrapper.PutItems).Methods("PUT")
 
-type DeleteTagRequestObject struct {
-	Name string `json:"name"`
-}
+	r.HandleFunc(options.BaseURL+"/v1/items/accept-tag", wrapper.AcceptItemTag).Methods("POST")
 
-type DeleteTagResponseObject interface {
-	VisitDeleteTagResponse(w http.ResponseWriter) error
-}
+	r.HandleFunc(options.BaseURL+"/v1/items/dismiss-tag", wrapper.DismissItemTag).Methods("POST")
 
-type DeleteTag204Response struct{}
+	r.HandleFunc(options.BaseURL+"/v1/items/semantic", wrapper.SemanticSearchItems).Methods("GET")
 
-func (response DeleteTag204Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	r.HandleFunc(options.BaseURL+"/v1/items/suggest-tags", wrapper.SuggestItemTags).Methods("POST")
 
-type DeleteTag401Response struct{}
+	r.HandleFunc(options.BaseURL+"/v1/items/{date}/backlinks", wrapper.GetItemBacklinks).Methods("GET")
 
-func (response DeleteTag401Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	r.HandleFunc(options.BaseURL+"/v1/items/{date}/summary", wrapper.SummarizeItem).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/items/{date}/transcribe", wrapper.TranscribeItemAsset).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/jobs", wrapper.GetJobs).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/memories", wrapper.GetMemories).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/places", wrapper.GetPlaces).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/recaps", wrapper.GetRecaps).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/sessions", wrapper.GetSessions).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/sessions/revoke-others", wrapper.RevokeOtherSessions).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/sessions/{id}", wrapper.RevokeSession).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/v1/stats", wrapper.GetStats).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/sync/changes", wrapper.GetChanges).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/tags", wrapper.GetTags).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/tags/consolidation", wrapper.GetTagConsolidation).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/tags/merge", wrapper.MergeTags).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/tags/stats", wrapper.GetTagStats).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/tags/tree", wrapper.GetTagTree).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/tags/{name}", wrapper.DeleteTag).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/v1/tags/{name}", wrapper.UpdateTag).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/v1/tokens", wrapper.GetAccessTokens).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/tokens", wrapper.CreateAccessToken).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/tokens/{id}", wrapper.RevokeAccessToken).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/v1/user", wrapper.GetUser).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/user/2fa/confirm", wrapper.ConfirmTwoFactor).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/user/2fa/disable", wrapper.DisableTwoFactor).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/user/2fa/enroll", wrapper.EnrollTwoFactor).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/user/2fa/recovery-codes", wrapper.RegenerateRecoveryCodes).Methods("POST")
+
+	return r
 }
 
-type RenameTagRequestObject struct {
-	Name string `json:"name"`
-	Body *RenameTagJSONRequestBody
+type AskDiaryRequestObject struct {
+	Body *AskDiaryJSONRequestBody
 }
 
-type RenameTagResponseObject interface {
-	VisitRenameTagResponse(w http.ResponseWriter) error
+type AskDiaryResponseObject interface {
+	VisitAskDiaryResponse(w http.ResponseWriter) error
 }
 
-type RenameTag200Response struct{}
+type AskDiary200JSONResponse AskResponse
 
-func (response RenameTag200Response) VisitRenameTagResponse(w http.ResponseWriter) error {
+func (response AskDiary200JSONResponse) VisitAskDiaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RenameTag400Response struct{}
+type AskDiary400Response struct{}
 
-func (response RenameTag400Response) VisitRenameTagResponse(w http.ResponseWriter) error {
+func (response AskDiary400Response) VisitAskDiaryResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type RenameTag401Response struct{}
+type AskDiary401Response struct{}
 
-func (response RenameTag401Response) VisitRenameTagResponse(w http.ResponseWriter) error {
+func (response AskDiary401Response) VisitAskDiaryResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetUserRequestObject struct{}
+type AskDiary429Response struct{}
 
-type GetUserResponseObject interface {
-	VisitGetUserResponse(w http.ResponseWriter) error
+func (response AskDiary429Response) VisitAskDiaryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
 }
 
-type GetUser200JSONResponse User
-
-func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type AskDiary503Response struct{}
 
-	return json.NewEncoder(w).Encode(response)
+func (response AskDiary503Response) VisitAskDiaryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(503)
+	return nil
 }
 
-// StrictServerInterface represents all server handlers.
+type GetAssetRequestObject struct {
+	Params GetAssetParams
+}
+
+type GetAssetResponseObject interface {
+	VisitGetAssetResponse(w http.ResponseWriter) error
+}
+
+type GetAsset200AsteriskResponse struct {
+	Body          io.Reader
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetAsset200AsteriskResponse) VisitGetAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAsset404Response struct{}
+
+func (response GetAsset404Response) VisitGetAssetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UploadAssetsBatchRequestObject struct {
+	Body *multipart.Reader
+}
+
+type UploadAssetsBatchResponseObject interface {
+	VisitUploadAssetsBatchResponse(w http.ResponseWriter) error
+}
+
+type UploadAssetsBatch200JSONResponse AssetsBatchResponse
+
+func (response UploadAssetsBatch200JSONResponse) VisitUploadAssetsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadAssetsBatch400Response struct{}
+
+func (response UploadAssetsBatch400Response) VisitUploadAssetsBatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UploadAssetsBatch401Response struct{}
+
+func (response UploadAssetsBatch401Response) VisitUploadAssetsBatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UploadAssetsBatch413Response struct{}
+
+func (response UploadAssetsBatch413Response) VisitUploadAssetsBatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(413)
+	return nil
+}
+
+type UploadAssetsBatch500Response struct{}
+
+func (response UploadAssetsBatch500Response) VisitUploadAssetsBatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListAssetsRequestObject struct {
+	Params ListAssetsParams
+}
+
+type ListAssetsResponseObject interface {
+	VisitListAssetsResponse(w http.ResponseWriter) error
+}
+
+type ListAssets200JSONResponse []AssetInfo
+
+func (response ListAssets200JSONResponse) VisitListAssetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssets401Response struct{}
+
+func (response ListAssets401Response) VisitListAssetsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetAuditEventsRequestObject struct {
+	Params GetAuditEventsParams
+}
+
+type GetAuditEventsResponseObject interface {
+	VisitGetAuditEventsResponse(w http.ResponseWriter) error
+}
+
+type GetAuditEvents200JSONResponse AuditLogResponse
+
+func (response GetAuditEvents200JSONResponse) VisitGetAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditEvents400Response struct{}
+
+func (response GetAuditEvents400Response) VisitGetAuditEventsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetAuditEvents401Response struct{}
+
+func (response GetAuditEvents401Response) VisitGetAuditEventsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AuthorizeRequestObject struct {
+	Body *AuthorizeJSONRequestBody
+}
+
+type AuthorizeResponseObject interface {
+	VisitAuthorizeResponse(w http.ResponseWriter) error
+}
+
+type Authorize200JSONResponse struct {
+	Token string `json:"token"`
+}
+
+func (response Authorize200JSONResponse) VisitAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Authorize202JSONResponse TwoFactorChallenge
+
+func (response Authorize202JSONResponse) VisitAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Authorize401Response struct{}
+
+func (response Authorize401Response) VisitAuthorizeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type Authorize429Response struct{}
+
+func (response Authorize429Response) VisitAuthorizeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
+}
+
+type AuthorizeTwoFactorRequestObject struct {
+	Body *AuthorizeTwoFactorJSONRequestBody
+}
+
+type AuthorizeTwoFactorResponseObject interface {
+	VisitAuthorizeTwoFactorResponse(w http.ResponseWriter) error
+}
+
+type AuthorizeTwoFactor200JSONResponse struct {
+	Token string `json:"token"`
+}
+
+func (response AuthorizeTwoFactor200JSONResponse) VisitAuthorizeTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthorizeTwoFactor401Response struct{}
+
+func (response AuthorizeTwoFactor401Response) VisitAuthorizeTwoFactorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AuthorizeTwoFactor429Response struct{}
+
+func (response AuthorizeTwoFactor429Response) VisitAuthorizeTwoFactorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetFamilyRequestObject struct{}
+
+type GetFamilyResponseObject interface {
+	VisitGetFamilyResponse(w http.ResponseWriter) error
+}
+
+type GetFamily200JSONResponse FamilyResponse
+
+func (response GetFamily200JSONResponse) VisitGetFamilyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFamily401Response struct{}
+
+func (response GetFamily401Response) VisitGetFamilyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetFamily404Response struct{}
+
+func (response GetFamily404Response) VisitGetFamilyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UpdateFamilySettingsRequestObject struct {
+	Body *UpdateFamilySettingsJSONRequestBody
+}
+
+type UpdateFamilySettingsResponseObject interface {
+	VisitUpdateFamilySettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateFamilySettings200JSONResponse FamilyResponse
+
+func (response UpdateFamilySettings200JSONResponse) VisitUpdateFamilySettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateFamilySettings400Response struct{}
+
+func (response UpdateFamilySettings400Response) VisitUpdateFamilySettingsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UpdateFamilySettings401Response struct{}
+
+func (response UpdateFamilySettings401Response) VisitUpdateFamilySettingsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetFamilyAIUsageRequestObject struct{}
+
+type GetFamilyAIUsageResponseObject interface {
+	VisitGetFamilyAIUsageResponse(w http.ResponseWriter) error
+}
+
+type GetFamilyAIUsage200JSONResponse AIUsageResponse
+
+func (response GetFamilyAIUsage200JSONResponse) VisitGetFamilyAIUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFamilyAIUsage401Response struct{}
+
+func (response GetFamilyAIUsage401Response) VisitGetFamilyAIUsageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetCustomFieldsRequestObject struct{}
+
+type GetCustomFieldsResponseObject interface {
+	VisitGetCustomFieldsResponse(w http.ResponseWriter) error
+}
+
+type GetCustomFields200JSONResponse CustomFieldsResponse
+
+func (response GetCustomFields200JSONResponse) VisitGetCustomFieldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCustomFields401Response struct{}
+
+func (response GetCustomFields401Response) VisitGetCustomFieldsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateCustomFieldRequestObject struct {
+	Body *CreateCustomFieldJSONRequestBody
+}
+
+type CreateCustomFieldResponseObject interface {
+	VisitCreateCustomFieldResponse(w http.ResponseWriter) error
+}
+
+type CreateCustomField201JSONResponse CustomField
+
+func (response CreateCustomField201JSONResponse) VisitCreateCustomFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCustomField400Response struct{}
+
+func (response CreateCustomField400Response) VisitCreateCustomFieldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type CreateCustomField401Response struct{}
+
+func (response CreateCustomField401Response) VisitCreateCustomFieldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateCustomField409Response struct{}
+
+func (response CreateCustomField409Response) VisitCreateCustomFieldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type DeleteCustomFieldRequestObject struct {
+	Key string `json:"key"`
+}
+
+type DeleteCustomFieldResponseObject interface {
+	VisitDeleteCustomFieldResponse(w http.ResponseWriter) error
+}
+
+type DeleteCustomField204Response struct{}
+
+func (response DeleteCustomField204Response) VisitDeleteCustomFieldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCustomField401Response struct{}
+
+func (response DeleteCustomField401Response) VisitDeleteCustomFieldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteCustomField404Response struct{}
+
+func (response DeleteCustomField404Response) VisitDeleteCustomFieldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UpdateCustomFieldRequestObject struct {
+	Key  string `json:"key"`
+	Body *UpdateCustomFieldJSONRequestBody
+}
+
+type UpdateCustomFieldResponseObject interface {
+	VisitUpdateCustomFieldResponse(w http.ResponseWriter) error
+}
+
+type UpdateCustomField200JSONResponse CustomField
+
+func (response UpdateCustomField200JSONResponse) VisitUpdateCustomFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCustomField400Response struct{}
+
+func (response UpdateCustomField400Response) VisitUpdateCustomFieldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UpdateCustomField401Response struct{}
+
+func (response UpdateCustomField401Response) VisitUpdateCustomFieldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateCustomField404Response struct{}
+
+func (response UpdateCustomField404Response) VisitUpdateCustomFieldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetCustomFieldStatsRequestObject struct {
+	Key    string `json:"key"`
+	Params GetCustomFieldStatsParams
+}
+
+type GetCustomFieldStatsResponseObject interface {
+	VisitGetCustomFieldStatsResponse(w http.ResponseWriter) error
+}
+
+type GetCustomFieldStats200JSONResponse CustomFieldStats
+
+func (response GetCustomFieldStats200JSONResponse) VisitGetCustomFieldStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCustomFieldStats400Response struct{}
+
+func (response GetCustomFieldStats400Response) VisitGetCustomFieldStatsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetCustomFieldStats401Response struct{}
+
+func (response GetCustomFieldStats401Response) VisitGetCustomFieldStatsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetCustomFieldStats404Response struct{}
+
+func (response GetCustomFieldStats404Response) VisitGetCustomFieldStatsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type FixHealthIssuesRequestObject struct {
+	Body *FixHealthIssuesJSONRequestBody
+}
+
+type FixHealthIssuesResponseObject interface {
+	VisitFixHealthIssuesResponse(w http.ResponseWriter) error
+}
+
+type FixHealthIssues200JSONResponse HealthIssuesResponse
+
+func (response FixHealthIssues200JSONResponse) VisitFixHealthIssuesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FixHealthIssues400Response struct{}
+
+func (response FixHealthIssues400Response) VisitFixHealthIssuesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type FixHealthIssues401Response struct{}
+
+func (response FixHealthIssues401Response) VisitFixHealthIssuesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type FixHealthIssues500Response struct{}
+
+func (response FixHealthIssues500Response) VisitFixHealthIssuesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetHealthIssuesRequestObject struct{}
+
+type GetHealthIssuesResponseObject interface {
+	VisitGetHealthIssuesResponse(w http.ResponseWriter) error
+}
+
+type GetHealthIssues200JSONResponse HealthIssuesResponse
+
+func (response GetHealthIssues200JSONResponse) VisitGetHealthIssuesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthIssues401Response struct{}
+
+func (response GetHealthIssues401Response) VisitGetHealthIssuesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteOrphanRequestObject struct {
+	Filename string `json:"filename"`
+}
+
+type DeleteOrphanResponseObject interface {
+	VisitDeleteOrphanResponse(w http.ResponseWriter) error
+}
+
+type DeleteOrphan200JSONResponse HealthIssuesResponse
+
+func (response DeleteOrphan200JSONResponse) VisitDeleteOrphanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrphan400Response struct{}
+
+func (response DeleteOrphan400Response) VisitDeleteOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type DeleteOrphan401Response struct{}
+
+func (response DeleteOrphan401Response) VisitDeleteOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteOrphan404Response struct{}
+
+func (response DeleteOrphan404Response) VisitDeleteOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DeleteOrphan500Response struct{}
+
+func (response DeleteOrphan500Response) VisitDeleteOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type AttachOrphanRequestObject struct {
+	Filename string `json:"filename"`
+	Body     *AttachOrphanJSONRequestBody
+}
+
+type AttachOrphanResponseObject interface {
+	VisitAttachOrphanResponse(w http.ResponseWriter) error
+}
+
+type AttachOrphan200JSONResponse HealthIssuesResponse
+
+func (response AttachOrphan200JSONResponse) VisitAttachOrphanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AttachOrphan400Response struct{}
+
+func (response AttachOrphan400Response) VisitAttachOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AttachOrphan401Response struct{}
+
+func (response AttachOrphan401Response) VisitAttachOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AttachOrphan500Response struct{}
+
+func (response AttachOrphan500Response) VisitAttachOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type UnignoreOrphanRequestObject struct {
+	Filename string `json:"filename"`
+}
+
+type UnignoreOrphanResponseObject interface {
+	VisitUnignoreOrphanResponse(w http.ResponseWriter) error
+}
+
+type UnignoreOrphan200JSONResponse HealthIssuesResponse
+
+func (response UnignoreOrphan200JSONResponse) VisitUnignoreOrphanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnignoreOrphan400Response struct{}
+
+func (response UnignoreOrphan400Response) VisitUnignoreOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UnignoreOrphan401Response struct{}
+
+func (response UnignoreOrphan401Response) VisitUnignoreOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UnignoreOrphan500Response struct{}
+
+func (response UnignoreOrphan500Response) VisitUnignoreOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type IgnoreOrphanRequestObject struct {
+	Filename string `json:"filename"`
+}
+
+type IgnoreOrphanResponseObject interface {
+	VisitIgnoreOrphanResponse(w http.ResponseWriter) error
+}
+
+type IgnoreOrphan200JSONResponse HealthIssuesResponse
+
+func (response IgnoreOrphan200JSONResponse) VisitIgnoreOrphanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IgnoreOrphan400Response struct{}
+
+func (response IgnoreOrphan400Response) VisitIgnoreOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type IgnoreOrphan401Response struct{}
+
+func (response IgnoreOrphan401Response) VisitIgnoreOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type IgnoreOrphan500Response struct{}
+
+func (response IgnoreOrphan500Response) VisitIgnoreOrphanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetItemsRequestObject struct {
+	Params GetItemsParams
+}
+
+type GetItemsResponseObject interface {
+	VisitGetItemsResponse(w http.ResponseWriter) error
+}
+
+type GetItems200JSONResponse ItemsListResponse
+
+func (response GetItems200JSONResponse) VisitGetItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItems400Response struct{}
+
+func (response GetItems400Response) VisitGetItemsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetItems404Response struct{}
+
+func (response GetItems404Response) VisitGetItemsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutItemsRequestObject struct {
+	Body *PutItemsJSONRequestBody
+}
+
+type PutItemsResponseObject interface {
+	VisitPutItemsResponse(w http.ResponseWriter) error
+}
+
+type PutItems200JSONResponse ItemsResponse
+
+func (response PutItems200JSONResponse) VisitPutItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItems400Response struct{}
+
+func (response PutItems400Response) VisitPutItemsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PutItems401Response struct{}
+
+func (response PutItems401Response) VisitPutItemsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AcceptItemTagRequestObject struct {
+	Body *AcceptItemTagJSONRequestBody
+}
+
+type AcceptItemTagResponseObject interface {
+	VisitAcceptItemTagResponse(w http.ResponseWriter) error
+}
+
+type AcceptItemTag200JSONResponse ItemsResponse
+
+func (response AcceptItemTag200JSONResponse) VisitAcceptItemTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptItemTag400Response struct{}
+
+func (response AcceptItemTag400Response) VisitAcceptItemTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AcceptItemTag401Response struct{}
+
+func (response AcceptItemTag401Response) VisitAcceptItemTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AcceptItemTag404Response struct{}
+
+func (response AcceptItemTag404Response) VisitAcceptItemTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DismissItemTagRequestObject struct {
+	Body *DismissItemTagJSONRequestBody
+}
+
+type DismissItemTagResponseObject interface {
+	VisitDismissItemTagResponse(w http.ResponseWriter) error
+}
+
+type DismissItemTag200JSONResponse ItemsResponse
+
+func (response DismissItemTag200JSONResponse) VisitDismissItemTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DismissItemTag400Response struct{}
+
+func (response DismissItemTag400Response) VisitDismissItemTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type DismissItemTag401Response struct{}
+
+func (response DismissItemTag401Response) VisitDismissItemTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DismissItemTag404Response struct{}
+
+func (response DismissItemTag404Response) VisitDismissItemTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SemanticSearchItemsRequestObject struct {
+	Params SemanticSearchItemsParams
+}
+
+type SemanticSearchItemsResponseObject interface {
+	VisitSemanticSearchItemsResponse(w http.ResponseWriter) error
+}
+
+type SemanticSearchItems200JSONResponse SemanticSearchResponse
+
+func (response SemanticSearchItems200JSONResponse) VisitSemanticSearchItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SemanticSearchItems400Response struct{}
+
+func (response SemanticSearchItems400Response) VisitSemanticSearchItemsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SemanticSearchItems401Response struct{}
+
+func (response SemanticSearchItems401Response) VisitSemanticSearchItemsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SemanticSearchItems429Response struct{}
+
+func (response SemanticSearchItems429Response) VisitSemanticSearchItemsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
+}
+
+type SemanticSearchItems503Response struct{}
+
+func (response SemanticSearchItems503Response) VisitSemanticSearchItemsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(503)
+	return nil
+}
+
+type SuggestItemTagsRequestObject struct {
+	Body *SuggestItemTagsJSONRequestBody
+}
+
+type SuggestItemTagsResponseObject interface {
+	VisitSuggestItemTagsResponse(w http.ResponseWriter) error
+}
+
+type SuggestItemTags200JSONResponse SuggestTagsResponse
+
+func (response SuggestItemTags200JSONResponse) VisitSuggestItemTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuggestItemTags400Response struct{}
+
+func (response SuggestItemTags400Response) VisitSuggestItemTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SuggestItemTags401Response struct{}
+
+func (response SuggestItemTags401Response) VisitSuggestItemTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SuggestItemTags429Response struct{}
+
+func (response SuggestItemTags429Response) VisitSuggestItemTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
+}
+
+type SuggestItemTags503Response struct{}
+
+func (response SuggestItemTags503Response) VisitSuggestItemTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(503)
+	return nil
+}
+
+type GetItemBacklinksRequestObject struct {
+	Date openapi_types.Date `json:"date"`
+}
+
+type GetItemBacklinksResponseObject interface {
+	VisitGetItemBacklinksResponse(w http.ResponseWriter) error
+}
+
+type GetItemBacklinks200JSONResponse BacklinksResponse
+
+func (response GetItemBacklinks200JSONResponse) VisitGetItemBacklinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemBacklinks401Response struct{}
+
+func (response GetItemBacklinks401Response) VisitGetItemBacklinksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SummarizeItemRequestObject struct {
+	Date openapi_types.Date `json:"date"`
+}
+
+type SummarizeItemResponseObject interface {
+	VisitSummarizeItemResponse(w http.ResponseWriter) error
+}
+
+type SummarizeItem200JSONResponse ItemSummary
+
+func (response SummarizeItem200JSONResponse) VisitSummarizeItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SummarizeItem401Response struct{}
+
+func (response SummarizeItem401Response) VisitSummarizeItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SummarizeItem404Response struct{}
+
+func (response SummarizeItem404Response) VisitSummarizeItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SummarizeItem429Response struct{}
+
+func (response SummarizeItem429Response) VisitSummarizeItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
+}
+
+type SummarizeItem503Response struct{}
+
+func (response SummarizeItem503Response) VisitSummarizeItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(503)
+	return nil
+}
+
+type TranscribeItemAssetRequestObject struct {
+	Date openapi_types.Date `json:"date"`
+	Body *TranscribeItemAssetJSONRequestBody
+}
+
+type TranscribeItemAssetResponseObject interface {
+	VisitTranscribeItemAssetResponse(w http.ResponseWriter) error
+}
+
+type TranscribeItemAsset200JSONResponse TranscribeResponse
+
+func (response TranscribeItemAsset200JSONResponse) VisitTranscribeItemAssetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TranscribeItemAsset400Response struct{}
+
+func (response TranscribeItemAsset400Response) VisitTranscribeItemAssetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type TranscribeItemAsset401Response struct{}
+
+func (response TranscribeItemAsset401Response) VisitTranscribeItemAssetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type TranscribeItemAsset404Response struct{}
+
+func (response TranscribeItemAsset404Response) VisitTranscribeItemAssetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type TranscribeItemAsset409Response struct{}
+
+func (response TranscribeItemAsset409Response) VisitTranscribeItemAssetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type TranscribeItemAsset429Response struct{}
+
+func (response TranscribeItemAsset429Response) VisitTranscribeItemAssetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
+}
+
+type TranscribeItemAsset503Response struct{}
+
+func (response TranscribeItemAsset503Response) VisitTranscribeItemAssetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(503)
+	return nil
+}
+
+type GetJobsRequestObject struct {
+	Params GetJobsParams
+}
+
+type GetJobsResponseObject interface {
+	VisitGetJobsResponse(w http.ResponseWriter) error
+}
+
+type GetJobs200JSONResponse JobsResponse
+
+func (response GetJobs200JSONResponse) VisitGetJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobs400Response struct{}
+
+func (response GetJobs400Response) VisitGetJobsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetJobs401Response struct{}
+
+func (response GetJobs401Response) VisitGetJobsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetMemoriesRequestObject struct {
+	Params GetMemoriesParams
+}
+
+type GetMemoriesResponseObject interface {
+	VisitGetMemoriesResponse(w http.ResponseWriter) error
+}
+
+type GetMemories200JSONResponse MemoriesResponse
+
+func (response GetMemories200JSONResponse) VisitGetMemoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMemories400Response struct{}
+
+func (response GetMemories400Response) VisitGetMemoriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetMemories401Response struct{}
+
+func (response GetMemories401Response) VisitGetMemoriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetPlacesRequestObject struct {
+	Params GetPlacesParams
+}
+
+type GetPlacesResponseObject interface {
+	VisitGetPlacesResponse(w http.ResponseWriter) error
+}
+
+type GetPlaces200JSONResponse PlacesResponse
+
+func (response GetPlaces200JSONResponse) VisitGetPlacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlaces400Response struct{}
+
+func (response GetPlaces400Response) VisitGetPlacesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetPlaces401Response struct{}
+
+func (response GetPlaces401Response) VisitGetPlacesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetRecapsRequestObject struct {
+	Params GetRecapsParams
+}
+
+type GetRecapsResponseObject interface {
+	VisitGetRecapsResponse(w http.ResponseWriter) error
+}
+
+type GetRecaps200JSONResponse []Recap
+
+func (response GetRecaps200JSONResponse) VisitGetRecapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecaps400Response struct{}
+
+func (response GetRecaps400Response) VisitGetRecapsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetRecaps401Response struct{}
+
+func (response GetRecaps401Response) VisitGetRecapsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetSessionsRequestObject struct{}
+
+type GetSessionsResponseObject interface {
+	VisitGetSessionsResponse(w http.ResponseWriter) error
+}
+
+type GetSessions200JSONResponse []Session
+
+func (response GetSessions200JSONResponse) VisitGetSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessions401Response struct{}
+
+func (response GetSessions401Response) VisitGetSessionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RevokeOtherSessionsRequestObject struct{}
+
+type RevokeOtherSessionsResponseObject interface {
+	VisitRevokeOtherSessionsResponse(w http.ResponseWriter) error
+}
+
+type RevokeOtherSessions200JSONResponse RevokedSessions
+
+func (response RevokeOtherSessions200JSONResponse) VisitRevokeOtherSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeOtherSessions401Response struct{}
+
+func (response RevokeOtherSessions401Response) VisitRevokeOtherSessionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RevokeSessionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RevokeSessionResponseObject interface {
+	VisitRevokeSessionResponse(w http.ResponseWriter) error
+}
+
+type RevokeSession204Response struct{}
+
+func (response RevokeSession204Response) VisitRevokeSessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeSession401Response struct{}
+
+func (response RevokeSession401Response) VisitRevokeSessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RevokeSession404Response struct{}
+
+func (response RevokeSession404Response) VisitRevokeSessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetStatsRequestObject struct {
+	Params GetStatsParams
+}
+
+type GetStatsResponseObject interface {
+	VisitGetStatsResponse(w http.ResponseWriter) error
+}
+
+type GetStats200JSONResponse StatsResponse
+
+func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats400Response struct{}
+
+func (response GetStats400Response) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetStats401Response struct{}
+
+func (response GetStats401Response) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetChangesRequestObject struct {
+	Params GetChangesParams
+}
+
+type GetChangesResponseObject interface {
+	VisitGetChangesResponse(w http.ResponseWriter) error
+}
+
+type GetChanges200JSONResponse SyncResponse
+
+func (response GetChanges200JSONResponse) VisitGetChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChanges400Response struct{}
+
+func (response GetChanges400Response) VisitGetChangesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetChanges401Response struct{}
+
+func (response GetChanges401Response) VisitGetChangesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetTagsRequestObject struct{}
+
+type GetTagsResponseObject interface {
+	VisitGetTagsResponse(w http.ResponseWriter) error
+}
+
+type GetTags200JSONResponse TagsResponse
+
+func (response GetTags200JSONResponse) VisitGetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTags401Response struct{}
+
+func (response GetTags401Response) VisitGetTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetTagConsolidationRequestObject struct {
+	Params GetTagConsolidationParams
+}
+
+type GetTagConsolidationResponseObject interface {
+	VisitGetTagConsolidationResponse(w http.ResponseWriter) error
+}
+
+type GetTagConsolidation200JSONResponse TagConsolidationResponse
+
+func (response GetTagConsolidation200JSONResponse) VisitGetTagConsolidationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagConsolidation401Response struct{}
+
+func (response GetTagConsolidation401Response) VisitGetTagConsolidationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type MergeTagsRequestObject struct {
+	Body *MergeTagsJSONRequestBody
+}
+
+type MergeTagsResponseObject interface {
+	VisitMergeTagsResponse(w http.ResponseWriter) error
+}
+
+type MergeTags200JSONResponse TagMergeResponse
+
+func (response MergeTags200JSONResponse) VisitMergeTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeTags400Response struct{}
+
+func (response MergeTags400Response) VisitMergeTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type MergeTags401Response struct{}
+
+func (response MergeTags401Response) VisitMergeTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetTagStatsRequestObject struct{}
+
+type GetTagStatsResponseObject interface {
+	VisitGetTagStatsResponse(w http.ResponseWriter) error
+}
+
+type GetTagStats200JSONResponse TagStatsResponse
+
+func (response GetTagStats200JSONResponse) VisitGetTagStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagStats401Response struct{}
+
+func (response GetTagStats401Response) VisitGetTagStatsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetTagTreeRequestObject struct{}
+
+type GetTagTreeResponseObject interface {
+	VisitGetTagTreeResponse(w http.ResponseWriter) error
+}
+
+type GetTagTree200JSONResponse TagTreeResponse
+
+func (response GetTagTree200JSONResponse) VisitGetTagTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagTree401Response struct{}
+
+func (response GetTagTree401Response) VisitGetTagTreeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteTagRequestObject struct {
+	Name   string `json:"name"`
+	Params DeleteTagParams
+}
+
+type DeleteTagResponseObject interface {
+	VisitDeleteTagResponse(w http.ResponseWriter) error
+}
+
+type DeleteTag204Response struct{}
+
+func (response DeleteTag204Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTag401Response struct{}
+
+func (response DeleteTag401Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateTagRequestObject struct {
+	Name string `json:"name"`
+	Body *UpdateTagJSONRequestBody
+}
+
+type UpdateTagResponseObject interface {
+	VisitUpdateTagResponse(w http.ResponseWriter) error
+}
+
+type UpdateTag200JSONResponse TagMetadata
+
+func (response UpdateTag200JSONResponse) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTag400Response struct{}
+
+func (response UpdateTag400Response) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UpdateTag401Response struct{}
+
+func (response UpdateTag401Response) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateTag409Response struct{}
+
+func (response UpdateTag409Response) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type GetAccessTokensRequestObject struct{}
+
+type GetAccessTokensResponseObject interface {
+	VisitGetAccessTokensResponse(w http.ResponseWriter) error
+}
+
+type GetAccessTokens200JSONResponse []AccessToken
+
+func (response GetAccessTokens200JSONResponse) VisitGetAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccessTokens401Response struct{}
+
+func (response GetAccessTokens401Response) VisitGetAccessTokensResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateAccessTokenRequestObject struct {
+	Body *CreateAccessTokenJSONRequestBody
+}
+
+type CreateAccessTokenResponseObject interface {
+	VisitCreateAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type CreateAccessToken201JSONResponse CreatedAccessToken
+
+func (response CreateAccessToken201JSONResponse) VisitCreateAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessToken400Response struct{}
+
+func (response CreateAccessToken400Response) VisitCreateAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type CreateAccessToken401Response struct{}
+
+func (response CreateAccessToken401Response) VisitCreateAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RevokeAccessTokenRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RevokeAccessTokenResponseObject interface {
+	VisitRevokeAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type RevokeAccessToken204Response struct{}
+
+func (response RevokeAccessToken204Response) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeAccessToken401Response struct{}
+
+func (response RevokeAccessToken401Response) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RevokeAccessToken404Response struct{}
+
+func (response RevokeAccessToken404Response) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetUserRequestObject struct{}
+
+type GetUserResponseObject interface {
+	VisitGetUserResponse(w http.ResponseWriter) error
+}
+
+type GetUser200JSONResponse User
+
+func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTwoFactorRequestObject struct {
+	Body *ConfirmTwoFactorJSONRequestBody
+}
+
+type ConfirmTwoFactorResponseObject interface {
+	VisitConfirmTwoFactorResponse(w http.ResponseWriter) error
+}
+
+type ConfirmTwoFactor200JSONResponse RecoveryCodesResponse
+
+func (response ConfirmTwoFactor200JSONResponse) VisitConfirmTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTwoFactor400Response struct{}
+
+func (response ConfirmTwoFactor400Response) VisitConfirmTwoFactorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ConfirmTwoFactor401Response struct{}
+
+func (response ConfirmTwoFactor401Response) VisitConfirmTwoFactorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DisableTwoFactorRequestObject struct {
+	Body *DisableTwoFactorJSONRequestBody
+}
+
+type DisableTwoFactorResponseObject interface {
+	VisitDisableTwoFactorResponse(w http.ResponseWriter) error
+}
+
+type DisableTwoFactor204Response struct{}
+
+func (response DisableTwoFactor204Response) VisitDisableTwoFactorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DisableTwoFactor400Response struct{}
+
+func (response DisableTwoFactor400Response) VisitDisableTwoFactorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type DisableTwoFactor401Response struct{}
+
+func (response DisableTwoFactor401Response) VisitDisableTwoFactorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type EnrollTwoFactorRequestObject struct{}
+
+type EnrollTwoFactorResponseObject interface {
+	VisitEnrollTwoFactorResponse(w http.ResponseWriter) error
+}
+
+type EnrollTwoFactor200JSONResponse TwoFactorEnrollment
+
+func (response EnrollTwoFactor200JSONResponse) VisitEnrollTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrollTwoFactor401Response struct{}
+
+func (response EnrollTwoFactor401Response) VisitEnrollTwoFactorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type EnrollTwoFactor409Response struct{}
+
+func (response EnrollTwoFactor409Response) VisitEnrollTwoFactorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type RegenerateRecoveryCodesRequestObject struct {
+	Body *RegenerateRecoveryCodesJSONRequestBody
+}
+
+type RegenerateRecoveryCodesResponseObject interface {
+	VisitRegenerateRecoveryCodesResponse(w http.ResponseWriter) error
+}
+
+type RegenerateRecoveryCodes200JSONResponse RecoveryCodesResponse
+
+func (response RegenerateRecoveryCodes200JSONResponse) VisitRegenerateRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegenerateRecoveryCodes400Response struct{}
+
+func (response RegenerateRecoveryCodes400Response) VisitRegenerateRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type RegenerateRecoveryCodes401Response struct{}
+
+func (response RegenerateRecoveryCodes401Response) VisitRegenerateRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// answer a question about the family's diary, citing the entries used
+	// (POST /v1/ask)
+	AskDiary(ctx context.Context, request AskDiaryRequestObject) (AskDiaryResponseObject, error)
 	// return asset by path
 	// (GET /v1/assets)
 	GetAsset(ctx context.Context, request GetAssetRequestObject) (GetAssetResponseObject, error)
 	// upload multiple asset files
 	// (POST /v1/assets/batch)
 	UploadAssetsBatch(ctx context.Context, request UploadAssetsBatchRequestObject) (UploadAssetsBatchResponseObject, error)
+	// list the family's asset files with their AI captions
+	// (GET /v1/assets/list)
+	ListAssets(ctx context.Context, request ListAssetsRequestObject) (ListAssetsResponseObject, error)
+	// list the family's security audit log, newest first
+	// (GET /v1/audit)
+	GetAuditEvents(ctx context.Context, request GetAuditEventsRequestObject) (GetAuditEventsResponseObject, error)
 	// validate user/password and return token
 	// (POST /v1/authorize)
 	Authorize(ctx context.Context, request AuthorizeRequestObject) (AuthorizeResponseObject, error)
+	// complete a two-factor login with a TOTP or recovery code
+	// (POST /v1/authorize/2fa)
+	AuthorizeTwoFactor(ctx context.Context, request AuthorizeTwoFactorRequestObject) (AuthorizeTwoFactorResponseObject, error)
 	// return family info with members
 	// (GET /v1/family)
 	GetFamily(ctx context.Context, request GetFamilyRequestObject) (GetFamilyResponseObject, error)
 	// update family settings (e.g. AI tagging)
 	// (PATCH /v1/family)
 	UpdateFamilySettings(ctx context.Context, request UpdateFamilySettingsRequestObject) (UpdateFamilySettingsResponseObject, error)
+	// return the family's AI usage per month and the monthly token budget
+	// (GET /v1/family/ai-usage)
+	GetFamilyAIUsage(ctx context.Context, request GetFamilyAIUsageRequestObject) (GetFamilyAIUsageResponseObject, error)
+	// list the family's custom fields
+	// (GET /v1/fields)
+	GetCustomFields(ctx context.Context, request GetCustomFieldsRequestObject) (GetCustomFieldsResponseObject, error)
+	// define a custom field entries can carry
+	// (POST /v1/fields)
+	CreateCustomField(ctx context.Context, request CreateCustomFieldRequestObject) (CreateCustomFieldResponseObject, error)
+	// delete a custom field and its values from all of the family's entries
+	// (DELETE /v1/fields/{key})
+	DeleteCustomField(ctx context.Context, request DeleteCustomFieldRequestObject) (DeleteCustomFieldResponseObject, error)
+	// edit a custom field
+	// (PATCH /v1/fields/{key})
+	UpdateCustomField(ctx context.Context, request UpdateCustomFieldRequestObject) (UpdateCustomFieldResponseObject, error)
+	// aggregate a custom field's values over the family's entries
+	// (GET /v1/fields/{key}/stats)
+	GetCustomFieldStats(ctx context.Context, request GetCustomFieldStatsRequestObject) (GetCustomFieldStatsResponseObject, error)
 	// fix storage issues for current user
 	// (POST /v1/health/fix)
 	FixHealthIssues(ctx context.Context, request FixHealthIssuesRequestObject) (FixHealthIssuesResponseObject, error)
@@ -1875,79 +4978,909 @@ type StrictServerInterface interface {
 	// dismiss a pending suggested tag for a day (removes it from pending)
 	// (POST /v1/items/dismiss-tag)
 	DismissItemTag(ctx context.Context, request DismissItemTagRequestObject) (DismissItemTagResponseObject, error)
+	// find the entries closest in meaning to a free-text query
+	// (GET /v1/items/semantic)
+	SemanticSearchItems(ctx context.Context, request SemanticSearchItemsRequestObject) (SemanticSearchItemsResponseObject, error)
 	// suggest tags for draft entry content (does not save)
 	// (POST /v1/items/suggest-tags)
 	SuggestItemTags(ctx context.Context, request SuggestItemTagsRequestObject) (SuggestItemTagsResponseObject, error)
+	// list the entries that link to a day with [[YYYY-MM-DD]]
+	// (GET /v1/items/{date}/backlinks)
+	GetItemBacklinks(ctx context.Context, request GetItemBacklinksRequestObject) (GetItemBacklinksResponseObject, error)
+	// summarize a day's entry in one paragraph (does not save)
+	// (POST /v1/items/{date}/summary)
+	SummarizeItem(ctx context.Context, request SummarizeItemRequestObject) (SummarizeItemResponseObject, error)
+	// transcribe a voice memo of the entry and append the text to its body
+	// (POST /v1/items/{date}/transcribe)
+	TranscribeItemAsset(ctx context.Context, request TranscribeItemAssetRequestObject) (TranscribeItemAssetResponseObject, error)
+	// return the family's background AI jobs and backfill progress
+	// (GET /v1/jobs)
+	GetJobs(ctx context.Context, request GetJobsRequestObject) (GetJobsResponseObject, error)
+	// list the entries of the same day in previous years and of the same week a month ago
+	// (GET /v1/memories)
+	GetMemories(ctx context.Context, request GetMemoriesRequestObject) (GetMemoriesResponseObject, error)
+	// list the places of the family's entries with how often each was visited
+	// (GET /v1/places)
+	GetPlaces(ctx context.Context, request GetPlacesRequestObject) (GetPlacesResponseObject, error)
+	// list the family's AI-written weekly and monthly recaps, newest first
+	// (GET /v1/recaps)
+	GetRecaps(ctx context.Context, request GetRecapsRequestObject) (GetRecapsResponseObject, error)
+	// list the user's active sign-in sessions
+	// (GET /v1/sessions)
+	GetSessions(ctx context.Context, request GetSessionsRequestObject) (GetSessionsResponseObject, error)
+	// sign out every session except the current one
+	// (POST /v1/sessions/revoke-others)
+	RevokeOtherSessions(ctx context.Context, request RevokeOtherSessionsRequestObject) (RevokeOtherSessionsResponseObject, error)
+	// sign out a session
+	// (DELETE /v1/sessions/{id})
+	RevokeSession(ctx context.Context, request RevokeSessionRequestObject) (RevokeSessionResponseObject, error)
+	// writing statistics of the family's entries
+	// (GET /v1/stats)
+	GetStats(ctx context.Context, request GetStatsRequestObject) (GetStatsResponseObject, error)
 	// get changes for synchronization
 	// (GET /v1/sync/changes)
 	GetChanges(ctx context.Context, request GetChangesRequestObject) (GetChangesResponseObject, error)
-	// list the family's distinct existing tags
+	// list the family's distinct existing tags and their metadata
 	// (GET /v1/tags)
 	GetTags(ctx context.Context, request GetTagsRequestObject) (GetTagsResponseObject, error)
+	// suggest clusters of near-duplicate tags to merge
+	// (GET /v1/tags/consolidation)
+	GetTagConsolidation(ctx context.Context, request GetTagConsolidationRequestObject) (GetTagConsolidationResponseObject, error)
+	// merge several tags into others in one transaction
+	// (POST /v1/tags/merge)
+	MergeTags(ctx context.Context, request MergeTagsRequestObject) (MergeTagsResponseObject, error)
 	// list the family's distinct tags with per-tag usage counts
 	// (GET /v1/tags/stats)
 	GetTagStats(ctx context.Context, request GetTagStatsRequestObject) (GetTagStatsResponseObject, error)
+	// list the family's tags as a tree of hierarchical tags
+	// (GET /v1/tags/tree)
+	GetTagTree(ctx context.Context, request GetTagTreeRequestObject) (GetTagTreeResponseObject, error)
 	// delete a tag from all of the family's entries
 	// (DELETE /v1/tags/{name})
 	DeleteTag(ctx context.Context, request DeleteTagRequestObject) (DeleteTagResponseObject, error)
-	// rename a tag across all of the family's entries
+	// rename a tag across all of the family's entries and edit its metadata
 	// (PATCH /v1/tags/{name})
-	RenameTag(ctx context.Context, request RenameTagRequestObject) (RenameTagResponseObject, error)
+	UpdateTag(ctx context.Context, request UpdateTagRequestObject) (UpdateTagResponseObject, error)
+	// list the user's active personal access tokens
+	// (GET /v1/tokens)
+	GetAccessTokens(ctx context.Context, request GetAccessTokensRequestObject) (GetAccessTokensResponseObject, error)
+	// create a named, scoped personal access token
+	// (POST /v1/tokens)
+	CreateAccessToken(ctx context.Context, request CreateAccessTokenRequestObject) (CreateAccessTokenResponseObject, error)
+	// revoke a personal access token
+	// (DELETE /v1/tokens/{id})
+	RevokeAccessToken(ctx context.Context, request RevokeAccessTokenRequestObject) (RevokeAccessTokenResponseObject, error)
 	// return user object
 	// (GET /v1/user)
 	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
+	// confirm enrollment with a TOTP code and enable two-factor login
+	// (POST /v1/user/2fa/confirm)
+	ConfirmTwoFactor(ctx context.Context, request ConfirmTwoFactorRequestObject) (ConfirmTwoFactorResponseObject, error)
+	// disable two-factor login (requires a TOTP or recovery code)
+	// (POST /v1/user/2fa/disable)
+	DisableTwoFactor(ctx context.Context, request DisableTwoFactorRequestObject) (DisableTwoFactorResponseObject, error)
+	// start TOTP enrollment and return the provisioning URI
+	// (POST /v1/user/2fa/enroll)
+	EnrollTwoFactor(ctx context.Context, request EnrollTwoFactorRequestObject) (EnrollTwoFactorResponseObject, error)
+	// replace all recovery codes (requires a TOTP code)
+	// (POST /v1/user/2fa/recovery-codes)
+	RegenerateRecoveryCodes(ctx context.Context, request RegenerateRecoveryCodesRequestObject) (RegenerateRecoveryCodesResponseObject, error)
+}
+
+type (
+	StrictHandlerFunc    = strictnethttp.StrictHTTPHandlerFunc
+	StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+)
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// AskDiary operation middleware
+func (sh *strictHandler) AskDiary(w http.ResponseWriter, r *http.Request) {
+	var request AskDiaryRequestObject
+
+	var body AskDiaryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AskDiary(ctx, request.(AskDiaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AskDiary")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AskDiaryResponseObject); ok {
+		if err := validResponse.VisitAskDiaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAsset operation middleware
+func (sh *strictHandler) GetAsset(w http.ResponseWriter, r *http.Request, params GetAssetParams) {
+	var request GetAssetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAsset(ctx, request.(GetAssetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAsset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAssetResponseObject); ok {
+		if err := validResponse.VisitGetAssetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UploadAssetsBatch operation middleware
+func (sh *strictHandler) UploadAssetsBatch(w http.ResponseWriter, r *http.Request) {
+	var request UploadAssetsBatchRequestObject
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadAssetsBatch(ctx, request.(UploadAssetsBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadAssetsBatch")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadAssetsBatchResponseObject); ok {
+		if err := validResponse.VisitUploadAssetsBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAssets operation middleware
+func (sh *strictHandler) ListAssets(w http.ResponseWriter, r *http.Request, params ListAssetsParams) {
+	var request ListAssetsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAssets(ctx, request.(ListAssetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAssets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAssetsResponseObject); ok {
+		if err := validResponse.VisitListAssetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuditEvents operation middleware
+func (sh *strictHandler) GetAuditEvents(w http.ResponseWriter, r *http.Request, params GetAuditEventsParams) {
+	var request GetAuditEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuditEvents(ctx, request.(GetAuditEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuditEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuditEventsResponseObject); ok {
+		if err := validResponse.VisitGetAuditEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Authorize operation middleware
+func (sh *strictHandler) Authorize(w http.ResponseWriter, r *http.Request) {
+	var request AuthorizeRequestObject
+
+	var body AuthorizeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Authorize(ctx, request.(AuthorizeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Authorize")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthorizeResponseObject); ok {
+		if err := validResponse.VisitAuthorizeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AuthorizeTwoFactor operation middleware
+func (sh *strictHandler) AuthorizeTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var request AuthorizeTwoFactorRequestObject
+
+	var body AuthorizeTwoFactorJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthorizeTwoFactor(ctx, request.(AuthorizeTwoFactorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthorizeTwoFactor")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthorizeTwoFactorResponseObject); ok {
+		if err := validResponse.VisitAuthorizeTwoFactorResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetFamily operation middleware
+func (sh *strictHandler) GetFamily(w http.ResponseWriter, r *http.Request) {
+	var request GetFamilyRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFamily(ctx, request.(GetFamilyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFamily")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFamilyResponseObject); ok {
+		if err := validResponse.VisitGetFamilyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateFamilySettings operation middleware
+func (sh *strictHandler) UpdateFamilySettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateFamilySettingsRequestObject
+
+	var body UpdateFamilySettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateFamilySettings(ctx, request.(UpdateFamilySettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateFamilySettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateFamilySettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateFamilySettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetFamilyAIUsage operation middleware
+func (sh *strictHandler) GetFamilyAIUsage(w http.ResponseWriter, r *http.Request) {
+	var request GetFamilyAIUsageRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFamilyAIUsage(ctx, request.(GetFamilyAIUsageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFamilyAIUsage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFamilyAIUsageResponseObject); ok {
+		if err := validResponse.VisitGetFamilyAIUsageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCustomFields operation middleware
+func (sh *strictHandler) GetCustomFields(w http.ResponseWriter, r *http.Request) {
+	var request GetCustomFieldsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCustomFields(ctx, request.(GetCustomFieldsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCustomFields")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCustomFieldsResponseObject); ok {
+		if err := validResponse.VisitGetCustomFieldsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCustomField operation middleware
+func (sh *strictHandler) CreateCustomField(w http.ResponseWriter, r *http.Request) {
+	var request CreateCustomFieldRequestObject
+
+	var body CreateCustomFieldJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCustomField(ctx, request.(CreateCustomFieldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCustomField")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCustomFieldResponseObject); ok {
+		if err := validResponse.VisitCreateCustomFieldResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCustomField operation middleware
+func (sh *strictHandler) DeleteCustomField(w http.ResponseWriter, r *http.Request, key string) {
+	var request DeleteCustomFieldRequestObject
+
+	request.Key = key
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCustomField(ctx, request.(DeleteCustomFieldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCustomField")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCustomFieldResponseObject); ok {
+		if err := validResponse.VisitDeleteCustomFieldResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCustomField operation middleware
+func (sh *strictHandler) UpdateCustomField(w http.ResponseWriter, r *http.Request, key string) {
+	var request UpdateCustomFieldRequestObject
+
+	request.Key = key
+
+	var body UpdateCustomFieldJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCustomField(ctx, request.(UpdateCustomFieldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCustomField")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCustomFieldResponseObject); ok {
+		if err := validResponse.VisitUpdateCustomFieldResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCustomFieldStats operation middleware
+func (sh *strictHandler) GetCustomFieldStats(w http.ResponseWriter, r *http.Request, key string, params GetCustomFieldStatsParams) {
+	var request GetCustomFieldStatsRequestObject
+
+	request.Key = key
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCustomFieldStats(ctx, request.(GetCustomFieldStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCustomFieldStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCustomFieldStatsResponseObject); ok {
+		if err := validResponse.VisitGetCustomFieldStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FixHealthIssues operation middleware
+func (sh *strictHandler) FixHealthIssues(w http.ResponseWriter, r *http.Request) {
+	var request FixHealthIssuesRequestObject
+
+	var body FixHealthIssuesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FixHealthIssues(ctx, request.(FixHealthIssuesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FixHealthIssues")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FixHealthIssuesResponseObject); ok {
+		if err := validResponse.VisitFixHealthIssuesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHealthIssues operation middleware
+func (sh *strictHandler) GetHealthIssues(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthIssuesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealthIssues(ctx, request.(GetHealthIssuesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealthIssues")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthIssuesResponseObject); ok {
+		if err := validResponse.VisitGetHealthIssuesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteOrphan operation middleware
+func (sh *strictHandler) DeleteOrphan(w http.ResponseWriter, r *http.Request, filename string) {
+	var request DeleteOrphanRequestObject
+
+	request.Filename = filename
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOrphan(ctx, request.(DeleteOrphanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOrphan")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteOrphanResponseObject); ok {
+		if err := validResponse.VisitDeleteOrphanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AttachOrphan operation middleware
+func (sh *strictHandler) AttachOrphan(w http.ResponseWriter, r *http.Request, filename string) {
+	var request AttachOrphanRequestObject
+
+	request.Filename = filename
+
+	var body AttachOrphanJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AttachOrphan(ctx, request.(AttachOrphanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AttachOrphan")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AttachOrphanResponseObject); ok {
+		if err := validResponse.VisitAttachOrphanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UnignoreOrphan operation middleware
+func (sh *strictHandler) UnignoreOrphan(w http.ResponseWriter, r *http.Request, filename string) {
+	var request UnignoreOrphanRequestObject
+
+	request.Filename = filename
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnignoreOrphan(ctx, request.(UnignoreOrphanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnignoreOrphan")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnignoreOrphanResponseObject); ok {
+		if err := validResponse.VisitUnignoreOrphanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// IgnoreOrphan operation middleware
+func (sh *strictHandler) IgnoreOrphan(w http.ResponseWriter, r *http.Request, filename string) {
+	var request IgnoreOrphanRequestObject
+
+	request.Filename = filename
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.IgnoreOrphan(ctx, request.(IgnoreOrphanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IgnoreOrphan")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(IgnoreOrphanResponseObject); ok {
+		if err := validResponse.VisitIgnoreOrphanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetItems operation middleware
+func (sh *strictHandler) GetItems(w http.ResponseWriter, r *http.Request, params GetItemsParams) {
+	var request GetItemsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetItems(ctx, request.(GetItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetItems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetItemsResponseObject); ok {
+		if err := validResponse.VisitGetItemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutItems operation middleware
+func (sh *strictHandler) PutItems(w http.ResponseWriter, r *http.Request) {
+	var request PutItemsRequestObject
+
+	var body PutItemsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutItems(ctx, request.(PutItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutItems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutItemsResponseObject); ok {
+		if err := validResponse.VisitPutItemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type (
-	StrictHandlerFunc    = strictnethttp.StrictHTTPHandlerFunc
-	StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
-)
+// AcceptItemTag operation middleware
+func (sh *strictHandler) AcceptItemTag(w http.ResponseWriter, r *http.Request) {
+	var request AcceptItemTagRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	var body AcceptItemTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AcceptItemTag(ctx, request.(AcceptItemTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcceptItemTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AcceptItemTagResponseObject); ok {
+		if err := validResponse.VisitAcceptItemTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// DismissItemTag operation middleware
+func (sh *strictHandler) DismissItemTag(w http.ResponseWriter, r *http.Request) {
+	var request DismissItemTagRequestObject
+
+	var body DismissItemTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DismissItemTag(ctx, request.(DismissItemTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DismissItemTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DismissItemTagResponseObject); ok {
+		if err := validResponse.VisitDismissItemTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// SemanticSearchItems operation middleware
+func (sh *strictHandler) SemanticSearchItems(w http.ResponseWriter, r *http.Request, params SemanticSearchItemsParams) {
+	var request SemanticSearchItemsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SemanticSearchItems(ctx, request.(SemanticSearchItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SemanticSearchItems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SemanticSearchItemsResponseObject); ok {
+		if err := validResponse.VisitSemanticSearchItemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// SuggestItemTags operation middleware
+func (sh *strictHandler) SuggestItemTags(w http.ResponseWriter, r *http.Request) {
+	var request SuggestItemTagsRequestObject
+
+	var body SuggestItemTagsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SuggestItemTags(ctx, request.(SuggestItemTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SuggestItemTags")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SuggestItemTagsResponseObject); ok {
+		if err := validResponse.VisitSuggestItemTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetAsset operation middleware
-func (sh *strictHandler) GetAsset(w http.ResponseWriter, r *http.Request, params GetAssetParams) {
-	var request GetAssetRequestObject
+// GetItemBacklinks operation middleware
+func (sh *strictHandler) GetItemBacklinks(w http.ResponseWriter, r *http.Request, date openapi_types.Date) {
+	var request GetItemBacklinksRequestObject
 
-	request.Params = params
+	request.Date = date
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAsset(ctx, request.(GetAssetRequestObject))
+		return sh.ssi.GetItemBacklinks(ctx, request.(GetItemBacklinksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAsset")
+		handler = middleware(handler, "GetItemBacklinks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAssetResponseObject); ok {
-		if err := validResponse.VisitGetAssetResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetItemBacklinksResponseObject); ok {
+		if err := validResponse.VisitGetItemBacklinksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1955,30 +5888,186 @@ func (sh *strictHandler) GetAsset(w http.ResponseWriter, r *http.Request, params
 	}
 }
 
-// UploadAssetsBatch operation middleware
-func (sh *strictHandler) UploadAssetsBatch(w http.ResponseWriter, r *http.Request) {
-	var request UploadAssetsBatchRequestObject
+// SummarizeItem operation middleware
+func (sh *strictHandler) SummarizeItem(w http.ResponseWriter, r *http.Request, date openapi_types.Date) {
+	var request SummarizeItemRequestObject
 
-	if reader, err := r.MultipartReader(); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+	request.Date = date
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SummarizeItem(ctx, request.(SummarizeItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SummarizeItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SummarizeItemResponseObject); ok {
+		if err := validResponse.VisitSummarizeItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TranscribeItemAsset operation middleware
+func (sh *strictHandler) TranscribeItemAsset(w http.ResponseWriter, r *http.Request, date openapi_types.Date) {
+	var request TranscribeItemAssetRequestObject
+
+	request.Date = date
+
+	var body TranscribeItemAssetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
-	} else {
-		request.Body = reader
 	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TranscribeItemAsset(ctx, request.(TranscribeItemAssetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TranscribeItemAsset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TranscribeItemAssetResponseObject); ok {
+		if err := validResponse.VisitTranscribeItemAssetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetJobs operation middleware
+func (sh *strictHandler) GetJobs(w http.ResponseWriter, r *http.Request, params GetJobsParams) {
+	var request GetJobsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJobs(ctx, request.(GetJobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJobs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJobsResponseObject); ok {
+		if err := validResponse.VisitGetJobsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMemories operation middleware
+func (sh *strictHandler) GetMemories(w http.ResponseWriter, r *http.Request, params GetMemoriesParams) {
+	var request GetMemoriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMemories(ctx, request.(GetMemoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMemories")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMemoriesResponseObject); ok {
+		if err := validResponse.VisitGetMemoriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPlaces operation middleware
+func (sh *strictHandler) GetPlaces(w http.ResponseWriter, r *http.Request, params GetPlacesParams) {
+	var request GetPlacesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlaces(ctx, request.(GetPlacesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlaces")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlacesResponseObject); ok {
+		if err := validResponse.VisitGetPlacesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRecaps operation middleware
+func (sh *strictHandler) GetRecaps(w http.ResponseWriter, r *http.Request, params GetRecapsParams) {
+	var request GetRecapsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecaps(ctx, request.(GetRecapsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecaps")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRecapsResponseObject); ok {
+		if err := validResponse.VisitGetRecapsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSessions operation middleware
+func (sh *strictHandler) GetSessions(w http.ResponseWriter, r *http.Request) {
+	var request GetSessionsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UploadAssetsBatch(ctx, request.(UploadAssetsBatchRequestObject))
+		return sh.ssi.GetSessions(ctx, request.(GetSessionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UploadAssetsBatch")
+		handler = middleware(handler, "GetSessions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UploadAssetsBatchResponseObject); ok {
-		if err := validResponse.VisitUploadAssetsBatchResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSessionsResponseObject); ok {
+		if err := validResponse.VisitGetSessionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1986,30 +6075,23 @@ func (sh *strictHandler) UploadAssetsBatch(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// Authorize operation middleware
-func (sh *strictHandler) Authorize(w http.ResponseWriter, r *http.Request) {
-	var request AuthorizeRequestObject
-
-	var body AuthorizeJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// RevokeOtherSessions operation middleware
+func (sh *strictHandler) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	var request RevokeOtherSessionsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Authorize(ctx, request.(AuthorizeRequestObject))
+		return sh.ssi.RevokeOtherSessions(ctx, request.(RevokeOtherSessionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Authorize")
+		handler = middleware(handler, "RevokeOtherSessions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthorizeResponseObject); ok {
-		if err := validResponse.VisitAuthorizeResponse(w); err != nil {
+	} else if validResponse, ok := response.(RevokeOtherSessionsResponseObject); ok {
+		if err := validResponse.VisitRevokeOtherSessionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2017,23 +6099,25 @@ func (sh *strictHandler) Authorize(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetFamily operation middleware
-func (sh *strictHandler) GetFamily(w http.ResponseWriter, r *http.Request) {
-	var request GetFamilyRequestObject
+// RevokeSession operation middleware
+func (sh *strictHandler) RevokeSession(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RevokeSessionRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFamily(ctx, request.(GetFamilyRequestObject))
+		return sh.ssi.RevokeSession(ctx, request.(RevokeSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFamily")
+		handler = middleware(handler, "RevokeSession")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetFamilyResponseObject); ok {
-		if err := validResponse.VisitGetFamilyResponse(w); err != nil {
+	} else if validResponse, ok := response.(RevokeSessionResponseObject); ok {
+		if err := validResponse.VisitRevokeSessionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2041,30 +6125,25 @@ func (sh *strictHandler) GetFamily(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// UpdateFamilySettings operation middleware
-func (sh *strictHandler) UpdateFamilySettings(w http.ResponseWriter, r *http.Request) {
-	var request UpdateFamilySettingsRequestObject
+// GetStats operation middleware
+func (sh *strictHandler) GetStats(w http.ResponseWriter, r *http.Request, params GetStatsParams) {
+	var request GetStatsRequestObject
 
-	var body UpdateFamilySettingsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateFamilySettings(ctx, request.(UpdateFamilySettingsRequestObject))
+		return sh.ssi.GetStats(ctx, request.(GetStatsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateFamilySettings")
+		handler = middleware(handler, "GetStats")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateFamilySettingsResponseObject); ok {
-		if err := validResponse.VisitUpdateFamilySettingsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStatsResponseObject); ok {
+		if err := validResponse.VisitGetStatsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2072,30 +6151,25 @@ func (sh *strictHandler) UpdateFamilySettings(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// FixHealthIssues operation middleware
-func (sh *strictHandler) FixHealthIssues(w http.ResponseWriter, r *http.Request) {
-	var request FixHealthIssuesRequestObject
+// GetChanges operation middleware
+func (sh *strictHandler) GetChanges(w http.ResponseWriter, r *http.Request, params GetChangesParams) {
+	var request GetChangesRequestObject
 
-	var body FixHealthIssuesJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FixHealthIssues(ctx, request.(FixHealthIssuesRequestObject))
+		return sh.ssi.GetChanges(ctx, request.(GetChangesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FixHealthIssues")
+		handler = middleware(handler, "GetChanges")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FixHealthIssuesResponseObject); ok {
-		if err := validResponse.VisitFixHealthIssuesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetChangesResponseObject); ok {
+		if err := validResponse.VisitGetChangesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2103,23 +6177,23 @@ func (sh *strictHandler) FixHealthIssues(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetHealthIssues operation middleware
-func (sh *strictHandler) GetHealthIssues(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthIssuesRequestObject
+// GetTags operation middleware
+func (sh *strictHandler) GetTags(w http.ResponseWriter, r *http.Request) {
+	var request GetTagsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealthIssues(ctx, request.(GetHealthIssuesRequestObject))
+		return sh.ssi.GetTags(ctx, request.(GetTagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealthIssues")
+		handler = middleware(handler, "GetTags")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthIssuesResponseObject); ok {
-		if err := validResponse.VisitGetHealthIssuesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTagsResponseObject); ok {
+		if err := validResponse.VisitGetTagsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2127,25 +6201,25 @@ func (sh *strictHandler) GetHealthIssues(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// DeleteOrphan operation middleware
-func (sh *strictHandler) DeleteOrphan(w http.ResponseWriter, r *http.Request, filename string) {
-	var request DeleteOrphanRequestObject
+// GetTagConsolidation operation middleware
+func (sh *strictHandler) GetTagConsolidation(w http.ResponseWriter, r *http.Request, params GetTagConsolidationParams) {
+	var request GetTagConsolidationRequestObject
 
-	request.Filename = filename
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteOrphan(ctx, request.(DeleteOrphanRequestObject))
+		return sh.ssi.GetTagConsolidation(ctx, request.(GetTagConsolidationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteOrphan")
+		handler = middleware(handler, "GetTagConsolidation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteOrphanResponseObject); ok {
-		if err := validResponse.VisitDeleteOrphanResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTagConsolidationResponseObject); ok {
+		if err := validResponse.VisitGetTagConsolidationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2153,13 +6227,11 @@ func (sh *strictHandler) DeleteOrphan(w http.ResponseWriter, r *http.Request, fi
 	}
 }
 
-// AttachOrphan operation middleware
-func (sh *strictHandler) AttachOrphan(w http.ResponseWriter, r *http.Request, filename string) {
-	var request AttachOrphanRequestObject
-
-	request.Filename = filename
+// MergeTags operation middleware
+func (sh *strictHandler) MergeTags(w http.ResponseWriter, r *http.Request) {
+	var request MergeTagsRequestObject
 
-	var body AttachOrphanJSONRequestBody
+	var body MergeTagsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2167,18 +6239,18 @@ func (sh *strictHandler) AttachOrphan(w http.ResponseWriter, r *http.Request, fi
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AttachOrphan(ctx, request.(AttachOrphanRequestObject))
+		return sh.ssi.MergeTags(ctx, request.(MergeTagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AttachOrphan")
+		handler = middleware(handler, "MergeTags")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AttachOrphanResponseObject); ok {
-		if err := validResponse.VisitAttachOrphanResponse(w); err != nil {
+	} else if validResponse, ok := response.(MergeTagsResponseObject); ok {
+		if err := validResponse.VisitMergeTagsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2186,25 +6258,23 @@ func (sh *strictHandler) AttachOrphan(w http.ResponseWriter, r *http.Request, fi
 	}
 }
 
-// UnignoreOrphan operation middleware
-func (sh *strictHandler) UnignoreOrphan(w http.ResponseWriter, r *http.Request, filename string) {
-	var request UnignoreOrphanRequestObject
-
-	request.Filename = filename
+// GetTagStats operation middleware
+func (sh *strictHandler) GetTagStats(w http.ResponseWriter, r *http.Request) {
+	var request GetTagStatsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UnignoreOrphan(ctx, request.(UnignoreOrphanRequestObject))
+		return sh.ssi.GetTagStats(ctx, request.(GetTagStatsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UnignoreOrphan")
+		handler = middleware(handler, "GetTagStats")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UnignoreOrphanResponseObject); ok {
-		if err := validResponse.VisitUnignoreOrphanResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTagStatsResponseObject); ok {
+		if err := validResponse.VisitGetTagStatsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2212,25 +6282,23 @@ func (sh *strictHandler) UnignoreOrphan(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// IgnoreOrphan operation middleware
-func (sh *strictHandler) IgnoreOrphan(w http.ResponseWriter, r *http.Request, filename string) {
-	var request IgnoreOrphanRequestObject
-
-	request.Filename = filename
+// GetTagTree operation middleware
+func (sh *strictHandler) GetTagTree(w http.ResponseWriter, r *http.Request) {
+	var request GetTagTreeRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.IgnoreOrphan(ctx, request.(IgnoreOrphanRequestObject))
+		return sh.ssi.GetTagTree(ctx, request.(GetTagTreeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "IgnoreOrphan")
+		handler = middleware(handler, "GetTagTree")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(IgnoreOrphanResponseObject); ok {
-		if err := validResponse.VisitIgnoreOrphanResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTagTreeResponseObject); ok {
+		if err := validResponse.VisitGetTagTreeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2238,25 +6306,26 @@ func (sh *strictHandler) IgnoreOrphan(w http.ResponseWriter, r *http.Request, fi
 	}
 }
 
-// GetItems operation middleware
-func (sh *strictHandler) GetItems(w http.ResponseWriter, r *http.Request, params GetItemsParams) {
-	var request GetItemsRequestObject
+// DeleteTag operation middleware
+func (sh *strictHandler) DeleteTag(w http.ResponseWriter, r *http.Request, name string, params DeleteTagParams) {
+	var request DeleteTagRequestObject
 
+	request.Name = name
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetItems(ctx, request.(GetItemsRequestObject))
+		return sh.ssi.DeleteTag(ctx, request.(DeleteTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetItems")
+		handler = middleware(handler, "DeleteTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetItemsResponseObject); ok {
-		if err := validResponse.VisitGetItemsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTagResponseObject); ok {
+		if err := validResponse.VisitDeleteTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2264,11 +6333,13 @@ func (sh *strictHandler) GetItems(w http.ResponseWriter, r *http.Request, params
 	}
 }
 
-// PutItems operation middleware
-func (sh *strictHandler) PutItems(w http.ResponseWriter, r *http.Request) {
-	var request PutItemsRequestObject
+// UpdateTag operation middleware
+func (sh *strictHandler) UpdateTag(w http.ResponseWriter, r *http.Request, name string) {
+	var request UpdateTagRequestObject
 
-	var body PutItemsJSONRequestBody
+	request.Name = name
+
+	var body UpdateTagJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2276,18 +6347,18 @@ func (sh *strictHandler) PutItems(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutItems(ctx, request.(PutItemsRequestObject))
+		return sh.ssi.UpdateTag(ctx, request.(UpdateTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutItems")
+		handler = middleware(handler, "UpdateTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutItemsResponseObject); ok {
-		if err := validResponse.VisitPutItemsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTagResponseObject); ok {
+		if err := validResponse.VisitUpdateTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2295,30 +6366,23 @@ func (sh *strictHandler) PutItems(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AcceptItemTag operation middleware
-func (sh *strictHandler) AcceptItemTag(w http.ResponseWriter, r *http.Request) {
-	var request AcceptItemTagRequestObject
-
-	var body AcceptItemTagJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetAccessTokens operation middleware
+func (sh *strictHandler) GetAccessTokens(w http.ResponseWriter, r *http.Request) {
+	var request GetAccessTokensRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AcceptItemTag(ctx, request.(AcceptItemTagRequestObject))
+		return sh.ssi.GetAccessTokens(ctx, request.(GetAccessTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AcceptItemTag")
+		handler = middleware(handler, "GetAccessTokens")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AcceptItemTagResponseObject); ok {
-		if err := validResponse.VisitAcceptItemTagResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetAccessTokensResponseObject); ok {
+		if err := validResponse.VisitGetAccessTokensResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2326,11 +6390,11 @@ func (sh *strictHandler) AcceptItemTag(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DismissItemTag operation middleware
-func (sh *strictHandler) DismissItemTag(w http.ResponseWriter, r *http.Request) {
-	var request DismissItemTagRequestObject
+// CreateAccessToken operation middleware
+func (sh *strictHandler) CreateAccessToken(w http.ResponseWriter, r *http.Request) {
+	var request CreateAccessTokenRequestObject
 
-	var body DismissItemTagJSONRequestBody
+	var body CreateAccessTokenJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2338,18 +6402,18 @@ func (sh *strictHandler) DismissItemTag(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DismissItemTag(ctx, request.(DismissItemTagRequestObject))
+		return sh.ssi.CreateAccessToken(ctx, request.(CreateAccessTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DismissItemTag")
+		handler = middleware(handler, "CreateAccessToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DismissItemTagResponseObject); ok {
-		if err := validResponse.VisitDismissItemTagResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateAccessTokenResponseObject); ok {
+		if err := validResponse.VisitCreateAccessTokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2357,30 +6421,25 @@ func (sh *strictHandler) DismissItemTag(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// SuggestItemTags operation middleware
-func (sh *strictHandler) SuggestItemTags(w http.ResponseWriter, r *http.Request) {
-	var request SuggestItemTagsRequestObject
+// RevokeAccessToken operation middleware
+func (sh *strictHandler) RevokeAccessToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RevokeAccessTokenRequestObject
 
-	var body SuggestItemTagsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SuggestItemTags(ctx, request.(SuggestItemTagsRequestObject))
+		return sh.ssi.RevokeAccessToken(ctx, request.(RevokeAccessTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SuggestItemTags")
+		handler = middleware(handler, "RevokeAccessToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SuggestItemTagsResponseObject); ok {
-		if err := validResponse.VisitSuggestItemTagsResponse(w); err != nil {
+	} else if validResponse, ok := response.(RevokeAccessTokenResponseObject); ok {
+		if err := validResponse.VisitRevokeAccessTokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2388,25 +6447,23 @@ func (sh *strictHandler) SuggestItemTags(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetChanges operation middleware
-func (sh *strictHandler) GetChanges(w http.ResponseWriter, r *http.Request, params GetChangesParams) {
-	var request GetChangesRequestObject
-
-	request.Params = params
+// GetUser operation middleware
+func (sh *strictHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	var request GetUserRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetChanges(ctx, request.(GetChangesRequestObject))
+		return sh.ssi.GetUser(ctx, request.(GetUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetChanges")
+		handler = middleware(handler, "GetUser")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetChangesResponseObject); ok {
-		if err := validResponse.VisitGetChangesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUserResponseObject); ok {
+		if err := validResponse.VisitGetUserResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2414,23 +6471,30 @@ func (sh *strictHandler) GetChanges(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// GetTags operation middleware
-func (sh *strictHandler) GetTags(w http.ResponseWriter, r *http.Request) {
-	var request GetTagsRequestObject
+// ConfirmTwoFactor operation middleware
+func (sh *strictHandler) ConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var request ConfirmTwoFactorRequestObject
+
+	var body ConfirmTwoFactorJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTags(ctx, request.(GetTagsRequestObject))
+		return sh.ssi.ConfirmTwoFactor(ctx, request.(ConfirmTwoFactorRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTags")
+		handler = middleware(handler, "ConfirmTwoFactor")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTagsResponseObject); ok {
-		if err := validResponse.VisitGetTagsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ConfirmTwoFactorResponseObject); ok {
+		if err := validResponse.VisitConfirmTwoFactorResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2438,23 +6502,30 @@ func (sh *strictHandler) GetTags(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTagStats operation middleware
-func (sh *strictHandler) GetTagStats(w http.ResponseWriter, r *http.Request) {
-	var request GetTagStatsRequestObject
+// DisableTwoFactor operation middleware
+func (sh *strictHandler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var request DisableTwoFactorRequestObject
+
+	var body DisableTwoFactorJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTagStats(ctx, request.(GetTagStatsRequestObject))
+		return sh.ssi.DisableTwoFactor(ctx, request.(DisableTwoFactorRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTagStats")
+		handler = middleware(handler, "DisableTwoFactor")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTagStatsResponseObject); ok {
-		if err := validResponse.VisitGetTagStatsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DisableTwoFactorResponseObject); ok {
+		if err := validResponse.VisitDisableTwoFactorResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2462,25 +6533,23 @@ func (sh *strictHandler) GetTagStats(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteTag operation middleware
-func (sh *strictHandler) DeleteTag(w http.ResponseWriter, r *http.Request, name string) {
-	var request DeleteTagRequestObject
-
-	request.Name = name
+// EnrollTwoFactor operation middleware
+func (sh *strictHandler) EnrollTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var request EnrollTwoFactorRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTag(ctx, request.(DeleteTagRequestObject))
+		return sh.ssi.EnrollTwoFactor(ctx, request.(EnrollTwoFactorRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTag")
+		handler = middleware(handler, "EnrollTwoFactor")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteTagResponseObject); ok {
-		if err := validResponse.VisitDeleteTagResponse(w); err != nil {
+	} else if validResponse, ok := response.(EnrollTwoFactorResponseObject); ok {
+		if err := validResponse.VisitEnrollTwoFactorResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2488,13 +6557,11 @@ func (sh *strictHandler) DeleteTag(w http.ResponseWriter, r *http.Request, name
 	}
 }
 
-// RenameTag operation middleware
-func (sh *strictHandler) RenameTag(w http.ResponseWriter, r *http.Request, name string) {
-	var request RenameTagRequestObject
-
-	request.Name = name
+// RegenerateRecoveryCodes operation middleware
+func (sh *strictHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	var request RegenerateRecoveryCodesRequestObject
 
-	var body RenameTagJSONRequestBody
+	var body RegenerateRecoveryCodesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2502,42 +6569,18 @@ func (sh *strictHandler) RenameTag(w http.ResponseWriter, r *http.Request, name
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RenameTag(ctx, request.(RenameTagRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RenameTag")
-	}
-
-	response, err := handler(r.Context(), w, r, request)
-
-	if err != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RenameTagResponseObject); ok {
-		if err := validResponse.VisitRenameTagResponse(w); err != nil {
-			sh.options.ResponseErrorHandlerFunc(w, r, err)
-		}
-	} else if response != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
-	}
-}
-
-// GetUser operation middleware
-func (sh *strictHandler) GetUser(w http.ResponseWriter, r *http.Request) {
-	var request GetUserRequestObject
-
-	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUser(ctx, request.(GetUserRequestObject))
+		return sh.ssi.RegenerateRecoveryCodes(ctx, request.(RegenerateRecoveryCodesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUser")
+		handler = middleware(handler, "RegenerateRecoveryCodes")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetUserResponseObject); ok {
-		if err := validResponse.VisitGetUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(RegenerateRecoveryCodesResponseObject); ok {
+		if err := validResponse.VisitRegenerateRecoveryCodesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
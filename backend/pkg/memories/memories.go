@@ -0,0 +1,133 @@
+// Package memories gathers "on this day" memories of a family's diary: the
+// entries of the same calendar day in previous years and of the same week a
+// month ago. It also delivers them as a daily digest through a Notifier.
+package memories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/utils"
+)
+
+const dateFormat = "2006-01-02"
+
+// Memory is one remembered entry.
+type Memory struct {
+	Date  string `json:"date"`
+	Title string `json:"title"`
+	// YearsAgo is the number of whole years between the entry and the day the
+	// memories are for.
+	YearsAgo int `json:"yearsAgo"`
+	// Image is the first image asset the entry references, or "".
+	Image string `json:"image,omitempty"`
+}
+
+// Memories are the memories of one day.
+type Memories struct {
+	Date string `json:"date"`
+	// OnThisDay are the entries of the same calendar day in previous years,
+	// newest first.
+	OnThisDay []Memory `json:"onThisDay"`
+	// MonthAgoFrom and MonthAgoTo bound the week (Monday to Sunday) holding
+	// the same day a month earlier; MonthAgo are its entries, oldest first.
+	MonthAgoFrom string   `json:"monthAgoFrom"`
+	MonthAgoTo   string   `json:"monthAgoTo"`
+	MonthAgo     []Memory `json:"monthAgo"`
+}
+
+// Empty reports whether there is nothing to remember.
+func (m *Memories) Empty() bool {
+	return len(m.OnThisDay) == 0 && len(m.MonthAgo) == 0
+}
+
+// Collect returns the family's memories of day.
+func Collect(db database.Storage, familyID uuid.UUID, day time.Time) (*Memories, error) {
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	weekFrom, weekTo := monthAgoWeek(day)
+	res := &Memories{
+		Date:         day.Format(dateFormat),
+		OnThisDay:    []Memory{},
+		MonthAgoFrom: weekFrom.Format(dateFormat),
+		MonthAgoTo:   weekTo.Format(dateFormat),
+		MonthAgo:     []Memory{},
+	}
+
+	first, err := db.GetNextDate(familyID, "")
+	if errors.Is(err, database.ErrNotFound) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting first entry: %w", err)
+	}
+
+	if dates := sameDayInPreviousYears(day, first); len(dates) > 0 {
+		items, _, err := db.GetItems(familyID, database.SearchParams{Dates: dates})
+		if err != nil {
+			return nil, fmt.Errorf("getting entries of previous years: %w", err)
+		}
+		// GetItems returns newest first.
+		for _, item := range items {
+			entryDay, err := time.Parse(dateFormat, item.Date)
+			if err != nil {
+				continue
+			}
+			res.OnThisDay = append(res.OnThisDay, newMemory(item, day.Year()-entryDay.Year()))
+		}
+	}
+
+	items, _, err := db.GetItems(familyID, database.SearchParams{
+		DateFrom: res.MonthAgoFrom, DateTo: res.MonthAgoTo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting entries of a month ago: %w", err)
+	}
+	for i := len(items) - 1; i >= 0; i-- {
+		res.MonthAgo = append(res.MonthAgo, newMemory(items[i], 0))
+	}
+	return res, nil
+}
+
+func newMemory(item *models.Item, yearsAgo int) Memory {
+	return Memory{
+		Date:     item.Date,
+		Title:    item.Title,
+		YearsAgo: yearsAgo,
+		Image:    utils.GetFirstImageFromMarkdown(item.Body),
+	}
+}
+
+// sameDayInPreviousYears returns day's month and day in every earlier year
+// back to the year of first (YYYY-MM-DD). February 29 only recurs in leap
+// years.
+func sameDayInPreviousYears(day time.Time, first string) []string {
+	firstDay, err := time.Parse(dateFormat, first)
+	if err != nil {
+		return nil
+	}
+	var dates []string
+	for year := day.Year() - 1; year >= firstDay.Year(); year-- {
+		d := time.Date(year, day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+		if d.Month() != day.Month() {
+			continue
+		}
+		dates = append(dates, d.Format(dateFormat))
+	}
+	return dates
+}
+
+// monthAgoWeek returns the Monday and Sunday of the week holding the same day
+// a month before day; in a shorter month that is its last day.
+func monthAgoWeek(day time.Time) (time.Time, time.Time) {
+	monthStart := time.Date(day.Year(), day.Month()-1, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := monthStart.AddDate(0, 1, -1).Day()
+	anchor := monthStart.AddDate(0, 0, min(day.Day(), lastDay)-1)
+
+	monday := anchor.AddDate(0, 0, -((int(anchor.Weekday()) + 6) % 7))
+	return monday, monday.AddDate(0, 0, 6)
+}
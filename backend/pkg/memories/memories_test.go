@@ -0,0 +1,117 @@
+package memories
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+func TestCollect(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	db := database.NewStorage(logger, &config.Config{DataPath: t.TempDir()})
+	if err := db.Open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	fam, _ := db.CreateFamily("fam")
+	other, _ := db.CreateFamily("other")
+
+	day := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	empty, err := Collect(db, fam.ID, day)
+	if err != nil || !empty.Empty() || empty.MonthAgoFrom != "2024-02-26" || empty.MonthAgoTo != "2024-03-03" {
+		t.Fatalf("memories without entries got %+v err %v", empty, err)
+	}
+
+	for _, item := range []*models.Item{
+		{Date: "2019-03-31", Title: "five years", Body: "![](clip.mp4) ![](dog.jpg)"},
+		{Date: "2023-03-31", Title: "last year", Body: "no pictures"},
+		{Date: "2023-03-30", Title: "a day off"},
+		{Date: "2024-02-25", Title: "Sunday before"},
+		{Date: "2024-03-03", Title: "Sunday", Body: "![](memo.m4a)"},
+		{Date: "2024-02-29", Title: "leap day", Body: "![x](photo.PNG)"},
+		{Date: "2024-03-31", Title: "today"},
+	} {
+		if err := db.PutItem(fam.ID, item); err != nil {
+			t.Fatalf("put item: %v", err)
+		}
+	}
+	if err := db.PutItem(other.ID, &models.Item{Date: "2022-03-31", Title: "not ours"}); err != nil {
+		t.Fatalf("put item: %v", err)
+	}
+
+	m, err := Collect(db, fam.ID, day)
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	want := []Memory{
+		{Date: "2023-03-31", Title: "last year", YearsAgo: 1},
+		{Date: "2019-03-31", Title: "five years", YearsAgo: 5, Image: "dog.jpg"},
+	}
+	if len(m.OnThisDay) != 2 || m.OnThisDay[0] != want[0] || m.OnThisDay[1] != want[1] {
+		t.Fatalf("on this day got %+v", m.OnThisDay)
+	}
+	// March 31 a month ago is the last day of February, in the week of
+	// Monday February 26.
+	if len(m.MonthAgo) != 2 || m.MonthAgo[0].Date != "2024-02-29" || m.MonthAgo[0].Image != "photo.PNG" ||
+		m.MonthAgo[1].Date != "2024-03-03" || m.MonthAgo[1].Image != "" {
+		t.Fatalf("a month ago got %+v", m.MonthAgo)
+	}
+
+	// February 29 only recurs in leap years.
+	leap, _ := Collect(db, fam.ID, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC))
+	if len(leap.OnThisDay) != 1 || leap.OnThisDay[0].YearsAgo != 4 {
+		t.Fatalf("leap day got %+v", leap.OnThisDay)
+	}
+}
+
+func TestNotifiers(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	if _, err := NewNotifier(logger, &config.Config{MemoriesNotifier: "carrier-pigeon"}); err == nil {
+		t.Fatal("unknown notifier accepted")
+	}
+	if _, err := NewNotifier(logger, &config.Config{MemoriesNotifier: NotifierWebhook}); err == nil {
+		t.Fatal("webhook without URL accepted")
+	}
+	if n, err := NewNotifier(logger, &config.Config{}); err != nil || n.Enabled() {
+		t.Fatalf("default notifier got %v err %v", n, err)
+	}
+
+	var got map[string]any
+	status := http.StatusNoContent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.Header.Get("Content-Type"))
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	n, err := NewNotifier(logger, &config.Config{MemoriesNotifier: NotifierWebhook, MemoriesWebhookURL: srv.URL})
+	if err != nil || !n.Enabled() {
+		t.Fatalf("webhook notifier got %v err %v", n, err)
+	}
+	digest := Digest{FamilyName: "fam", Memories: &Memories{
+		Date: "2024-03-31", OnThisDay: []Memory{{Date: "2023-03-31", Title: "last year", YearsAgo: 1}},
+	}}
+	if err := n.Notify(context.Background(), digest); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if got["familyName"] != "fam" || got["date"] != "2024-03-31" || len(got["onThisDay"].([]any)) != 1 {
+		t.Fatalf("webhook payload got %v", got)
+	}
+
+	status = http.StatusBadGateway
+	if err := n.Notify(context.Background(), digest); err == nil {
+		t.Fatal("failed delivery not reported")
+	}
+}
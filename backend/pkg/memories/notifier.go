@@ -0,0 +1,116 @@
+package memories
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+)
+
+// Notifier names accepted by config.Config.MemoriesNotifier.
+const (
+	NotifierWebhook = "webhook"
+	NotifierLog     = "log"
+	NotifierNone    = "none"
+)
+
+// webhookTimeout bounds one digest delivery.
+const webhookTimeout = 10 * time.Second
+
+// Digest is the daily memories digest of one family.
+type Digest struct {
+	FamilyID   uuid.UUID `json:"familyId"`
+	FamilyName string    `json:"familyName"`
+	*Memories
+}
+
+// Notifier delivers memories digests.
+type Notifier interface {
+	// Enabled reports whether digests are delivered at all.
+	Enabled() bool
+	// Notify delivers one family's digest.
+	Notify(ctx context.Context, digest Digest) error
+}
+
+// NewNotifier builds the Notifier for config.Config.MemoriesNotifier; an
+// unknown name, or a webhook without URL, is an error.
+func NewNotifier(logger *slog.Logger, cfg *config.Config) (Notifier, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.MemoriesNotifier)) {
+	case NotifierWebhook:
+		if cfg.MemoriesWebhookURL == "" {
+			return nil, fmt.Errorf("memories_notifier %q needs memories_webhook_url", NotifierWebhook)
+		}
+		return newWebhookNotifier(cfg.MemoriesWebhookURL), nil
+	case NotifierLog:
+		return logNotifier{logger: logger}, nil
+	case "", NotifierNone:
+		return disabledNotifier{}, nil
+	default:
+		return nil, fmt.Errorf("unknown memories_notifier %q (want %s, %s or %s)",
+			cfg.MemoriesNotifier, NotifierWebhook, NotifierLog, NotifierNone)
+	}
+}
+
+// NewDisabledNotifier returns a Notifier that is always disabled.
+func NewDisabledNotifier() Notifier { return disabledNotifier{} }
+
+type disabledNotifier struct{}
+
+func (disabledNotifier) Enabled() bool { return false }
+
+func (disabledNotifier) Notify(context.Context, Digest) error { return nil }
+
+// logNotifier writes digests to the server log, for trying the digest out.
+type logNotifier struct {
+	logger *slog.Logger
+}
+
+func (logNotifier) Enabled() bool { return true }
+
+func (n logNotifier) Notify(_ context.Context, digest Digest) error {
+	n.logger.Info("Memories digest", "familyID", digest.FamilyID, "date", digest.Date,
+		"onThisDay", len(digest.OnThisDay), "monthAgo", len(digest.MonthAgo))
+	return nil
+}
+
+// webhookNotifier POSTs each digest as JSON to a URL, e.g. of a chat or
+// mail bridge.
+type webhookNotifier struct {
+	url        string
+	httpClient *http.Client
+}
+
+func newWebhookNotifier(url string) *webhookNotifier {
+	return &webhookNotifier{url: url, httpClient: &http.Client{Timeout: webhookTimeout}}
+}
+
+func (*webhookNotifier) Enabled() bool { return true }
+
+func (n *webhookNotifier) Notify(ctx context.Context, digest Digest) error {
+	body, err := json.Marshal(digest)
+	if err != nil {
+		return fmt.Errorf("encoding digest: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook answered %s", resp.Status)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"os"
@@ -9,19 +10,23 @@ import (
 	"strings"
 
 	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
 	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
 	"github.com/ya-breeze/diary.be/pkg/server/common"
+	"github.com/ya-breeze/diary.be/pkg/utils"
 )
 
 type AssetsAPIServiceImpl struct {
 	logger *slog.Logger
 	cfg    *config.Config
+	db     database.Storage
 }
 
-func NewAssetsAPIService(logger *slog.Logger, cfg *config.Config) goserver.AssetsAPIService {
+func NewAssetsAPIService(logger *slog.Logger, cfg *config.Config, db database.Storage) goserver.AssetsAPIService {
 	return &AssetsAPIServiceImpl{
 		logger: logger,
 		cfg:    cfg,
+		db:     db,
 	}
 }
 
@@ -60,6 +65,71 @@ func (s *AssetsAPIServiceImpl) GetAsset(ctx context.Context, path string) (goser
 	return goserver.Response(http.StatusOK, file), nil
 }
 
+// ListAssets - list the family's asset files with their AI captions. With a
+// date, only the files referenced by that entry are listed.
+func (s *AssetsAPIServiceImpl) ListAssets(ctx context.Context, date string) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Failed to get family ID from context")
+		return goserver.Response(http.StatusUnauthorized, nil), nil
+	}
+
+	var referenced map[string]bool
+	if date != "" {
+		referenced = map[string]bool{}
+		item, err := s.db.GetItem(familyID, date)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			s.logger.Error("Failed to get item", "error", err, "date", date, "familyID", familyID)
+			return goserver.Response(http.StatusInternalServerError, nil), nil
+		}
+		if item != nil {
+			for _, name := range utils.GetAssetsFromMarkdown(item.Body) {
+				referenced[filepath.Clean(name)] = true
+			}
+		}
+	}
+
+	familyDir := filepath.Join(s.cfg.DataPath, config.AssetsDirName, familyID.String())
+	entries, err := os.ReadDir(familyDir)
+	if err != nil && !os.IsNotExist(err) {
+		s.logger.Error("Failed to read asset directory", "error", err, "familyID", familyID)
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	captions, err := s.db.GetAssetCaptions(familyID)
+	if err != nil {
+		s.logger.Error("Failed to get asset captions", "error", err, "familyID", familyID)
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+	byName := make(map[string]string, len(captions))
+	for _, c := range captions {
+		byName[c.Filename] = c.Caption
+	}
+
+	// os.ReadDir returns entries sorted by name.
+	assets := make([]goserver.AssetInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || (referenced != nil && !referenced[entry.Name()]) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			// Removed since the directory was read.
+			continue
+		}
+		asset := goserver.AssetInfo{
+			Filename:   entry.Name(),
+			Size:       info.Size(),
+			ModifiedAt: info.ModTime().UTC(),
+		}
+		if caption := byName[entry.Name()]; caption != "" {
+			asset.Caption = &caption
+		}
+		assets = append(assets, asset)
+	}
+	return goserver.Response(http.StatusOK, assets), nil
+}
+
 // validateAndCleanPath validates the path and returns a cleaned version
 func (s *AssetsAPIServiceImpl) validateAndCleanPath(path, familyID string) (string, *goserver.ImplResponse) {
 	if strings.Contains(path, "..") {
@@ -57,7 +57,7 @@ var _ = Describe("AssetsAPIService", func() {
 		err = os.WriteFile(testFile, []byte("fake image content"), 0o600)
 		Expect(err).NotTo(HaveOccurred())
 
-		serviceInterface := api.NewAssetsAPIService(logger, cfg)
+		serviceInterface := api.NewAssetsAPIService(logger, cfg, nil)
 		var ok bool
 		service, ok = serviceInterface.(*api.AssetsAPIServiceImpl)
 		Expect(ok).To(BeTrue(), "Failed to cast service to AssetsAPIServiceImpl")
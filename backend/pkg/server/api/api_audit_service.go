@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+const (
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 500
+)
+
+type AuditAPIServiceImpl struct {
+	logger *slog.Logger
+	db     database.Storage
+}
+
+func NewAuditAPIService(logger *slog.Logger, db database.Storage) goserver.AuditAPIService {
+	return &AuditAPIServiceImpl{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// GetAuditEvents - list the family's security audit log, newest first
+func (s *AuditAPIServiceImpl) GetAuditEvents(ctx context.Context, before, limit int32) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+	if before < 0 || limit < 0 || limit > maxAuditPageSize {
+		return goserver.Response(400, nil), nil
+	}
+	if limit == 0 {
+		limit = defaultAuditPageSize
+	}
+
+	// Fetch one extra event to learn whether another page exists.
+	events, err := s.db.GetAuditEvents(database.AuditFilter{
+		FamilyID: &familyID,
+		Before:   uint(before),
+		Limit:    int(limit) + 1,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list audit events", "familyID", familyID, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	hasMore := len(events) > int(limit)
+	if hasMore {
+		events = events[:limit]
+	}
+	res := goserver.AuditLogResponse{
+		Events:  make([]goserver.AuditEvent, len(events)),
+		HasMore: hasMore,
+	}
+	for i, event := range events {
+		res.Events[i] = event.FromDB()
+	}
+	if hasMore {
+		res.NextBefore = &res.Events[len(res.Events)-1].Id
+	}
+	return goserver.Response(200, res), nil
+}
+
+// auditActor identifies the signed-in caller for audit records.
+func auditActor(ctx context.Context, db database.Storage) models.AuditActor {
+	var actor models.AuditActor
+	if ip, ok := ctx.Value(common.ClientIPKey).(string); ok {
+		actor.IPAddress = ip
+	}
+	if userID, ok := ctx.Value(common.UserIDKey).(uuid.UUID); ok {
+		if user, err := db.GetUser(userID); err == nil {
+			actor.Name = user.Username
+		}
+	}
+	return actor
+}
+
+// recordAudit appends an event performed by the signed-in caller to the
+// family's audit log. Failures are only logged: the audited action has
+// already happened.
+func recordAudit(
+	ctx context.Context, logger *slog.Logger, db database.Storage, familyID uuid.UUID, action, target string,
+) {
+	event := models.NewAuditEvent(familyID, auditActor(ctx, db), action, target)
+	if err := db.CreateAuditEvent(event); err != nil {
+		logger.Warn("Failed to record audit event", "action", action, "familyID", familyID, "error", err)
+	}
+}
@@ -2,13 +2,18 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/ya-breeze/diary.be/pkg/auth"
 	"github.com/ya-breeze/diary.be/pkg/config"
 	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
 	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
 	kinauth "github.com/ya-breeze/kin-core/auth"
 	"github.com/ya-breeze/kin-core/authdb"
 	kincookies "github.com/ya-breeze/kin-core/cookies"
@@ -17,7 +22,7 @@ import (
 
 // CustomAuthAPIController wraps the generated AuthAPIController to add kin-core cookie support.
 type CustomAuthAPIController struct {
-	service      goserver.AuthAPIServicer
+	service      common.LoginService
 	errorHandler goserver.ErrorHandler
 	logger       *slog.Logger
 	cfg          *config.Config
@@ -28,7 +33,7 @@ type CustomAuthAPIController struct {
 
 // NewCustomAuthAPIController creates a custom auth controller with kin-core cookie support.
 func NewCustomAuthAPIController(
-	service goserver.AuthAPIServicer, logger *slog.Logger, cfg *config.Config,
+	service common.LoginService, logger *slog.Logger, cfg *config.Config,
 	db database.Storage, gormDB *gorm.DB,
 ) *CustomAuthAPIController {
 	return &CustomAuthAPIController{
@@ -50,6 +55,11 @@ func (c *CustomAuthAPIController) Routes() goserver.Routes {
 			Pattern:     "/v1/authorize",
 			HandlerFunc: c.Authorize,
 		},
+		"AuthorizeTwoFactor": goserver.Route{
+			Method:      strings.ToUpper("Post"),
+			Pattern:     "/v1/authorize/2fa",
+			HandlerFunc: c.AuthorizeTwoFactor,
+		},
 		"Logout": goserver.Route{
 			Method:      strings.ToUpper("Post"),
 			Pattern:     "/v1/logout",
@@ -77,33 +87,71 @@ func (c *CustomAuthAPIController) Authorize(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	result, err := c.service.Authorize(r.Context(), authDataParam)
+	result, user, err := c.service.AuthorizeUser(common.WithClientIP(r), authDataParam)
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
 		return
 	}
 
-	if result.Code == 200 {
-		authResponse, ok := result.Body.(goserver.Authorize200Response)
-		if ok && authResponse.Token != "" {
-			// Parse access token to get userID for refresh token creation
-			claims, parseErr := kinauth.ParseToken(authResponse.Token, []byte(c.cfg.JWTSecret))
-			if parseErr == nil {
-				rt, rtErr := authdb.CreateRefreshToken(c.gormDB, claims.UserID, refreshTokenTTL)
-				if rtErr != nil {
-					c.logger.Warn("Failed to create refresh token", "error", rtErr)
-				} else {
-					kincookies.SetRefreshCookie(w, rt.Token, int(refreshTokenTTL.Seconds()), c.cookieCfg)
-				}
-			}
-			kincookies.SetAccessCookie(w, authResponse.Token, int(accessTokenTTL.Seconds()), c.cookieCfg)
-			c.logger.Info("Auth cookies set successfully")
-		}
+	// A 202 means a second factor is still required; no cookies or audit
+	// event until then.
+	if result.Code == http.StatusOK || result.Code == http.StatusUnauthorized {
+		common.AuditLogin(c.logger, c.db, r, user, authDataParam.Email, "api", result.Code == http.StatusOK)
+	}
+	c.setAuthCookies(w, r, result)
+	_ = goserver.EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// AuthorizeTwoFactor verifies the second factor for a pending login and sets
+// kin-core access + refresh cookies.
+func (c *CustomAuthAPIController) AuthorizeTwoFactor(w http.ResponseWriter, r *http.Request) {
+	reqParam := goserver.TwoFactorLoginRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&reqParam); err != nil {
+		c.errorHandler(w, r, &goserver.ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.AuthorizeTwoFactor(common.WithClientIP(r), reqParam)
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
 	}
 
+	user := common.ChallengedUser(c.db, c.cfg.JWTSecret, reqParam.MfaToken)
+	common.AuditLogin(c.logger, c.db, r, user, "", "api-2fa", result.Code == http.StatusOK)
+	c.setAuthCookies(w, r, result)
 	_ = goserver.EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// setAuthCookies issues access + refresh cookies for a successful (200) login result.
+func (c *CustomAuthAPIController) setAuthCookies(w http.ResponseWriter, r *http.Request, result goserver.ImplResponse) {
+	if result.Code != http.StatusOK {
+		return
+	}
+	authResponse, ok := result.Body.(goserver.Authorize200Response)
+	if !ok || authResponse.Token == "" {
+		return
+	}
+	// Parse access token to get userID for refresh token creation
+	claims, parseErr := kinauth.ParseToken(authResponse.Token, []byte(c.cfg.JWTSecret))
+	if parseErr == nil {
+		rt, rtErr := authdb.CreateRefreshToken(c.gormDB, claims.UserID, refreshTokenTTL)
+		if rtErr != nil {
+			c.logger.Warn("Failed to create refresh token", "error", rtErr)
+		} else {
+			kincookies.SetRefreshCookie(w, rt.Token, int(refreshTokenTTL.Seconds()), c.cookieCfg)
+			session := models.NewSession(claims.UserID, rt.ID, authResponse.Token, r.UserAgent(), common.ClientIP(r))
+			if err := c.db.CreateSession(session); err != nil {
+				c.logger.Warn("Failed to record session", "error", err)
+			}
+		}
+	}
+	kincookies.SetAccessCookie(w, authResponse.Token, int(accessTokenTTL.Seconds()), c.cookieCfg)
+	c.logger.Info("Auth cookies set successfully")
+}
+
 // Logout blacklists the access token, revokes the refresh token, and clears cookies.
 func (c *CustomAuthAPIController) Logout(w http.ResponseWriter, r *http.Request) {
 	if tokenStr := kincookies.GetAccessToken(r); tokenStr != "" {
@@ -132,6 +180,9 @@ func (c *CustomAuthAPIController) Refresh(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// Resolve the session before rotation revokes the presented token.
+	session, sessionErr := c.db.GetSessionByRefreshToken(rtStr)
+
 	newRT, err := authdb.RotateRefreshToken(c.gormDB, rtStr, refreshTokenTTL)
 	if err != nil {
 		if err == authdb.ErrTokenCompromised {
@@ -153,15 +204,36 @@ func (c *CustomAuthAPIController) Refresh(w http.ResponseWriter, r *http.Request
 		return
 	}
 	familyID := user.FamilyID
-	accessToken, err := kinauth.GenerateAccessToken(newRT.UserID, &familyID, []byte(c.cfg.JWTSecret), accessTokenTTL)
+	accessToken, err := auth.GenerateAccessToken(newRT.UserID, &familyID, []byte(c.cfg.JWTSecret), accessTokenTTL)
 	if err != nil {
 		c.logger.Error("Failed to generate access token on refresh", "error", err)
 		http.Error(w, "Internal error", http.StatusInternalServerError)
 		return
 	}
 
+	c.recordRefresh(r, session, sessionErr, newRT, accessToken)
+
 	kincookies.SetAccessCookie(w, accessToken, int(accessTokenTTL.Seconds()), c.cookieCfg)
 	kincookies.SetRefreshCookie(w, newRT.Token, int(refreshTokenTTL.Seconds()), c.cookieCfg)
 	c.logger.Info("Token refreshed", "userID", newRT.UserID)
 	w.WriteHeader(http.StatusOK)
 }
+
+// recordRefresh moves the session onto the rotated refresh token. Tokens issued
+// before sessions were tracked get a session on their first refresh.
+func (c *CustomAuthAPIController) recordRefresh(
+	r *http.Request, session *models.Session, lookupErr error, newRT *authdb.RefreshToken, accessToken string,
+) {
+	var err error
+	switch {
+	case lookupErr == nil:
+		err = c.db.RotateSession(session.ID, newRT.ID, accessToken, r.UserAgent(), common.ClientIP(r), time.Now())
+	case errors.Is(lookupErr, database.ErrNotFound):
+		err = c.db.CreateSession(models.NewSession(newRT.UserID, newRT.ID, accessToken, r.UserAgent(), common.ClientIP(r)))
+	default:
+		err = lookupErr
+	}
+	if err != nil {
+		c.logger.Warn("Failed to record session refresh", "userID", newRT.UserID, "error", err)
+	}
+}
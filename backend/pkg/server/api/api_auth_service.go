@@ -6,14 +6,18 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/ya-breeze/diary.be/pkg/auth"
 	"github.com/ya-breeze/diary.be/pkg/config"
 	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
 	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
 	kinauth "github.com/ya-breeze/kin-core/auth"
 )
 
 const (
-	accessTokenTTL  = 15 * time.Minute
+	accessTokenTTL  = auth.AccessTokenTTL
 	refreshTokenTTL = 365 * 24 * time.Hour
 )
 
@@ -23,7 +27,7 @@ type AuthAPIServiceImpl struct {
 	cfg    *config.Config
 }
 
-func NewAuthAPIService(logger *slog.Logger, db database.Storage, cfg *config.Config) goserver.AuthAPIService {
+func NewAuthAPIService(logger *slog.Logger, db database.Storage, cfg *config.Config) common.LoginService {
 	return &AuthAPIServiceImpl{
 		logger: logger,
 		db:     db,
@@ -34,8 +38,21 @@ func NewAuthAPIService(logger *slog.Logger, db database.Storage, cfg *config.Con
 // Authorize validates credentials and returns a signed access token.
 // Cookie setting (access + refresh) is handled by CustomAuthAPIController.
 func (s *AuthAPIServiceImpl) Authorize(ctx context.Context, authData goserver.AuthData) (goserver.ImplResponse, error) {
+	result, _, err := s.AuthorizeUser(ctx, authData)
+	return result, err
+}
+
+// AuthorizeUser is Authorize that also returns the account the email
+// resolved to, or nil if there is none or the login is locked out.
+func (s *AuthAPIServiceImpl) AuthorizeUser(
+	ctx context.Context, authData goserver.AuthData,
+) (goserver.ImplResponse, *models.User, error) {
 	s.logger.Info("Authorize request", "email", authData.Email)
 
+	if s.lockedOut(authData.Email) {
+		return goserver.Response(429, nil), nil, nil
+	}
+
 	// Timing-safe credential verification
 	hash := kinauth.DummyHash
 	user, err := s.db.GetUserByUsername(authData.Email)
@@ -50,17 +67,139 @@ func (s *AuthAPIServiceImpl) Authorize(ctx context.Context, authData goserver.Au
 		} else {
 			s.logger.Warn("Invalid password", "email", authData.Email)
 		}
+		if err != nil {
+			user = nil
+		}
+		s.recordLoginFailure(ctx, user, authData.Email)
+		return goserver.Response(401, nil), user, nil
+	}
+
+	if user.TOTPEnabled {
+		challenge, err := auth.CreateMFAChallenge(user.ID.String(), s.cfg.JWTSecret)
+		if err != nil {
+			s.logger.Error("Failed to create MFA challenge", "error", err)
+			return goserver.Response(500, nil), user, nil
+		}
+		s.logger.Info("Password accepted, second factor required", "email", authData.Email, "userID", user.ID)
+		return goserver.Response(202, goserver.TwoFactorChallenge{MfaToken: challenge}), user, nil
+	}
+
+	result, err := s.issueAccessToken(user)
+	return result, user, err
+}
+
+// AuthorizeTwoFactor completes a login started by Authorize for a user with
+// two-factor enabled. The code may be a TOTP code or an unused recovery code.
+func (s *AuthAPIServiceImpl) AuthorizeTwoFactor(
+	ctx context.Context, req goserver.TwoFactorLoginRequest,
+) (goserver.ImplResponse, error) {
+	subject, err := auth.CheckMFAChallenge(req.MfaToken, s.cfg.JWTSecret)
+	if err != nil {
+		s.logger.Warn("Invalid MFA challenge", "error", err)
+		return goserver.Response(401, nil), nil
+	}
+	userID, err := uuid.Parse(subject)
+	if err != nil {
 		return goserver.Response(401, nil), nil
 	}
+	user, err := s.db.GetUser(userID)
+	if err != nil {
+		s.logger.Warn("MFA challenge for unknown user", "userID", userID, "error", err)
+		return goserver.Response(401, nil), nil
+	}
+	if s.lockedOut(user.Username) {
+		return goserver.Response(429, nil), nil
+	}
+	if !user.TOTPEnabled || !verifySecondFactor(user, req.Code, true) {
+		s.logger.Warn("Invalid second factor", "userID", userID)
+		s.recordLoginFailure(ctx, user, user.Username)
+		return goserver.Response(401, nil), nil
+	}
+	if err := s.db.PutUser(user); err != nil {
+		s.logger.Error("Failed to persist second factor use", "userID", userID, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	return s.issueAccessToken(user)
+}
 
+func (s *AuthAPIServiceImpl) issueAccessToken(user *models.User) (goserver.ImplResponse, error) {
 	familyID := user.FamilyID
-	accessToken, err := kinauth.GenerateAccessToken(user.ID, &familyID, []byte(s.cfg.JWTSecret), accessTokenTTL)
+	accessToken, err := auth.GenerateAccessToken(user.ID, &familyID, []byte(s.cfg.JWTSecret), accessTokenTTL)
 	if err != nil {
 		s.logger.Error("Failed to create access token", "error", err)
 		return goserver.Response(500, nil), nil
 	}
 
-	s.logger.Info("User authenticated successfully", "email", authData.Email, "userID", user.ID)
+	if err := s.db.ClearLoginLockout(auth.LoginKey(user.Username)); err != nil && !errors.Is(err, database.ErrNotFound) {
+		s.logger.Warn("Failed to reset failed logins", "userID", user.ID, "error", err)
+	}
+	s.logger.Info("User authenticated successfully", "email", user.Username, "userID", user.ID)
 
 	return goserver.Response(200, goserver.Authorize200Response{Token: accessToken}), nil
 }
+
+// lockedOut reports whether logins for username are currently refused after
+// too many failures. Storage errors fail open; the per-IP rate limit still applies.
+func (s *AuthAPIServiceImpl) lockedOut(username string) bool {
+	lockout, err := s.db.GetLoginLockout(auth.LoginKey(username))
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			s.logger.Error("Failed to check login lockout", "username", username, "error", err)
+		}
+		return false
+	}
+	if lockout.Locked(time.Now()) {
+		s.logger.Warn("Login refused, account locked", "username", username, "until", lockout.LockedUntil)
+		return true
+	}
+	return false
+}
+
+// recordLoginFailure counts a failed login against username and locks the
+// account once the failures pile up. user is nil for unknown names.
+func (s *AuthAPIServiceImpl) recordLoginFailure(ctx context.Context, user *models.User, username string) {
+	now := time.Now()
+	lockout, err := s.db.UpdateLoginLockout(auth.LoginKey(username), func(l *models.LoginLockout) {
+		l.Failures, l.LockedUntil = auth.RegisterLoginFailure(l.Failures, l.LastFailureAt, now)
+		l.LastFailureAt = now
+	})
+	if err != nil {
+		s.logger.Error("Failed to record failed login", "username", username, "error", err)
+		return
+	}
+	if !lockout.Locked(now) {
+		return
+	}
+
+	s.logger.Warn("Account locked after failed logins",
+		"username", username, "failures", lockout.Failures, "until", lockout.LockedUntil)
+	familyID := uuid.Nil
+	if user != nil {
+		familyID = user.FamilyID
+	}
+	ip, _ := ctx.Value(common.ClientIPKey).(string)
+	event := models.NewAuditEvent(familyID, models.AuditActor{Name: username, IPAddress: ip},
+		models.AuditAccountLocked, lockout.LockedUntil.Format(time.RFC3339))
+	if err := s.db.CreateAuditEvent(event); err != nil {
+		s.logger.Warn("Failed to record lockout audit event", "error", err)
+	}
+}
+
+// verifySecondFactor checks code as a TOTP code and, if allowRecovery is set,
+// as a recovery code. On success it updates user in place (last TOTP step or
+// the consumed recovery code); the caller must persist the change.
+func verifySecondFactor(user *models.User, code string, allowRecovery bool) bool {
+	if step, ok := auth.ValidateTOTP(user.TOTPSecret, code, time.Now(), user.TOTPLastStep); ok {
+		user.TOTPLastStep = step
+		return true
+	}
+	if !allowRecovery {
+		return false
+	}
+	remaining, ok := auth.ConsumeRecoveryCode(user.RecoveryCodes, code)
+	if ok {
+		user.RecoveryCodes = remaining
+	}
+	return ok
+}
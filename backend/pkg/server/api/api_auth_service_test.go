@@ -140,4 +140,25 @@ var _ = Describe("AuthAPIService", func() {
 			})
 		})
 	})
+
+	Describe("AuthorizeUser", func() {
+		It("returns the account the email resolved to alongside the response", func() {
+			users := api.NewAuthAPIService(logger, storage, cfg)
+
+			response, user, err := users.AuthorizeUser(ctx, goserver.AuthData{Email: testEmail, Password: testPass})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Code).To(Equal(200))
+			Expect(user.Username).To(Equal(testEmail))
+
+			response, user, err = users.AuthorizeUser(ctx, goserver.AuthData{Email: testEmail, Password: "wrong"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Code).To(Equal(401))
+			Expect(user.Username).To(Equal(testEmail))
+
+			response, user, err = users.AuthorizeUser(ctx, goserver.AuthData{Email: "nobody@example.com", Password: testPass})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Code).To(Equal(401))
+			Expect(user).To(BeNil())
+		})
+	})
 })
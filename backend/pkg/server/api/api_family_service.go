@@ -4,7 +4,11 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/ya-breeze/diary.be/pkg/ai"
 	"github.com/ya-breeze/diary.be/pkg/database"
 	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
 	"github.com/ya-breeze/diary.be/pkg/server/common"
@@ -13,10 +17,12 @@ import (
 type FamilyAPIServiceImpl struct {
 	logger *slog.Logger
 	db     database.Storage
+	// monthlyTokenBudget is config.Config.AIMonthlyTokenBudget (0 = unlimited).
+	monthlyTokenBudget int64
 }
 
-func NewFamilyAPIService(logger *slog.Logger, db database.Storage) goserver.FamilyAPIService {
-	return &FamilyAPIServiceImpl{logger: logger, db: db}
+func NewFamilyAPIService(logger *slog.Logger, db database.Storage, monthlyTokenBudget int64) goserver.FamilyAPIService {
+	return &FamilyAPIServiceImpl{logger: logger, db: db, monthlyTokenBudget: monthlyTokenBudget}
 }
 
 func (s *FamilyAPIServiceImpl) GetFamily(ctx context.Context) (goserver.ImplResponse, error) {
@@ -80,6 +86,62 @@ func (s *FamilyAPIServiceImpl) UpdateFamilySettings(
 		s.logger.Error("Failed to update family settings", "error", err, "familyID", familyID)
 		return goserver.Response(500, nil), nil
 	}
+	if req.AiSummariesEnabled != nil && *req.AiSummariesEnabled != current.AISummariesEnabled {
+		if err = s.db.SetFamilyAISummariesEnabled(familyID, *req.AiSummariesEnabled); err != nil {
+			s.logger.Error("Failed to update family summary setting", "error", err, "familyID", familyID)
+			return goserver.Response(500, nil), nil
+		}
+	}
+	if req.AiSearchEnabled != nil && *req.AiSearchEnabled != current.AISearchEnabled {
+		if err = s.db.SetFamilyAISearchEnabled(familyID, *req.AiSearchEnabled); err != nil {
+			s.logger.Error("Failed to update family search setting", "error", err, "familyID", familyID)
+			return goserver.Response(500, nil), nil
+		}
+	}
+	if req.AiCaptionsEnabled != nil && *req.AiCaptionsEnabled != current.AICaptionsEnabled {
+		if err = s.db.SetFamilyAICaptionsEnabled(familyID, *req.AiCaptionsEnabled); err != nil {
+			s.logger.Error("Failed to update family caption setting", "error", err, "familyID", familyID)
+			return goserver.Response(500, nil), nil
+		}
+	}
+	if req.AiTranscriptionEnabled != nil && *req.AiTranscriptionEnabled != current.AITranscriptionEnabled {
+		if err = s.db.SetFamilyAITranscriptionEnabled(familyID, *req.AiTranscriptionEnabled); err != nil {
+			s.logger.Error("Failed to update family transcription setting", "error", err, "familyID", familyID)
+			return goserver.Response(500, nil), nil
+		}
+	}
+
+	if req.MemoriesDigestEnabled != nil && *req.MemoriesDigestEnabled != current.MemoriesDigestEnabled {
+		if err = s.db.SetFamilyMemoriesDigestEnabled(familyID, *req.MemoriesDigestEnabled); err != nil {
+			s.logger.Error("Failed to update family memories digest setting", "error", err, "familyID", familyID)
+			return goserver.Response(500, nil), nil
+		}
+	}
+
+	if req.AiTaggingInstructions != nil || req.AiTaggingClosedVocabulary != nil || req.AiTaggingMaxTags != nil {
+		instructions := current.AITaggingInstructions
+		if req.AiTaggingInstructions != nil {
+			instructions = strings.TrimSpace(*req.AiTaggingInstructions)
+		}
+		closedVocabulary := current.AITaggingClosedVocabulary
+		if req.AiTaggingClosedVocabulary != nil {
+			closedVocabulary = *req.AiTaggingClosedVocabulary
+		}
+		maxTags := current.AITaggingMaxTags
+		if req.AiTaggingMaxTags != nil {
+			maxTags = *req.AiTaggingMaxTags
+		}
+		if utf8.RuneCountInString(instructions) > ai.MaxInstructionsChars {
+			return goserver.Response(400, nil), nil
+		}
+		if req.AiTaggingMaxTags != nil && (maxTags < 1 || maxTags > ai.MaxTagsLimit) {
+			return goserver.Response(400, nil), nil
+		}
+		if err = s.db.SetFamilyTaggingPolicy(familyID, instructions, closedVocabulary, maxTags); err != nil {
+			s.logger.Error("Failed to update family tagging policy", "error", err, "familyID", familyID)
+			return goserver.Response(500, nil), nil
+		}
+	}
 
 	family, err := s.db.GetFamily(familyID)
 	if err != nil {
@@ -87,3 +149,39 @@ func (s *FamilyAPIServiceImpl) UpdateFamilySettings(
 	}
 	return goserver.Response(200, family.FromDB()), nil
 }
+
+func (s *FamilyAPIServiceImpl) GetFamilyAIUsage(ctx context.Context) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+
+	month := time.Now().UTC().Format(ai.UsageMonthFormat)
+	current, err := s.db.GetAIUsage(familyID, month)
+	if err != nil {
+		s.logger.Error("Failed to load AI usage", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	history, err := s.db.GetAIUsageHistory(familyID)
+	if err != nil {
+		s.logger.Error("Failed to load AI usage history", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+
+	months := make([]goserver.AIUsageMonth, 0, len(history))
+	for _, u := range history {
+		months = append(months, goserver.AIUsageMonth{
+			Month:        u.Month,
+			Requests:     u.Requests,
+			InputTokens:  u.InputTokens,
+			OutputTokens: u.OutputTokens,
+			Images:       u.Images,
+		})
+	}
+	return goserver.Response(200, goserver.AIUsageResponse{
+		Month:              month,
+		MonthlyTokenBudget: s.monthlyTokenBudget,
+		BudgetExhausted:    ai.BudgetExhausted(current, s.monthlyTokenBudget),
+		Months:             months,
+	}), nil
+}
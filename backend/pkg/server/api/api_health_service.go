@@ -13,10 +13,11 @@ import (
 
 type HealthAPIServiceImpl struct {
 	task *tasks.CheckerTask
+	db   database.Storage
 }
 
-func NewHealthAPIServiceImpl(task *tasks.CheckerTask) goserver.HealthAPIService {
-	return &HealthAPIServiceImpl{task: task}
+func NewHealthAPIServiceImpl(task *tasks.CheckerTask, db database.Storage) goserver.HealthAPIService {
+	return &HealthAPIServiceImpl{task: task, db: db}
 }
 
 func (s *HealthAPIServiceImpl) GetHealthIssues(ctx context.Context) (goserver.ImplResponse, error) {
@@ -35,7 +36,7 @@ func (s *HealthAPIServiceImpl) FixHealthIssues(ctx context.Context, req goserver
 		return goserver.Response(http.StatusUnauthorized, nil), nil
 	}
 
-	result, err := s.task.RunFix(familyID, req.Checks)
+	result, err := s.task.RunFix(familyID, req.Checks, auditActor(ctx, s.db))
 	if err != nil {
 		return goserver.Response(http.StatusInternalServerError, nil), err
 	}
@@ -49,7 +50,7 @@ func (s *HealthAPIServiceImpl) DeleteOrphan(ctx context.Context, filename string
 		return goserver.Response(http.StatusUnauthorized, nil), nil
 	}
 
-	result, err := s.task.DeleteOrphan(familyID, filename)
+	result, err := s.task.DeleteOrphan(familyID, filename, auditActor(ctx, s.db))
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			return goserver.Response(http.StatusNotFound, nil), nil
@@ -0,0 +1,385 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+const (
+	// maxFieldNameChars bounds a custom field's display name.
+	maxFieldNameChars = 100
+	// maxFieldUnitChars bounds a custom field's unit.
+	maxFieldUnitChars = 20
+	// maxFieldOptions bounds the number of options of an enum field.
+	maxFieldOptions = 50
+	// maxFieldOptionChars bounds one option of an enum field.
+	maxFieldOptionChars = 100
+)
+
+// fieldKeyRe matches a custom field key.
+var fieldKeyRe = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// GetCustomFields lists the family's custom fields.
+func (s *ItemsAPIServiceImpl) GetCustomFields(ctx context.Context) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	fields, err := s.db.GetCustomFields(familyID)
+	if err != nil {
+		s.logger.Error("Failed to get custom fields", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	res := goserver.CustomFieldsResponse{Fields: make([]goserver.CustomField, 0, len(fields))}
+	for _, f := range fields {
+		res.Fields = append(res.Fields, f.FromDB())
+	}
+	return goserver.Response(200, res), nil
+}
+
+// CreateCustomField defines a new custom field for the family's entries.
+func (s *ItemsAPIServiceImpl) CreateCustomField(
+	ctx context.Context, req goserver.CustomFieldCreateRequest,
+) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	field := models.CustomField{
+		FamilyID: familyID,
+		Key:      req.Key,
+		Name:     strings.TrimSpace(req.Name),
+		Type:     string(req.Type),
+		Min:      req.Min,
+		Max:      req.Max,
+	}
+	if req.Unit != nil {
+		field.Unit = strings.TrimSpace(*req.Unit)
+	}
+	if req.Options != nil {
+		field.Options = normalizeFieldOptions(*req.Options)
+	}
+	if req.Position != nil {
+		field.Position = *req.Position
+	}
+	if field.Type == models.FieldTypeScale {
+		if field.Min == nil {
+			lowest := float64(models.DefaultScaleMin)
+			field.Min = &lowest
+		}
+		if field.Max == nil {
+			highest := float64(models.DefaultScaleMax)
+			field.Max = &highest
+		}
+	}
+	if !fieldKeyRe.MatchString(field.Key) || !validCustomField(field) {
+		return goserver.Response(400, nil), nil
+	}
+
+	if err := s.db.CreateCustomField(&field); err != nil {
+		if errors.Is(err, database.ErrFieldConflict) {
+			return goserver.Response(409, nil), nil
+		}
+		s.logger.Error("Failed to create custom field", "error", err, "familyID", familyID, "key", field.Key)
+		return goserver.Response(500, nil), nil
+	}
+	return goserver.Response(201, field.FromDB()), nil
+}
+
+// UpdateCustomField edits a custom field's name, unit, bounds, options or
+// position. Existing values are kept as they are.
+func (s *ItemsAPIServiceImpl) UpdateCustomField(
+	ctx context.Context, key string, req goserver.CustomFieldUpdateRequest,
+) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	if req.Name == nil && req.Unit == nil && req.Min == nil && req.Max == nil &&
+		req.Options == nil && req.Position == nil {
+		return goserver.Response(400, nil), nil
+	}
+	apply := func(f *models.CustomField) {
+		if req.Name != nil {
+			f.Name = strings.TrimSpace(*req.Name)
+		}
+		if req.Unit != nil {
+			f.Unit = strings.TrimSpace(*req.Unit)
+		}
+		if req.Min != nil {
+			f.Min = req.Min
+		}
+		if req.Max != nil {
+			f.Max = req.Max
+		}
+		if req.Options != nil {
+			f.Options = normalizeFieldOptions(*req.Options)
+		}
+		if req.Position != nil {
+			f.Position = *req.Position
+		}
+	}
+
+	// Check the result before saving; key and type never change.
+	current, found, err := s.customField(familyID, key)
+	if err != nil {
+		s.logger.Error("Failed to get custom fields", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	if !found {
+		return goserver.Response(404, nil), nil
+	}
+	apply(&current)
+	if !validCustomField(current) {
+		return goserver.Response(400, nil), nil
+	}
+
+	field, err := s.db.UpdateCustomField(familyID, key, apply)
+	if errors.Is(err, database.ErrNotFound) {
+		return goserver.Response(404, nil), nil
+	}
+	if err != nil {
+		s.logger.Error("Failed to update custom field", "error", err, "familyID", familyID, "key", key)
+		return goserver.Response(500, nil), nil
+	}
+	return goserver.Response(200, field.FromDB()), nil
+}
+
+// DeleteCustomField deletes a custom field together with its values on all
+// of the family's entries.
+func (s *ItemsAPIServiceImpl) DeleteCustomField(ctx context.Context, key string) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	if err := s.db.DeleteCustomField(familyID, key); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(404, nil), nil
+		}
+		s.logger.Error("Failed to delete custom field", "error", err, "familyID", familyID, "key", key)
+		return goserver.Response(500, nil), nil
+	}
+	recordAudit(ctx, s.logger, s.db, familyID, models.AuditFieldDeleted, key)
+	return goserver.Response(204, nil), nil
+}
+
+// GetCustomFieldStats aggregates a custom field's values, optionally within
+// a date range.
+func (s *ItemsAPIServiceImpl) GetCustomFieldStats(
+	ctx context.Context, key, from, to string,
+) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+	if from != "" && to != "" && from > to {
+		return goserver.Response(400, nil), nil
+	}
+
+	field, found, err := s.customField(familyID, key)
+	if err != nil {
+		s.logger.Error("Failed to get custom fields", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	if !found {
+		return goserver.Response(404, nil), nil
+	}
+
+	stats, err := s.db.GetFieldStats(familyID, field, from, to)
+	if err != nil {
+		s.logger.Error("Failed to get custom field stats", "error", err, "familyID", familyID, "key", key)
+		return goserver.Response(500, nil), nil
+	}
+	res := goserver.CustomFieldStats{
+		Key:    field.Key,
+		Type:   goserver.CustomFieldType(field.Type),
+		Count:  stats.Count,
+		Min:    stats.Min,
+		Max:    stats.Max,
+		Avg:    stats.Avg,
+		Sum:    stats.Sum,
+		Values: make([]goserver.CustomFieldValueCount, 0, len(stats.Values)),
+		Months: make([]goserver.CustomFieldMonthStat, 0, len(stats.Months)),
+	}
+	for _, v := range stats.Values {
+		res.Values = append(res.Values, goserver.CustomFieldValueCount{Value: v.Value, Count: v.Count})
+	}
+	for _, m := range stats.Months {
+		res.Months = append(res.Months, goserver.CustomFieldMonthStat{Month: m.Month, Count: m.Count, Avg: m.Avg})
+	}
+	return goserver.Response(200, res), nil
+}
+
+// customField looks up the family's field key.
+func (s *ItemsAPIServiceImpl) customField(familyID uuid.UUID, key string) (models.CustomField, bool, error) {
+	fields, err := s.db.GetCustomFields(familyID)
+	if err != nil {
+		return models.CustomField{}, false, err
+	}
+	for _, f := range fields {
+		if f.Key == key {
+			return f, true, nil
+		}
+	}
+	return models.CustomField{}, false, nil
+}
+
+// validCustomField checks a field definition: a name, a known type, options
+// for enums only, bounds for numbers and scales only (whole numbers for
+// scales), and min not above max.
+func validCustomField(f models.CustomField) bool {
+	if f.Name == "" || utf8.RuneCountInString(f.Name) > maxFieldNameChars ||
+		utf8.RuneCountInString(f.Unit) > maxFieldUnitChars {
+		return false
+	}
+	if !goserver.CustomFieldType(f.Type).Valid() {
+		return false
+	}
+	if (f.Type == models.FieldTypeEnum) != (len(f.Options) > 0) || len(f.Options) > maxFieldOptions {
+		return false
+	}
+	for _, o := range f.Options {
+		if o == "" || utf8.RuneCountInString(o) > maxFieldOptionChars {
+			return false
+		}
+	}
+	bounded := f.Type == models.FieldTypeNumber || f.Type == models.FieldTypeScale
+	for _, b := range []*float64{f.Min, f.Max} {
+		if b == nil {
+			continue
+		}
+		if !bounded || math.IsNaN(*b) || math.IsInf(*b, 0) ||
+			(f.Type == models.FieldTypeScale && *b != math.Trunc(*b)) {
+			return false
+		}
+	}
+	return f.Min == nil || f.Max == nil || *f.Min <= *f.Max
+}
+
+// normalizeFieldOptions trims enum options and drops duplicates, preserving
+// order. Blank options are kept for validCustomField to reject.
+func normalizeFieldOptions(in []string) models.StringList {
+	out := make(models.StringList, 0, len(in))
+	seen := map[string]struct{}{}
+	for _, o := range in {
+		o = strings.TrimSpace(o)
+		if _, ok := seen[o]; ok {
+			continue
+		}
+		seen[o] = struct{}{}
+		out = append(out, o)
+	}
+	return out
+}
+
+// normalizeFieldValues checks an entry's custom field values against the
+// family's fields and returns them in their stored form. Null values are
+// dropped; an unknown key or a value of the wrong type is an error.
+func normalizeFieldValues(fields []models.CustomField, in map[string]any) (models.FieldValues, error) {
+	byKey := make(map[string]models.CustomField, len(fields))
+	for _, f := range fields {
+		byKey[f.Key] = f
+	}
+	out := make(models.FieldValues, len(in))
+	for key, v := range in {
+		if v == nil {
+			continue
+		}
+		f, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", key)
+		}
+		value, err := f.NormalizeValue(v)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = value
+	}
+	return out, nil
+}
+
+// fieldFilterOps lists the operators of a field condition, two-character ones
+// first so "<=" is not read as "<".
+var fieldFilterOps = []string{"!=", ">=", "<=", "=", ">", "<"}
+
+// parseFieldFilters parses comma-separated field conditions such as
+// "rating>=4,sick=false" against the family's fields. Numbers and scales take
+// every operator, other types only = and !=.
+func parseFieldFilters(fields []models.CustomField, raw string) ([]database.FieldFilter, error) {
+	byKey := make(map[string]models.CustomField, len(fields))
+	for _, f := range fields {
+		byKey[f.Key] = f
+	}
+	var filters []database.FieldFilter
+	for _, cond := range strings.Split(raw, ",") {
+		if strings.TrimSpace(cond) == "" {
+			continue
+		}
+		i := strings.IndexAny(cond, "!=<>")
+		if i <= 0 {
+			return nil, fmt.Errorf("malformed field condition %q", cond)
+		}
+		key := strings.TrimSpace(cond[:i])
+		var op string
+		for _, o := range fieldFilterOps {
+			if strings.HasPrefix(cond[i:], o) {
+				op = o
+				break
+			}
+		}
+		if op == "" {
+			return nil, fmt.Errorf("malformed field condition %q", cond)
+		}
+		f, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", key)
+		}
+		text := strings.TrimSpace(cond[i+len(op):])
+
+		var value any
+		switch f.Type {
+		case models.FieldTypeNumber, models.FieldTypeScale:
+			n, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: expected a number", key)
+			}
+			value = n
+		case models.FieldTypeBoolean:
+			b, err := strconv.ParseBool(text)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: expected true or false", key)
+			}
+			value = b
+		default:
+			value = text
+		}
+		if !f.IsNumeric() || f.Type == models.FieldTypeBoolean {
+			if op != "=" && op != "!=" {
+				return nil, fmt.Errorf("field %q: operator %s needs a number", key, op)
+			}
+		}
+		filters = append(filters, database.FieldFilter{Key: key, Op: op, Value: value})
+	}
+	return filters, nil
+}
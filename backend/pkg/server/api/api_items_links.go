@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+// maxBacklinkSnippetChars bounds the snippet shown for a backlink.
+const maxBacklinkSnippetChars = 200
+
+// GetItemBacklinks lists the entries that link to the day with
+// [[YYYY-MM-DD]], oldest first, each with the line holding the link.
+func (s *ItemsAPIServiceImpl) GetItemBacklinks(ctx context.Context, date string) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	items, err := s.db.GetBacklinks(familyID, date)
+	if err != nil {
+		s.logger.Error("Failed to get backlinks", "error", err, "familyID", familyID, "date", date)
+		return goserver.Response(500, nil), nil
+	}
+	res := goserver.BacklinksResponse{Backlinks: make([]goserver.Backlink, 0, len(items))}
+	for _, item := range items {
+		res.Backlinks = append(res.Backlinks, goserver.Backlink{
+			Date:    parseDate(item.Date),
+			Title:   item.Title,
+			Snippet: backlinkSnippet(item.Body, date),
+		})
+	}
+	return goserver.Response(200, res), nil
+}
+
+// backlinkSnippet returns the first line of body linking to date, trimmed to
+// maxBacklinkSnippetChars.
+func backlinkSnippet(body, date string) string {
+	link := "[[" + date + "]]"
+	for line := range strings.SplitSeq(body, "\n") {
+		if !strings.Contains(line, link) {
+			continue
+		}
+		line = strings.TrimSpace(line)
+		if runes := []rune(line); len(runes) > maxBacklinkSnippetChars {
+			line = strings.TrimSpace(string(runes[:maxBacklinkSnippetChars])) + "…"
+		}
+		return line
+	}
+	return ""
+}
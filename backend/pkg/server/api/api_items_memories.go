@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/memories"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+// GetMemories returns the entries of the same day in previous years and of
+// the same week a month ago, for date or, when empty, today.
+func (s *ItemsAPIServiceImpl) GetMemories(ctx context.Context, date string) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	day := time.Now()
+	if date != "" {
+		parsed, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			return goserver.Response(400, nil), nil
+		}
+		day = parsed
+	}
+
+	m, err := memories.Collect(s.db, familyID, day)
+	if err != nil {
+		s.logger.Error("Failed to collect memories", "error", err, "familyID", familyID, "date", date)
+		return goserver.Response(500, nil), nil
+	}
+	return goserver.Response(200, goserver.MemoriesResponse{
+		Date:         parseDate(m.Date),
+		OnThisDay:    memoriesToResponse(m.OnThisDay),
+		MonthAgoFrom: parseDate(m.MonthAgoFrom),
+		MonthAgoTo:   parseDate(m.MonthAgoTo),
+		MonthAgo:     memoriesToResponse(m.MonthAgo),
+	}), nil
+}
+
+func memoriesToResponse(in []memories.Memory) []goserver.Memory {
+	out := make([]goserver.Memory, 0, len(in))
+	for _, m := range in {
+		memory := goserver.Memory{Date: parseDate(m.Date), Title: m.Title, YearsAgo: m.YearsAgo}
+		if m.Image != "" {
+			memory.Image = &m.Image
+		}
+		out = append(out, memory)
+	}
+	return out
+}
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+	"github.com/ya-breeze/diary.be/pkg/utils"
+)
+
+const (
+	// maxPlaceNameChars bounds a place's name.
+	maxPlaceNameChars = 200
+	// weatherLookupTimeout bounds the weather lookup done while saving an
+	// entry; on timeout the entry is saved without a snapshot.
+	weatherLookupTimeout = 5 * time.Second
+)
+
+// GetPlaces lists the places of the family's entries, most visited first.
+func (s *ItemsAPIServiceImpl) GetPlaces(ctx context.Context, from, to string) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+	if from != "" && to != "" && from > to {
+		return goserver.Response(400, nil), nil
+	}
+
+	places, err := s.db.GetPlaces(familyID, from, to)
+	if err != nil {
+		s.logger.Error("Failed to get places", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	res := goserver.PlacesResponse{Places: make([]goserver.PlaceStat, 0, len(places))}
+	for _, p := range places {
+		res.Places = append(res.Places, goserver.PlaceStat{
+			Name:      p.Name,
+			Latitude:  p.Latitude,
+			Longitude: p.Longitude,
+			Count:     p.Count,
+			FirstDate: parseDate(p.FirstDate),
+			LastDate:  parseDate(p.LastDate),
+		})
+	}
+	return goserver.Response(200, res), nil
+}
+
+// placeFromRequest validates the place of a save request. It returns nil when
+// the request carries none and an empty place when it clears it.
+func placeFromRequest(req *goserver.PlaceRequest) (*models.Place, error) {
+	if req == nil {
+		return nil, nil
+	}
+	place := &models.Place{}
+	if req.Name != nil {
+		place.Name = strings.TrimSpace(*req.Name)
+		if utf8.RuneCountInString(place.Name) > maxPlaceNameChars {
+			return nil, fmt.Errorf("place name is longer than %d characters", maxPlaceNameChars)
+		}
+	}
+	if (req.Latitude == nil) != (req.Longitude == nil) {
+		return nil, errors.New("latitude and longitude go together")
+	}
+	if req.Latitude != nil {
+		lat, lon := *req.Latitude, *req.Longitude
+		if math.IsNaN(lat) || math.IsNaN(lon) || math.Abs(lat) > 90 || math.Abs(lon) > 180 {
+			return nil, fmt.Errorf("coordinates %v,%v are out of range", lat, lon)
+		}
+		place.Latitude, place.Longitude = &lat, &lon
+	}
+	if !place.IsZero() {
+		place.Source = models.PlaceSourceManual
+	}
+	return place, nil
+}
+
+// setPlaceAndWeather settles the place and weather item is saved with. A
+// requested place replaces the stored one; without one the entry keeps its
+// place, or takes the position of its first geotagged photo when it has
+// none. The weather is looked up again only when the coordinates change.
+func (s *ItemsAPIServiceImpl) setPlaceAndWeather(
+	ctx context.Context, familyID uuid.UUID, item *models.Item, requested *models.Place,
+) error {
+	existing, err := s.db.GetItem(familyID, item.Date)
+	if errors.Is(err, database.ErrNotFound) {
+		existing = &models.Item{}
+	} else if err != nil {
+		return err
+	}
+
+	place := requested
+	switch {
+	case place == nil && !existing.Place.IsZero():
+		place = existing.Place
+	case place == nil:
+		place = s.photoPlace(familyID, item.Body)
+	case place.SameCoordinates(existing.Place):
+		// Coordinates sent back unchanged keep their origin.
+		place.Source = existing.Place.Source
+	}
+	item.Place = place
+
+	switch {
+	case !place.HasCoordinates():
+		item.Weather = &models.Weather{}
+	case place.SameCoordinates(existing.Place) && !existing.Weather.IsZero():
+		item.Weather = existing.Weather
+	default:
+		item.Weather = s.lookupWeather(ctx, familyID, item.Date, place)
+	}
+	return nil
+}
+
+// photoPlace returns the GPS position of the first geotagged JPEG the body
+// references, or an empty place.
+func (s *ItemsAPIServiceImpl) photoPlace(familyID uuid.UUID, body string) *models.Place {
+	seen := map[string]struct{}{}
+	for _, name := range utils.GetAssetsFromMarkdown(body) {
+		clean := filepath.Clean(name)
+		if _, dup := seen[clean]; dup {
+			continue
+		}
+		seen[clean] = struct{}{}
+		if len(seen) > ai.MaxImages {
+			break
+		}
+		asset, ok := ai.LoadImageAsset(s.dataPath, familyID.String(), clean)
+		if !ok || asset.MIMEType != "image/jpeg" {
+			continue
+		}
+		if lat, lon, ok := utils.ImageGPS(asset.Data); ok {
+			return &models.Place{Latitude: &lat, Longitude: &lon, Source: models.PlaceSourcePhoto}
+		}
+	}
+	return &models.Place{}
+}
+
+// lookupWeather asks the weather provider for the day at the place. Failures
+// are logged and leave the entry without a snapshot, so the next save tries
+// again.
+func (s *ItemsAPIServiceImpl) lookupWeather(
+	ctx context.Context, familyID uuid.UUID, date string, place *models.Place,
+) *models.Weather {
+	if s.weather == nil || !s.weather.Enabled() {
+		return &models.Weather{}
+	}
+	ctx, cancel := context.WithTimeout(ctx, weatherLookupTimeout)
+	defer cancel()
+	snapshot, err := s.weather.Lookup(ctx, *place.Latitude, *place.Longitude, date)
+	if err != nil {
+		s.logger.Warn("Weather lookup failed", "error", err, "familyID", familyID, "date", date)
+		return &models.Weather{}
+	}
+	if snapshot == nil {
+		return &models.Weather{}
+	}
+	return &models.Weather{
+		Condition:       snapshot.Condition,
+		TempMinC:        snapshot.TempMinC,
+		TempMaxC:        snapshot.TempMaxC,
+		PrecipitationMM: snapshot.PrecipitationMM,
+		Provider:        s.weather.Name(),
+	}
+}
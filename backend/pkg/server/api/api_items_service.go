@@ -4,8 +4,13 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -14,23 +19,44 @@ import (
 	"github.com/ya-breeze/diary.be/pkg/database/models"
 	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
 	"github.com/ya-breeze/diary.be/pkg/server/common"
+	"github.com/ya-breeze/diary.be/pkg/utils"
+	"github.com/ya-breeze/diary.be/pkg/weather"
 )
 
+const (
+	// maxTagDescriptionChars bounds a tag's description.
+	maxTagDescriptionChars = 500
+	// maxTagAliases bounds the number of aliases of one tag.
+	maxTagAliases = 20
+)
+
+// tagColorRe matches a tag's display colour, "#RRGGBB".
+var tagColorRe = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
 type ItemsAPIServiceImpl struct {
-	logger    *slog.Logger
-	db        database.Storage
-	suggester ai.Suggester
-	dataPath  string
+	logger      *slog.Logger
+	db          database.Storage
+	suggester   ai.Suggester
+	summarizer  ai.Summarizer
+	clusterer   ai.TagClusterer
+	transcriber ai.Transcriber
+	weather     weather.Provider
+	dataPath    string
 }
 
 func NewItemsAPIService(
-	logger *slog.Logger, db database.Storage, suggester ai.Suggester, dataPath string,
+	logger *slog.Logger, db database.Storage, suggester ai.Suggester, transcriber ai.Transcriber,
+	weatherProvider weather.Provider, dataPath string,
 ) goserver.ItemsAPIService {
 	return &ItemsAPIServiceImpl{
-		logger:    logger,
-		db:        db,
-		suggester: suggester,
-		dataPath:  dataPath,
+		logger:      logger,
+		db:          db,
+		suggester:   suggester,
+		summarizer:  ai.NewSummarizer(suggester),
+		clusterer:   ai.NewTagClusterer(suggester),
+		transcriber: transcriber,
+		weather:     weatherProvider,
+		dataPath:    dataPath,
 	}
 }
 
@@ -40,6 +66,7 @@ func (s *ItemsAPIServiceImpl) GetItems(
 	date string,
 	search string,
 	tags string,
+	fields string,
 ) (goserver.ImplResponse, error) {
 	familyID, ok := common.GetFamilyID(ctx)
 	if !ok {
@@ -47,7 +74,7 @@ func (s *ItemsAPIServiceImpl) GetItems(
 		return goserver.Response(401, nil), nil
 	}
 
-	s.logger.Info("Getting items", "familyID", familyID, "date", date, "search", search, "tags", tags)
+	s.logger.Info("Getting items", "familyID", familyID, "date", date, "search", search, "tags", tags, "fields", fields)
 
 	searchParams := database.SearchParams{
 		Date:       date,
@@ -57,7 +84,18 @@ func (s *ItemsAPIServiceImpl) GetItems(
 	if tags != "" {
 		searchParams.Tags = strings.Split(tags, ",")
 		for i, tag := range searchParams.Tags {
-			searchParams.Tags[i] = strings.TrimSpace(tag)
+			searchParams.Tags[i] = utils.NormalizeTagPath(tag)
+		}
+	}
+	if fields != "" {
+		defs, err := s.db.GetCustomFields(familyID)
+		if err != nil {
+			s.logger.Error("Failed to get custom fields", "error", err, "familyID", familyID)
+			return goserver.Response(500, nil), nil
+		}
+		if searchParams.Fields, err = parseFieldFilters(defs, fields); err != nil {
+			s.logger.Info("Invalid field filter", "error", err, "familyID", familyID)
+			return goserver.Response(400, nil), nil
 		}
 	}
 
@@ -105,7 +143,16 @@ func (s *ItemsAPIServiceImpl) GetTags(ctx context.Context) (goserver.ImplRespons
 		tags = []string{}
 	}
 
-	return goserver.Response(200, goserver.TagsResponse{Tags: tags}), nil
+	metadata, err := s.db.GetTagMetadata(familyID)
+	if err != nil {
+		s.logger.Error("Failed to get tag metadata", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	res := goserver.TagsResponse{Tags: tags, Metadata: make([]goserver.TagMetadata, 0, len(metadata))}
+	for _, m := range metadata {
+		res.Metadata = append(res.Metadata, m.FromDB())
+	}
+	return goserver.Response(200, res), nil
 }
 
 // GetTagStats - list the family's distinct tags with per-tag usage counts.
@@ -124,15 +171,129 @@ func (s *ItemsAPIServiceImpl) GetTagStats(ctx context.Context) (goserver.ImplRes
 
 	tags := make([]goserver.TagStat, 0, len(stats))
 	for _, st := range stats {
-		tags = append(tags, goserver.TagStat{Name: st.Name, Count: st.Count})
+		tags = append(tags, goserver.TagStat{Name: st.Name, Count: st.Count, OwnCount: st.OwnCount})
 	}
 	return goserver.Response(200, goserver.TagStatsResponse{Tags: tags}), nil
 }
 
-// RenameTag renames a tag across all of the family's entries. A blank new name
-// or one equal to the existing name is rejected; collisions merge.
-func (s *ItemsAPIServiceImpl) RenameTag(
-	ctx context.Context, name string, req goserver.RenameTagRequest,
+// GetTagTree - list the family's tags as a tree of hierarchical tags, with
+// counts rolled up from descendants.
+func (s *ItemsAPIServiceImpl) GetTagTree(ctx context.Context) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	stats, err := s.db.GetTagStats(familyID)
+	if err != nil {
+		s.logger.Error("Failed to get tag stats", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	return goserver.Response(200, goserver.TagTreeResponse{Roots: buildTagTree(stats)}), nil
+}
+
+// buildTagTree arranges stats, which include every ancestor of a used tag,
+// into a tree. Siblings are sorted by name.
+func buildTagTree(stats []database.TagStat) []goserver.TagTreeNode {
+	sorted := slices.Clone(stats)
+	slices.SortFunc(sorted, func(a, b database.TagStat) int { return strings.Compare(a.Name, b.Name) })
+
+	children := map[string][]string{}
+	byName := make(map[string]database.TagStat, len(sorted))
+	var roots []string
+	for _, st := range sorted {
+		byName[st.Name] = st
+		if i := strings.LastIndex(st.Name, utils.TagSeparator); i >= 0 {
+			children[st.Name[:i]] = append(children[st.Name[:i]], st.Name)
+		} else {
+			roots = append(roots, st.Name)
+		}
+	}
+
+	var build func(names []string) []goserver.TagTreeNode
+	build = func(names []string) []goserver.TagTreeNode {
+		nodes := make([]goserver.TagTreeNode, 0, len(names))
+		for _, name := range names {
+			st := byName[name]
+			nodes = append(nodes, goserver.TagTreeNode{
+				Name:     name[strings.LastIndex(name, utils.TagSeparator)+1:],
+				Path:     name,
+				Count:    st.Count,
+				OwnCount: st.OwnCount,
+				Children: build(children[name]),
+			})
+		}
+		return nodes
+	}
+	return build(roots)
+}
+
+// GetTagConsolidation - suggest clusters of near-duplicate tags. The AI
+// provider is only asked when useAI is set and the family has AI tagging on;
+// if it fails, the heuristic clusters are still returned.
+func (s *ItemsAPIServiceImpl) GetTagConsolidation(ctx context.Context, useAI bool) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	stats, err := s.db.GetTagStats(familyID)
+	if err != nil {
+		s.logger.Error("Failed to get tag stats", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	// Only tags actually carried by entries can be merged; ancestors that
+	// exist only through their descendants are left out.
+	counts := make([]utils.TagCount, 0, len(stats))
+	names := make([]string, 0, len(stats))
+	for _, st := range stats {
+		if st.OwnCount == 0 {
+			continue
+		}
+		counts = append(counts, utils.TagCount{Name: st.Name, Count: st.OwnCount})
+		names = append(names, st.Name)
+	}
+
+	var groups [][]string
+	aiUsed := false
+	if useAI && s.clusterer.Enabled() {
+		if _, enabled := s.enabledFamily(familyID); enabled {
+			groups, err = s.clusterer.ClusterTags(ai.WithFamily(ctx, familyID), names)
+			if err != nil {
+				s.logger.Warn("AI tag clustering failed, using heuristics only", "error", err, "familyID", familyID)
+				groups = nil
+			} else {
+				aiUsed = true
+			}
+		}
+	}
+
+	clusters := utils.ClusterTags(counts, groups)
+	res := goserver.TagConsolidationResponse{
+		Clusters: make([]goserver.TagCluster, 0, len(clusters)),
+		AiUsed:   aiUsed,
+	}
+	for _, c := range clusters {
+		tags := make([]goserver.TagStat, 0, len(c.Tags))
+		for _, t := range c.Tags {
+			tags = append(tags, goserver.TagStat{Name: t.Name, Count: t.Count, OwnCount: t.Count})
+		}
+		reasons := make([]goserver.TagClusterReasons, 0, len(c.Reasons))
+		for _, r := range c.Reasons {
+			reasons = append(reasons, goserver.TagClusterReasons(r))
+		}
+		res.Clusters = append(res.Clusters, goserver.TagCluster{Canonical: c.Canonical, Tags: tags, Reasons: reasons})
+	}
+	return goserver.Response(200, res), nil
+}
+
+// MergeTags applies several merges in one transaction. Every source may
+// appear once, never as its own target, and no target may itself be merged
+// away, so the result does not depend on the order of the merges.
+func (s *ItemsAPIServiceImpl) MergeTags(
+	ctx context.Context, req goserver.TagMergeRequest,
 ) (goserver.ImplResponse, error) {
 	familyID, ok := common.GetFamilyID(ctx)
 	if !ok {
@@ -140,39 +301,184 @@ func (s *ItemsAPIServiceImpl) RenameTag(
 		return goserver.Response(401, nil), nil
 	}
 
-	oldName := strings.TrimSpace(name)
-	newName := strings.TrimSpace(req.NewName)
-	if oldName == "" || newName == "" || oldName == newName {
+	if len(req.Merges) == 0 {
 		return goserver.Response(400, nil), nil
 	}
+	renames := map[string]string{}
+	targets := map[string]struct{}{}
+	for _, m := range req.Merges {
+		to := strings.TrimSpace(m.To)
+		if to == "" || len(m.From) == 0 {
+			return goserver.Response(400, nil), nil
+		}
+		targets[to] = struct{}{}
+		for _, from := range m.From {
+			from = strings.TrimSpace(from)
+			if from == "" || from == to {
+				return goserver.Response(400, nil), nil
+			}
+			if _, dup := renames[from]; dup {
+				return goserver.Response(400, nil), nil
+			}
+			renames[from] = to
+		}
+	}
+	for from := range renames {
+		if _, chained := targets[from]; chained {
+			return goserver.Response(400, nil), nil
+		}
+	}
 
-	if err := s.db.RenameTag(familyID, oldName, newName); err != nil {
-		s.logger.Error("Failed to rename tag", "error", err, "familyID", familyID, "old", oldName, "new", newName)
+	updated, err := s.db.MergeTags(familyID, renames)
+	if err != nil {
+		s.logger.Error("Failed to merge tags", "error", err, "familyID", familyID)
 		return goserver.Response(500, nil), nil
 	}
-	return goserver.Response(200, nil), nil
+	for _, m := range req.Merges {
+		for _, from := range m.From {
+			recordAudit(ctx, s.logger, s.db, familyID, models.AuditTagRenamed,
+				strings.TrimSpace(from)+" → "+strings.TrimSpace(m.To))
+		}
+	}
+	return goserver.Response(200, goserver.TagMergeResponse{UpdatedEntries: updated}), nil
+}
+
+// UpdateTag edits a tag's metadata and renames it across all of the family's
+// entries. The metadata goes first, so a conflicting alias changes nothing,
+// and the rename then carries it along. A blank new name or one equal to the
+// existing name is rejected; collisions merge. With req.Subtree the tag's
+// descendants move along with it.
+func (s *ItemsAPIServiceImpl) UpdateTag(
+	ctx context.Context, name string, req goserver.TagUpdateRequest,
+) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	oldName := utils.NormalizeTagPath(name)
+	if oldName == "" {
+		return goserver.Response(400, nil), nil
+	}
+	editsMetadata := req.Color != nil || req.Description != nil || req.Aliases != nil
+	newName := oldName
+	if req.NewName != nil {
+		newName = utils.NormalizeTagPath(*req.NewName)
+		if newName == "" || newName == oldName {
+			return goserver.Response(400, nil), nil
+		}
+	} else if !editsMetadata {
+		return goserver.Response(400, nil), nil
+	}
+
+	if editsMetadata {
+		aliases, valid := validTagMetadata(req, oldName, newName)
+		if !valid {
+			return goserver.Response(400, nil), nil
+		}
+		_, err := s.db.UpdateTagMetadata(familyID, oldName, func(tag *models.Tag) {
+			if req.Color != nil {
+				tag.Color = strings.ToLower(strings.TrimSpace(*req.Color))
+			}
+			if req.Description != nil {
+				tag.Description = strings.TrimSpace(*req.Description)
+			}
+			if req.Aliases != nil {
+				tag.Aliases = aliases
+			}
+		})
+		if errors.Is(err, database.ErrTagConflict) {
+			return goserver.Response(409, nil), nil
+		}
+		if err != nil {
+			s.logger.Error("Failed to update tag metadata", "error", err, "familyID", familyID, "tag", oldName)
+			return goserver.Response(500, nil), nil
+		}
+		recordAudit(ctx, s.logger, s.db, familyID, models.AuditTagUpdated, oldName)
+	}
+
+	if newName != oldName {
+		subtree := req.Subtree != nil && *req.Subtree
+		if err := s.db.RenameTag(familyID, oldName, newName, subtree); err != nil {
+			s.logger.Error("Failed to rename tag", "error", err, "familyID", familyID, "old", oldName, "new", newName)
+			return goserver.Response(500, nil), nil
+		}
+		recordAudit(ctx, s.logger, s.db, familyID, models.AuditTagRenamed, oldName+" → "+newName+subtreeSuffix(subtree))
+	}
+
+	metadata, err := s.db.GetTagMetadata(familyID)
+	if err != nil {
+		s.logger.Error("Failed to get tag metadata", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	tag := models.Tag{Name: newName}
+	for _, m := range metadata {
+		if m.Name == newName {
+			tag = m
+		}
+	}
+	return goserver.Response(200, tag.FromDB()), nil
+}
+
+// validTagMetadata checks the metadata fields of req and returns its aliases
+// normalized like tags. An alias may not be the tag's old or new name.
+func validTagMetadata(req goserver.TagUpdateRequest, oldName, newName string) ([]string, bool) {
+	if req.Color != nil {
+		if color := strings.TrimSpace(*req.Color); color != "" && !tagColorRe.MatchString(color) {
+			return nil, false
+		}
+	}
+	if req.Description != nil && utf8.RuneCountInString(strings.TrimSpace(*req.Description)) > maxTagDescriptionChars {
+		return nil, false
+	}
+	if req.Aliases == nil {
+		return nil, true
+	}
+	aliases := make([]string, 0, len(*req.Aliases))
+	for _, a := range *req.Aliases {
+		a = utils.NormalizeTagPath(a)
+		if a == "" || strings.EqualFold(a, oldName) || strings.EqualFold(a, newName) {
+			return nil, false
+		}
+		aliases = append(aliases, a)
+	}
+	if len(aliases) > maxTagAliases {
+		return nil, false
+	}
+	return aliases, true
 }
 
-// DeleteTag removes a tag from all of the family's entries.
-func (s *ItemsAPIServiceImpl) DeleteTag(ctx context.Context, name string) (goserver.ImplResponse, error) {
+// DeleteTag removes a tag, and with subtree its descendants, from all of the
+// family's entries.
+func (s *ItemsAPIServiceImpl) DeleteTag(ctx context.Context, name string, subtree bool) (goserver.ImplResponse, error) {
 	familyID, ok := common.GetFamilyID(ctx)
 	if !ok {
 		s.logger.Error("Family ID not found in context")
 		return goserver.Response(401, nil), nil
 	}
 
-	tagName := strings.TrimSpace(name)
+	tagName := utils.NormalizeTagPath(name)
 	if tagName == "" {
 		return goserver.Response(204, nil), nil
 	}
 
-	if err := s.db.DeleteTag(familyID, tagName); err != nil {
+	if err := s.db.DeleteTag(familyID, tagName, subtree); err != nil {
 		s.logger.Error("Failed to delete tag", "error", err, "familyID", familyID, "tag", tagName)
 		return goserver.Response(500, nil), nil
 	}
+	recordAudit(ctx, s.logger, s.db, familyID, models.AuditTagDeleted, tagName+subtreeSuffix(subtree))
 	return goserver.Response(204, nil), nil
 }
 
+// subtreeSuffix marks audit targets of operations that covered descendants.
+func subtreeSuffix(subtree bool) string {
+	if subtree {
+		return " (subtree)"
+	}
+	return ""
+}
+
 // DismissItemTag removes a single pending suggestion from a day without
 // confirming it.
 func (s *ItemsAPIServiceImpl) DismissItemTag(
@@ -267,6 +573,27 @@ func (s *ItemsAPIServiceImpl) PutItems(
 		Body:  body,
 		Tags:  models.StringList(filteredTags),
 	}
+	// Without fields in the request the entry keeps its values.
+	if itemsRequest.Fields != nil {
+		defs, err := s.db.GetCustomFields(familyID)
+		if err != nil {
+			s.logger.Error("Failed to get custom fields", "error", err, "familyID", familyID)
+			return goserver.Response(500, nil), nil
+		}
+		if item.Fields, err = normalizeFieldValues(defs, *itemsRequest.Fields); err != nil {
+			s.logger.Info("Invalid field values", "error", err, "familyID", familyID, "date", dateStr)
+			return goserver.Response(400, nil), nil
+		}
+	}
+	place, err := placeFromRequest(itemsRequest.Place)
+	if err != nil {
+		s.logger.Info("Invalid place", "error", err, "familyID", familyID, "date", dateStr)
+		return goserver.Response(400, nil), nil
+	}
+	if err := s.setPlaceAndWeather(ctx, familyID, item, place); err != nil {
+		s.logger.Error("Failed to load item", "error", err, "familyID", familyID, "date", dateStr)
+		return goserver.Response(500, nil), nil
+	}
 
 	if err := s.db.PutItem(familyID, item); err != nil {
 		s.logger.Error("Failed to save item", "error", err, "item", item)
@@ -285,12 +612,16 @@ func newItemResponse(item *models.Item) goserver.ItemsResponse {
 	tags := nonNil(filterTags((*[]string)(&item.Tags)))
 	pendingTags := nonNil(filterTags((*[]string)(&item.PendingTags)))
 	body := item.Body
+	fields := item.Fields.OrEmpty()
 	return goserver.ItemsResponse{
 		Date:        parseDate(item.Date),
 		Title:       item.Title,
 		Body:        &body,
 		Tags:        &tags,
 		PendingTags: &pendingTags,
+		Fields:      &fields,
+		Place:       item.Place.FromDB(),
+		Weather:     item.Weather.FromDB(),
 	}
 }
 
@@ -308,7 +639,7 @@ func filterTags(in *[]string) []string {
 	}
 	out := make([]string, 0, len(*in))
 	for _, t := range *in {
-		if t = strings.TrimSpace(t); t != "" {
+		if t = utils.NormalizeTagPath(t); t != "" {
 			out = append(out, t)
 		}
 	}
@@ -342,6 +673,12 @@ func (s *ItemsAPIServiceImpl) SuggestItemTags(
 		return goserver.Response(500, nil), nil
 	}
 
+	tagMetadata, err := s.db.GetTagMetadata(familyID)
+	if err != nil {
+		s.logger.Error("Failed to load tag metadata", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+
 	var images []ai.ImageAsset
 	if family.AITaggingUseImages {
 		images = ai.LoadImageAssets(body, s.dataPath, familyID.String())
@@ -350,7 +687,12 @@ func (s *ItemsAPIServiceImpl) SuggestItemTags(
 		images = append(images, ai.LoadVideoKeyframes(body, s.dataPath, familyID.String(), s.logger, ai.MaxImages-len(images))...)
 	}
 
-	suggestions, err := s.suggester.SuggestTags(ctx, req.Title, body, images, knownTags)
+	suggestions, err := s.suggester.SuggestTags(
+		ai.WithFamily(ctx, familyID), req.Title, body, images, knownTags, ai.FamilyTagPolicy(family, tagMetadata))
+	if errors.Is(err, ai.ErrBudgetExhausted) {
+		s.logger.Info("Tag suggestion refused: monthly AI budget exhausted", "familyID", familyID)
+		return goserver.Response(429, nil), nil
+	}
 	if err != nil {
 		s.logger.Error("Tag suggestion failed", "error", err, "familyID", familyID)
 		return goserver.Response(500, nil), nil
@@ -378,6 +720,163 @@ func (s *ItemsAPIServiceImpl) enabledFamily(familyID uuid.UUID) (*models.Family,
 	return family, true
 }
 
+// SummarizeItem - summarize a day's entry in one paragraph without saving.
+func (s *ItemsAPIServiceImpl) SummarizeItem(ctx context.Context, date string) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	if !s.summariesEnabled(familyID) {
+		return goserver.Response(503, nil), nil
+	}
+
+	item, err := s.db.GetItem(familyID, date)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(404, nil), nil
+		}
+		s.logger.Error("Summary: failed to load item", "error", err, "familyID", familyID, "date", date)
+		return goserver.Response(500, nil), nil
+	}
+
+	summary, err := s.summarizer.SummarizeEntry(ai.WithFamily(ctx, familyID), item.Title, item.Body)
+	if errors.Is(err, ai.ErrBudgetExhausted) {
+		s.logger.Info("Entry summary refused: monthly AI budget exhausted", "familyID", familyID)
+		return goserver.Response(429, nil), nil
+	}
+	if err != nil {
+		s.logger.Error("Entry summary failed", "error", err, "familyID", familyID, "date", date)
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, goserver.ItemSummary{
+		Date:    parseDate(item.Date),
+		Summary: summary,
+	}), nil
+}
+
+// TranscribeItemAsset - transcribe a voice memo referenced by the entry and
+// append the text to its body. The entry is saved through PutItem, so the
+// change is recorded for sync like any other edit.
+func (s *ItemsAPIServiceImpl) TranscribeItemAsset(
+	ctx context.Context, date string, req goserver.TranscribeRequest,
+) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	asset := filepath.Clean(strings.TrimSpace(req.Asset))
+	if req.Asset == "" || !ai.IsAudioFile(asset) {
+		return goserver.Response(400, nil), nil
+	}
+	if !s.transcriptionEnabled(familyID) {
+		return goserver.Response(503, nil), nil
+	}
+
+	item, err := s.db.GetItem(familyID, date)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(404, nil), nil
+		}
+		s.logger.Error("Transcription: failed to load item", "error", err, "familyID", familyID, "date", date)
+		return goserver.Response(500, nil), nil
+	}
+	referenced := false
+	for _, name := range utils.GetAssetsFromMarkdown(item.Body) {
+		if filepath.Clean(name) == asset {
+			referenced = true
+			break
+		}
+	}
+	if !referenced {
+		return goserver.Response(400, nil), nil
+	}
+	// Transcribing a memo appends its text, so a second request would append
+	// it again; refuse while the earlier transcript is still in the entry.
+	previous, err := s.db.GetAssetTranscript(familyID, asset)
+	switch {
+	case err == nil && previous.Transcript != "" && strings.Contains(item.Body, previous.Transcript):
+		return goserver.Response(409, nil), nil
+	case err != nil && !errors.Is(err, database.ErrNotFound):
+		s.logger.Error("Transcription: failed to load previous transcript", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+
+	audio, err := ai.LoadAudioAsset(s.dataPath, familyID.String(), asset)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return goserver.Response(404, nil), nil
+	case errors.Is(err, ai.ErrUnsupportedAudio), errors.Is(err, ai.ErrAudioTooLarge):
+		return goserver.Response(400, nil), nil
+	case err != nil:
+		s.logger.Error("Transcription: failed to read asset", "error", err, "familyID", familyID, "asset", asset)
+		return goserver.Response(500, nil), nil
+	}
+
+	transcript, err := s.transcriber.Transcribe(ai.WithFamily(ctx, familyID), audio)
+	if errors.Is(err, ai.ErrBudgetExhausted) {
+		s.logger.Info("Transcription refused: monthly AI budget exhausted", "familyID", familyID)
+		return goserver.Response(429, nil), nil
+	}
+	if err != nil {
+		s.logger.Error("Transcription failed", "error", err, "familyID", familyID, "asset", asset)
+		return goserver.Response(500, nil), nil
+	}
+
+	if transcript != "" {
+		if body := strings.TrimRight(item.Body, " \t\n"); body != "" {
+			item.Body = body + "\n\n" + transcript
+		} else {
+			item.Body = transcript
+		}
+		if err := s.db.PutItem(familyID, item); err != nil {
+			s.logger.Error("Transcription: failed to save item", "error", err, "familyID", familyID, "date", date)
+			return goserver.Response(500, nil), nil
+		}
+		if err := s.db.PutAssetTranscript(&models.AssetTranscript{
+			FamilyID: familyID, Filename: asset, Transcript: transcript,
+		}); err != nil {
+			s.logger.Warn("Transcription: failed to record transcript", "error", err, "familyID", familyID, "asset", asset)
+		}
+	}
+
+	response := newItemResponse(item)
+	s.addNavigationDates(&response, familyID, item.Date)
+	return goserver.Response(200, goserver.TranscribeResponse{Transcript: transcript, Item: response}), nil
+}
+
+// summariesEnabled reports whether AI summaries are available for the family
+// (summarizer configured + family opted in).
+func (s *ItemsAPIServiceImpl) summariesEnabled(familyID uuid.UUID) bool {
+	if !s.summarizer.Enabled() {
+		return false
+	}
+	family, err := s.db.GetFamily(familyID)
+	if err != nil {
+		s.logger.Error("Failed to load family for AI gate", "error", err, "familyID", familyID)
+		return false
+	}
+	return family.AISummariesEnabled
+}
+
+// transcriptionEnabled reports whether voice memo transcription is available
+// for the family (transcriber configured + family opted in).
+func (s *ItemsAPIServiceImpl) transcriptionEnabled(familyID uuid.UUID) bool {
+	if s.transcriber == nil || !s.transcriber.Enabled() {
+		return false
+	}
+	family, err := s.db.GetFamily(familyID)
+	if err != nil {
+		s.logger.Error("Failed to load family for AI gate", "error", err, "familyID", familyID)
+		return false
+	}
+	return family.AITranscriptionEnabled
+}
+
 // toAPITagSuggestions maps internal suggestions to the API response type.
 func toAPITagSuggestions(in []ai.TagSuggestion) []goserver.TagSuggestion {
 	out := make([]goserver.TagSuggestion, len(in))
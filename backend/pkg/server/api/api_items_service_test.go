@@ -2,8 +2,12 @@ package api_test
 
 import (
 	"context"
+	"encoding/binary"
 	"log/slog"
+	"math"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,6 +22,7 @@ import (
 	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
 	"github.com/ya-breeze/diary.be/pkg/server/api"
 	"github.com/ya-breeze/diary.be/pkg/server/common"
+	"github.com/ya-breeze/diary.be/pkg/weather"
 )
 
 func parseTestDate(s string) openapi_types.Date {
@@ -91,7 +96,8 @@ var _ = Describe("ItemsAPIService", func() {
 		storage = database.NewStorage(logger, cfg)
 		Expect(storage.Open()).To(Succeed())
 
-		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), "")
+		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), ai.NewDisabledTranscriber(),
+			weather.NewDisabledProvider(), "")
 	})
 
 	AfterEach(func() {
@@ -103,7 +109,7 @@ var _ = Describe("ItemsAPIService", func() {
 		Context("when no user ID in context", func() {
 			It("should return 401 unauthorized", func() {
 				emptyCtx := context.Background()
-				response, err := service.GetItems(emptyCtx, testDate, "", "")
+				response, err := service.GetItems(emptyCtx, testDate, "", "", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Code).To(Equal(401))
 			})
@@ -111,7 +117,7 @@ var _ = Describe("ItemsAPIService", func() {
 
 		Context("when item does not exist (backward compatibility with date filter)", func() {
 			It("should return empty item with navigation dates for the requested date", func() {
-				response, err := service.GetItems(ctx, testDate, "", "")
+				response, err := service.GetItems(ctx, testDate, "", "", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Code).To(Equal(200))
 
@@ -152,7 +158,7 @@ var _ = Describe("ItemsAPIService", func() {
 				})
 
 				It("should include navigation dates even for empty item", func() {
-					response, err := service.GetItems(ctx, testDate, "", "")
+					response, err := service.GetItems(ctx, testDate, "", "", "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(response.Code).To(Equal(200))
 
@@ -187,7 +193,7 @@ var _ = Describe("ItemsAPIService", func() {
 			})
 
 			It("should return the item in list format with 200 status", func() {
-				response, err := service.GetItems(ctx, testDate, "", "")
+				response, err := service.GetItems(ctx, testDate, "", "", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Code).To(Equal(200))
 
@@ -237,7 +243,7 @@ var _ = Describe("ItemsAPIService", func() {
 			})
 
 			It("should include previous and next dates", func() {
-				response, err := service.GetItems(ctx, testDate, "", "")
+				response, err := service.GetItems(ctx, testDate, "", "", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Code).To(Equal(200))
 
@@ -286,7 +292,7 @@ var _ = Describe("ItemsAPIService", func() {
 			})
 
 			It("should return items matching search text in title", func() {
-				response, err := service.GetItems(ctx, "", "vacation", "")
+				response, err := service.GetItems(ctx, "", "vacation", "", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Code).To(Equal(200))
 
@@ -298,7 +304,7 @@ var _ = Describe("ItemsAPIService", func() {
 			})
 
 			It("should return items matching search text in body", func() {
-				response, err := service.GetItems(ctx, "", "beach", "")
+				response, err := service.GetItems(ctx, "", "beach", "", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Code).To(Equal(200))
 
@@ -309,7 +315,7 @@ var _ = Describe("ItemsAPIService", func() {
 			})
 
 			It("should return empty list when no matches found", func() {
-				response, err := service.GetItems(ctx, "", "nonexistent", "")
+				response, err := service.GetItems(ctx, "", "nonexistent", "", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Code).To(Equal(200))
 
@@ -352,7 +358,7 @@ var _ = Describe("ItemsAPIService", func() {
 			})
 
 			It("should return items matching single tag", func() {
-				response, err := service.GetItems(ctx, "", "", "work")
+				response, err := service.GetItems(ctx, "", "", "work", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Code).To(Equal(200))
 
@@ -363,7 +369,7 @@ var _ = Describe("ItemsAPIService", func() {
 			})
 
 			It("should return items matching multiple tags", func() {
-				response, err := service.GetItems(ctx, "", "", "family,personal")
+				response, err := service.GetItems(ctx, "", "", "family,personal", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Code).To(Equal(200))
 
@@ -375,7 +381,7 @@ var _ = Describe("ItemsAPIService", func() {
 			})
 
 			It("should return empty list when no tag matches found", func() {
-				response, err := service.GetItems(ctx, "", "", "nonexistent")
+				response, err := service.GetItems(ctx, "", "", "nonexistent", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Code).To(Equal(200))
 
@@ -411,7 +417,7 @@ var _ = Describe("ItemsAPIService", func() {
 			})
 
 			It("should return items matching both text and tags", func() {
-				response, err := service.GetItems(ctx, "", "project", "work")
+				response, err := service.GetItems(ctx, "", "project", "work", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Code).To(Equal(200))
 
@@ -548,7 +554,7 @@ type fakeSuggester struct {
 func (f fakeSuggester) Enabled() bool { return true }
 
 func (f fakeSuggester) SuggestTags(
-	_ context.Context, _, _ string, _ []ai.ImageAsset, _ []string,
+	_ context.Context, _, _ string, _ []ai.ImageAsset, _ []string, _ ai.TagPolicy,
 ) ([]ai.TagSuggestion, error) {
 	return f.suggestions, nil
 }
@@ -564,7 +570,7 @@ type countingSuggester struct {
 func (c countingSuggester) Enabled() bool { return true }
 
 func (c countingSuggester) SuggestTags(
-	_ context.Context, _, _ string, _ []ai.ImageAsset, _ []string,
+	_ context.Context, _, _ string, _ []ai.ImageAsset, _ []string, _ ai.TagPolicy,
 ) ([]ai.TagSuggestion, error) {
 	*c.calls++
 	return []ai.TagSuggestion{{Name: "beach", Confidence: 0.9}}, nil
@@ -597,7 +603,8 @@ var _ = Describe("ItemsAPIService PutItems never auto-tags", func() {
 		// AI fully enabled (incl. auto) — saving must STILL not trigger the model.
 		Expect(storage.SetFamilyAISettings(familyID, true, true, true, false, false)).To(Succeed())
 		calls = 0
-		service = api.NewItemsAPIService(logger, storage, countingSuggester{calls: &calls}, "")
+		service = api.NewItemsAPIService(logger, storage, countingSuggester{calls: &calls}, ai.NewDisabledTranscriber(),
+			weather.NewDisabledProvider(), "")
 	})
 
 	AfterEach(func() {
@@ -674,7 +681,8 @@ var _ = Describe("ItemsAPIService SuggestItemTags", func() {
 	}
 
 	It("returns 503 when the suggester is disabled", func() {
-		svc := api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), "")
+		svc := api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), ai.NewDisabledTranscriber(),
+			weather.NewDisabledProvider(), "")
 		resp, err := svc.SuggestItemTags(ctx, req)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(resp.Code).To(Equal(503))
@@ -682,7 +690,8 @@ var _ = Describe("ItemsAPIService SuggestItemTags", func() {
 
 	It("returns 503 when the family has not enabled AI tagging", func() {
 		svc := api.NewItemsAPIService(logger, storage,
-			fakeSuggester{suggestions: []ai.TagSuggestion{{Name: "beach", Confidence: 0.9}}}, "")
+			fakeSuggester{suggestions: []ai.TagSuggestion{{Name: "beach", Confidence: 0.9}}},
+			ai.NewDisabledTranscriber(), weather.NewDisabledProvider(), "")
 		resp, err := svc.SuggestItemTags(ctx, req)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(resp.Code).To(Equal(503))
@@ -693,7 +702,7 @@ var _ = Describe("ItemsAPIService SuggestItemTags", func() {
 		svc := api.NewItemsAPIService(logger, storage, fakeSuggester{suggestions: []ai.TagSuggestion{
 			{Name: "beach", Confidence: 0.9},
 			{Name: "summer", Confidence: 0.5},
-		}}, "")
+		}}, ai.NewDisabledTranscriber(), weather.NewDisabledProvider(), "")
 		resp, err := svc.SuggestItemTags(ctx, req)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(resp.Code).To(Equal(200))
@@ -704,7 +713,8 @@ var _ = Describe("ItemsAPIService SuggestItemTags", func() {
 	})
 
 	It("returns 401 without a family in context", func() {
-		svc := api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), "")
+		svc := api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), ai.NewDisabledTranscriber(),
+			weather.NewDisabledProvider(), "")
 		resp, err := svc.SuggestItemTags(context.Background(), req)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(resp.Code).To(Equal(401))
@@ -732,7 +742,8 @@ var _ = Describe("ItemsAPIService GetTags", func() {
 		Expect(err).NotTo(HaveOccurred())
 		familyID = fam.ID
 		ctx = createContextWithFamilyIDForItems(familyID)
-		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), "")
+		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), ai.NewDisabledTranscriber(),
+			weather.NewDisabledProvider(), "")
 	})
 
 	AfterEach(func() {
@@ -791,7 +802,8 @@ var _ = Describe("ItemsAPIService tag management", func() {
 		Expect(err).NotTo(HaveOccurred())
 		familyID = fam.ID
 		ctx = createContextWithFamilyIDForItems(familyID)
-		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), "")
+		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), ai.NewDisabledTranscriber(),
+			weather.NewDisabledProvider(), "")
 	})
 
 	AfterEach(func() {
@@ -819,9 +831,23 @@ var _ = Describe("ItemsAPIService tag management", func() {
 			Expect(resp.Code).To(Equal(200))
 			body := resp.Body.(goserver.TagStatsResponse)
 			Expect(body.Tags).To(Equal([]goserver.TagStat{
-				{Name: "family", Count: 3},
-				{Name: "travel", Count: 1},
-				{Name: "work", Count: 1},
+				{Name: "family", Count: 3, OwnCount: 3},
+				{Name: "travel", Count: 1, OwnCount: 1},
+				{Name: "work", Count: 1, OwnCount: 1},
+			}))
+		})
+
+		It("rolls hierarchical tags up into their ancestors", func() {
+			Expect(storage.PutItem(familyID, &models.Item{
+				Date: "2024-03-01", Title: "a", Tags: models.StringList{"travel/italy/rome", "travel/italy"},
+			})).To(Succeed())
+			resp, err := service.GetTagStats(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			body := resp.Body.(goserver.TagStatsResponse)
+			Expect(body.Tags).To(Equal([]goserver.TagStat{
+				{Name: "travel", Count: 1, OwnCount: 0},
+				{Name: "travel/italy", Count: 1, OwnCount: 1},
+				{Name: "travel/italy/rome", Count: 1, OwnCount: 1},
 			}))
 		})
 
@@ -834,21 +860,55 @@ var _ = Describe("ItemsAPIService tag management", func() {
 		})
 	})
 
-	Describe("RenameTag", func() {
+	Describe("GetTagTree", func() {
 		It("returns 401 without a family in context", func() {
-			resp, err := service.RenameTag(context.Background(), "a", goserver.RenameTagRequest{NewName: "b"})
+			resp, err := service.GetTagTree(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Code).To(Equal(401))
+		})
+
+		It("nests hierarchical tags under their parents", func() {
+			for _, it := range []*models.Item{
+				{Date: "2024-03-01", Title: "a", Tags: models.StringList{"travel/italy/rome", "work"}},
+				{Date: "2024-03-02", Title: "b", Tags: models.StringList{"travel/france"}},
+			} {
+				Expect(storage.PutItem(familyID, it)).To(Succeed())
+			}
+			resp, err := service.GetTagTree(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Code).To(Equal(200))
+			leaf := func(name, path string) goserver.TagTreeNode {
+				return goserver.TagTreeNode{
+					Name: name, Path: path, Count: 1, OwnCount: 1, Children: []goserver.TagTreeNode{},
+				}
+			}
+			Expect(resp.Body).To(Equal(goserver.TagTreeResponse{Roots: []goserver.TagTreeNode{
+				{Name: "travel", Path: "travel", Count: 2, Children: []goserver.TagTreeNode{
+					leaf("france", "travel/france"),
+					{Name: "italy", Path: "travel/italy", Count: 1, Children: []goserver.TagTreeNode{
+						leaf("rome", "travel/italy/rome"),
+					}},
+				}},
+				leaf("work", "work"),
+			}}))
+		})
+	})
+
+	Describe("UpdateTag", func() {
+		It("returns 401 without a family in context", func() {
+			resp, err := service.UpdateTag(context.Background(), "a", goserver.TagUpdateRequest{NewName: ptr("b")})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.Code).To(Equal(401))
 		})
 
 		It("rejects a blank new name with 400", func() {
-			resp, err := service.RenameTag(ctx, "old", goserver.RenameTagRequest{NewName: "  "})
+			resp, err := service.UpdateTag(ctx, "old", goserver.TagUpdateRequest{NewName: ptr("  ")})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.Code).To(Equal(400))
 		})
 
 		It("rejects an unchanged name with 400", func() {
-			resp, err := service.RenameTag(ctx, "old", goserver.RenameTagRequest{NewName: "old"})
+			resp, err := service.UpdateTag(ctx, "old", goserver.TagUpdateRequest{NewName: ptr("old")})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.Code).To(Equal(400))
 		})
@@ -857,18 +917,91 @@ var _ = Describe("ItemsAPIService tag management", func() {
 			Expect(storage.PutItem(familyID, &models.Item{
 				Date: "2024-03-01", Title: "a", Tags: models.StringList{"vacaiton", "work"},
 			})).To(Succeed())
-			resp, err := service.RenameTag(ctx, "vacaiton", goserver.RenameTagRequest{NewName: "vacation"})
+			resp, err := service.UpdateTag(ctx, "vacaiton", goserver.TagUpdateRequest{NewName: ptr("vacation")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Code).To(Equal(200))
+			saved, err := storage.GetItem(familyID, "2024-03-01")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(saved.Tags).To(Equal(models.StringList{"vacation", "work"}))
+		})
+
+		It("moves descendants along when subtree is set", func() {
+			Expect(storage.PutItem(familyID, &models.Item{
+				Date: "2024-03-01", Title: "a", Tags: models.StringList{"travel/italy", "travelogue"},
+			})).To(Succeed())
+			subtree := true
+			resp, err := service.UpdateTag(ctx, "travel", goserver.TagUpdateRequest{NewName: ptr("trips"), Subtree: &subtree})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.Code).To(Equal(200))
 			saved, err := storage.GetItem(familyID, "2024-03-01")
 			Expect(err).NotTo(HaveOccurred())
+			Expect(saved.Tags).To(Equal(models.StringList{"trips/italy", "travelogue"}))
+		})
+
+		It("rejects a request that changes nothing with 400", func() {
+			resp, err := service.UpdateTag(ctx, "travel", goserver.TagUpdateRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Code).To(Equal(400))
+		})
+
+		DescribeTable("rejects invalid metadata with 400",
+			func(req goserver.TagUpdateRequest) {
+				resp, err := service.UpdateTag(ctx, "travel", req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Code).To(Equal(400))
+			},
+			Entry("colour not #RRGGBB", goserver.TagUpdateRequest{Color: ptr("blue")}),
+			Entry("description too long", goserver.TagUpdateRequest{Description: ptr(strings.Repeat("a", 501))}),
+			Entry("blank alias", goserver.TagUpdateRequest{Aliases: &[]string{" "}}),
+			Entry("alias equal to the name", goserver.TagUpdateRequest{Aliases: &[]string{"Travel"}}),
+			Entry("alias equal to the new name", goserver.TagUpdateRequest{
+				NewName: ptr("trips"), Aliases: &[]string{"trips"},
+			}),
+		)
+
+		It("stores metadata, re-tags alias uses and carries metadata through a rename", func() {
+			Expect(storage.PutItem(familyID, &models.Item{
+				Date: "2024-03-01", Title: "a", Tags: models.StringList{"holiday", "work"},
+			})).To(Succeed())
+			resp, err := service.UpdateTag(ctx, "vacation", goserver.TagUpdateRequest{
+				Color: ptr("#1E88E5"), Description: ptr(" Time off "), Aliases: &[]string{"holiday", "Holiday"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Code).To(Equal(200))
+			Expect(resp.Body).To(Equal(goserver.TagMetadata{
+				Name: "vacation", Color: ptr("#1e88e5"), Description: ptr("Time off"), Aliases: []string{"holiday"},
+			}))
+			saved, err := storage.GetItem(familyID, "2024-03-01")
+			Expect(err).NotTo(HaveOccurred())
 			Expect(saved.Tags).To(Equal(models.StringList{"vacation", "work"}))
+
+			resp, err = service.UpdateTag(ctx, "vacation", goserver.TagUpdateRequest{NewName: ptr("trips")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Body.(goserver.TagMetadata).Name).To(Equal("trips"))
+			Expect(resp.Body.(goserver.TagMetadata).Aliases).To(Equal([]string{"holiday"}))
+
+			resp, err = service.GetTags(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			body := resp.Body.(goserver.TagsResponse)
+			Expect(body.Tags).To(Equal([]string{"trips", "work"}))
+			Expect(body.Metadata).To(HaveLen(1))
+			Expect(body.Metadata[0].Name).To(Equal("trips"))
+		})
+
+		It("returns 409 for an alias another tag already has", func() {
+			_, err := storage.UpdateTagMetadata(familyID, "travel", func(tag *models.Tag) {
+				tag.Aliases = models.StringList{"trip"}
+			})
+			Expect(err).NotTo(HaveOccurred())
+			resp, err := service.UpdateTag(ctx, "vacation", goserver.TagUpdateRequest{Aliases: &[]string{"Trip"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Code).To(Equal(409))
 		})
 	})
 
 	Describe("DeleteTag", func() {
 		It("returns 401 without a family in context", func() {
-			resp, err := service.DeleteTag(context.Background(), "a")
+			resp, err := service.DeleteTag(context.Background(), "a", false)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.Code).To(Equal(401))
 		})
@@ -877,7 +1010,19 @@ var _ = Describe("ItemsAPIService tag management", func() {
 			Expect(storage.PutItem(familyID, &models.Item{
 				Date: "2024-03-01", Title: "a", Tags: models.StringList{"misc", "work"},
 			})).To(Succeed())
-			resp, err := service.DeleteTag(ctx, "misc")
+			resp, err := service.DeleteTag(ctx, "misc", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Code).To(Equal(204))
+			saved, err := storage.GetItem(familyID, "2024-03-01")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(saved.Tags).To(Equal(models.StringList{"work"}))
+		})
+
+		It("removes descendants too when subtree is set", func() {
+			Expect(storage.PutItem(familyID, &models.Item{
+				Date: "2024-03-01", Title: "a", Tags: models.StringList{"misc/old", "misc", "work"},
+			})).To(Succeed())
+			resp, err := service.DeleteTag(ctx, "misc", true)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.Code).To(Equal(204))
 			saved, err := storage.GetItem(familyID, "2024-03-01")
@@ -885,6 +1030,72 @@ var _ = Describe("ItemsAPIService tag management", func() {
 			Expect(saved.Tags).To(Equal(models.StringList{"work"}))
 		})
 	})
+
+	Describe("GetTagConsolidation", func() {
+		It("clusters near-duplicate tags without AI", func() {
+			Expect(storage.PutItem(familyID, &models.Item{
+				Date: "2024-03-01", Title: "a", Tags: models.StringList{"running", "work"},
+			})).To(Succeed())
+			Expect(storage.PutItem(familyID, &models.Item{
+				Date: "2024-03-02", Title: "b", Tags: models.StringList{"Runs"},
+			})).To(Succeed())
+			resp, err := service.GetTagConsolidation(ctx, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Code).To(Equal(200))
+			body := resp.Body.(goserver.TagConsolidationResponse)
+			Expect(body.AiUsed).To(BeFalse())
+			Expect(body.Clusters).To(HaveLen(1))
+			Expect(body.Clusters[0].Tags).To(ConsistOf(
+				goserver.TagStat{Name: "running", Count: 1, OwnCount: 1}, goserver.TagStat{Name: "Runs", Count: 1, OwnCount: 1}))
+			Expect(body.Clusters[0].Reasons).To(Equal([]goserver.TagClusterReasons{"stem"}))
+		})
+	})
+
+	Describe("MergeTags", func() {
+		merge := func(to string, from ...string) goserver.TagMerge {
+			return goserver.TagMerge{To: to, From: from}
+		}
+
+		It("returns 401 without a family in context", func() {
+			resp, err := service.MergeTags(context.Background(), goserver.TagMergeRequest{
+				Merges: []goserver.TagMerge{merge("run", "Runs")},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Code).To(Equal(401))
+		})
+
+		DescribeTable("rejects invalid merges with 400",
+			func(merges []goserver.TagMerge) {
+				resp, err := service.MergeTags(ctx, goserver.TagMergeRequest{Merges: merges})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Code).To(Equal(400))
+			},
+			Entry("no merges", []goserver.TagMerge{}),
+			Entry("blank target", []goserver.TagMerge{merge(" ", "a")}),
+			Entry("no sources", []goserver.TagMerge{merge("a")}),
+			Entry("merged into itself", []goserver.TagMerge{merge("a", "a")}),
+			Entry("source listed twice", []goserver.TagMerge{merge("a", "b"), merge("c", "b")}),
+			Entry("target merged away", []goserver.TagMerge{merge("a", "b"), merge("c", "a")}),
+		)
+
+		It("applies all merges and reports the entries changed", func() {
+			Expect(storage.PutItem(familyID, &models.Item{
+				Date: "2024-03-01", Title: "a", Tags: models.StringList{"Runs", "running", "Road trip"},
+			})).To(Succeed())
+			Expect(storage.PutItem(familyID, &models.Item{
+				Date: "2024-03-02", Title: "b", Tags: models.StringList{"work"},
+			})).To(Succeed())
+			resp, err := service.MergeTags(ctx, goserver.TagMergeRequest{Merges: []goserver.TagMerge{
+				merge("run", "Runs", "running"), merge("road-trip", "Road trip"),
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Code).To(Equal(200))
+			Expect(resp.Body).To(Equal(goserver.TagMergeResponse{UpdatedEntries: 1}))
+			saved, err := storage.GetItem(familyID, "2024-03-01")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(saved.Tags).To(Equal(models.StringList{"run", "road-trip"}))
+		})
+	})
 })
 
 var _ = Describe("ItemsAPIService DismissItemTag", func() {
@@ -908,7 +1119,8 @@ var _ = Describe("ItemsAPIService DismissItemTag", func() {
 		Expect(err).NotTo(HaveOccurred())
 		familyID = fam.ID
 		ctx = createContextWithFamilyIDForItems(familyID)
-		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), "")
+		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), ai.NewDisabledTranscriber(),
+			weather.NewDisabledProvider(), "")
 	})
 
 	AfterEach(func() {
@@ -971,7 +1183,8 @@ var _ = Describe("ItemsAPIService AcceptItemTag", func() {
 		Expect(err).NotTo(HaveOccurred())
 		familyID = fam.ID
 		ctx = createContextWithFamilyIDForItems(familyID)
-		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), "")
+		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), ai.NewDisabledTranscriber(),
+			weather.NewDisabledProvider(), "")
 	})
 
 	AfterEach(func() {
@@ -1039,7 +1252,8 @@ var _ = Describe("ItemsAPIService blank-tag filtering", func() {
 		Expect(err).NotTo(HaveOccurred())
 		familyID = fam.ID
 		ctx = createContextWithFamilyIDForItems(familyID)
-		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), "")
+		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), ai.NewDisabledTranscriber(),
+			weather.NewDisabledProvider(), "")
 	})
 
 	AfterEach(func() {
@@ -1056,7 +1270,7 @@ var _ = Describe("ItemsAPIService blank-tag filtering", func() {
 			 WHERE date = ? AND family_id = ?`, "2024-03-01", familyID,
 		).Error).To(Succeed())
 
-		resp, err := service.GetItems(ctx, "2024-03-01", "", "")
+		resp, err := service.GetItems(ctx, "2024-03-01", "", "", "")
 		Expect(err).NotTo(HaveOccurred())
 		Expect(resp.Code).To(Equal(200))
 		body := resp.Body.(goserver.ItemsListResponse)
@@ -1073,7 +1287,7 @@ var _ = Describe("ItemsAPIService blank-tag filtering", func() {
 			 WHERE date = ? AND family_id = ?`, "2024-03-02", familyID,
 		).Error).To(Succeed())
 
-		resp, err := service.GetItems(ctx, "2024-03-02", "", "")
+		resp, err := service.GetItems(ctx, "2024-03-02", "", "", "")
 		Expect(err).NotTo(HaveOccurred())
 		Expect(resp.Code).To(Equal(200))
 		body := resp.Body.(goserver.ItemsListResponse)
@@ -1084,3 +1298,426 @@ var _ = Describe("ItemsAPIService blank-tag filtering", func() {
 		Expect(*item.PendingTags).To(BeEmpty())
 	})
 })
+
+var _ = Describe("ItemsAPIService custom fields", func() {
+	var (
+		logger   *slog.Logger
+		storage  database.Storage
+		service  goserver.ItemsAPIService
+		tempDir  string
+		familyID uuid.UUID
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		logger = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+		var err error
+		tempDir, err = os.MkdirTemp("", "fields_test")
+		Expect(err).NotTo(HaveOccurred())
+		storage = database.NewStorage(logger, &config.Config{DataPath: tempDir})
+		Expect(storage.Open()).To(Succeed())
+		fam, err := storage.CreateFamily("fields-fam")
+		Expect(err).NotTo(HaveOccurred())
+		familyID = fam.ID
+		ctx = createContextWithFamilyIDForItems(familyID)
+		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), ai.NewDisabledTranscriber(),
+			weather.NewDisabledProvider(), "")
+	})
+
+	AfterEach(func() {
+		storage.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	create := func(req goserver.CustomFieldCreateRequest) goserver.ImplResponse {
+		resp, err := service.CreateCustomField(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		return resp
+	}
+	put := func(date string, fields map[string]any) goserver.ImplResponse {
+		req := goserver.ItemsRequest{Date: parseTestDate(date), Title: "day"}
+		if fields != nil {
+			req.Fields = &fields
+		}
+		resp, err := service.PutItems(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		return resp
+	}
+	datesMatching := func(fields string) (int, []string) {
+		resp, err := service.GetItems(ctx, "", "", "", fields)
+		Expect(err).NotTo(HaveOccurred())
+		if resp.Code != 200 {
+			return resp.Code, nil
+		}
+		var dates []string
+		for _, it := range resp.Body.(goserver.ItemsListResponse).Items {
+			dates = append(dates, it.Date.Time.Format("2006-01-02"))
+		}
+		return resp.Code, dates
+	}
+
+	BeforeEach(func() {
+		Expect(create(goserver.CustomFieldCreateRequest{Key: "rating", Name: "Rating", Type: goserver.Scale}).Code).To(Equal(201))
+		Expect(create(goserver.CustomFieldCreateRequest{Key: "sleep", Name: "Sleep", Type: goserver.Number, Unit: ptr("h")}).Code).To(Equal(201))
+		Expect(create(goserver.CustomFieldCreateRequest{
+			Key: "mood", Name: "Mood", Type: goserver.Enum, Options: &[]string{"calm", " tired ", "calm"},
+		}).Code).To(Equal(201))
+	})
+
+	It("defaults scales to 1–5, rejects invalid definitions and duplicate keys", func() {
+		resp, err := service.GetCustomFields(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		fields := resp.Body.(goserver.CustomFieldsResponse).Fields
+		Expect(fields).To(HaveLen(3))
+		Expect(fields[1].Key).To(Equal("rating"))
+		Expect(*fields[1].Min).To(Equal(1.0))
+		Expect(*fields[1].Max).To(Equal(5.0))
+		Expect(fields[0].Options).To(Equal([]string{"calm", "tired"}))
+
+		for _, req := range []goserver.CustomFieldCreateRequest{
+			{Key: "Bad Key", Name: "x", Type: goserver.Number},
+			{Key: "blank", Name: " ", Type: goserver.Number},
+			{Key: "kind", Name: "Kind", Type: "colour"},
+			{Key: "choice", Name: "Choice", Type: goserver.Enum},
+			{Key: "flag", Name: "Flag", Type: goserver.Boolean, Options: &[]string{"yes"}},
+			{Key: "weight", Name: "Weight", Type: goserver.Number, Min: ptrFloat(90), Max: ptrFloat(40)},
+			{Key: "half", Name: "Half", Type: goserver.Scale, Max: ptrFloat(4.5)},
+		} {
+			Expect(create(req).Code).To(Equal(400), "key %q", req.Key)
+		}
+		Expect(create(goserver.CustomFieldCreateRequest{Key: "rating", Name: "Again", Type: goserver.Number}).Code).
+			To(Equal(409))
+	})
+
+	It("validates values on save, keeps them when left out and filters by them", func() {
+		resp := put("2024-01-01", map[string]any{"rating": 4.0, "sleep": 7.5, "mood": "calm"})
+		Expect(resp.Code).To(Equal(200))
+		Expect(*resp.Body.(goserver.ItemsResponse).Fields).To(Equal(map[string]any{"rating": 4.0, "sleep": 7.5, "mood": "calm"}))
+		Expect(put("2024-01-02", map[string]any{"rating": 2.0, "mood": nil}).Code).To(Equal(200))
+
+		for _, fields := range []map[string]any{
+			{"unknown": 1.0},
+			{"rating": 6.0},
+			{"rating": 3.5},
+			{"sleep": "long"},
+			{"mood": "angry"},
+		} {
+			Expect(put("2024-01-03", fields).Code).To(Equal(400), "%v", fields)
+		}
+
+		resp = put("2024-01-01", nil)
+		Expect(*resp.Body.(goserver.ItemsResponse).Fields).To(HaveKeyWithValue("rating", 4.0))
+
+		code, dates := datesMatching("rating>=3")
+		Expect(code).To(Equal(200))
+		Expect(dates).To(Equal([]string{"2024-01-01"}))
+		_, dates = datesMatching("rating<4, mood != calm")
+		Expect(dates).To(BeEmpty(), "entries without a value never match")
+		_, dates = datesMatching("mood=calm,sleep>7")
+		Expect(dates).To(Equal([]string{"2024-01-01"}))
+
+		for _, bad := range []string{"ghost=1", "rating", "=3", "rating>=x", "mood>calm"} {
+			code, _ = datesMatching(bad)
+			Expect(code).To(Equal(400), bad)
+		}
+	})
+
+	It("aggregates values and deletes a field with its values", func() {
+		put("2024-01-01", map[string]any{"rating": 4.0})
+		put("2024-01-02", map[string]any{"rating": 5.0})
+		put("2024-02-01", map[string]any{"rating": 3.0})
+
+		resp, err := service.GetCustomFieldStats(ctx, "rating", "2024-01-01", "2024-01-31")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+		stats := resp.Body.(goserver.CustomFieldStats)
+		Expect(stats.Count).To(Equal(2))
+		Expect(*stats.Avg).To(Equal(4.5))
+		Expect(stats.Values).To(ConsistOf(
+			goserver.CustomFieldValueCount{Value: "4", Count: 1},
+			goserver.CustomFieldValueCount{Value: "5", Count: 1},
+		))
+		Expect(stats.Months).To(HaveLen(1))
+
+		resp, _ = service.GetCustomFieldStats(ctx, "rating", "2024-02-01", "2024-01-01")
+		Expect(resp.Code).To(Equal(400))
+		resp, _ = service.GetCustomFieldStats(ctx, "ghost", "", "")
+		Expect(resp.Code).To(Equal(404))
+
+		resp, err = service.UpdateCustomField(ctx, "rating", goserver.CustomFieldUpdateRequest{Min: ptrFloat(6)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(400), "min above the existing max")
+		resp, _ = service.UpdateCustomField(ctx, "rating", goserver.CustomFieldUpdateRequest{Name: ptr("Day rating")})
+		Expect(resp.Code).To(Equal(200))
+		Expect(resp.Body.(goserver.CustomField).Name).To(Equal("Day rating"))
+
+		resp, err = service.DeleteCustomField(ctx, "rating")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(204))
+		item, err := storage.GetItem(familyID, "2024-01-01")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(item.Fields).To(BeEmpty())
+		resp, _ = service.DeleteCustomField(ctx, "rating")
+		Expect(resp.Code).To(Equal(404))
+	})
+})
+
+func ptrFloat(f float64) *float64 { return &f }
+
+// countingWeather is the fake weather provider, counting its lookups.
+type countingWeather struct {
+	weather.Provider
+	lookups *int
+}
+
+func (c countingWeather) Lookup(ctx context.Context, lat, lon float64, date string) (*weather.Snapshot, error) {
+	*c.lookups++
+	return c.Provider.Lookup(ctx, lat, lon, date)
+}
+
+// gpsJPEG builds a minimal big-endian JPEG whose EXIF GPS tags hold a
+// north-eastern position, with whole seconds.
+func gpsJPEG(lat, lon float64) []byte {
+	be := binary.BigEndian
+	dms := func(v float64) []byte {
+		deg, minutes := math.Floor(v), math.Floor((v-math.Floor(v))*60)
+		seconds := math.Round(((v-deg)*60 - minutes) * 60)
+		out := []byte{}
+		for _, n := range []float64{deg, minutes, seconds} {
+			out = be.AppendUint32(be.AppendUint32(out, uint32(n)), 1)
+		}
+		return out
+	}
+	entry := func(out []byte, tag, typ uint16, count, value uint32) []byte {
+		return be.AppendUint32(be.AppendUint32(be.AppendUint16(be.AppendUint16(out, tag), typ), count), value)
+	}
+	tiff := be.AppendUint16([]byte("MM\x00\x2a\x00\x00\x00\x08"), 1)
+	tiff = be.AppendUint32(entry(tiff, 0x8825, 4, 1, 26), 0)
+	tiff = be.AppendUint16(tiff, 4)
+	tiff = entry(tiff, 1, 2, 2, 'N'<<24)
+	tiff = entry(tiff, 2, 5, 3, 80)
+	tiff = entry(tiff, 3, 2, 2, 'E'<<24)
+	tiff = entry(tiff, 4, 5, 3, 104)
+	tiff = append(be.AppendUint32(tiff, 0), append(dms(lat), dms(lon)...)...)
+
+	out := be.AppendUint16([]byte{0xFF, 0xD8, 0xFF, 0xE1}, uint16(2+6+len(tiff)))
+	out = append(append(out, "Exif\x00\x00"...), tiff...)
+	return append(out, 0xFF, 0xD9)
+}
+
+var _ = Describe("ItemsAPIService places", func() {
+	var (
+		logger   *slog.Logger
+		storage  database.Storage
+		service  goserver.ItemsAPIService
+		tempDir  string
+		familyID uuid.UUID
+		ctx      context.Context
+		lookups  int
+	)
+
+	BeforeEach(func() {
+		logger = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+		var err error
+		tempDir, err = os.MkdirTemp("", "places_test")
+		Expect(err).NotTo(HaveOccurred())
+		storage = database.NewStorage(logger, &config.Config{DataPath: tempDir})
+		Expect(storage.Open()).To(Succeed())
+		fam, err := storage.CreateFamily("places-fam")
+		Expect(err).NotTo(HaveOccurred())
+		familyID = fam.ID
+		ctx = createContextWithFamilyIDForItems(familyID)
+		lookups = 0
+		service = api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), ai.NewDisabledTranscriber(),
+			countingWeather{Provider: weather.NewFakeProvider(), lookups: &lookups}, tempDir)
+	})
+
+	AfterEach(func() {
+		storage.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	put := func(req goserver.ItemsRequest) goserver.ImplResponse {
+		if req.Title == "" {
+			req.Title = "day"
+		}
+		resp, err := service.PutItems(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		return resp
+	}
+
+	It("stores a manual place with the weather of the day and keeps both on later saves", func() {
+		resp := put(goserver.ItemsRequest{Date: parseTestDate("2024-07-01"), Place: &goserver.PlaceRequest{
+			Name: ptr(" Lake Como "), Latitude: ptrFloat(45.98), Longitude: ptrFloat(9.25),
+		}})
+		Expect(resp.Code).To(Equal(200))
+		item := resp.Body.(goserver.ItemsResponse)
+		Expect(item.Place).NotTo(BeNil())
+		Expect(item.Place.Name).To(Equal("Lake Como"))
+		Expect(item.Place.Source).To(Equal(goserver.PlaceSource(models.PlaceSourceManual)))
+		Expect(item.Weather).NotTo(BeNil())
+		Expect(item.Weather.Provider).To(Equal(weather.ProviderFake))
+		Expect(lookups).To(Equal(1))
+
+		resp = put(goserver.ItemsRequest{Date: parseTestDate("2024-07-01"), Title: "edited"})
+		item = resp.Body.(goserver.ItemsResponse)
+		Expect(item.Place.Name).To(Equal("Lake Como"))
+		Expect(item.Weather).NotTo(BeNil())
+		resp = put(goserver.ItemsRequest{Date: parseTestDate("2024-07-01"), Place: &goserver.PlaceRequest{
+			Name: ptr("Como"), Latitude: ptrFloat(45.98), Longitude: ptrFloat(9.25),
+		}})
+		Expect(resp.Body.(goserver.ItemsResponse).Place.Name).To(Equal("Como"))
+		Expect(lookups).To(Equal(1), "unchanged coordinates keep the snapshot")
+
+		resp = put(goserver.ItemsRequest{Date: parseTestDate("2024-07-01"), Place: &goserver.PlaceRequest{Name: ptr("Home")}})
+		item = resp.Body.(goserver.ItemsResponse)
+		Expect(item.Place.Latitude).To(BeNil())
+		Expect(item.Weather).To(BeNil(), "no coordinates, no weather")
+
+		resp = put(goserver.ItemsRequest{Date: parseTestDate("2024-07-01"), Place: &goserver.PlaceRequest{}})
+		Expect(resp.Body.(goserver.ItemsResponse).Place).To(BeNil())
+	})
+
+	It("rejects invalid places", func() {
+		for _, place := range []goserver.PlaceRequest{
+			{Latitude: ptrFloat(45)},
+			{Latitude: ptrFloat(91), Longitude: ptrFloat(0)},
+			{Latitude: ptrFloat(0), Longitude: ptrFloat(-181)},
+			{Name: ptr(strings.Repeat("x", 201))},
+		} {
+			Expect(put(goserver.ItemsRequest{Date: parseTestDate("2024-07-01"), Place: &place}).Code).To(Equal(400))
+		}
+	})
+
+	It("takes the place from the first geotagged photo", func() {
+		assetsDir := filepath.Join(tempDir, config.AssetsDirName, familyID.String())
+		Expect(os.MkdirAll(assetsDir, 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(assetsDir, "plain.png"), []byte("\x89PNG\r\n\x1a\n"), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(assetsDir, "lake.jpg"), gpsJPEG(45.9868, 9.2572), 0o600)).To(Succeed())
+
+		body := "![](plain.png)\n\n![](lake.jpg)"
+		resp := put(goserver.ItemsRequest{Date: parseTestDate("2024-07-02"), Body: &body})
+		Expect(resp.Code).To(Equal(200))
+		item := resp.Body.(goserver.ItemsResponse)
+		Expect(item.Place).NotTo(BeNil())
+		Expect(item.Place.Source).To(Equal(goserver.PlaceSource(models.PlaceSourcePhoto)))
+		Expect(*item.Place.Latitude).To(BeNumerically("~", 45.9868, 0.0002))
+		Expect(item.Weather).NotTo(BeNil())
+
+		// Naming the place keeps the photo's coordinates and their origin.
+		resp = put(goserver.ItemsRequest{Date: parseTestDate("2024-07-02"), Body: &body, Place: &goserver.PlaceRequest{
+			Name: ptr("Lake Como"), Latitude: item.Place.Latitude, Longitude: item.Place.Longitude,
+		}})
+		Expect(resp.Body.(goserver.ItemsResponse).Place.Source).To(Equal(goserver.PlaceSource(models.PlaceSourcePhoto)))
+		Expect(lookups).To(Equal(1))
+
+		// A cleared place stays cleared for clients that send it.
+		resp = put(goserver.ItemsRequest{Date: parseTestDate("2024-07-02"), Body: &body, Place: &goserver.PlaceRequest{}})
+		Expect(resp.Body.(goserver.ItemsResponse).Place).To(BeNil())
+	})
+
+	It("lists places by visits", func() {
+		for _, date := range []string{"2024-07-01", "2024-07-05"} {
+			put(goserver.ItemsRequest{Date: parseTestDate(date), Place: &goserver.PlaceRequest{
+				Name: ptr("Como"), Latitude: ptrFloat(45.98), Longitude: ptrFloat(9.25),
+			}})
+		}
+		put(goserver.ItemsRequest{Date: parseTestDate("2024-07-03"), Place: &goserver.PlaceRequest{Name: ptr("Milan")}})
+
+		resp, err := service.GetPlaces(ctx, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+		places := resp.Body.(goserver.PlacesResponse).Places
+		Expect(places).To(HaveLen(2))
+		Expect(places[0].Name).To(Equal("Como"))
+		Expect(places[0].Count).To(Equal(2))
+		Expect(places[0].FirstDate).To(Equal(parseTestDate("2024-07-01")))
+		Expect(places[0].LastDate).To(Equal(parseTestDate("2024-07-05")))
+
+		resp, _ = service.GetPlaces(ctx, "2024-07-02", "2024-07-04")
+		Expect(resp.Body.(goserver.PlacesResponse).Places).To(HaveLen(1))
+		resp, _ = service.GetPlaces(ctx, "2024-07-05", "2024-07-01")
+		Expect(resp.Code).To(Equal(400))
+	})
+})
+
+type fakeTranscriber struct {
+	transcript string
+	err        error
+	calls      *int
+}
+
+func (f fakeTranscriber) Enabled() bool { return true }
+
+func (f fakeTranscriber) Transcribe(context.Context, ai.AudioAsset) (string, error) {
+	*f.calls++
+	return f.transcript, f.err
+}
+
+var _ = Describe("ItemsAPIService TranscribeItemAsset", func() {
+	var (
+		logger   *slog.Logger
+		storage  database.Storage
+		tempDir  string
+		familyID uuid.UUID
+		ctx      context.Context
+		calls    int
+		err      error
+	)
+
+	BeforeEach(func() {
+		logger = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+		tempDir, err = os.MkdirTemp("", "transcribe_test")
+		Expect(err).NotTo(HaveOccurred())
+		storage = database.NewStorage(logger, &config.Config{DataPath: tempDir})
+		Expect(storage.Open()).To(Succeed())
+		fam, err := storage.CreateFamily("transcribe-fam")
+		Expect(err).NotTo(HaveOccurred())
+		familyID = fam.ID
+		ctx = createContextWithFamilyIDForItems(familyID)
+		calls = 0
+
+		assetsDir := filepath.Join(tempDir, config.AssetsDirName, familyID.String())
+		Expect(os.MkdirAll(assetsDir, 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(assetsDir, "memo.m4a"), []byte("audio"), 0o600)).To(Succeed())
+		Expect(storage.PutItem(familyID, &models.Item{Date: "2024-08-01", Title: "Walk", Body: "![](memo.m4a)"})).
+			To(Succeed())
+	})
+
+	AfterEach(func() {
+		storage.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	transcribe := func(t ai.Transcriber) goserver.ImplResponse {
+		service := api.NewItemsAPIService(logger, storage, ai.NewDisabledSuggester(), t,
+			weather.NewDisabledProvider(), tempDir)
+		resp, err := service.TranscribeItemAsset(ctx, "2024-08-01", goserver.TranscribeRequest{Asset: "memo.m4a"})
+		Expect(err).NotTo(HaveOccurred())
+		return resp
+	}
+
+	It("transcribes only for families that opted in, and appends each memo once", func() {
+		t := fakeTranscriber{transcript: "Saw a heron by the river.", calls: &calls}
+		Expect(transcribe(t).Code).To(Equal(503))
+		Expect(calls).To(BeZero(), "no audio leaves the server before the family opts in")
+
+		Expect(storage.SetFamilyAITranscriptionEnabled(familyID, true)).To(Succeed())
+		resp := transcribe(t)
+		Expect(resp.Code).To(Equal(200))
+		Expect(*resp.Body.(goserver.TranscribeResponse).Item.Body).To(Equal("![](memo.m4a)\n\nSaw a heron by the river."))
+
+		Expect(transcribe(t).Code).To(Equal(409))
+		Expect(calls).To(Equal(1))
+
+		// Once the transcript is edited out of the entry the memo can be transcribed again.
+		Expect(storage.PutItem(familyID, &models.Item{Date: "2024-08-01", Title: "Walk", Body: "![](memo.m4a)"})).
+			To(Succeed())
+		Expect(transcribe(t).Code).To(Equal(200))
+	})
+
+	It("refuses once the monthly budget is used up", func() {
+		Expect(storage.SetFamilyAITranscriptionEnabled(familyID, true)).To(Succeed())
+		Expect(transcribe(fakeTranscriber{err: ai.ErrBudgetExhausted, calls: &calls}).Code).To(Equal(429))
+	})
+})
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+// GetStats returns the writing statistics of the family's entries between
+// from and to (either may be empty). The heatmap covers the year of to, or
+// the current year.
+func (s *ItemsAPIServiceImpl) GetStats(ctx context.Context, from, to string) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+	if from != "" && to != "" && from > to {
+		return goserver.Response(400, nil), nil
+	}
+
+	now := time.Now()
+	stats, err := s.db.GetWritingStats(familyID, from, to, now.Format("2006-01-02"))
+	if err != nil {
+		s.logger.Error("Failed to get writing stats", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	days, err := s.db.GetDayWordCounts(familyID, from, to)
+	if err != nil {
+		s.logger.Error("Failed to get word counts", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	year := now.Year()
+	if end, err := time.Parse("2006-01-02", to); err == nil {
+		year = end.Year()
+	}
+	yearDays, err := s.db.GetDayWordCounts(familyID, fmt.Sprintf("%04d-01-01", year), fmt.Sprintf("%04d-12-31", year))
+	if err != nil {
+		s.logger.Error("Failed to get heatmap", "error", err, "familyID", familyID, "year", year)
+		return goserver.Response(500, nil), nil
+	}
+
+	res := goserver.StatsResponse{
+		Entries:           stats.Entries,
+		Words:             stats.Words,
+		Days:              make([]goserver.DayWordCount, 0, len(days)),
+		CurrentStreak:     streakToResponse(stats.CurrentStreak),
+		LongestStreak:     streakToResponse(stats.LongestStreak),
+		Months:            make([]goserver.MonthEntryStat, 0, len(stats.Months)),
+		Tags:              make([]goserver.TagMonthStat, 0, len(stats.TagMonths)),
+		Assets:            stats.AssetRefs,
+		EntriesWithAssets: stats.EntriesWithAssets,
+		Heatmap:           yearHeatmap(year, yearDays),
+	}
+	for _, d := range days {
+		res.Days = append(res.Days, goserver.DayWordCount{Date: parseDate(d.Date), Words: d.Words})
+	}
+	for _, m := range stats.Months {
+		res.Months = append(res.Months, goserver.MonthEntryStat{Month: m.Month, Entries: m.Entries, Words: m.Words})
+	}
+	for _, t := range stats.TagMonths {
+		res.Tags = append(res.Tags, goserver.TagMonthStat{Month: t.Month, Tag: t.Tag, Count: t.Count})
+	}
+	return goserver.Response(200, res), nil
+}
+
+func streakToResponse(streak database.Streak) goserver.Streak {
+	res := goserver.Streak{Days: streak.Days}
+	if streak.Days > 0 {
+		start, end := parseDate(streak.Start), parseDate(streak.End)
+		res.Start, res.End = &start, &end
+	}
+	return res
+}
+
+// yearHeatmap spreads the word counts of the year's entries over an array
+// with one value per day of the year, January 1 first.
+func yearHeatmap(year int, days []database.DayWords) goserver.StatsHeatmap {
+	dec31 := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	words := make([]int, dec31.YearDay())
+	for _, d := range days {
+		day, err := time.Parse("2006-01-02", d.Date)
+		if err != nil || day.Year() != year {
+			continue
+		}
+		words[day.YearDay()-1] = d.Words
+	}
+	return goserver.StatsHeatmap{Year: year, Words: words}
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/ya-breeze/diary.be/pkg/checker"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+const (
+	defaultJobsLimit = 50
+	maxJobsLimit     = 500
+)
+
+type JobsAPIServiceImpl struct {
+	logger *slog.Logger
+	db     database.Storage
+}
+
+func NewJobsAPIService(logger *slog.Logger, db database.Storage) goserver.JobsAPIService {
+	return &JobsAPIServiceImpl{logger: logger, db: db}
+}
+
+// GetJobs reports the family's AI job queue and how far the tagging backfill
+// has progressed.
+func (s *JobsAPIServiceImpl) GetJobs(ctx context.Context, limit int) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+	if limit < 0 || limit > maxJobsLimit {
+		return goserver.Response(400, nil), nil
+	}
+	if limit == 0 {
+		limit = defaultJobsLimit
+	}
+
+	family, err := s.db.GetFamily(familyID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(401, nil), nil
+		}
+		s.logger.Error("Failed to load family", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	counts, err := s.db.CountAIJobs(familyID)
+	if err != nil {
+		s.logger.Error("Failed to count AI jobs", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	jobs, err := s.db.GetAIJobs(familyID, limit)
+	if err != nil {
+		s.logger.Error("Failed to load AI jobs", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	candidates, err := checker.BackfillCandidates(s.db, family)
+	if err != nil {
+		s.logger.Error("Failed to load backfill candidates", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+
+	res := goserver.JobsResponse{
+		Counts: goserver.JobCounts{
+			Queued:  counts[models.AIJobQueued],
+			Running: counts[models.AIJobRunning],
+			Done:    counts[models.AIJobDone],
+			Failed:  counts[models.AIJobFailed],
+		},
+		BackfillRemainingDays: len(candidates),
+		BackfillDone:          family.AITaggingBackfillDone,
+		Jobs:                  make([]goserver.AIJob, 0, len(jobs)),
+	}
+	for _, j := range jobs {
+		res.Jobs = append(res.Jobs, j.FromDB())
+	}
+	return goserver.Response(200, res), nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+type RecapsAPIServiceImpl struct {
+	logger *slog.Logger
+	db     database.Storage
+}
+
+func NewRecapsAPIService(logger *slog.Logger, db database.Storage) goserver.RecapsAPIService {
+	return &RecapsAPIServiceImpl{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// GetRecaps - list the family's AI-written weekly and monthly recaps, newest first
+func (s *RecapsAPIServiceImpl) GetRecaps(ctx context.Context, period string) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+	if period != "" && period != models.RecapPeriodWeek && period != models.RecapPeriodMonth {
+		return goserver.Response(400, nil), nil
+	}
+
+	recaps, err := s.db.GetRecaps(familyID, period)
+	if err != nil {
+		s.logger.Error("Failed to get recaps", "error", err, "familyID", familyID, "period", period)
+		return goserver.Response(500, nil), nil
+	}
+
+	resp := make([]goserver.Recap, 0, len(recaps))
+	for _, r := range recaps {
+		resp = append(resp, r.FromDB())
+	}
+	return goserver.Response(200, resp), nil
+}
@@ -0,0 +1,270 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+const (
+	defaultSemanticLimit = 10
+	maxSemanticLimit     = 50
+
+	// maxQuestionChars bounds an /ask question.
+	maxQuestionChars = 1000
+	// maxAskEntries is how many retrieved entries are sent as /ask context.
+	maxAskEntries = 8
+	// Keyword retrieval (used when semantic search is off) searches for at most
+	// maxKeywordTerms words of the question, ignoring words shorter than
+	// minKeywordLength.
+	maxKeywordTerms  = 8
+	minKeywordLength = 4
+)
+
+type SearchAPIServiceImpl struct {
+	logger   *slog.Logger
+	db       database.Storage
+	embedder ai.Embedder
+	answerer ai.Answerer
+}
+
+func NewSearchAPIService(
+	logger *slog.Logger, db database.Storage, embedder ai.Embedder, answerer ai.Answerer,
+) goserver.SearchAPIService {
+	return &SearchAPIServiceImpl{
+		logger:   logger,
+		db:       db,
+		embedder: embedder,
+		answerer: answerer,
+	}
+}
+
+// scoredItem is a retrieved entry with its relevance (higher is better).
+type scoredItem struct {
+	item  *models.Item
+	score float64
+}
+
+// SemanticSearchItems - find the entries closest in meaning to a free-text query.
+// Only entries the background embedding task has already processed are found.
+func (s *SearchAPIServiceImpl) SemanticSearchItems(
+	ctx context.Context, q string, limit int32,
+) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	q = strings.TrimSpace(q)
+	if q == "" || limit < 0 || limit > maxSemanticLimit {
+		return goserver.Response(400, nil), nil
+	}
+	if limit == 0 {
+		limit = defaultSemanticLimit
+	}
+
+	family, err := s.db.GetFamily(familyID)
+	if err != nil {
+		s.logger.Error("Failed to load family for AI gate", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	if !s.searchEnabled(family) {
+		return goserver.Response(503, nil), nil
+	}
+
+	found, err := s.semanticMatches(ctx, familyID, q, int(limit))
+	if errors.Is(err, ai.ErrBudgetExhausted) {
+		s.logger.Info("Semantic search refused: monthly AI budget exhausted", "familyID", familyID)
+		return goserver.Response(429, nil), nil
+	}
+	if err != nil {
+		s.logger.Error("Semantic search failed", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+
+	matches := make([]goserver.SemanticMatch, 0, len(found))
+	for _, f := range found {
+		matches = append(matches, goserver.SemanticMatch{Score: f.score, Item: newItemResponse(f.item)})
+	}
+	return goserver.Response(200, goserver.SemanticSearchResponse{Matches: matches}), nil
+}
+
+// AskDiary - answer a question about the family's diary, citing the entries
+// used. Entries are retrieved by semantic search when the family has it
+// enabled and by keyword search otherwise; only the family's own entries are
+// ever sent to the model.
+func (s *SearchAPIServiceImpl) AskDiary(ctx context.Context, req goserver.AskRequest) (goserver.ImplResponse, error) {
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("Family ID not found in context")
+		return goserver.Response(401, nil), nil
+	}
+
+	question := strings.TrimSpace(req.Question)
+	if question == "" || len(question) > maxQuestionChars {
+		return goserver.Response(400, nil), nil
+	}
+
+	family, err := s.db.GetFamily(familyID)
+	if err != nil {
+		s.logger.Error("Failed to load family for AI gate", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+	if s.answerer == nil || !s.answerer.Enabled() || !family.AISummariesEnabled {
+		return goserver.Response(503, nil), nil
+	}
+
+	var found []scoredItem
+	if s.searchEnabled(family) {
+		found, err = s.semanticMatches(ctx, familyID, question, maxAskEntries)
+	} else {
+		found, err = s.keywordMatches(familyID, question, maxAskEntries)
+	}
+	if errors.Is(err, ai.ErrBudgetExhausted) {
+		s.logger.Info("Ask refused: monthly AI budget exhausted", "familyID", familyID)
+		return goserver.Response(429, nil), nil
+	}
+	if err != nil {
+		s.logger.Error("Ask: retrieval failed", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+
+	entries := make([]ai.EntryText, len(found))
+	titles := make(map[string]string, len(found))
+	for i, f := range found {
+		entries[i] = ai.EntryText{Date: f.item.Date, Title: f.item.Title, Body: f.item.Body}
+		titles[f.item.Date] = f.item.Title
+	}
+
+	answer, err := s.answerer.Answer(ai.WithFamily(ctx, familyID), question, entries)
+	if errors.Is(err, ai.ErrBudgetExhausted) {
+		s.logger.Info("Ask refused: monthly AI budget exhausted", "familyID", familyID)
+		return goserver.Response(429, nil), nil
+	}
+	if err != nil {
+		s.logger.Error("Ask: answer failed", "error", err, "familyID", familyID)
+		return goserver.Response(500, nil), nil
+	}
+
+	citations := make([]goserver.AskCitation, 0, len(answer.Citations))
+	for _, date := range answer.Citations {
+		citations = append(citations, goserver.AskCitation{Date: parseDate(date), Title: titles[date]})
+	}
+	return goserver.Response(200, goserver.AskResponse{Answer: answer.Text, Citations: citations}), nil
+}
+
+// searchEnabled reports whether semantic search is available for the family
+// (embedder configured + family opted in).
+func (s *SearchAPIServiceImpl) searchEnabled(family *models.Family) bool {
+	return s.embedder != nil && s.embedder.Enabled() && family.AISearchEnabled
+}
+
+// semanticMatches returns up to limit of the family's entries most similar to
+// query, best first. Entries with a non-positive similarity are left out.
+func (s *SearchAPIServiceImpl) semanticMatches(
+	ctx context.Context, familyID uuid.UUID, query string, limit int,
+) ([]scoredItem, error) {
+	embeddings, err := s.db.GetItemEmbeddings(familyID, s.embedder.Model())
+	if err != nil || len(embeddings) == 0 {
+		return nil, err
+	}
+	vector, err := s.embedder.EmbedQuery(ai.WithFamily(ctx, familyID), query)
+	if err != nil {
+		return nil, err
+	}
+
+	type scoredDate struct {
+		date  string
+		score float64
+	}
+	ranked := make([]scoredDate, 0, len(embeddings))
+	for _, e := range embeddings {
+		if score := ai.CosineSimilarity(vector, e.Values()); score > 0 {
+			ranked = append(ranked, scoredDate{date: e.Date, score: score})
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].score > ranked[j].score })
+
+	var found []scoredItem
+	for _, r := range ranked {
+		if len(found) == limit {
+			break
+		}
+		item, err := s.db.GetItem(familyID, r.date)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		found = append(found, scoredItem{item: item, score: r.score})
+	}
+	return found, nil
+}
+
+// keywordMatches returns up to limit of the family's entries containing the
+// most distinct words of text, newest first among equals.
+func (s *SearchAPIServiceImpl) keywordMatches(familyID uuid.UUID, text string, limit int) ([]scoredItem, error) {
+	byDate := map[string]*scoredItem{}
+	for _, term := range keywordTerms(text) {
+		items, _, err := s.db.GetItems(familyID, database.SearchParams{SearchText: term})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if f, ok := byDate[item.Date]; ok {
+				f.score++
+			} else {
+				byDate[item.Date] = &scoredItem{item: item, score: 1}
+			}
+		}
+	}
+
+	found := make([]scoredItem, 0, len(byDate))
+	for _, f := range byDate {
+		found = append(found, *f)
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].score != found[j].score {
+			return found[i].score > found[j].score
+		}
+		return found[i].item.Date > found[j].item.Date
+	})
+	if len(found) > limit {
+		found = found[:limit]
+	}
+	return found, nil
+}
+
+// keywordTerms returns the distinct lower-cased words of text worth searching
+// for, in order of appearance.
+func keywordTerms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	seen := map[string]bool{}
+	var terms []string
+	for _, w := range words {
+		if len([]rune(w)) < minKeywordLength || seen[w] {
+			continue
+		}
+		seen[w] = true
+		terms = append(terms, w)
+		if len(terms) == maxKeywordTerms {
+			break
+		}
+	}
+	return terms
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+type SessionsAPIServiceImpl struct {
+	logger *slog.Logger
+	db     database.Storage
+}
+
+func NewSessionsAPIService(logger *slog.Logger, db database.Storage) goserver.SessionsAPIService {
+	return &SessionsAPIServiceImpl{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// GetSessions - list the user's active sign-in sessions
+func (s *SessionsAPIServiceImpl) GetSessions(ctx context.Context) (goserver.ImplResponse, error) {
+	userID, ok := ctx.Value(common.UserIDKey).(uuid.UUID)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+
+	sessions, err := s.db.GetActiveSessions(userID)
+	if err != nil {
+		s.logger.Error("Failed to list sessions", "userID", userID, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	currentID := s.currentSessionID(ctx)
+	res := make([]goserver.Session, len(sessions))
+	for i, session := range sessions {
+		res[i] = session.FromDB(session.ID == currentID)
+	}
+	return goserver.Response(200, res), nil
+}
+
+// RevokeSession - sign out one of the user's sessions
+func (s *SessionsAPIServiceImpl) RevokeSession(ctx context.Context, id uuid.UUID) (goserver.ImplResponse, error) {
+	userID, ok := ctx.Value(common.UserIDKey).(uuid.UUID)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+
+	if err := s.db.RevokeSession(userID, id); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(404, nil), nil
+		}
+		s.logger.Error("Failed to revoke session", "sessionID", id, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	s.logger.Info("Session revoked", "userID", userID, "sessionID", id)
+	return goserver.Response(204, nil), nil
+}
+
+// RevokeOtherSessions - sign out every session except the one making the request
+func (s *SessionsAPIServiceImpl) RevokeOtherSessions(ctx context.Context) (goserver.ImplResponse, error) {
+	userID, ok := ctx.Value(common.UserIDKey).(uuid.UUID)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+
+	// A caller whose token predates session tracking has no session of its
+	// own, so every session goes.
+	currentID := s.currentSessionID(ctx)
+	revoked, err := s.db.RevokeOtherSessions(userID, currentID)
+	if err != nil {
+		s.logger.Error("Failed to revoke other sessions", "userID", userID, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	s.logger.Info("Other sessions revoked", "userID", userID, "kept", currentID, "revoked", revoked)
+	return goserver.Response(200, goserver.RevokedSessions{Revoked: revoked}), nil
+}
+
+// currentSessionID identifies the caller's own session from its access
+// token, or returns uuid.Nil when there is none.
+func (s *SessionsAPIServiceImpl) currentSessionID(ctx context.Context) uuid.UUID {
+	token, ok := ctx.Value(common.AccessTokenKey).(string)
+	if !ok || token == "" {
+		return uuid.Nil
+	}
+	session, err := s.db.GetSessionByAccessToken(token)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			s.logger.Warn("Failed to resolve current session", "error", err)
+		}
+		return uuid.Nil
+	}
+	return session.ID
+}
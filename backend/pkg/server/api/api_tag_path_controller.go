@@ -0,0 +1,77 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+)
+
+// TagPathController serves updateTag and deleteTag for hierarchical tags
+// such as "travel/italy". Routing matches the decoded path, so the generated
+// /v1/tags/{name} routes never see a name containing "/", whether the client
+// escapes it or not; these routes take the rest of the path as the name.
+type TagPathController struct {
+	service      goserver.ItemsAPIService
+	errorHandler goserver.ErrorHandler
+}
+
+// NewTagPathController creates a controller for multi-level tag names.
+func NewTagPathController(service goserver.ItemsAPIService) *TagPathController {
+	return &TagPathController{service: service, errorHandler: goserver.DefaultErrorHandler}
+}
+
+// Routes implements goserver.Router.
+func (c *TagPathController) Routes() goserver.Routes {
+	return goserver.Routes{
+		"UpdateTagPath": goserver.Route{
+			Method:      http.MethodPatch,
+			Pattern:     "/v1/tags/{name:.+/.+}",
+			HandlerFunc: c.UpdateTag,
+		},
+		"DeleteTagPath": goserver.Route{
+			Method:      http.MethodDelete,
+			Pattern:     "/v1/tags/{name:.+/.+}",
+			HandlerFunc: c.DeleteTag,
+		},
+	}
+}
+
+// UpdateTag updates a hierarchical tag; see ItemsAPIService.UpdateTag.
+func (c *TagPathController) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	req := goserver.TagUpdateRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&req); err != nil {
+		c.errorHandler(w, r, &goserver.ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.UpdateTag(r.Context(), mux.Vars(r)["name"], req)
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	_ = goserver.EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DeleteTag deletes a hierarchical tag; see ItemsAPIService.DeleteTag.
+func (c *TagPathController) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	subtree := false
+	if v := r.URL.Query().Get("subtree"); v != "" {
+		var err error
+		if subtree, err = strconv.ParseBool(v); err != nil {
+			c.errorHandler(w, r, &goserver.ParsingError{Param: "subtree", Err: err}, nil)
+			return
+		}
+	}
+
+	result, err := c.service.DeleteTag(r.Context(), mux.Vars(r)["name"], subtree)
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	_ = goserver.EncodeJSONResponse(result.Body, &result.Code, w)
+}
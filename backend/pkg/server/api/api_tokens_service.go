@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	coremodels "github.com/ya-breeze/kin-core/models"
+
+	"github.com/ya-breeze/diary.be/pkg/auth"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+// maxTokenNameLength bounds the user-chosen label of a personal access token.
+const maxTokenNameLength = 100
+
+type TokensAPIServiceImpl struct {
+	logger *slog.Logger
+	db     database.Storage
+}
+
+func NewTokensAPIService(logger *slog.Logger, db database.Storage) goserver.TokensAPIService {
+	return &TokensAPIServiceImpl{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// GetAccessTokens - list the user's active personal access tokens
+func (s *TokensAPIServiceImpl) GetAccessTokens(ctx context.Context) (goserver.ImplResponse, error) {
+	userID, ok := ctx.Value(common.UserIDKey).(uuid.UUID)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+
+	tokens, err := s.db.GetAccessTokens(userID)
+	if err != nil {
+		s.logger.Error("Failed to list access tokens", "userID", userID, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	res := make([]goserver.AccessToken, len(tokens))
+	for i, t := range tokens {
+		res[i] = t.FromDB()
+	}
+	return goserver.Response(200, res), nil
+}
+
+// CreateAccessToken - create a named, scoped personal access token
+func (s *TokensAPIServiceImpl) CreateAccessToken(
+	ctx context.Context, req goserver.CreateAccessTokenRequest,
+) (goserver.ImplResponse, error) {
+	userID, ok := ctx.Value(common.UserIDKey).(uuid.UUID)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+	familyID, ok := common.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > maxTokenNameLength {
+		return goserver.Response(400, nil), nil
+	}
+	if len(req.Scopes) == 0 {
+		return goserver.Response(400, nil), nil
+	}
+	scopes := make(models.StringList, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		if !auth.ValidScope(string(scope)) {
+			return goserver.Response(400, nil), nil
+		}
+		if !slices.Contains(scopes, string(scope)) {
+			scopes = append(scopes, string(scope))
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return goserver.Response(400, nil), nil
+	}
+
+	secret, prefix, hash, err := auth.GeneratePersonalToken()
+	if err != nil {
+		s.logger.Error("Failed to generate access token", "error", err)
+		return goserver.Response(500, nil), nil
+	}
+	token := models.AccessToken{
+		TenantModel: coremodels.TenantModel{FamilyID: familyID},
+		UserID:      userID,
+		Name:        name,
+		TokenHash:   hash,
+		Prefix:      prefix,
+		Scopes:      scopes,
+		ExpiresAt:   req.ExpiresAt,
+	}
+	if err := s.db.CreateAccessToken(&token); err != nil {
+		s.logger.Error("Failed to store access token", "userID", userID, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	s.logger.Info("Personal access token created", "userID", userID, "tokenID", token.ID, "scopes", scopes)
+	return goserver.Response(201, goserver.CreatedAccessToken{
+		Token:  token.FromDB(),
+		Secret: secret,
+	}), nil
+}
+
+// RevokeAccessToken - revoke one of the user's personal access tokens
+func (s *TokensAPIServiceImpl) RevokeAccessToken(ctx context.Context, id uuid.UUID) (goserver.ImplResponse, error) {
+	userID, ok := ctx.Value(common.UserIDKey).(uuid.UUID)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+
+	if err := s.db.RevokeAccessToken(userID, id); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(404, nil), nil
+		}
+		s.logger.Error("Failed to revoke access token", "tokenID", id, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	s.logger.Info("Personal access token revoked", "userID", userID, "tokenID", id)
+	return goserver.Response(204, nil), nil
+}
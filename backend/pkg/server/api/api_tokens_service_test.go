@@ -0,0 +1,110 @@
+package api_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/auth"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/api"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+var _ = Describe("TokensAPIService", func() {
+	var (
+		service goserver.TokensAPIService
+		storage database.Storage
+		ctx     context.Context
+		userID  uuid.UUID
+		tempDir string
+	)
+
+	create := func(name string, scopes ...goserver.AccessTokenScope) goserver.ImplResponse {
+		resp, err := service.CreateAccessToken(ctx, goserver.CreateAccessTokenRequest{Name: name, Scopes: scopes})
+		Expect(err).ToNot(HaveOccurred())
+		return resp
+	}
+
+	BeforeEach(func() {
+		logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		var err error
+		tempDir, err = os.MkdirTemp("", "tokens_test")
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := &config.Config{DataPath: tempDir}
+		storage = database.NewStorage(logger, cfg)
+		Expect(storage.Open()).To(Succeed())
+		service = api.NewTokensAPIService(logger, storage)
+
+		userID = uuid.New()
+		ctx = context.WithValue(context.Background(), common.UserIDKey, userID)
+		ctx = context.WithValue(ctx, common.FamilyIDKey, uuid.New())
+	})
+
+	AfterEach(func() {
+		storage.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	It("creates a token, returns the secret once, and stores only its hash", func() {
+		resp := create("backup script", goserver.ItemsRead, goserver.ItemsRead, goserver.AssetsWrite)
+		Expect(resp.Code).To(Equal(201))
+		created := resp.Body.(goserver.CreatedAccessToken)
+		Expect(created.Secret).To(HavePrefix(auth.PersonalTokenPrefix))
+		Expect(created.Secret).To(HavePrefix(created.Token.Prefix))
+		Expect(created.Token.Scopes).To(Equal([]goserver.AccessTokenScope{goserver.ItemsRead, goserver.AssetsWrite}))
+
+		stored, err := storage.GetAccessTokenByHash(auth.HashPersonalToken(created.Secret))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored.TokenHash).ToNot(Equal(created.Secret))
+		Expect(stored.UserID).To(Equal(userID))
+
+		resp, err = service.GetAccessTokens(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+		list := resp.Body.([]goserver.AccessToken)
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].Name).To(Equal("backup script"))
+	})
+
+	It("validates name, scopes and expiry", func() {
+		Expect(create("  ", goserver.ItemsRead).Code).To(Equal(400))
+		Expect(create("no scopes").Code).To(Equal(400))
+		Expect(create("bad scope", goserver.AccessTokenScope("admin")).Code).To(Equal(400))
+
+		past := time.Now().Add(-time.Hour)
+		resp, err := service.CreateAccessToken(ctx, goserver.CreateAccessTokenRequest{
+			Name: "expired", Scopes: []goserver.AccessTokenScope{goserver.ItemsRead}, ExpiresAt: &past,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(400))
+	})
+
+	It("revokes only the caller's own tokens", func() {
+		created := create("ci", goserver.ItemsWrite).Body.(goserver.CreatedAccessToken)
+
+		otherCtx := context.WithValue(ctx, common.UserIDKey, uuid.New())
+		resp, err := service.RevokeAccessToken(otherCtx, created.Token.Id)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(404))
+
+		resp, err = service.RevokeAccessToken(ctx, created.Token.Id)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(204))
+
+		_, err = storage.GetAccessTokenByHash(auth.HashPersonalToken(created.Secret))
+		Expect(err).To(MatchError(database.ErrNotFound))
+
+		resp, err = service.GetAccessTokens(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Body).To(BeEmpty())
+	})
+})
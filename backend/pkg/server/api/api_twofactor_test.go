@@ -0,0 +1,208 @@
+package api_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kinauth "github.com/ya-breeze/kin-core/auth"
+
+	"github.com/ya-breeze/diary.be/pkg/auth"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/api"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+var _ = Describe("Two-factor authentication", func() {
+	var (
+		authService goserver.AuthAPIService
+		userService goserver.UserAPIService
+		storage     database.Storage
+		cfg         *config.Config
+		userCtx     context.Context
+		user        *models.User
+		tempDir     string
+	)
+
+	const (
+		testEmail = "totp@test.com"
+		testPass  = "testpassword123"
+	)
+
+	codeAt := func(secret string, offset int64) string {
+		code, err := auth.TOTPCode(secret, auth.TOTPStep(time.Now())+offset)
+		Expect(err).ToNot(HaveOccurred())
+		return code
+	}
+
+	// enroll runs enroll + confirm and returns the secret and recovery codes.
+	enroll := func() (string, []string) {
+		resp, err := userService.EnrollTwoFactor(userCtx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+		enrollment := resp.Body.(goserver.TwoFactorEnrollment)
+		Expect(enrollment.ProvisioningUri).To(HavePrefix("otpauth://totp/"))
+
+		resp, err = userService.ConfirmTwoFactor(userCtx, goserver.TwoFactorCodeRequest{
+			Code: codeAt(enrollment.Secret, -1),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+		return enrollment.Secret, resp.Body.(goserver.RecoveryCodesResponse).RecoveryCodes
+	}
+
+	login := func() string {
+		resp, err := authService.Authorize(context.Background(), goserver.AuthData{Email: testEmail, Password: testPass})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(202))
+		return resp.Body.(goserver.TwoFactorChallenge).MfaToken
+	}
+
+	BeforeEach(func() {
+		logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		var err error
+		tempDir, err = os.MkdirTemp("", "twofactor_test")
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg = &config.Config{DataPath: tempDir, JWTSecret: "test-secret-key-for-jwt-tokens"}
+		storage = database.NewStorage(logger, cfg)
+		Expect(storage.Open()).To(Succeed())
+
+		authService = api.NewAuthAPIService(logger, storage, cfg)
+		userService = api.NewUserAPIService(logger, storage)
+
+		family, err := storage.CreateFamily("TestFamily")
+		Expect(err).ToNot(HaveOccurred())
+		hashedPass, err := kinauth.HashPassword(testPass)
+		Expect(err).ToNot(HaveOccurred())
+		user, err = storage.CreateUser(testEmail, hashedPass, family.ID)
+		Expect(err).ToNot(HaveOccurred())
+		userCtx = context.WithValue(context.Background(), common.UserIDKey, user.ID)
+	})
+
+	AfterEach(func() {
+		storage.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	It("keeps single-step login until enrollment is confirmed", func() {
+		resp, err := userService.EnrollTwoFactor(userCtx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+
+		resp, err = authService.Authorize(context.Background(), goserver.AuthData{Email: testEmail, Password: testPass})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+	})
+
+	It("rejects confirmation with a wrong code", func() {
+		_, err := userService.EnrollTwoFactor(userCtx)
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := userService.ConfirmTwoFactor(userCtx, goserver.TwoFactorCodeRequest{Code: "000000x"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(400))
+	})
+
+	It("requires a second step once enabled and issues a token for a valid code", func() {
+		secret, recovery := enroll()
+		Expect(recovery).To(HaveLen(auth.RecoveryCodeCount))
+
+		resp, err := userService.EnrollTwoFactor(userCtx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(409))
+
+		challenge := login()
+
+		// The code used for confirmation cannot be replayed.
+		resp, err = authService.AuthorizeTwoFactor(context.Background(), goserver.TwoFactorLoginRequest{
+			MfaToken: challenge, Code: codeAt(secret, -1),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(401))
+
+		resp, err = authService.AuthorizeTwoFactor(context.Background(), goserver.TwoFactorLoginRequest{
+			MfaToken: challenge, Code: codeAt(secret, 0),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+		token := resp.Body.(goserver.Authorize200Response).Token
+		claims, err := kinauth.ParseToken(token, []byte(cfg.JWTSecret))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(claims.UserID).To(Equal(user.ID))
+	})
+
+	It("accepts each recovery code once", func() {
+		_, recovery := enroll()
+
+		resp, err := authService.AuthorizeTwoFactor(context.Background(), goserver.TwoFactorLoginRequest{
+			MfaToken: login(), Code: recovery[0],
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+
+		resp, err = authService.AuthorizeTwoFactor(context.Background(), goserver.TwoFactorLoginRequest{
+			MfaToken: login(), Code: recovery[0],
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(401))
+	})
+
+	It("rejects a forged or foreign challenge token", func() {
+		secret, _ := enroll()
+		accessToken, err := kinauth.GenerateAccessToken(user.ID, &user.FamilyID, []byte(cfg.JWTSecret), time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+
+		resp, err := authService.AuthorizeTwoFactor(context.Background(), goserver.TwoFactorLoginRequest{
+			MfaToken: accessToken, Code: codeAt(secret, 0),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(401))
+	})
+
+	It("regenerates recovery codes and invalidates the old ones", func() {
+		secret, oldCodes := enroll()
+
+		resp, err := userService.RegenerateRecoveryCodes(userCtx, goserver.TwoFactorCodeRequest{Code: oldCodes[0]})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(400), "recovery codes cannot be used to mint new ones")
+
+		resp, err = userService.RegenerateRecoveryCodes(userCtx, goserver.TwoFactorCodeRequest{Code: codeAt(secret, 0)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+		newCodes := resp.Body.(goserver.RecoveryCodesResponse).RecoveryCodes
+		Expect(newCodes).ToNot(ContainElement(oldCodes[1]))
+
+		resp, err = authService.AuthorizeTwoFactor(context.Background(), goserver.TwoFactorLoginRequest{
+			MfaToken: login(), Code: oldCodes[1],
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(401))
+	})
+
+	It("disables two-factor with a recovery code and restores single-step login", func() {
+		_, recovery := enroll()
+
+		resp, err := userService.DisableTwoFactor(userCtx, goserver.TwoFactorCodeRequest{Code: "123456"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(400))
+
+		resp, err = userService.DisableTwoFactor(userCtx, goserver.TwoFactorCodeRequest{Code: recovery[2]})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(204))
+
+		resp, err = userService.GetUser(userCtx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*resp.Body.(goserver.User).TwoFactorEnabled).To(BeFalse())
+
+		resp, err = authService.Authorize(context.Background(), goserver.AuthData{Email: testEmail, Password: testPass})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+	})
+})
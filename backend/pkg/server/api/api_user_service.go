@@ -6,7 +6,9 @@ import (
 	"log/slog"
 
 	"github.com/google/uuid"
+	"github.com/ya-breeze/diary.be/pkg/auth"
 	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
 	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
 	"github.com/ya-breeze/diary.be/pkg/server/common"
 )
@@ -40,3 +42,131 @@ func (s *UserAPIServiceImpl) GetUser(ctx context.Context) (goserver.ImplResponse
 
 	return goserver.Response(200, user.FromDB()), nil
 }
+
+// totpIssuer is the account issuer shown by authenticator apps.
+const totpIssuer = "Diary"
+
+func (s *UserAPIServiceImpl) currentUser(ctx context.Context) (*models.User, bool) {
+	userID, ok := ctx.Value(common.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, false
+	}
+	user, err := s.db.GetUser(userID)
+	if err != nil {
+		s.logger.Error("Failed to get user", "userID", userID, "error", err)
+		return nil, false
+	}
+	return user, true
+}
+
+// EnrollTwoFactor - generate a new TOTP secret, pending confirmation
+func (s *UserAPIServiceImpl) EnrollTwoFactor(ctx context.Context) (goserver.ImplResponse, error) {
+	user, ok := s.currentUser(ctx)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+	if user.TOTPEnabled {
+		return goserver.Response(409, nil), nil
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		s.logger.Error("Failed to generate TOTP secret", "error", err)
+		return goserver.Response(500, nil), nil
+	}
+	user.TOTPSecret = secret
+	user.TOTPLastStep = 0
+	if err := s.db.PutUser(user); err != nil {
+		s.logger.Error("Failed to update user", "userID", user.ID, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	s.logger.Info("Two-factor enrollment started", "userID", user.ID)
+	return goserver.Response(200, goserver.TwoFactorEnrollment{
+		Secret:          secret,
+		ProvisioningUri: auth.TOTPProvisioningURI(totpIssuer, user.Username, secret),
+	}), nil
+}
+
+// ConfirmTwoFactor - verify the first code from the authenticator and enable 2FA
+func (s *UserAPIServiceImpl) ConfirmTwoFactor(
+	ctx context.Context, req goserver.TwoFactorCodeRequest,
+) (goserver.ImplResponse, error) {
+	user, ok := s.currentUser(ctx)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+	if user.TOTPEnabled || user.TOTPSecret == "" {
+		return goserver.Response(400, nil), nil
+	}
+	if !verifySecondFactor(user, req.Code, false) {
+		return goserver.Response(400, nil), nil
+	}
+
+	codes, hashes, err := auth.GenerateRecoveryCodes()
+	if err != nil {
+		s.logger.Error("Failed to generate recovery codes", "error", err)
+		return goserver.Response(500, nil), nil
+	}
+	user.TOTPEnabled = true
+	user.RecoveryCodes = hashes
+	if err := s.db.PutUser(user); err != nil {
+		s.logger.Error("Failed to update user", "userID", user.ID, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	s.logger.Info("Two-factor enabled", "userID", user.ID)
+	return goserver.Response(200, goserver.RecoveryCodesResponse{RecoveryCodes: codes}), nil
+}
+
+// DisableTwoFactor - turn off 2FA; accepts a TOTP code or a recovery code
+func (s *UserAPIServiceImpl) DisableTwoFactor(
+	ctx context.Context, req goserver.TwoFactorCodeRequest,
+) (goserver.ImplResponse, error) {
+	user, ok := s.currentUser(ctx)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+	if !user.TOTPEnabled || !verifySecondFactor(user, req.Code, true) {
+		return goserver.Response(400, nil), nil
+	}
+
+	user.TOTPEnabled = false
+	user.TOTPSecret = ""
+	user.TOTPLastStep = 0
+	user.RecoveryCodes = nil
+	if err := s.db.PutUser(user); err != nil {
+		s.logger.Error("Failed to update user", "userID", user.ID, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	s.logger.Info("Two-factor disabled", "userID", user.ID)
+	return goserver.Response(204, nil), nil
+}
+
+// RegenerateRecoveryCodes - replace all recovery codes; requires a TOTP code
+func (s *UserAPIServiceImpl) RegenerateRecoveryCodes(
+	ctx context.Context, req goserver.TwoFactorCodeRequest,
+) (goserver.ImplResponse, error) {
+	user, ok := s.currentUser(ctx)
+	if !ok {
+		return goserver.Response(401, nil), nil
+	}
+	if !user.TOTPEnabled || !verifySecondFactor(user, req.Code, false) {
+		return goserver.Response(400, nil), nil
+	}
+
+	codes, hashes, err := auth.GenerateRecoveryCodes()
+	if err != nil {
+		s.logger.Error("Failed to generate recovery codes", "error", err)
+		return goserver.Response(500, nil), nil
+	}
+	user.RecoveryCodes = hashes
+	if err := s.db.PutUser(user); err != nil {
+		s.logger.Error("Failed to update user", "userID", user.ID, "error", err)
+		return goserver.Response(500, nil), nil
+	}
+
+	s.logger.Info("Recovery codes regenerated", "userID", user.ID)
+	return goserver.Response(200, goserver.RecoveryCodesResponse{RecoveryCodes: codes}), nil
+}
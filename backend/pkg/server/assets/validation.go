@@ -19,6 +19,7 @@ import (
 //nolint:gochecknoglobals
 var AllowedExtensions = []string{
 	".jpg", ".jpeg", ".png", ".gif", ".bmp", ".webp", ".mp4", ".mov", ".avi", ".wmv", ".flv", ".mkv",
+	".mp3", ".m4a", ".wav", ".aac", ".flac", ".opus",
 }
 
 // ValidateExtension checks the filename extension against allowed list.
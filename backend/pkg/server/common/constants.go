@@ -5,4 +5,9 @@ type ContextKey string
 const (
 	UserIDKey   ContextKey = "userID"
 	FamilyIDKey ContextKey = "familyID"
+	// AccessTokenKey holds the caller's kin-core access token so the
+	// sign-in session it belongs to can be identified.
+	AccessTokenKey ContextKey = "accessToken"
+	// ClientIPKey holds the caller's IP address for audit records.
+	ClientIPKey ContextKey = "clientIP"
 )
@@ -0,0 +1,53 @@
+package common
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/auth"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+)
+
+// LoginService is the API auth service as the login handlers use it.
+// AuthorizeUser behaves like Authorize and also returns the account the
+// email resolved to (nil if unknown), so a handler can audit the attempt
+// without loading the account again.
+type LoginService interface {
+	goserver.AuthAPIService
+	AuthorizeUser(ctx context.Context, authData goserver.AuthData) (goserver.ImplResponse, *models.User, error)
+}
+
+// ChallengedUser resolves the user a pending second-factor challenge belongs
+// to, or nil if the challenge is invalid.
+func ChallengedUser(db database.Storage, jwtSecret, mfaToken string) *models.User {
+	subject, err := auth.CheckMFAChallenge(mfaToken, jwtSecret)
+	if err != nil {
+		return nil
+	}
+	userID, err := uuid.Parse(subject)
+	if err != nil {
+		return nil
+	}
+	user, err := db.GetUser(userID)
+	if err != nil {
+		return nil
+	}
+	return user
+}
+
+// AuditLogin records a login attempt in the audit log. A failure to record it
+// is logged and does not affect the login.
+func AuditLogin(
+	logger *slog.Logger, db database.Storage, req *http.Request,
+	user *models.User, username, method string, succeeded bool,
+) {
+	event := models.NewLoginAuditEvent(user, username, method, ClientIP(req), succeeded)
+	if err := db.CreateAuditEvent(event); err != nil {
+		logger.Warn("Failed to record login audit event", "error", err)
+	}
+}
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// ClientIP extracts the client IP address from the request.
+// Checks X-Forwarded-For header first (for proxied requests), then falls back to RemoteAddr
+func ClientIP(req *http.Request) string {
+	// Check X-Forwarded-For header (set by proxies like Nginx)
+	if forwardedFor := req.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+		// X-Forwarded-For can contain multiple IPs, take the first one
+		ips := strings.Split(forwardedFor, ",")
+		if len(ips) > 0 {
+			return strings.TrimSpace(ips[0])
+		}
+	}
+
+	// Check X-Real-IP header (alternative proxy header)
+	if realIP := req.Header.Get("X-Real-IP"); realIP != "" {
+		return strings.TrimSpace(realIP)
+	}
+
+	// Fall back to RemoteAddr
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return ip
+}
+
+// WithClientIP returns the request context carrying the client IP under
+// ClientIPKey, for public endpoints that AuthMiddleware does not annotate.
+func WithClientIP(req *http.Request) context.Context {
+	return context.WithValue(req.Context(), ClientIPKey, ClientIP(req))
+}
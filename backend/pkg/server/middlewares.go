@@ -2,32 +2,46 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"log"
 	"log/slog"
-	"net"
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"github.com/ya-breeze/diary.be/pkg/auth"
 	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
 	"github.com/ya-breeze/diary.be/pkg/server/common"
 	kincookies "github.com/ya-breeze/kin-core/cookies"
 	kinmiddleware "github.com/ya-breeze/kin-core/middleware"
 	"golang.org/x/time/rate"
-	"gorm.io/gorm"
 )
 
 // skipAuthPaths lists paths that don't require authentication.
 var skipAuthPaths = map[string]bool{
-	"/v1/authorize": true,
-	"/auth/refresh": true,
+	"/v1/authorize":     true,
+	"/v1/authorize/2fa": true,
+	"/auth/refresh":     true,
 }
 
-func AuthMiddleware(logger *slog.Logger, cfg *config.Config, db *gorm.DB) mux.MiddlewareFunc {
+// rateLimitedPaths lists the credential-checking endpoints guarded by RateLimitMiddleware.
+var rateLimitedPaths = map[string]bool{
+	"/v1/authorize":     true,
+	"/v1/authorize/2fa": true,
+	"/web/login":        true,
+	"/web/login/2fa":    true,
+}
+
+func AuthMiddleware(logger *slog.Logger, cfg *config.Config, storage database.Storage) mux.MiddlewareFunc {
 	kinCfg := kinmiddleware.Config{
 		JWTSecret: []byte(cfg.JWTSecret),
-		DB:        db,
+		DB:        storage.GetDB(),
 		CookieCfg: kincookies.Config{Secure: cfg.CookieSecure},
 	}
 
@@ -46,8 +60,12 @@ func AuthMiddleware(logger *slog.Logger, cfg *config.Config, db *gorm.DB) mux.Mi
 			// Fall back to Authorization: Bearer header for API clients.
 			if bearer := req.Header.Get("Authorization"); bearer != "" &&
 				strings.HasPrefix(bearer, "Bearer ") {
+				token := strings.TrimPrefix(bearer, "Bearer ")
+				if auth.IsPersonalToken(token) {
+					authenticatePersonalToken(logger, storage, token, next, writer, req)
+					return
+				}
 				if c, _ := req.Cookie("kin_access"); c == nil {
-					token := strings.TrimPrefix(bearer, "Bearer ")
 					req.AddCookie(&http.Cookie{Name: "kin_access", Value: token})
 				}
 			}
@@ -59,6 +77,22 @@ func AuthMiddleware(logger *slog.Logger, cfg *config.Config, db *gorm.DB) mux.Mi
 				return
 			}
 
+			// A signed-out session's access token stays valid as a JWT until
+			// it expires; the revocation list cuts it off right away. Storage
+			// answers from memory, so this costs no query per request.
+			accessToken := kincookies.GetAccessToken(req)
+			revoked, err := storage.IsSessionTokenRevoked(accessToken)
+			if err != nil {
+				logger.Error("Failed to check session revocation", "path", req.URL.Path, "error", err)
+				http.Error(writer, "Internal Server Error", http.StatusInternalServerError)
+				return
+			}
+			if revoked {
+				logger.Warn("Unauthorized request with a signed-out session's token", "path", req.URL.Path)
+				http.Error(writer, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
 			logger.Info("Request authenticated",
 				"userID", claims.UserID,
 				"familyID", claims.FamilyID,
@@ -70,14 +104,100 @@ func AuthMiddleware(logger *slog.Logger, cfg *config.Config, db *gorm.DB) mux.Mi
 			if claims.FamilyID != nil {
 				ctx = context.WithValue(ctx, common.FamilyIDKey, *claims.FamilyID)
 			}
+			ctx = context.WithValue(ctx, common.AccessTokenKey, accessToken)
+			ctx = context.WithValue(ctx, common.ClientIPKey, common.ClientIP(req))
 			next.ServeHTTP(writer, req.WithContext(ctx))
 		})
 	}
 }
 
+// tokenLastUsedGranularity throttles last-used bookkeeping to avoid a DB write
+// on every request made with a personal access token.
+const tokenLastUsedGranularity = time.Minute
+
+// authenticatePersonalToken resolves a personal access token, enforces its
+// scopes for the requested route, and forwards the request with the owner's
+// user and family in the context.
+func authenticatePersonalToken(
+	logger *slog.Logger, storage database.Storage, token string,
+	next http.Handler, writer http.ResponseWriter, req *http.Request,
+) {
+	pat, err := storage.GetAccessTokenByHash(auth.HashPersonalToken(token))
+	now := time.Now()
+	if err != nil || pat.Expired(now) {
+		logger.Warn("Unauthorized request with personal access token", "path", req.URL.Path, "error", err)
+		http.Error(writer, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	scope := personalTokenScope(req)
+	if scope == "" || !pat.HasScope(scope) {
+		logger.Warn("Personal access token lacks scope",
+			"tokenID", pat.ID, "path", req.URL.Path, "method", req.Method, "required", scope)
+		http.Error(writer, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	if pat.LastUsedAt == nil || now.Sub(*pat.LastUsedAt) >= tokenLastUsedGranularity {
+		if err := storage.TouchAccessToken(pat.ID, now); err != nil {
+			logger.Warn("Failed to record access token use", "tokenID", pat.ID, "error", err)
+		}
+	}
+
+	logger.Info("Request authenticated with personal access token",
+		"userID", pat.UserID,
+		"familyID", pat.FamilyID,
+		"tokenID", pat.ID,
+		"path", req.URL.Path,
+		"method", req.Method,
+	)
+
+	ctx := context.WithValue(req.Context(), common.UserIDKey, pat.UserID)
+	ctx = context.WithValue(ctx, common.FamilyIDKey, pat.FamilyID)
+	ctx = context.WithValue(ctx, common.ClientIPKey, common.ClientIP(req))
+	next.ServeHTTP(writer, req.WithContext(ctx))
+}
+
+// personalTokenScope returns the scope a personal access token needs for the
+// request, or "" if tokens may not call the route at all (account, family,
+// health, and token management stay session-only).
+func personalTokenScope(req *http.Request) string {
+	path := req.URL.Path
+	read := req.Method == http.MethodGet || req.Method == http.MethodHead
+	switch {
+	case path == "/v1/assets/batch":
+		return auth.ScopeAssetsWrite
+	case path == "/v1/assets", path == "/v1/assets/list", path == "/v1/recaps", path == "/v1/jobs",
+		path == "/v1/places", path == "/v1/memories", path == "/v1/stats":
+		if read {
+			return auth.ScopeItemsRead
+		}
+	case path == "/v1/ask":
+		// Asking reads the diary without changing it.
+		return auth.ScopeItemsRead
+	case hasPathPrefix(path, "/v1/items"), hasPathPrefix(path, "/v1/tags"), hasPathPrefix(path, "/v1/sync"),
+		hasPathPrefix(path, "/v1/fields"):
+		if read {
+			return auth.ScopeItemsRead
+		}
+		return auth.ScopeItemsWrite
+	}
+	return ""
+}
+
+// hasPathPrefix reports whether path is prefix itself or a sub-path of it.
+func hasPathPrefix(path, prefix string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// rateLimitAuditInterval throttles audit events for a client that keeps
+// hammering a limited endpoint to one per key and interval.
+const rateLimitAuditInterval = time.Minute
+
 // RateLimiterStore manages per-IP rate limiters for authentication endpoints
 type RateLimiterStore struct {
 	limiters map[string]*rate.Limiter
+	audited  map[string]time.Time
 	mu       sync.RWMutex
 }
 
@@ -85,7 +205,21 @@ type RateLimiterStore struct {
 func NewRateLimiterStore() *RateLimiterStore {
 	return &RateLimiterStore{
 		limiters: make(map[string]*rate.Limiter),
+		audited:  make(map[string]time.Time),
+	}
+}
+
+// shouldAudit reports whether a rejection for key should be written to the
+// audit log, and if so remembers it until rateLimitAuditInterval has passed.
+func (s *RateLimiterStore) shouldAudit(key string, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if last, ok := s.audited[key]; ok && now.Sub(last) < rateLimitAuditInterval {
+		return false
 	}
+	s.audited[key] = now
+	return true
 }
 
 // GetLimiter returns a rate limiter for the given IP address
@@ -103,21 +237,40 @@ func (s *RateLimiterStore) GetLimiter(ip string) *rate.Limiter {
 	return limiter
 }
 
-// RateLimitMiddleware creates a middleware that applies rate limiting to the /v1/authorize endpoint
-func RateLimitMiddleware(logger *slog.Logger, store *RateLimiterStore, disableRateLimit bool) mux.MiddlewareFunc {
+// RateLimitMiddleware creates a middleware that applies rate limiting to the login endpoints.
+// Rejections are recorded in the audit log of the family whose account the
+// attempt named (see rateLimitedUser); attempts naming no known account are
+// only visible from the CLI.
+func RateLimitMiddleware(
+	logger *slog.Logger, store *RateLimiterStore, storage database.Storage, cfg *config.Config,
+) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
-			// Only apply rate limiting to the authorize endpoint if not disabled
-			if !disableRateLimit && req.URL.Path == "/v1/authorize" && req.Method == "POST" {
+			// Only apply rate limiting to the login endpoints if not disabled
+			if !cfg.DisableRateLimit && rateLimitedPaths[req.URL.Path] && req.Method == "POST" {
 				// Extract client IP address
 				clientIP := getClientIP(req)
 
-				// Get rate limiter for this IP
-				limiter := store.GetLimiter(clientIP)
+				// Get rate limiter for this IP; each endpoint has its own budget so the
+				// second login step isn't starved by the first.
+				key := req.URL.Path + " " + clientIP
+				limiter := store.GetLimiter(key)
 
 				// Check if request is allowed
 				if !limiter.Allow() {
 					logger.Warn("Rate limit exceeded for auth endpoint", "ip", clientIP, "path", req.URL.Path)
+					actor := models.AuditActor{IPAddress: clientIP}
+					familyID := uuid.Nil
+					if user := rateLimitedUser(req, storage, cfg.JWTSecret); user != nil {
+						actor.Name = user.Username
+						familyID = user.FamilyID
+					}
+					if store.shouldAudit(key+" "+familyID.String(), time.Now()) {
+						event := models.NewAuditEvent(familyID, actor, models.AuditRateLimited, req.URL.Path)
+						if err := storage.CreateAuditEvent(event); err != nil {
+							logger.Warn("Failed to record rate limit audit event", "error", err)
+						}
+					}
 					http.Error(writer, "Too Many Requests", http.StatusTooManyRequests)
 					return
 				}
@@ -128,27 +281,47 @@ func RateLimitMiddleware(logger *slog.Logger, store *RateLimiterStore, disableRa
 	}
 }
 
-// getClientIP extracts the client IP address from the request
-// Checks X-Forwarded-For header first (for proxied requests), then falls back to RemoteAddr
-func getClientIP(req *http.Request) string {
-	// Check X-Forwarded-For header (set by proxies like Nginx)
-	if forwardedFor := req.Header.Get("X-Forwarded-For"); forwardedFor != "" {
-		// X-Forwarded-For can contain multiple IPs, take the first one
-		ips := strings.Split(forwardedFor, ",")
-		if len(ips) > 0 {
-			return strings.TrimSpace(ips[0])
+// maxLoginBodyBytes bounds how much of a rejected login request is read to
+// find the account it named.
+const maxLoginBodyBytes = 64 << 10
+
+// rateLimitedUser returns the account a rejected login attempt named, by the
+// submitted username or second-factor challenge, or nil if none matches. The
+// request is rejected afterwards, so its body need not be preserved.
+func rateLimitedUser(req *http.Request, storage database.Storage, jwtSecret string) *models.User {
+	var username, mfaToken string
+	switch req.URL.Path {
+	case "/v1/authorize", "/v1/authorize/2fa":
+		var body struct {
+			Email    string `json:"email"`
+			MfaToken string `json:"mfaToken"`
+		}
+		if err := json.NewDecoder(io.LimitReader(req.Body, maxLoginBodyBytes)).Decode(&body); err != nil {
+			return nil
 		}
+		username, mfaToken = body.Email, body.MfaToken
+	case "/web/login", "/web/login/2fa":
+		req.Body = http.MaxBytesReader(nil, req.Body, maxLoginBodyBytes)
+		if err := req.ParseForm(); err != nil {
+			return nil
+		}
+		username, mfaToken = req.PostForm.Get("username"), req.PostForm.Get("mfa_token")
 	}
 
-	// Check X-Real-IP header (alternative proxy header)
-	if realIP := req.Header.Get("X-Real-IP"); realIP != "" {
-		return strings.TrimSpace(realIP)
+	switch {
+	case mfaToken != "":
+		return common.ChallengedUser(storage, jwtSecret, mfaToken)
+	case username != "":
+		user, err := storage.GetUserByUsername(username)
+		if err != nil {
+			return nil
+		}
+		return user
 	}
+	return nil
+}
 
-	// Fall back to RemoteAddr
-	ip, _, err := net.SplitHostPort(req.RemoteAddr)
-	if err != nil {
-		return req.RemoteAddr
-	}
-	return ip
+// getClientIP extracts the client IP address from the request.
+func getClientIP(req *http.Request) string {
+	return common.ClientIP(req)
 }
@@ -1,10 +1,12 @@
 package server
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,9 +14,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	kinauth "github.com/ya-breeze/kin-core/auth"
+	coremodels "github.com/ya-breeze/kin-core/models"
 
+	"github.com/ya-breeze/diary.be/pkg/auth"
 	"github.com/ya-breeze/diary.be/pkg/config"
 	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
 )
 
 func TestMiddlewares(t *testing.T) {
@@ -60,7 +66,7 @@ var _ = Describe("AuthMiddleware", func() {
 
 	Context("when authenticating requests", func() {
 		It("should allow request with valid Authorization header (Bearer token)", func() {
-			middleware := AuthMiddleware(logger, cfg, storage.GetDB())
+			middleware := AuthMiddleware(logger, cfg, storage)
 			handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusOK)
 			}))
@@ -77,7 +83,7 @@ var _ = Describe("AuthMiddleware", func() {
 		})
 
 		It("should allow request with valid kin_access cookie", func() {
-			middleware := AuthMiddleware(logger, cfg, storage.GetDB())
+			middleware := AuthMiddleware(logger, cfg, storage)
 			handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusOK)
 			}))
@@ -94,7 +100,7 @@ var _ = Describe("AuthMiddleware", func() {
 		})
 
 		It("should reject request with no auth", func() {
-			middleware := AuthMiddleware(logger, cfg, storage.GetDB())
+			middleware := AuthMiddleware(logger, cfg, storage)
 			handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusOK)
 			}))
@@ -107,7 +113,7 @@ var _ = Describe("AuthMiddleware", func() {
 		})
 
 		It("should reject request with invalid token", func() {
-			middleware := AuthMiddleware(logger, cfg, storage.GetDB())
+			middleware := AuthMiddleware(logger, cfg, storage)
 			handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusOK)
 			}))
@@ -122,21 +128,141 @@ var _ = Describe("AuthMiddleware", func() {
 	})
 })
 
+var _ = Describe("AuthMiddleware with personal access tokens", func() {
+	var (
+		logger   *slog.Logger
+		cfg      *config.Config
+		storage  database.Storage
+		tempDir  string
+		userID   uuid.UUID
+		familyID uuid.UUID
+		handler  http.Handler
+		seen     context.Context
+	)
+
+	createToken := func(scopes []string, expiresAt *time.Time) (string, *models.AccessToken) {
+		secret, prefix, hash, err := auth.GeneratePersonalToken()
+		Expect(err).NotTo(HaveOccurred())
+		token := &models.AccessToken{
+			TenantModel: coremodels.TenantModel{FamilyID: familyID},
+			UserID:      userID,
+			Name:        "script",
+			TokenHash:   hash,
+			Prefix:      prefix,
+			Scopes:      scopes,
+			ExpiresAt:   expiresAt,
+		}
+		Expect(storage.CreateAccessToken(token)).To(Succeed())
+		return secret, token
+	}
+
+	serve := func(method, path, secret string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+secret)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	BeforeEach(func() {
+		logger = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		var err error
+		tempDir, err = os.MkdirTemp("", "middleware_pat_test")
+		Expect(err).NotTo(HaveOccurred())
+		cfg = &config.Config{JWTSecret: "secret", DataPath: tempDir}
+		storage = database.NewStorage(logger, cfg)
+		Expect(storage.Open()).To(Succeed())
+
+		userID = uuid.New()
+		familyID = uuid.New()
+		seen = nil
+		handler = AuthMiddleware(logger, cfg, storage)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seen = r.Context()
+			w.WriteHeader(http.StatusOK)
+		}))
+	})
+
+	AfterEach(func() {
+		storage.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	It("authenticates a read-scoped token on item reads and sets the owner in context", func() {
+		secret, token := createToken([]string{auth.ScopeItemsRead}, nil)
+
+		Expect(serve("GET", "/v1/items?date=2024-01-01", secret)).To(Equal(http.StatusOK))
+		Expect(seen.Value(common.UserIDKey)).To(Equal(userID))
+		Expect(seen.Value(common.FamilyIDKey)).To(Equal(familyID))
+
+		tokens, err := storage.GetAccessTokens(userID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tokens).To(HaveLen(1))
+		Expect(tokens[0].ID).To(Equal(token.ID))
+		Expect(tokens[0].LastUsedAt).NotTo(BeNil())
+	})
+
+	It("enforces scopes per route", func() {
+		readSecret, _ := createToken([]string{auth.ScopeItemsRead}, nil)
+		Expect(serve("PUT", "/v1/items", readSecret)).To(Equal(http.StatusForbidden))
+		Expect(serve("POST", "/v1/assets/batch", readSecret)).To(Equal(http.StatusForbidden))
+
+		writeSecret, _ := createToken([]string{auth.ScopeItemsWrite, auth.ScopeAssetsWrite}, nil)
+		Expect(serve("PUT", "/v1/items", writeSecret)).To(Equal(http.StatusOK))
+		Expect(serve("DELETE", "/v1/tags/old", writeSecret)).To(Equal(http.StatusOK))
+		Expect(serve("POST", "/v1/assets/batch", writeSecret)).To(Equal(http.StatusOK))
+		Expect(serve("GET", "/v1/items", writeSecret)).To(Equal(http.StatusForbidden))
+	})
+
+	It("never lets a token reach account or token management routes", func() {
+		secret, _ := createToken([]string{auth.ScopeItemsRead, auth.ScopeItemsWrite, auth.ScopeAssetsWrite}, nil)
+		Expect(serve("GET", "/v1/tokens", secret)).To(Equal(http.StatusForbidden))
+		Expect(serve("POST", "/v1/tokens", secret)).To(Equal(http.StatusForbidden))
+		Expect(serve("GET", "/v1/user", secret)).To(Equal(http.StatusForbidden))
+		Expect(serve("PATCH", "/v1/family", secret)).To(Equal(http.StatusForbidden))
+		Expect(serve("GET", "/v1/itemsfoo", secret)).To(Equal(http.StatusForbidden))
+	})
+
+	It("rejects unknown, revoked and expired tokens", func() {
+		Expect(serve("GET", "/v1/items", auth.PersonalTokenPrefix+"unknown")).To(Equal(http.StatusUnauthorized))
+
+		secret, token := createToken([]string{auth.ScopeItemsRead}, nil)
+		Expect(storage.RevokeAccessToken(userID, token.ID)).To(Succeed())
+		Expect(serve("GET", "/v1/items", secret)).To(Equal(http.StatusUnauthorized))
+
+		past := time.Now().Add(-time.Minute)
+		expired, _ := createToken([]string{auth.ScopeItemsRead}, &past)
+		Expect(serve("GET", "/v1/items", expired)).To(Equal(http.StatusUnauthorized))
+	})
+})
+
 var _ = Describe("RateLimitMiddleware", func() {
 	var (
-		logger *slog.Logger
-		store  *RateLimiterStore
+		logger  *slog.Logger
+		store   *RateLimiterStore
+		storage database.Storage
+		tempDir string
 	)
 
 	BeforeEach(func() {
 		logger = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
 		store = NewRateLimiterStore()
+
+		var err error
+		tempDir, err = os.MkdirTemp("", "ratelimit_test")
+		Expect(err).NotTo(HaveOccurred())
+		storage = database.NewStorage(logger, &config.Config{DataPath: tempDir})
+		Expect(storage.Open()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		storage.Close()
+		os.RemoveAll(tempDir)
 	})
 
 	Describe("Rate limiting on /v1/authorize endpoint", func() {
 		Context("when rate limiting is enabled", func() {
 			It("should allow first request", func() {
-				middleware := RateLimitMiddleware(logger, store, false)
+				middleware := RateLimitMiddleware(logger, store, storage, &config.Config{})
 				handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 					w.WriteHeader(http.StatusOK)
 				}))
@@ -150,7 +276,7 @@ var _ = Describe("RateLimitMiddleware", func() {
 			})
 
 			It("should reject rapid successive requests", func() {
-				middleware := RateLimitMiddleware(logger, store, false)
+				middleware := RateLimitMiddleware(logger, store, storage, &config.Config{})
 				handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 					w.WriteHeader(http.StatusOK)
 				}))
@@ -171,7 +297,7 @@ var _ = Describe("RateLimitMiddleware", func() {
 			})
 
 			It("should apply rate limiting per IP address", func() {
-				middleware := RateLimitMiddleware(logger, store, false)
+				middleware := RateLimitMiddleware(logger, store, storage, &config.Config{})
 				handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 					w.WriteHeader(http.StatusOK)
 				}))
@@ -191,8 +317,98 @@ var _ = Describe("RateLimitMiddleware", func() {
 				Expect(w.Code).To(Equal(http.StatusOK))
 			})
 
+			It("should limit the second-factor endpoint with its own budget", func() {
+				middleware := RateLimitMiddleware(logger, store, storage, &config.Config{})
+				handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}))
+
+				// Password step consumes the /v1/authorize budget only
+				req := httptest.NewRequest("POST", "/v1/authorize", nil)
+				req.RemoteAddr = "127.0.0.1:12345"
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, req)
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				req = httptest.NewRequest("POST", "/v1/authorize/2fa", nil)
+				req.RemoteAddr = "127.0.0.1:12345"
+				w = httptest.NewRecorder()
+				handler.ServeHTTP(w, req)
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				// Rapid code guessing is rejected
+				req = httptest.NewRequest("POST", "/v1/authorize/2fa", nil)
+				req.RemoteAddr = "127.0.0.1:12345"
+				w = httptest.NewRecorder()
+				handler.ServeHTTP(w, req)
+				Expect(w.Code).To(Equal(http.StatusTooManyRequests))
+			})
+
+			It("should record throttled rejections in the audit log", func() {
+				middleware := RateLimitMiddleware(logger, store, storage, &config.Config{})
+				handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}))
+
+				for i := 0; i < 4; i++ {
+					req := httptest.NewRequest("POST", "/v1/authorize", nil)
+					req.RemoteAddr = "203.0.113.9:12345"
+					handler.ServeHTTP(httptest.NewRecorder(), req)
+				}
+
+				// Three rejections within a minute leave a single event
+				events, err := storage.GetAuditEvents(database.AuditFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].Action).To(Equal(models.AuditRateLimited))
+				Expect(events[0].IPAddress).To(Equal("203.0.113.9"))
+				Expect(events[0].Target).To(Equal("/v1/authorize"))
+				Expect(events[0].FamilyID).To(Equal(uuid.Nil))
+			})
+
+			It("should attribute a rejection to the family of the named account", func() {
+				family, err := storage.CreateFamily("Smiths")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = storage.CreateUser("anna@example.com", "hash", family.ID)
+				Expect(err).NotTo(HaveOccurred())
+
+				middleware := RateLimitMiddleware(logger, store, storage, &config.Config{})
+				handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}))
+
+				post := func(path, contentType, body string) int {
+					req := httptest.NewRequest("POST", path, strings.NewReader(body))
+					req.Header.Set("Content-Type", contentType)
+					req.RemoteAddr = "203.0.113.9:12345"
+					w := httptest.NewRecorder()
+					handler.ServeHTTP(w, req)
+					return w.Code
+				}
+				for range 2 {
+					post("/v1/authorize", "application/json", `{"email":"anna@example.com","password":"guess"}`)
+				}
+				for range 2 {
+					post("/web/login", "application/x-www-form-urlencoded", "username=nobody&password=guess")
+				}
+
+				events, err := storage.GetAuditEvents(database.AuditFilter{FamilyID: &family.ID})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].Action).To(Equal(models.AuditRateLimited))
+				Expect(events[0].Actor).To(Equal("anna@example.com"))
+				Expect(events[0].Target).To(Equal("/v1/authorize"))
+
+				// An unknown username stays unattributed.
+				all, err := storage.GetAuditEvents(database.AuditFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(all).To(HaveLen(2))
+				Expect(all[0].Target).To(Equal("/web/login"))
+				Expect(all[0].FamilyID).To(Equal(uuid.Nil))
+			})
+
 			It("should not apply rate limiting to non-authorize endpoints", func() {
-				middleware := RateLimitMiddleware(logger, store, false)
+				middleware := RateLimitMiddleware(logger, store, storage, &config.Config{})
 				handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 					w.WriteHeader(http.StatusOK)
 				}))
@@ -210,7 +426,7 @@ var _ = Describe("RateLimitMiddleware", func() {
 
 		Context("when rate limiting is disabled", func() {
 			It("should allow unlimited requests", func() {
-				middleware := RateLimitMiddleware(logger, store, true)
+				middleware := RateLimitMiddleware(logger, store, storage, &config.Config{DisableRateLimit: true})
 				handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 					w.WriteHeader(http.StatusOK)
 				}))
@@ -18,12 +18,14 @@ import (
 	"github.com/ya-breeze/diary.be/pkg/config"
 	"github.com/ya-breeze/diary.be/pkg/database"
 	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/memories"
 	"github.com/ya-breeze/diary.be/pkg/server/api"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
 	"github.com/ya-breeze/diary.be/pkg/server/tasks"
 	"github.com/ya-breeze/diary.be/pkg/server/webapp"
+	"github.com/ya-breeze/diary.be/pkg/weather"
 	kinauth "github.com/ya-breeze/kin-core/auth"
 	"github.com/ya-breeze/kin-core/authdb"
-	"gorm.io/gorm"
 )
 
 func Server(logger *slog.Logger, cfg *config.Config) error {
@@ -51,17 +53,24 @@ func Server(logger *slog.Logger, cfg *config.Config) error {
 }
 
 func createControllers(
-	logger *slog.Logger, cfg *config.Config, db database.Storage,
-	checkerTask *tasks.CheckerTask, suggester ai.Suggester,
+	logger *slog.Logger, cfg *config.Config, db database.Storage, authService common.LoginService,
+	checkerTask *tasks.CheckerTask, suggester ai.Suggester, embedder ai.Embedder, transcriber ai.Transcriber,
+	weatherProvider weather.Provider,
 ) goserver.CustomControllers {
 	return goserver.CustomControllers{
-		AuthAPIService:   api.NewAuthAPIService(logger, db, cfg),
-		FamilyAPIService: api.NewFamilyAPIService(logger, db),
-		UserAPIService:   api.NewUserAPIService(logger, db),
-		AssetsAPIService: api.NewAssetsAPIService(logger, cfg),
-		HealthAPIService: api.NewHealthAPIServiceImpl(checkerTask),
-		ItemsAPIService:  api.NewItemsAPIService(logger, db, suggester, cfg.DataPath),
-		SyncAPIService:   api.NewSyncAPIService(logger, db),
+		AuditAPIService:    api.NewAuditAPIService(logger, db),
+		AuthAPIService:     authService,
+		FamilyAPIService:   api.NewFamilyAPIService(logger, db, cfg.AIMonthlyTokenBudget),
+		UserAPIService:     api.NewUserAPIService(logger, db),
+		AssetsAPIService:   api.NewAssetsAPIService(logger, cfg, db),
+		HealthAPIService:   api.NewHealthAPIServiceImpl(checkerTask, db),
+		ItemsAPIService:    api.NewItemsAPIService(logger, db, suggester, transcriber, weatherProvider, cfg.DataPath),
+		JobsAPIService:     api.NewJobsAPIService(logger, db),
+		RecapsAPIService:   api.NewRecapsAPIService(logger, db),
+		SearchAPIService:   api.NewSearchAPIService(logger, db, embedder, ai.NewAnswerer(suggester)),
+		SessionsAPIService: api.NewSessionsAPIService(logger, db),
+		SyncAPIService:     api.NewSyncAPIService(logger, db),
+		TokensAPIService:   api.NewTokensAPIService(logger, db),
 	}
 }
 
@@ -117,36 +126,94 @@ func Serve(
 			case <-ticker.C:
 				authdb.CleanupExpiredBlacklist(gormDB)
 				authdb.CleanupExpiredRefreshTokens(gormDB)
+				if err := storage.DeleteInactiveSessions(); err != nil {
+					logger.Warn("Failed to clean up sessions", "error", err)
+				}
 			}
 		}
 	}()
 
-	// Construct the AI tag suggester (disabled gracefully if GEMINI_API_KEY unset)
-	suggester, err := ai.NewSuggester(ctx, logger)
+	// Construct the AI tag suggester (disabled gracefully if the provider is not configured)
+	suggester, err := ai.NewSuggester(ctx, logger, cfg)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create AI suggester: %w", err)
 	}
+	suggester = ai.NewMeteredSuggester(suggester, storage, cfg.AIMonthlyTokenBudget, logger)
+	cacheTTL, err := cfg.SuggestionCacheTTL()
+	if err != nil {
+		return nil, nil, err
+	}
+	suggester = ai.NewCachingSuggester(suggester, storage, cacheTTL, logger)
+
+	// Construct the semantic search embedder (disabled gracefully like the suggester)
+	embedder, err := ai.NewEmbedder(ctx, logger, cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create AI embedder: %w", err)
+	}
+	embedder = ai.NewMeteredEmbedder(embedder, storage, cfg.AIMonthlyTokenBudget, logger)
+
+	// Construct the voice memo transcriber (disabled gracefully like the suggester)
+	transcriber, err := ai.NewTranscriber(ctx, logger, cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create AI transcriber: %w", err)
+	}
+	transcriber = ai.NewMeteredTranscriber(transcriber, storage, cfg.AIMonthlyTokenBudget, logger)
+
+	// Construct the weather provider for entries with coordinates (disabled unless configured)
+	weatherProvider, err := weather.NewProvider(logger, cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create weather provider: %w", err)
+	}
+
+	// Construct the notifier delivering the daily memories digest (disabled unless configured)
+	memoriesNotifier, err := memories.NewNotifier(logger, cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create memories notifier: %w", err)
+	}
 
 	// Start background health-check task (includes the AI backfill check)
 	checkerTask := tasks.NewCheckerTask(logger, storage, cfg, suggester)
 	checkerTask.Start(ctx)
 
+	// Start the AI job workers that run the queued backfill (no-op unless an AI provider is configured)
+	aiJobQueue := tasks.NewAIJobQueue(logger, storage, cfg, suggester, checkerTask)
+	aiJobQueue.Start(ctx)
+
 	// Start background backup task
 	backupTask := tasks.NewBackupTask(logger, cfg)
 	backupTask.Start(ctx)
 
+	// Start background recap task (no-op unless an AI provider is configured)
+	recapTask := tasks.NewRecapTask(logger, storage, cfg, ai.NewSummarizer(suggester))
+	recapTask.Start(ctx)
+
+	// Start background embedding task (no-op unless an embedding provider is configured)
+	embeddingTask := tasks.NewEmbeddingTask(logger, storage, cfg, embedder)
+	embeddingTask.Start(ctx)
+
+	// Start background image caption task (no-op unless an AI provider is configured)
+	captionTask := tasks.NewCaptionTask(logger, storage, cfg, ai.NewCaptioner(suggester))
+	captionTask.Start(ctx)
+
+	// Start background memories digest task (no-op unless a notifier is configured)
+	memoriesTask := tasks.NewMemoriesDigestTask(logger, storage, cfg, memoriesNotifier)
+	memoriesTask.Start(ctx)
+
 	// Create controllers
-	controllers := createControllers(logger, cfg, storage, checkerTask, suggester)
+	authService := api.NewAuthAPIService(logger, storage, cfg)
+	controllers := createControllers(logger, cfg, storage, authService, checkerTask, suggester, embedder, transcriber,
+		weatherProvider)
 
 	// Add extra routers
-	extraRouters := []goserver.Router{webapp.NewWebAppRouter(controllers, commit, logger, cfg, storage, gormDB)}
+	extraRouters := []goserver.Router{webapp.NewWebAppRouter(controllers, authService, commit, logger, cfg, storage, gormDB)}
 	extraRouters = append(extraRouters, api.NewAssetsBatchRouter(logger, cfg))
-	extraRouters = append(extraRouters, api.NewCustomAuthAPIController(controllers.AuthAPIService, logger, cfg, storage, gormDB))
+	extraRouters = append(extraRouters, api.NewCustomAuthAPIController(authService, logger, cfg, storage, gormDB))
+	extraRouters = append(extraRouters, api.NewTagPathController(controllers.ItemsAPIService))
 
 	return goserver.Serve(ctx, logger, cfg,
 		controllers,
 		extraRouters,
-		createMiddlewares(logger, cfg, gormDB)...)
+		createMiddlewares(logger, cfg, storage)...)
 }
 
 // upsertSeedUser creates or updates a user from a "Family:Username:Password" entry.
@@ -196,10 +263,10 @@ func upsertSeedUser(storage database.Storage, entry string, logger *slog.Logger)
 	return nil
 }
 
-func createMiddlewares(logger *slog.Logger, cfg *config.Config, gormDB *gorm.DB) []mux.MiddlewareFunc {
+func createMiddlewares(logger *slog.Logger, cfg *config.Config, storage database.Storage) []mux.MiddlewareFunc {
 	rateLimiterStore := NewRateLimiterStore()
 	return []mux.MiddlewareFunc{
-		RateLimitMiddleware(logger, rateLimiterStore, cfg.DisableRateLimit),
-		AuthMiddleware(logger, cfg, gormDB),
+		RateLimitMiddleware(logger, rateLimiterStore, storage, cfg),
+		AuthMiddleware(logger, cfg, storage),
 	}
 }
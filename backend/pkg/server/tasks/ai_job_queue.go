@@ -0,0 +1,227 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/checker"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+const (
+	// maxJobBackoff caps the delay between retries of a failing job.
+	maxJobBackoff = time.Hour
+	// finishedJobRetention is how long done and failed jobs stay visible in
+	// /v1/jobs before they are pruned.
+	finishedJobRetention = 7 * 24 * time.Hour
+)
+
+// AIJobQueue runs the queued AI jobs (see models.AIJob) on a pool of worker
+// goroutines. At most perFamily jobs of one family run at once, so a large
+// backfill cannot starve the other families. A failed attempt is retried with
+// exponential backoff; a job refused for an exhausted AI budget waits for the
+// next month without using up an attempt.
+type AIJobQueue struct {
+	logger       *slog.Logger
+	db           database.Storage
+	cfg          *config.Config
+	untagged     checker.UntaggedCheck
+	workers      int
+	perFamily    int
+	maxAttempts  int
+	pollInterval time.Duration
+	// refresh is called when a family has no more queued or running jobs, so
+	// the results of the finished work are surfaced right away.
+	refresh func(familyID uuid.UUID)
+	// claimMu serializes claims so the per-family limit holds across workers.
+	claimMu sync.Mutex
+	now     func() time.Time
+}
+
+func NewAIJobQueue(
+	logger *slog.Logger, db database.Storage, cfg *config.Config, suggester ai.Suggester, checkerTask *CheckerTask,
+) *AIJobQueue {
+	pollInterval := 10 * time.Second
+	if cfg.AIJobPollInterval != "" {
+		if d, err := time.ParseDuration(cfg.AIJobPollInterval); err == nil && d > 0 {
+			pollInterval = d
+		} else {
+			logger.Warn("Invalid ai_job_poll_interval, using 10s", "value", cfg.AIJobPollInterval, "error", err)
+		}
+	}
+	q := &AIJobQueue{
+		logger:       logger,
+		db:           db,
+		cfg:          cfg,
+		untagged:     checker.UntaggedCheck{Suggester: suggester},
+		workers:      max(cfg.AIJobWorkers, 1),
+		perFamily:    max(cfg.AIJobsPerFamily, 1),
+		maxAttempts:  max(cfg.AIJobMaxAttempts, 1),
+		pollInterval: pollInterval,
+		now:          time.Now,
+	}
+	if checkerTask != nil {
+		q.refresh = checkerTask.RefreshUntagged
+	}
+	return q
+}
+
+// Start puts jobs interrupted by a previous shutdown back in the queue and
+// launches the workers. It does nothing when no AI provider is configured.
+func (q *AIJobQueue) Start(ctx context.Context) {
+	if q.untagged.Suggester == nil || !q.untagged.Suggester.Enabled() {
+		return
+	}
+	if n, err := q.db.RequeueRunningAIJobs(); err != nil {
+		q.logger.Error("Failed to requeue interrupted AI jobs", "error", err)
+	} else if n > 0 {
+		q.logger.Info("Requeued interrupted AI jobs", "count", n)
+	}
+	for range q.workers {
+		go q.work(ctx)
+	}
+	go func() {
+		ticker := time.NewTicker(24 * time.Hour)
+		defer ticker.Stop()
+		for {
+			q.prune()
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// work runs due jobs one after another, sleeping for the poll interval
+// whenever there is nothing to do.
+func (q *AIJobQueue) work(ctx context.Context) {
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+		if q.runNext(ctx) {
+			continue
+		}
+		select {
+		case <-time.After(q.pollInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runNext claims and runs one due job. It reports whether there was one.
+func (q *AIJobQueue) runNext(ctx context.Context) bool {
+	q.claimMu.Lock()
+	job, err := q.db.ClaimAIJob(q.now(), q.perFamily)
+	q.claimMu.Unlock()
+	if err != nil {
+		q.logger.Error("Failed to claim AI job", "error", err)
+		return false
+	}
+	if job == nil {
+		return false
+	}
+	q.run(ctx, job)
+	return true
+}
+
+// run executes a claimed job and records the outcome.
+func (q *AIJobQueue) run(ctx context.Context, job *models.AIJob) {
+	var err error
+	switch job.Kind {
+	case models.AIJobTagDay:
+		err = q.untagged.TagDay(ctx, q.db, q.cfg, q.logger, job.FamilyID, job.Date)
+	default:
+		err = fmt.Errorf("unknown AI job kind %q", job.Kind)
+	}
+
+	now := q.now()
+	switch {
+	case err == nil:
+		job.Status = models.AIJobDone
+		job.LastError = ""
+		job.FinishedAt = &now
+	case errors.Is(err, ai.ErrBudgetExhausted):
+		// Not the job's fault: retry once the budget resets, without
+		// counting the attempt.
+		job.Status = models.AIJobQueued
+		job.Attempts--
+		job.LastError = err.Error()
+		job.RunAfter = nextMonth(now)
+	case job.Attempts >= q.maxAttempts:
+		job.Status = models.AIJobFailed
+		job.LastError = err.Error()
+		job.FinishedAt = &now
+	default:
+		job.Status = models.AIJobQueued
+		job.LastError = err.Error()
+		job.RunAfter = now.Add(jobBackoff(job.Attempts))
+	}
+	if err != nil {
+		q.logger.Warn("AI job failed", "familyID", job.FamilyID, "kind", job.Kind, "date", job.Date,
+			"attempts", job.Attempts, "status", job.Status, "error", err)
+	}
+	if uerr := q.db.UpdateAIJob(job); uerr != nil {
+		q.logger.Error("Failed to update AI job", "jobID", job.ID, "error", uerr)
+		return
+	}
+	if job.FinishedAt != nil {
+		q.refreshWhenIdle(job.FamilyID)
+	}
+}
+
+// refreshWhenIdle re-runs the family's untagged check once its last job has
+// finished.
+func (q *AIJobQueue) refreshWhenIdle(familyID uuid.UUID) {
+	if q.refresh == nil {
+		return
+	}
+	counts, err := q.db.CountAIJobs(familyID)
+	if err != nil {
+		q.logger.Error("Failed to count AI jobs", "familyID", familyID, "error", err)
+		return
+	}
+	if counts[models.AIJobQueued] == 0 && counts[models.AIJobRunning] == 0 {
+		q.refresh(familyID)
+	}
+}
+
+func (q *AIJobQueue) prune() {
+	n, err := q.db.DeleteFinishedAIJobs(q.now().Add(-finishedJobRetention))
+	if err != nil {
+		q.logger.Error("Failed to delete finished AI jobs", "error", err)
+		return
+	}
+	if n > 0 {
+		q.logger.Info("Deleted finished AI jobs", "count", n)
+	}
+}
+
+// jobBackoff is the delay before retrying a job that failed attempts times:
+// one minute, doubling per attempt, capped at maxJobBackoff.
+func jobBackoff(attempts int) time.Duration {
+	d := time.Minute
+	for i := 1; i < attempts && d < maxJobBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxJobBackoff)
+}
+
+// nextMonth returns the start of the month after t, in UTC, when monthly AI
+// budgets reset.
+func nextMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,154 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+// scriptedSuggester fails with err until fails is used up, then suggests one
+// confident tag.
+type scriptedSuggester struct {
+	err   error
+	fails int
+	calls int
+}
+
+func (s *scriptedSuggester) Enabled() bool { return true }
+
+func (s *scriptedSuggester) SuggestTags(
+	context.Context, string, string, []ai.ImageAsset, []string, ai.TagPolicy,
+) ([]ai.TagSuggestion, error) {
+	s.calls++
+	if s.fails > 0 {
+		s.fails--
+		return nil, s.err
+	}
+	return []ai.TagSuggestion{{Name: "hiking", Confidence: 0.95}}, nil
+}
+
+// newJobQueue returns a queue over a fresh storage holding one family with
+// the auto-applying backfill on and one never-analyzed day queued for it.
+func newJobQueue(t *testing.T, suggester ai.Suggester) (*AIJobQueue, database.Storage, uuid.UUID, *time.Time) {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cfg := &config.Config{DataPath: t.TempDir(), AITaggingThreshold: 0.8, AIJobMaxAttempts: 3}
+	db := database.NewStorage(logger, cfg)
+	if err := db.Open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	fam, _ := db.CreateFamily("hikers")
+	if err := db.SetFamilyAISettings(fam.ID, true, true, true, false, false); err != nil {
+		t.Fatalf("ai settings: %v", err)
+	}
+	if err := db.PutItem(fam.ID, &models.Item{Date: "2024-05-01", Title: "Hike", Body: "Up the hill."}); err != nil {
+		t.Fatalf("put item: %v", err)
+	}
+	// Saving stamps the content as analyzed; clear it like a day written
+	// before AI tagging existed.
+	if err := db.GetDB().Model(&models.Item{}).Where("date = ?", "2024-05-01").Update("tags_source_hash", "").Error; err != nil {
+		t.Fatalf("clear hash: %v", err)
+	}
+	if _, err := db.EnqueueAIJob(fam.ID, models.AIJobTagDay, "2024-05-01"); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	q := NewAIJobQueue(logger, db, cfg, suggester, nil)
+	now := time.Now().Add(time.Second)
+	q.now = func() time.Time { return now }
+	return q, db, fam.ID, &now
+}
+
+func onlyJob(t *testing.T, db database.Storage, familyID uuid.UUID) models.AIJob {
+	t.Helper()
+	jobs, err := db.GetAIJobs(familyID, 10)
+	if err != nil || len(jobs) != 1 {
+		t.Fatalf("GetAIJobs = %d jobs, %v", len(jobs), err)
+	}
+	return jobs[0]
+}
+
+func TestAIJobQueueRetriesWithBackoff(t *testing.T) {
+	suggester := &scriptedSuggester{err: errors.New("provider unavailable"), fails: 1}
+	q, db, familyID, now := newJobQueue(t, suggester)
+
+	if !q.runNext(context.Background()) {
+		t.Fatal("expected the queued job to run")
+	}
+	job := onlyJob(t, db, familyID)
+	if job.Status != models.AIJobQueued || job.Attempts != 1 || job.LastError == "" {
+		t.Fatalf("failed attempt must requeue the job, got %+v", job)
+	}
+	if want := now.Add(time.Minute); !job.RunAfter.Equal(want) {
+		t.Fatalf("RunAfter = %v, want %v", job.RunAfter, want)
+	}
+	if q.runNext(context.Background()) {
+		t.Fatal("the retry must wait for its backoff")
+	}
+
+	*now = now.Add(time.Minute)
+	if !q.runNext(context.Background()) {
+		t.Fatal("expected the retry to run")
+	}
+	job = onlyJob(t, db, familyID)
+	if job.Status != models.AIJobDone || job.FinishedAt == nil {
+		t.Fatalf("retry must finish the job, got %+v", job)
+	}
+	item, _ := db.GetItem(familyID, "2024-05-01")
+	if len(item.Tags) != 1 || item.Tags[0] != "hiking" {
+		t.Fatalf("tags = %v", item.Tags)
+	}
+}
+
+func TestAIJobQueueFailsAfterMaxAttempts(t *testing.T) {
+	suggester := &scriptedSuggester{err: errors.New("bad response"), fails: 100}
+	q, db, familyID, now := newJobQueue(t, suggester)
+
+	for range 5 {
+		q.runNext(context.Background())
+		*now = now.Add(maxJobBackoff)
+	}
+	job := onlyJob(t, db, familyID)
+	if job.Status != models.AIJobFailed || job.Attempts != 3 || job.FinishedAt == nil {
+		t.Fatalf("job must fail after 3 attempts, got %+v", job)
+	}
+	if suggester.calls != 3 {
+		t.Fatalf("calls = %d, want 3", suggester.calls)
+	}
+}
+
+func TestAIJobQueueWaitsForBudgetReset(t *testing.T) {
+	suggester := &scriptedSuggester{err: ai.ErrBudgetExhausted, fails: 100}
+	q, db, familyID, now := newJobQueue(t, suggester)
+
+	q.runNext(context.Background())
+	job := onlyJob(t, db, familyID)
+	if job.Status != models.AIJobQueued || job.Attempts != 0 {
+		t.Fatalf("an exhausted budget must not use up an attempt, got %+v", job)
+	}
+	if want := nextMonth(*now); !job.RunAfter.Equal(want) {
+		t.Fatalf("RunAfter = %v, want start of next month %v", job.RunAfter, want)
+	}
+}
+
+func TestJobBackoff(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{
+		1: time.Minute, 2: 2 * time.Minute, 4: 8 * time.Minute, 7: time.Hour, 30: time.Hour,
+	} {
+		if got := jobBackoff(attempts); got != want {
+			t.Errorf("jobBackoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/utils"
+)
+
+// maxCaptionsPerRun bounds the model calls one family can cause per run; a
+// large backlog is worked off over several runs.
+const maxCaptionsPerRun = 50
+
+// CaptionTask describes the images referenced by the entries of every family
+// that opted into AI captions. Each asset is captioned once; files that are not
+// supported images get an empty caption so they are not looked at again.
+type CaptionTask struct {
+	logger    *slog.Logger
+	db        database.Storage
+	captioner ai.Captioner
+	dataPath  string
+	interval  time.Duration
+}
+
+func NewCaptionTask(
+	logger *slog.Logger, db database.Storage, cfg *config.Config, captioner ai.Captioner,
+) *CaptionTask {
+	interval := time.Hour
+	if cfg.CaptionInterval != "" {
+		if d, err := time.ParseDuration(cfg.CaptionInterval); err == nil {
+			interval = d
+		} else {
+			logger.Warn("Invalid caption_interval, using 1h", "value", cfg.CaptionInterval, "error", err)
+		}
+	}
+	return &CaptionTask{
+		logger:    logger,
+		db:        db,
+		captioner: captioner,
+		dataPath:  cfg.DataPath,
+		interval:  interval,
+	}
+}
+
+// Start launches the background goroutine. It does nothing when no AI
+// provider is configured. It waits 30s on startup (matching CheckerTask),
+// then runs on the ticker.
+func (t *CaptionTask) Start(ctx context.Context) {
+	if !t.captioner.Enabled() {
+		return
+	}
+	go func() {
+		select {
+		case <-time.After(30 * time.Second):
+		case <-ctx.Done():
+			return
+		}
+		t.runAll(ctx)
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.runAll(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// runAll captions the new images of every opted-in family.
+func (t *CaptionTask) runAll(ctx context.Context) {
+	users, err := t.db.GetAllUsers()
+	if err != nil {
+		t.logger.Error("caption: failed to list users", "error", err)
+		return
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, user := range users {
+		familyID := user.FamilyID
+		if seen[familyID] {
+			continue
+		}
+		seen[familyID] = true
+
+		family, err := t.db.GetFamily(familyID)
+		if err != nil {
+			t.logger.Error("caption: failed to load family", "error", err, "familyID", familyID)
+			continue
+		}
+		if !family.AICaptionsEnabled {
+			continue
+		}
+		err = t.runForFamily(ai.WithFamily(ctx, familyID), familyID)
+		switch {
+		case errors.Is(err, ai.ErrBudgetExhausted):
+			t.logger.Info("caption: skipped, monthly AI budget exhausted", "familyID", familyID)
+		case err != nil:
+			t.logger.Error("caption: failed", "error", err, "familyID", familyID)
+		}
+	}
+}
+
+// runForFamily captions up to maxCaptionsPerRun of the uncaptioned assets
+// referenced by the family's entries, newest entries first.
+func (t *CaptionTask) runForFamily(ctx context.Context, familyID uuid.UUID) error {
+	existing, err := t.db.GetAssetCaptions(familyID)
+	if err != nil {
+		return err
+	}
+	done := make(map[string]bool, len(existing))
+	for _, c := range existing {
+		done[c.Filename] = true
+	}
+
+	items, _, err := t.db.GetItems(familyID, database.SearchParams{})
+	if err != nil {
+		return err
+	}
+
+	familyDir := filepath.Join(t.dataPath, config.AssetsDirName, familyID.String())
+	model := t.captioner.Model()
+	captioned := 0
+	for _, item := range items {
+		for _, name := range utils.GetAssetsFromMarkdown(item.Body) {
+			if captioned == maxCaptionsPerRun {
+				t.logger.Info("caption: described", "familyID", familyID, "count", captioned)
+				return nil
+			}
+			name = filepath.Clean(name)
+			if done[name] || strings.Contains(name, "..") {
+				continue
+			}
+			done[name] = true
+			// A file referenced before it was uploaded is retried next run.
+			if _, err := os.Stat(filepath.Join(familyDir, name)); err != nil {
+				continue
+			}
+
+			caption := ""
+			if image, ok := ai.LoadImageAsset(t.dataPath, familyID.String(), name); ok {
+				caption, err = t.captioner.CaptionImage(ctx, image)
+				if err != nil {
+					return fmt.Errorf("captioning %s: %w", name, err)
+				}
+				captioned++
+			}
+			if err := t.db.PutAssetCaption(&models.AssetCaption{
+				FamilyID: familyID,
+				Filename: name,
+				Caption:  caption,
+				Model:    model,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if captioned > 0 {
+		t.logger.Info("caption: described", "familyID", familyID, "count", captioned)
+	}
+	return nil
+}
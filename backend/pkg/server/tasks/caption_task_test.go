@@ -0,0 +1,92 @@
+package tasks
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+// fakeCaptioner describes every image the same way and counts the calls.
+type fakeCaptioner struct {
+	calls int
+}
+
+func (f *fakeCaptioner) Enabled() bool { return true }
+
+func (f *fakeCaptioner) Model() string { return "fake-vision" }
+
+func (f *fakeCaptioner) CaptionImage(_ context.Context, _ ai.ImageAsset) (string, error) {
+	f.calls++
+	return "A red kite above a field.", nil
+}
+
+// pngHeader is enough for http.DetectContentType to report image/png.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestCaptionTaskCaptionsReferencedImagesOnce(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cfg := &config.Config{DataPath: t.TempDir()}
+	db := database.NewStorage(logger, cfg)
+	if err := db.Open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	fam, _ := db.CreateFamily("kites")
+	if _, err := db.CreateUser("a@x", "password", fam.ID); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	dir := filepath.Join(cfg.DataPath, config.AssetsDirName, fam.ID.String())
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	for name, data := range map[string][]byte{
+		"kite.png":   pngHeader,
+		"notes.txt":  []byte("plain text"),
+		"orphan.png": pngHeader,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+			t.Fatalf("write asset: %v", err)
+		}
+	}
+	body := "![](kite.png) ![](notes.txt) ![](missing.png)"
+	if err := db.PutItem(fam.ID, &models.Item{Date: "2024-03-01", Title: "Kites", Body: body}); err != nil {
+		t.Fatalf("put item: %v", err)
+	}
+
+	captioner := &fakeCaptioner{}
+	task := NewCaptionTask(logger, db, cfg, captioner)
+	task.runAll(context.Background())
+	if captioner.calls != 0 {
+		t.Fatalf("family has not opted in, got %d calls", captioner.calls)
+	}
+
+	if err := db.SetFamilyAICaptionsEnabled(fam.ID, true); err != nil {
+		t.Fatalf("opt in: %v", err)
+	}
+	task.runAll(context.Background())
+	task.runAll(context.Background())
+	if captioner.calls != 1 {
+		t.Fatalf("want the one referenced image captioned once, got %d calls", captioner.calls)
+	}
+
+	captions, err := db.GetAssetCaptions(fam.ID)
+	if err != nil {
+		t.Fatalf("GetAssetCaptions: %v", err)
+	}
+	got := map[string]string{}
+	for _, c := range captions {
+		got[c.Filename] = c.Caption
+	}
+	if len(got) != 2 || got["kite.png"] != "A red kite above a field." || got["notes.txt"] != "" {
+		t.Fatalf("unexpected captions %v", got)
+	}
+}
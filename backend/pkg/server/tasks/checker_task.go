@@ -35,6 +35,7 @@ var baseChecks = []checker.Check{
 	checker.MimeCheck{},
 	checker.OrphansCheck{},
 	checker.RefsCheck{},
+	checker.LinksCheck{},
 }
 
 // FamilyResult holds the last health check results for a single family.
@@ -111,8 +112,8 @@ func (t *CheckerTask) GetIssues(familyID uuid.UUID) *UserResult {
 }
 
 // RunFix re-runs the named checks with fix=true for a specific family, then re-scans to get clean results.
-// If checks is empty, all checks are run.
-func (t *CheckerTask) RunFix(familyID uuid.UUID, checks []string) (*UserResult, error) {
+// If checks is empty, all checks are run. The fix is recorded in the audit log on behalf of actor.
+func (t *CheckerTask) RunFix(familyID uuid.UUID, checks []string, actor models.AuditActor) (*UserResult, error) {
 	selected, err := t.selectChecks(checks)
 	if err != nil {
 		return nil, err
@@ -123,6 +124,11 @@ func (t *CheckerTask) RunFix(familyID uuid.UUID, checks []string) (*UserResult,
 	if _, err := runner.RunForFamily(t.db, t.cfg, familyID, true); err != nil {
 		return nil, err
 	}
+	names := make([]string, len(selected))
+	for i, c := range selected {
+		names[i] = c.Name()
+	}
+	t.audit(familyID, actor, models.AuditHealthFixed, strings.Join(names, ","))
 
 	// Second pass: re-scan to get true current state (fixed issues should be gone)
 	issues, err := runner.RunForFamily(t.db, t.cfg, familyID, false)
@@ -133,9 +139,9 @@ func (t *CheckerTask) RunFix(familyID uuid.UUID, checks []string) (*UserResult,
 	ignored, _ := t.db.GetIgnoredOrphans(familyID)
 
 	// Collect the names of checks we just ran so we can replace only those in the cache.
-	selectedNames := make(map[string]bool, len(selected))
-	for _, c := range selected {
-		selectedNames[c.Name()] = true
+	selectedNames := make(map[string]bool, len(names))
+	for _, name := range names {
+		selectedNames[name] = true
 	}
 
 	t.mu.Lock()
@@ -161,7 +167,8 @@ func (t *CheckerTask) GetIgnoredOrphans(familyID uuid.UUID) ([]string, error) {
 }
 
 // DeleteOrphan deletes a single orphaned asset file for the family then re-runs orphan checks.
-func (t *CheckerTask) DeleteOrphan(familyID uuid.UUID, filename string) (*UserResult, error) {
+// The deletion is recorded in the audit log on behalf of actor.
+func (t *CheckerTask) DeleteOrphan(familyID uuid.UUID, filename string, actor models.AuditActor) (*UserResult, error) {
 	if err := validateFilename(filename); err != nil {
 		return nil, err
 	}
@@ -174,9 +181,18 @@ func (t *CheckerTask) DeleteOrphan(familyID uuid.UUID, filename string) (*UserRe
 		return nil, fmt.Errorf("deleting orphan %q: %w", filename, err)
 	}
 	t.logger.Info("Deleted orphan", "file", filename, "familyID", familyID)
+	t.audit(familyID, actor, models.AuditOrphanDeleted, filename)
 	return t.refreshOrphansForFamily(familyID)
 }
 
+// audit records a destructive maintenance action; failures are only logged so
+// they never undo the action itself.
+func (t *CheckerTask) audit(familyID uuid.UUID, actor models.AuditActor, action, target string) {
+	if err := t.db.CreateAuditEvent(models.NewAuditEvent(familyID, actor, action, target)); err != nil {
+		t.logger.Warn("Failed to record audit event", "action", action, "familyID", familyID, "error", err)
+	}
+}
+
 // AttachOrphan inserts a markdown image reference into a diary entry (creating it if needed).
 func (t *CheckerTask) AttachOrphan(familyID uuid.UUID, filename, date string) (*UserResult, error) {
 	if err := validateFilename(filename); err != nil {
@@ -228,7 +244,26 @@ func (t *CheckerTask) UnignoreOrphan(familyID uuid.UUID, filename string) (*User
 // refreshOrphansForFamily re-runs the orphans check for a single family and merges the fresh
 // orphan results with whatever non-orphan issues are currently cached in memory.
 func (t *CheckerTask) refreshOrphansForFamily(familyID uuid.UUID) (*UserResult, error) {
-	runner := checker.NewRunner(t.logger, []checker.Check{checker.OrphansCheck{}})
+	return t.refreshCheckForFamily(familyID, checker.OrphansCheck{})
+}
+
+// RefreshUntagged re-runs the untagged check for a single family, so days
+// tagged by AI jobs show up for review without waiting for the next sweep.
+func (t *CheckerTask) RefreshUntagged(familyID uuid.UUID) {
+	for _, c := range t.checks {
+		if c.Name() != "untagged" {
+			continue
+		}
+		if _, err := t.refreshCheckForFamily(familyID, c); err != nil {
+			t.logger.Error("Failed to refresh untagged check", "familyID", familyID, "error", err)
+		}
+	}
+}
+
+// refreshCheckForFamily re-runs one check for a single family and merges the fresh
+// results with whatever issues of other checks are currently cached in memory.
+func (t *CheckerTask) refreshCheckForFamily(familyID uuid.UUID, check checker.Check) (*UserResult, error) {
+	runner := checker.NewRunner(t.logger, []checker.Check{check})
 	issues, err := runner.RunForFamily(t.db, t.cfg, familyID, false)
 	if err != nil {
 		return nil, err
@@ -240,7 +275,7 @@ func (t *CheckerTask) refreshOrphansForFamily(familyID uuid.UUID) (*UserResult,
 	var mergedIssues []checker.Issue
 	if existing != nil {
 		for _, i := range existing.Issues {
-			if i.Check != "orphans" {
+			if i.Check != check.Name() {
 				mergedIssues = append(mergedIssues, i)
 			}
 		}
@@ -326,7 +361,7 @@ func (t *CheckerTask) selectChecks(names []string) ([]checker.Check, error) {
 	for _, n := range names {
 		c, ok := known[n]
 		if !ok {
-			return nil, fmt.Errorf("unknown check %q (available: mime, orphans, refs, untagged)", n)
+			return nil, fmt.Errorf("unknown check %q (available: mime, orphans, refs, links, untagged)", n)
 		}
 		selected = append(selected, c)
 	}
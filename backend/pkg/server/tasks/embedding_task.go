@@ -0,0 +1,163 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+const (
+	// embeddingBatchSize is how many entries go into one provider call.
+	embeddingBatchSize = 16
+	// maxEmbeddingsPerRun bounds the provider calls one family can cause per
+	// run; a large backlog is worked off over several runs.
+	maxEmbeddingsPerRun = 20 * embeddingBatchSize
+)
+
+// EmbeddingTask keeps the semantic search vectors of every family that opted
+// in up to date. An entry is (re-)embedded when it has no vector for the
+// current model or its TagsSourceHash changed since the vector was computed.
+type EmbeddingTask struct {
+	logger   *slog.Logger
+	db       database.Storage
+	embedder ai.Embedder
+	interval time.Duration
+}
+
+func NewEmbeddingTask(
+	logger *slog.Logger, db database.Storage, cfg *config.Config, embedder ai.Embedder,
+) *EmbeddingTask {
+	interval := time.Hour
+	if cfg.EmbeddingInterval != "" {
+		if d, err := time.ParseDuration(cfg.EmbeddingInterval); err == nil {
+			interval = d
+		} else {
+			logger.Warn("Invalid embedding_interval, using 1h", "value", cfg.EmbeddingInterval, "error", err)
+		}
+	}
+	return &EmbeddingTask{logger: logger, db: db, embedder: embedder, interval: interval}
+}
+
+// Start launches the background goroutine. It does nothing when no embedding
+// provider is configured. It waits 30s on startup (matching CheckerTask),
+// then runs on the ticker.
+func (t *EmbeddingTask) Start(ctx context.Context) {
+	if !t.embedder.Enabled() {
+		return
+	}
+	go func() {
+		select {
+		case <-time.After(30 * time.Second):
+		case <-ctx.Done():
+			return
+		}
+		t.runAll(ctx)
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.runAll(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// runAll refreshes the stale embeddings of every opted-in family.
+func (t *EmbeddingTask) runAll(ctx context.Context) {
+	users, err := t.db.GetAllUsers()
+	if err != nil {
+		t.logger.Error("embedding: failed to list users", "error", err)
+		return
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, user := range users {
+		familyID := user.FamilyID
+		if seen[familyID] {
+			continue
+		}
+		seen[familyID] = true
+
+		family, err := t.db.GetFamily(familyID)
+		if err != nil {
+			t.logger.Error("embedding: failed to load family", "error", err, "familyID", familyID)
+			continue
+		}
+		if !family.AISearchEnabled {
+			continue
+		}
+		err = t.runForFamily(ai.WithFamily(ctx, familyID), familyID)
+		switch {
+		case errors.Is(err, ai.ErrBudgetExhausted):
+			t.logger.Info("embedding: skipped, monthly AI budget exhausted", "familyID", familyID)
+		case err != nil:
+			t.logger.Error("embedding: failed", "error", err, "familyID", familyID)
+		}
+	}
+}
+
+// runForFamily embeds up to maxEmbeddingsPerRun of the family's stale entries.
+func (t *EmbeddingTask) runForFamily(ctx context.Context, familyID uuid.UUID) error {
+	model := t.embedder.Model()
+	items, err := t.db.GetItemsWithStaleEmbeddings(familyID, model, maxEmbeddingsPerRun)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(items); start += embeddingBatchSize {
+		batch := items[start:min(start+embeddingBatchSize, len(items))]
+
+		// Blank entries are stored without a vector rather than sent.
+		var texts []string
+		var embedded []*models.Item
+		for _, item := range batch {
+			if text := ai.EmbeddingText(item.Title, item.Body); text != "" {
+				texts = append(texts, text)
+				embedded = append(embedded, item)
+			} else if err := t.store(familyID, item, model, nil); err != nil {
+				return err
+			}
+		}
+		if len(texts) == 0 {
+			continue
+		}
+
+		vectors, err := t.embedder.EmbedDocuments(ctx, texts)
+		if err != nil {
+			return fmt.Errorf("embedding %d entries: %w", len(texts), err)
+		}
+		for i, item := range embedded {
+			if err := t.store(familyID, item, model, vectors[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(items) > 0 {
+		t.logger.Info("embedding: refreshed", "familyID", familyID, "count", len(items))
+	}
+	return nil
+}
+
+func (t *EmbeddingTask) store(familyID uuid.UUID, item *models.Item, model string, vector []float32) error {
+	embedding := &models.ItemEmbedding{
+		FamilyID:   familyID,
+		Date:       item.Date,
+		Model:      model,
+		SourceHash: item.TagsSourceHash,
+	}
+	embedding.SetVector(vector)
+	return t.db.PutItemEmbedding(embedding)
+}
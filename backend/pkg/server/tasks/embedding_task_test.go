@@ -0,0 +1,80 @@
+package tasks
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+// countingEmbedder wraps the fake embedder and counts the texts it embeds.
+type countingEmbedder struct {
+	ai.Embedder
+	texts int
+}
+
+func (c *countingEmbedder) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+	c.texts += len(texts)
+	return c.Embedder.EmbedDocuments(ctx, texts)
+}
+
+func TestEmbeddingTaskEmbedsStaleEntriesOfOptedInFamilies(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	db := database.NewStorage(logger, &config.Config{DataPath: t.TempDir()})
+	if err := db.Open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	optedIn, _ := db.CreateFamily("opted-in")
+	optedOut, _ := db.CreateFamily("opted-out")
+	for i, fam := range []*models.Family{optedIn, optedOut} {
+		if _, err := db.CreateUser([]string{"a@x", "b@x"}[i], "password", fam.ID); err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+		for _, it := range []*models.Item{
+			{Date: "2024-03-01", Title: "Hike", Body: "trail"},
+			{Date: "2024-03-02", Title: "Work", Body: "meetings"},
+			{Date: "2024-03-03"},
+		} {
+			if err := db.PutItem(fam.ID, it); err != nil {
+				t.Fatalf("put item: %v", err)
+			}
+		}
+	}
+	if err := db.SetFamilyAISearchEnabled(optedIn.ID, true); err != nil {
+		t.Fatalf("opt in: %v", err)
+	}
+
+	embedder := &countingEmbedder{Embedder: ai.NewFakeEmbedder()}
+	task := NewEmbeddingTask(logger, db, &config.Config{}, embedder)
+	task.runAll(context.Background())
+	if embedder.texts != 2 {
+		t.Fatalf("want the 2 non-blank entries embedded, got %d", embedder.texts)
+	}
+	task.runAll(context.Background())
+	if embedder.texts != 2 {
+		t.Fatalf("up-to-date entries must not be re-embedded, got %d texts", embedder.texts)
+	}
+
+	if err := db.PutItem(optedIn.ID, &models.Item{Date: "2024-03-02", Title: "Work", Body: "no meetings"}); err != nil {
+		t.Fatalf("put item: %v", err)
+	}
+	task.runAll(context.Background())
+	if embedder.texts != 3 {
+		t.Fatalf("an edited entry must be re-embedded, got %d texts", embedder.texts)
+	}
+
+	vectors, err := db.GetItemEmbeddings(optedIn.ID, embedder.Model())
+	if err != nil || len(vectors) != 2 {
+		t.Fatalf("want 2 vectors, got %d (%v)", len(vectors), err)
+	}
+	if others, _ := db.GetItemEmbeddings(optedOut.ID, embedder.Model()); len(others) != 0 {
+		t.Fatalf("opted-out family got vectors: %d", len(others))
+	}
+}
@@ -0,0 +1,123 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/memories"
+)
+
+// MemoriesDigestTask sends the day's memories of every family that opted into
+// the digest through the configured notifier, once a day from the configured
+// hour on. Days without memories send nothing.
+type MemoriesDigestTask struct {
+	logger   *slog.Logger
+	db       database.Storage
+	notifier memories.Notifier
+	hour     int
+	interval time.Duration
+}
+
+func NewMemoriesDigestTask(
+	logger *slog.Logger, db database.Storage, cfg *config.Config, notifier memories.Notifier,
+) *MemoriesDigestTask {
+	interval := 15 * time.Minute
+	if cfg.MemoriesDigestInterval != "" {
+		if d, err := time.ParseDuration(cfg.MemoriesDigestInterval); err == nil {
+			interval = d
+		} else {
+			logger.Warn("Invalid memories_digest_interval, using 15m", "value", cfg.MemoriesDigestInterval, "error", err)
+		}
+	}
+	return &MemoriesDigestTask{
+		logger: logger, db: db, notifier: notifier, hour: cfg.MemoriesDigestHour, interval: interval,
+	}
+}
+
+// Start launches the background goroutine. It does nothing when no notifier
+// is configured. It waits 30s on startup (matching CheckerTask), then runs on
+// the ticker.
+func (t *MemoriesDigestTask) Start(ctx context.Context) {
+	if !t.notifier.Enabled() {
+		return
+	}
+	go func() {
+		select {
+		case <-time.After(30 * time.Second):
+		case <-ctx.Done():
+			return
+		}
+		t.runAll(ctx, time.Now())
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.runAll(ctx, time.Now())
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// runAll sends the digests due at now.
+func (t *MemoriesDigestTask) runAll(ctx context.Context, now time.Time) {
+	if now.Hour() < t.hour {
+		return
+	}
+	users, err := t.db.GetAllUsers()
+	if err != nil {
+		t.logger.Error("memories: failed to list users", "error", err)
+		return
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, user := range users {
+		familyID := user.FamilyID
+		if seen[familyID] {
+			continue
+		}
+		seen[familyID] = true
+
+		family, err := t.db.GetFamily(familyID)
+		if err != nil {
+			t.logger.Error("memories: failed to load family", "error", err, "familyID", familyID)
+			continue
+		}
+		if !family.MemoriesDigestEnabled {
+			continue
+		}
+		if err := t.runForFamily(ctx, family, now); err != nil {
+			t.logger.Error("memories: failed", "error", err, "familyID", familyID)
+		}
+	}
+}
+
+// runForFamily sends the family's digest of now's day unless it went out
+// already. A failed delivery is tried again on the next run.
+func (t *MemoriesDigestTask) runForFamily(ctx context.Context, family *models.Family, now time.Time) error {
+	today := now.Format("2006-01-02")
+	if family.MemoriesDigestSentOn == today {
+		return nil
+	}
+	m, err := memories.Collect(t.db, family.ID, now)
+	if err != nil {
+		return err
+	}
+	if !m.Empty() {
+		digest := memories.Digest{FamilyID: family.ID, FamilyName: family.Name, Memories: m}
+		if err := t.notifier.Notify(ctx, digest); err != nil {
+			return fmt.Errorf("sending digest of %s: %w", today, err)
+		}
+		t.logger.Info("memories: digest sent", "familyID", family.ID, "date", today)
+	}
+	return t.db.SetFamilyMemoriesDigestSent(family.ID, today)
+}
@@ -0,0 +1,89 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/memories"
+)
+
+// fakeNotifier records the digests it delivered; fail makes the next
+// delivery fail.
+type fakeNotifier struct {
+	digests []memories.Digest
+	fail    bool
+}
+
+func (f *fakeNotifier) Enabled() bool { return true }
+
+func (f *fakeNotifier) Notify(_ context.Context, digest memories.Digest) error {
+	if f.fail {
+		f.fail = false
+		return errors.New("unreachable")
+	}
+	f.digests = append(f.digests, digest)
+	return nil
+}
+
+func TestMemoriesDigestTaskSendsOncePerDay(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	db := database.NewStorage(logger, &config.Config{DataPath: t.TempDir()})
+	if err := db.Open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	optedIn, _ := db.CreateFamily("opted-in")
+	optedOut, _ := db.CreateFamily("opted-out")
+	quiet, _ := db.CreateFamily("quiet")
+	for i, fam := range []*models.Family{optedIn, optedOut, quiet} {
+		if _, err := db.CreateUser([]string{"a@x", "b@x", "c@x"}[i], "password", fam.ID); err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+		if fam == quiet {
+			continue
+		}
+		if err := db.PutItem(fam.ID, &models.Item{Date: "2023-03-13", Title: "a year ago"}); err != nil {
+			t.Fatalf("put item: %v", err)
+		}
+	}
+	for _, fam := range []*models.Family{optedIn, quiet} {
+		if err := db.SetFamilyMemoriesDigestEnabled(fam.ID, true); err != nil {
+			t.Fatalf("opt in: %v", err)
+		}
+	}
+
+	fake := &fakeNotifier{fail: true}
+	task := NewMemoriesDigestTask(logger, db, &config.Config{MemoriesDigestHour: 8}, fake)
+
+	// Before the digest hour nothing goes out.
+	task.runAll(context.Background(), time.Date(2024, 3, 13, 7, 0, 0, 0, time.Local))
+	// The first delivery fails and is retried on the next run, then the day
+	// is done.
+	for range 3 {
+		task.runAll(context.Background(), time.Date(2024, 3, 13, 9, 0, 0, 0, time.Local))
+	}
+	if len(fake.digests) != 1 {
+		t.Fatalf("want one digest, got %+v", fake.digests)
+	}
+	d := fake.digests[0]
+	if d.FamilyID != optedIn.ID || d.FamilyName != "opted-in" || d.Date != "2024-03-13" ||
+		len(d.OnThisDay) != 1 || d.OnThisDay[0].YearsAgo != 1 {
+		t.Fatalf("unexpected digest: %+v", d)
+	}
+	if fam, _ := db.GetFamily(quiet.ID); fam.MemoriesDigestSentOn != "2024-03-13" {
+		t.Fatalf("a day without memories should still count as done, got %q", fam.MemoriesDigestSentOn)
+	}
+
+	task.runAll(context.Background(), time.Date(2024, 3, 14, 9, 0, 0, 0, time.Local))
+	if len(fake.digests) != 1 {
+		t.Fatalf("a day without memories sent a digest: %+v", fake.digests[1:])
+	}
+}
@@ -0,0 +1,188 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+const recapDateFormat = "2006-01-02"
+
+// RecapTask writes AI recaps of the last completed week (Monday to Sunday) and
+// month for every family that opted into AI summaries. Each recap is written
+// once; periods without entries are skipped.
+type RecapTask struct {
+	logger     *slog.Logger
+	db         database.Storage
+	summarizer ai.Summarizer
+	interval   time.Duration
+}
+
+func NewRecapTask(
+	logger *slog.Logger, db database.Storage, cfg *config.Config, summarizer ai.Summarizer,
+) *RecapTask {
+	interval := 24 * time.Hour
+	if cfg.RecapInterval != "" {
+		if d, err := time.ParseDuration(cfg.RecapInterval); err == nil {
+			interval = d
+		} else {
+			logger.Warn("Invalid recap_interval, using 24h", "value", cfg.RecapInterval, "error", err)
+		}
+	}
+	return &RecapTask{logger: logger, db: db, summarizer: summarizer, interval: interval}
+}
+
+// Start launches the background goroutine. It does nothing when no AI
+// provider is configured. It waits 30s on startup (matching CheckerTask),
+// then runs on the ticker.
+func (t *RecapTask) Start(ctx context.Context) {
+	if !t.summarizer.Enabled() {
+		return
+	}
+	go func() {
+		select {
+		case <-time.After(30 * time.Second):
+		case <-ctx.Done():
+			return
+		}
+		t.runAll(ctx, time.Now())
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.runAll(ctx, time.Now())
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// runAll writes the missing recaps of every opted-in family.
+func (t *RecapTask) runAll(ctx context.Context, now time.Time) {
+	users, err := t.db.GetAllUsers()
+	if err != nil {
+		t.logger.Error("recap: failed to list users", "error", err)
+		return
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, user := range users {
+		familyID := user.FamilyID
+		if seen[familyID] {
+			continue
+		}
+		seen[familyID] = true
+
+		family, err := t.db.GetFamily(familyID)
+		if err != nil {
+			t.logger.Error("recap: failed to load family", "error", err, "familyID", familyID)
+			continue
+		}
+		if !family.AISummariesEnabled {
+			continue
+		}
+		err = t.runForFamily(ai.WithFamily(ctx, familyID), familyID, now)
+		switch {
+		case errors.Is(err, ai.ErrBudgetExhausted):
+			t.logger.Info("recap: skipped, monthly AI budget exhausted", "familyID", familyID)
+		case err != nil:
+			t.logger.Error("recap: failed", "error", err, "familyID", familyID)
+		}
+	}
+}
+
+// runForFamily writes the family's recaps of the periods that ended before now,
+// unless they exist already.
+func (t *RecapTask) runForFamily(ctx context.Context, familyID uuid.UUID, now time.Time) error {
+	for _, p := range completedPeriods(now) {
+		_, err := t.db.GetRecap(familyID, p.period, p.start)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+
+		items, _, err := t.db.GetItems(familyID, database.SearchParams{DateFrom: p.start, DateTo: p.end})
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			continue
+		}
+
+		// GetItems returns newest first; a recap reads better in order.
+		entries := make([]ai.EntryText, 0, len(items))
+		for i := len(items) - 1; i >= 0; i-- {
+			entries = append(entries, ai.EntryText{Date: items[i].Date, Title: items[i].Title, Body: items[i].Body})
+		}
+		summary, err := t.summarizer.SummarizePeriod(ctx, p.label, entries)
+		if err != nil {
+			return fmt.Errorf("summarizing %s %s: %w", p.period, p.start, err)
+		}
+		if summary == "" {
+			// Nothing usable came back; try again on the next run.
+			continue
+		}
+
+		if err := t.db.CreateRecap(&models.Recap{
+			FamilyID:   familyID,
+			Period:     p.period,
+			StartDate:  p.start,
+			EndDate:    p.end,
+			Summary:    summary,
+			EntryCount: len(items),
+		}); err != nil {
+			return err
+		}
+		t.logger.Info("recap: written", "familyID", familyID, "period", p.period, "start", p.start)
+	}
+	return nil
+}
+
+// recapPeriod is one week or month, bounded by inclusive YYYY-MM-DD dates.
+type recapPeriod struct {
+	period string
+	start  string
+	end    string
+	label  string
+}
+
+// completedPeriods returns the last week (Monday to Sunday) and the last
+// calendar month that ended before now's day.
+func completedPeriods(now time.Time) []recapPeriod {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	daysSinceMonday := (int(today.Weekday()) + 6) % 7
+	weekStart := today.AddDate(0, 0, -daysSinceMonday-7)
+	weekEnd := weekStart.AddDate(0, 0, 6)
+
+	monthEnd := today.AddDate(0, 0, -today.Day())
+	monthStart := monthEnd.AddDate(0, 0, 1-monthEnd.Day())
+
+	return []recapPeriod{
+		{
+			period: models.RecapPeriodWeek,
+			start:  weekStart.Format(recapDateFormat),
+			end:    weekEnd.Format(recapDateFormat),
+			label:  "the week of " + weekStart.Format(recapDateFormat),
+		},
+		{
+			period: models.RecapPeriodMonth,
+			start:  monthStart.Format(recapDateFormat),
+			end:    monthEnd.Format(recapDateFormat),
+			label:  monthStart.Format("January 2006"),
+		},
+	}
+}
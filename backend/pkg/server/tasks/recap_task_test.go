@@ -0,0 +1,101 @@
+package tasks
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+)
+
+// fakeSummarizer records the periods it was asked to recap.
+type fakeSummarizer struct {
+	labels  []string
+	entries [][]ai.EntryText
+}
+
+func (f *fakeSummarizer) Enabled() bool { return true }
+
+func (f *fakeSummarizer) SummarizeEntry(context.Context, string, string) (string, error) {
+	return "entry", nil
+}
+
+func (f *fakeSummarizer) SummarizePeriod(_ context.Context, label string, entries []ai.EntryText) (string, error) {
+	f.labels = append(f.labels, label)
+	f.entries = append(f.entries, entries)
+	return "recap of " + label, nil
+}
+
+func TestCompletedPeriods(t *testing.T) {
+	// Wednesday 2024-03-13: last week is Mar 4–10, last month February (leap year).
+	got := completedPeriods(time.Date(2024, 3, 13, 9, 0, 0, 0, time.UTC))
+	want := []recapPeriod{
+		{period: models.RecapPeriodWeek, start: "2024-03-04", end: "2024-03-10", label: "the week of 2024-03-04"},
+		{period: models.RecapPeriodMonth, start: "2024-02-01", end: "2024-02-29", label: "February 2024"},
+	}
+	if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+
+	// On a Monday the week that just ended is complete.
+	got = completedPeriods(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	if got[0].start != "2023-12-25" || got[0].end != "2023-12-31" || got[1].start != "2023-12-01" {
+		t.Fatalf("unexpected periods on Monday 2024-01-01: %+v", got)
+	}
+}
+
+func TestRecapTaskWritesOncePerOptedInFamily(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	db := database.NewStorage(logger, &config.Config{DataPath: t.TempDir()})
+	if err := db.Open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	optedIn, _ := db.CreateFamily("opted-in")
+	optedOut, _ := db.CreateFamily("opted-out")
+	for i, fam := range []*models.Family{optedIn, optedOut} {
+		if _, err := db.CreateUser([]string{"a@x", "b@x"}[i], "password", fam.ID); err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+		for _, it := range []*models.Item{
+			{Date: "2024-03-05", Title: "Tue", Body: "work"},
+			{Date: "2024-03-09", Title: "Sat", Body: "hike"},
+			{Date: "2024-03-12", Title: "this week", Body: "not yet"},
+		} {
+			if err := db.PutItem(fam.ID, it); err != nil {
+				t.Fatalf("put item: %v", err)
+			}
+		}
+	}
+	if err := db.SetFamilyAISummariesEnabled(optedIn.ID, true); err != nil {
+		t.Fatalf("opt in: %v", err)
+	}
+
+	fake := &fakeSummarizer{}
+	task := NewRecapTask(logger, db, &config.Config{}, fake)
+	now := time.Date(2024, 3, 13, 9, 0, 0, 0, time.UTC)
+	task.runAll(context.Background(), now)
+	task.runAll(context.Background(), now)
+
+	// February has no entries, so only the week is recapped — and only once.
+	if len(fake.labels) != 1 || fake.labels[0] != "the week of 2024-03-04" {
+		t.Fatalf("unexpected summarizer calls: %v", fake.labels)
+	}
+	if e := fake.entries[0]; len(e) != 2 || e[0].Date != "2024-03-05" || e[1].Date != "2024-03-09" {
+		t.Fatalf("want the week's entries oldest first, got %+v", e)
+	}
+
+	recaps, err := db.GetRecaps(optedIn.ID, "")
+	if err != nil || len(recaps) != 1 || recaps[0].EntryCount != 2 || recaps[0].EndDate != "2024-03-10" {
+		t.Fatalf("unexpected recaps: %+v, %v", recaps, err)
+	}
+	if others, _ := db.GetRecaps(optedOut.ID, ""); len(others) != 0 {
+		t.Fatalf("opted-out family got recaps: %+v", others)
+	}
+}
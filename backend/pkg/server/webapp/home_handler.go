@@ -61,7 +61,7 @@ func (r *WebAppRouter) populateItemsData(data map[string]any, familyID uuid.UUID
 
 	// Use the items service to get items (new API signature with search parameters)
 	// For home page, we use date filter for backward compatibility
-	response, err := r.itemsService.GetItems(ctx, date, "", "")
+	response, err := r.itemsService.GetItems(ctx, date, "", "", "")
 	if err != nil {
 		r.logger.Error("Failed to get items from service", "error", err, "date", date)
 		return err
@@ -128,7 +128,19 @@ func (r *WebAppRouter) populateItemsData(data map[string]any, familyID uuid.UUID
 		"Tags":  tags,
 	}
 
-	body := markdown.ToHTML([]byte(bodyStr), nil, utils.NewImagePrefixRenderer("/web/assets/"))
+	captions := map[string]string{}
+	if rows, err := r.db.GetAssetCaptions(familyID); err == nil {
+		for _, c := range rows {
+			if c.Caption != "" {
+				captions[c.Filename] = c.Caption
+			}
+		}
+	} else {
+		// Captions only improve alt text; render without them.
+		r.logger.Warn("Failed to load asset captions", "error", err)
+	}
+	renderer := utils.NewImagePrefixRenderer("/web/assets/").WithCaptions(captions)
+	body := markdown.ToHTML([]byte(bodyStr), nil, renderer)
 	//nolint:gosec // this is safe
 	data["body"] = template.HTML(string(body))
 
@@ -9,6 +9,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/ya-breeze/diary.be/pkg/auth"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
 	kinauth "github.com/ya-breeze/kin-core/auth"
 	"github.com/ya-breeze/kin-core/authdb"
 	kincookies "github.com/ya-breeze/kin-core/cookies"
@@ -17,7 +21,7 @@ import (
 )
 
 const (
-	accessTokenTTL  = 15 * time.Minute
+	accessTokenTTL  = auth.AccessTokenTTL
 	refreshTokenTTL = 365 * 24 * time.Hour
 )
 
@@ -73,37 +77,136 @@ func (r *WebAppRouter) loginHandler(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	// Timing-safe credential verification
-	hash := kinauth.DummyHash
-	user, err := r.db.GetUserByUsername(username)
-	if err == nil {
-		hash = user.PasswordHash
+	// Credentials go through the API service so both login paths share the
+	// account lockout.
+	result, user, err := r.authService.AuthorizeUser(common.WithClientIP(req), goserver.AuthData{
+		Email:    username,
+		Password: password,
+	})
+	if err != nil {
+		r.logger.Error("Authentication failed", "username", username, "error", err)
+		http.Error(w, "Authentication failed", http.StatusInternalServerError)
+		return
 	}
-	if !kinauth.VerifyPassword(password, hash) || err != nil {
+
+	switch result.Code {
+	case http.StatusOK:
+	case http.StatusAccepted:
+		challenge, ok := result.Body.(goserver.TwoFactorChallenge)
+		if !ok {
+			http.Error(w, "Authentication failed", http.StatusInternalServerError)
+			return
+		}
+		r.renderTwoFactorForm(w, req, challenge.MfaToken, redirectURL)
+		return
+	case http.StatusUnauthorized:
 		r.logger.Warn("Authentication failed", "username", username)
+		common.AuditLogin(r.logger, r.db, req, user, username, "web", false)
 		http.Error(w, "Invalid credentials", http.StatusUnauthorized)
 		return
+	case http.StatusTooManyRequests:
+		http.Error(w, "Too many failed attempts, try again later", http.StatusTooManyRequests)
+		return
+	default:
+		http.Error(w, "Authentication failed", http.StatusInternalServerError)
+		return
+	}
+
+	authResponse, ok := result.Body.(goserver.Authorize200Response)
+	if !ok {
+		http.Error(w, "Authentication failed", http.StatusInternalServerError)
+		return
 	}
 
-	familyID := user.FamilyID
-	accessToken, err := kinauth.GenerateAccessToken(user.ID, &familyID, []byte(r.cfg.JWTSecret), accessTokenTTL)
+	r.logger.Info("User logged in", "username", username, "familyID", user.FamilyID)
+	common.AuditLogin(r.logger, r.db, req, user, username, "web", true)
+	r.completeLogin(w, req, user.ID, authResponse.Token, redirectURL)
+}
+
+// renderTwoFactorForm shows the second login step after a correct password.
+func (r *WebAppRouter) renderTwoFactorForm(w http.ResponseWriter, req *http.Request, challenge, redirectURL string) {
+	tmpl, err := r.loadTemplates()
 	if err != nil {
-		r.logger.Error("Failed to generate access token", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := utils.CreateTemplateData(req, "login")
+	data["MFAToken"] = challenge
+	if isValidRedirectURL(redirectURL) {
+		data["RedirectURL"] = redirectURL
+	}
+	if err := tmpl.ExecuteTemplate(w, "login.tpl", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// loginTwoFactorHandler completes a login for users with two-factor enabled.
+func (r *WebAppRouter) loginTwoFactorHandler(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mfaToken := req.Form.Get("mfa_token")
+	code := req.Form.Get("code")
+	redirectURL := req.Form.Get("redirect")
+	if mfaToken == "" || code == "" {
+		http.Error(w, "Verification code is required", http.StatusBadRequest)
+		return
+	}
+
+	result, err := r.authService.AuthorizeTwoFactor(common.WithClientIP(req), goserver.TwoFactorLoginRequest{
+		MfaToken: mfaToken,
+		Code:     code,
+	})
+	if err != nil || result.Code != http.StatusOK {
+		r.logger.Warn("Second factor verification failed", "status", result.Code, "error", err)
+		user := common.ChallengedUser(r.db, r.cfg.JWTSecret, mfaToken)
+		common.AuditLogin(r.logger, r.db, req, user, "", "web-2fa", false)
+		if result.Code == http.StatusTooManyRequests {
+			http.Error(w, "Too many failed attempts, try again later", http.StatusTooManyRequests)
+			return
+		}
+		http.Error(w, "Invalid verification code", http.StatusUnauthorized)
+		return
+	}
+	authResponse, ok := result.Body.(goserver.Authorize200Response)
+	if !ok {
+		http.Error(w, "Authentication failed", http.StatusInternalServerError)
+		return
+	}
+	claims, err := kinauth.ParseToken(authResponse.Token, []byte(r.cfg.JWTSecret))
+	if err != nil {
+		r.logger.Error("Failed to parse issued access token", "error", err)
 		http.Error(w, "Authentication failed", http.StatusInternalServerError)
 		return
 	}
-	rt, rtErr := authdb.CreateRefreshToken(r.gormDB, user.ID, refreshTokenTTL)
+
+	r.logger.Info("User logged in with second factor", "userID", claims.UserID)
+	if user, err := r.db.GetUser(claims.UserID); err == nil {
+		common.AuditLogin(r.logger, r.db, req, user, "", "web-2fa", true)
+	}
+	r.completeLogin(w, req, claims.UserID, authResponse.Token, redirectURL)
+}
+
+// completeLogin creates a refresh token, sets both auth cookies, and redirects.
+func (r *WebAppRouter) completeLogin(
+	w http.ResponseWriter, req *http.Request, userID uuid.UUID, accessToken, redirectURL string,
+) {
+	rt, rtErr := authdb.CreateRefreshToken(r.gormDB, userID, refreshTokenTTL)
 	if rtErr != nil {
 		r.logger.Error("Failed to create refresh token", "error", rtErr)
 		http.Error(w, "Authentication failed", http.StatusInternalServerError)
 		return
 	}
 
+	if err := r.db.CreateSession(models.NewSession(userID, rt.ID, accessToken, req.UserAgent(), common.ClientIP(req))); err != nil {
+		r.logger.Warn("Failed to record session", "error", err)
+	}
+
 	kincookies.SetAccessCookie(w, accessToken, int(accessTokenTTL.Seconds()), r.cookieCfg)
 	kincookies.SetRefreshCookie(w, rt.Token, int(refreshTokenTTL.Seconds()), r.cookieCfg)
 
-	r.logger.Info("User logged in", "username", username, "familyID", familyID)
-
 	// Determine redirect destination with security validation
 	destination := "/"
 	if isValidRedirectURL(redirectURL) {
@@ -138,6 +241,7 @@ func (r *WebAppRouter) logoutHandler(w http.ResponseWriter, req *http.Request) {
 	}
 
 	data := utils.CreateTemplateData(req, "login")
+	data["OIDCEnabled"] = r.oidc != nil
 
 	// Check if there's a redirect parameter in the logout request
 	redirectURL := req.URL.Query().Get("redirect")
@@ -175,6 +279,7 @@ func (r *WebAppRouter) ValidateFamilyID(
 
 		data := map[string]any{
 			"RedirectURL": redirectURL,
+			"OIDCEnabled": r.oidc != nil,
 		}
 
 		w.WriteHeader(statusCode)
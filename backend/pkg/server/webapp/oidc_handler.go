@@ -0,0 +1,189 @@
+package webapp
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	kinauth "github.com/ya-breeze/kin-core/auth"
+
+	"github.com/ya-breeze/diary.be/pkg/auth"
+	"github.com/ya-breeze/diary.be/pkg/database"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
+)
+
+// oidcStateCookie carries the signed state/nonce/PKCE verifier between the
+// login redirect and the callback.
+const oidcStateCookie = "diary_oidc"
+
+var errOIDCNoAccount = errors.New("no account for identity")
+
+func (r *WebAppRouter) routesOIDC() goserver.Routes {
+	if r.oidc == nil {
+		return goserver.Routes{}
+	}
+	return goserver.Routes{
+		"OIDCLogin":    {Method: "GET", Pattern: "/web/oidc/login", HandlerFunc: r.oidcLoginHandler},
+		"OIDCCallback": {Method: "GET", Pattern: "/web/oidc/callback", HandlerFunc: r.oidcCallbackHandler},
+	}
+}
+
+// oidcLoginHandler starts the authorization-code + PKCE flow by redirecting
+// to the identity provider.
+func (r *WebAppRouter) oidcLoginHandler(w http.ResponseWriter, req *http.Request) {
+	redirectURL := req.URL.Query().Get("redirect")
+	if !isValidRedirectURL(redirectURL) {
+		redirectURL = ""
+	}
+
+	st, err := auth.NewOIDCFlowState(redirectURL)
+	if err != nil {
+		r.logger.Error("Failed to create OIDC state", "error", err)
+		http.Error(w, "Authentication failed", http.StatusInternalServerError)
+		return
+	}
+	authURL, err := r.oidc.AuthCodeURL(req.Context(), st)
+	if err != nil {
+		r.logger.Error("Failed to build OIDC authorization URL", "error", err)
+		http.Error(w, "Identity provider unavailable", http.StatusBadGateway)
+		return
+	}
+	signed, err := auth.SignOIDCFlowState(st, r.cfg.JWTSecret)
+	if err != nil {
+		r.logger.Error("Failed to sign OIDC state", "error", err)
+		http.Error(w, "Authentication failed", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    signed,
+		Path:     "/",
+		MaxAge:   int(auth.OIDCStateTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   r.cfg.CookieSecure,
+		// Lax: the callback is a top-level cross-site GET navigation.
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, req, authURL, http.StatusFound)
+}
+
+// oidcCallbackHandler completes the flow: verifies state, exchanges the code,
+// maps the identity to an account and issues the same cookies as a password login.
+// The identity provider is trusted to enforce its own second factor, so the
+// local TOTP step does not apply here.
+func (r *WebAppRouter) oidcCallbackHandler(w http.ResponseWriter, req *http.Request) {
+	c, err := req.Cookie(oidcStateCookie)
+	http.SetCookie(w, &http.Cookie{
+		Name: oidcStateCookie, Value: "", Path: "/", MaxAge: -1,
+		HttpOnly: true, Secure: r.cfg.CookieSecure, SameSite: http.SameSiteLaxMode,
+	})
+	if err != nil {
+		http.Error(w, "Login session expired, please try again", http.StatusBadRequest)
+		return
+	}
+	st, err := auth.ParseOIDCFlowState(c.Value, r.cfg.JWTSecret)
+	if err != nil || st.State == "" || req.URL.Query().Get("state") != st.State {
+		r.logger.Warn("OIDC state mismatch", "error", err)
+		http.Error(w, "Invalid login state", http.StatusBadRequest)
+		return
+	}
+	if idpErr := req.URL.Query().Get("error"); idpErr != "" {
+		r.logger.Warn("OIDC provider returned an error", "error", idpErr,
+			"description", req.URL.Query().Get("error_description"))
+		http.Error(w, "Sign-in was not completed", http.StatusUnauthorized)
+		return
+	}
+	code := req.URL.Query().Get("code")
+	if code == "" {
+		http.Error(w, "Missing authorization code", http.StatusBadRequest)
+		return
+	}
+
+	claims, err := r.oidc.Exchange(req.Context(), code, st)
+	if err != nil {
+		r.logger.Warn("OIDC code exchange failed", "error", err)
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	user, err := r.resolveOIDCUser(claims)
+	if err != nil {
+		r.logger.Warn("OIDC login rejected", "subject", claims.Subject, "error", err)
+		if errors.Is(err, errOIDCNoAccount) {
+			http.Error(w, "No Diary account for this identity", http.StatusForbidden)
+			return
+		}
+		http.Error(w, "Authentication failed", http.StatusInternalServerError)
+		return
+	}
+
+	familyID := user.FamilyID
+	accessToken, err := auth.GenerateAccessToken(user.ID, &familyID, []byte(r.cfg.JWTSecret), accessTokenTTL)
+	if err != nil {
+		r.logger.Error("Failed to generate access token", "error", err)
+		http.Error(w, "Authentication failed", http.StatusInternalServerError)
+		return
+	}
+
+	r.logger.Info("User logged in via OIDC", "username", user.Username, "subject", claims.Subject)
+	common.AuditLogin(r.logger, r.db, req, user, "", "oidc", true)
+	r.completeLogin(w, req, user.ID, accessToken, st.Redirect)
+}
+
+// resolveOIDCUser maps verified claims to a Diary account via the configured
+// username claim, creating the account in the JIT family when enabled.
+func (r *WebAppRouter) resolveOIDCUser(claims *auth.OIDCClaims) (*models.User, error) {
+	claimName := r.cfg.OIDCUsernameClaim
+	username := strings.TrimSpace(claims.StringClaim(claimName))
+	if username == "" {
+		return nil, fmt.Errorf("%w: claim %q is empty", errOIDCNoAccount, claimName)
+	}
+	// Never map by an address the provider has not verified; a provider that
+	// omits email_verified gets no benefit of the doubt.
+	if claimName == "email" {
+		if verified, _ := claims.Raw["email_verified"].(bool); !verified {
+			return nil, fmt.Errorf("%w: email %q is not verified", errOIDCNoAccount, username)
+		}
+	}
+
+	user, err := r.db.GetUserByUsername(username)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, err
+	}
+	if r.cfg.OIDCJITFamily == "" {
+		return nil, fmt.Errorf("%w: %q", errOIDCNoAccount, username)
+	}
+
+	family, err := r.db.GetFamilyByName(r.cfg.OIDCJITFamily)
+	if errors.Is(err, database.ErrNotFound) {
+		family, err = r.db.CreateFamily(r.cfg.OIDCJITFamily)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create family %q: %w", r.cfg.OIDCJITFamily, err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load family %q: %w", r.cfg.OIDCJITFamily, err)
+	}
+	// The account gets an unguessable password: it signs in through the
+	// identity provider until an admin sets a real one.
+	password, err := auth.RandomURLString(32)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := kinauth.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	user, err = r.db.CreateUser(username, hash, family.ID)
+	if err != nil {
+		return nil, err
+	}
+	r.logger.Info("Created user from OIDC login", "username", username, "familyID", family.ID)
+	return user, nil
+}
@@ -82,7 +82,7 @@ func (r *WebAppRouter) populateSearchData(
 	tagsParam := strings.Join(searchTags, ",")
 
 	// Use the items service to get search results
-	response, err := r.itemsService.GetItems(ctx, dateParam, searchQuery, tagsParam)
+	response, err := r.itemsService.GetItems(ctx, dateParam, searchQuery, tagsParam, "")
 	if err != nil {
 		r.logger.Error(
 			"Failed to get search results from service",
@@ -11,9 +11,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ya-breeze/diary.be/pkg/auth"
 	"github.com/ya-breeze/diary.be/pkg/config"
 	"github.com/ya-breeze/diary.be/pkg/database"
 	"github.com/ya-breeze/diary.be/pkg/generated/goserver"
+	"github.com/ya-breeze/diary.be/pkg/server/common"
 	"github.com/ya-breeze/diary.be/pkg/utils"
 	kincookies "github.com/ya-breeze/kin-core/cookies"
 	kinmiddleware "github.com/ya-breeze/kin-core/middleware"
@@ -28,29 +30,37 @@ type WebAppRouter struct {
 	gormDB       *gorm.DB
 	kinCfg       kinmiddleware.Config
 	cookieCfg    kincookies.Config
-	authService  goserver.AuthAPIService
+	authService  common.LoginService
 	itemsService goserver.ItemsAPIService
+	// oidc is nil unless single sign-on is configured.
+	oidc *auth.OIDCProvider
 }
 
 func NewWebAppRouter(
-	controllers goserver.CustomControllers, commit string, logger *slog.Logger,
+	controllers goserver.CustomControllers, authService common.LoginService, commit string, logger *slog.Logger,
 	cfg *config.Config, db database.Storage, gormDB *gorm.DB,
 ) *WebAppRouter {
 	cookieCfg := kincookies.Config{Secure: cfg.CookieSecure}
+	var oidc *auth.OIDCProvider
+	if cfg.OIDCEnabled() {
+		oidc = auth.NewOIDCProvider(cfg.OIDCIssuer, cfg.OIDCClientID, cfg.OIDCClientSecret,
+			cfg.OIDCRedirectURL, strings.Fields(cfg.OIDCScopes))
+	}
 	return &WebAppRouter{
-		commit:       commit,
-		logger:       logger,
-		cfg:          cfg,
-		db:           db,
-		gormDB:       gormDB,
+		commit: commit,
+		logger: logger,
+		cfg:    cfg,
+		db:     db,
+		gormDB: gormDB,
 		kinCfg: kinmiddleware.Config{
 			JWTSecret: []byte(cfg.JWTSecret),
 			DB:        gormDB,
 			CookieCfg: cookieCfg,
 		},
 		cookieCfg:    cookieCfg,
-		authService:  controllers.AuthAPIService,
+		authService:  authService,
 		itemsService: controllers.ItemsAPIService,
+		oidc:         oidc,
 	}
 }
 
@@ -60,18 +70,20 @@ func (r *WebAppRouter) Routes() goserver.Routes {
 	merge(r.routesCore())
 	merge(r.routesUploads())
 	merge(r.routesStatic())
+	merge(r.routesOIDC())
 	return res
 }
 
 func (r *WebAppRouter) routesCore() goserver.Routes {
 	return goserver.Routes{
-		"RootPath":  {Method: "GET", Pattern: "/", HandlerFunc: r.homeHandler},
-		"Login":     {Method: "POST", Pattern: "/web/login", HandlerFunc: r.loginHandler},
-		"Logout":    {Method: "GET", Pattern: "/web/logout", HandlerFunc: r.logoutHandler},
-		"AboutPath": {Method: "GET", Pattern: "/web/about", HandlerFunc: r.aboutHandler},
-		"Search":    {Method: "GET", Pattern: "/web/search", HandlerFunc: r.searchHandler},
-		"Edit":      {Method: "GET", Pattern: "/web/edit", HandlerFunc: r.editHandler},
-		"Save":      {Method: "POST", Pattern: "/web/edit", HandlerFunc: r.saveHandler},
+		"RootPath":       {Method: "GET", Pattern: "/", HandlerFunc: r.homeHandler},
+		"Login":          {Method: "POST", Pattern: "/web/login", HandlerFunc: r.loginHandler},
+		"LoginTwoFactor": {Method: "POST", Pattern: "/web/login/2fa", HandlerFunc: r.loginTwoFactorHandler},
+		"Logout":         {Method: "GET", Pattern: "/web/logout", HandlerFunc: r.logoutHandler},
+		"AboutPath":      {Method: "GET", Pattern: "/web/about", HandlerFunc: r.aboutHandler},
+		"Search":         {Method: "GET", Pattern: "/web/search", HandlerFunc: r.searchHandler},
+		"Edit":           {Method: "GET", Pattern: "/web/edit", HandlerFunc: r.editHandler},
+		"Save":           {Method: "POST", Pattern: "/web/edit", HandlerFunc: r.saveHandler},
 	}
 }
 
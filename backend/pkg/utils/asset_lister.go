@@ -15,6 +15,17 @@ func GetAssetsFromMarkdown(md string) []string {
 	return lister.Assets
 }
 
+// GetFirstImageFromMarkdown returns the first image asset md references, or
+// "" when it has none.
+func GetFirstImageFromMarkdown(md string) string {
+	for _, name := range GetAssetsFromMarkdown(md) {
+		if IsImageFile(name) {
+			return name
+		}
+	}
+	return ""
+}
+
 type assetLister struct {
 	html.Renderer
 	Assets []string
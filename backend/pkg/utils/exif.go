@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// EXIF tags read by ImageGPS.
+const (
+	exifTagGPSIFD       = 0x8825
+	exifTagGPSLatRef    = 0x0001
+	exifTagGPSLat       = 0x0002
+	exifTagGPSLonRef    = 0x0003
+	exifTagGPSLon       = 0x0004
+	exifTypeASCII       = 2
+	exifTypeLong        = 4
+	exifTypeRational    = 5
+	exifIFDEntrySize    = 12
+	jpegMarkerStartScan = 0xDA
+)
+
+// ImageGPS returns the position recorded in the EXIF GPS tags of a JPEG
+// image. ok is false for other formats and for photos without a usable
+// position.
+func ImageGPS(data []byte) (lat, lon float64, ok bool) {
+	tiff := jpegExif(data)
+	if tiff == nil {
+		return 0, 0, false
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0, 0, false
+	}
+	r := exifReader{tiff: tiff, order: order}
+	gps, found := r.entry(r.u32(4), exifTagGPSIFD)
+	if !found || gps.typ != exifTypeLong {
+		return 0, 0, false
+	}
+	gpsIFD := r.u32(gps.at + 8)
+
+	lat, latOK := r.coordinate(gpsIFD, exifTagGPSLat, exifTagGPSLatRef, 'S', 90)
+	lon, lonOK := r.coordinate(gpsIFD, exifTagGPSLon, exifTagGPSLonRef, 'W', 180)
+	if !latOK || !lonOK || (lat == 0 && lon == 0) {
+		return 0, 0, false
+	}
+	return lat, lon, true
+}
+
+// jpegExif returns the TIFF structure of a JPEG's EXIF segment, or nil.
+func jpegExif(data []byte) []byte {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil
+	}
+	for pos := 2; pos+4 <= len(data); {
+		if data[pos] != 0xFF {
+			return nil
+		}
+		marker := data[pos+1]
+		if marker == jpegMarkerStartScan {
+			return nil
+		}
+		size := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + size
+		if size < 2 || end > len(data) {
+			return nil
+		}
+		segment := data[pos+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) && len(segment) >= 14 {
+			return segment[6:]
+		}
+		pos = end
+	}
+	return nil
+}
+
+// exifReader reads IFD entries of a TIFF structure. Offsets out of range read
+// as zero, so a corrupt file yields no position rather than a panic.
+type exifReader struct {
+	tiff  []byte
+	order binary.ByteOrder
+}
+
+type exifEntry struct {
+	typ   uint16
+	count uint32
+	// at is the offset of the entry itself; its value or value offset
+	// starts at at+8.
+	at uint32
+}
+
+func (r exifReader) u16(at uint32) uint16 {
+	if uint64(at)+2 > uint64(len(r.tiff)) {
+		return 0
+	}
+	return r.order.Uint16(r.tiff[at:])
+}
+
+func (r exifReader) u32(at uint32) uint32 {
+	if uint64(at)+4 > uint64(len(r.tiff)) {
+		return 0
+	}
+	return r.order.Uint32(r.tiff[at:])
+}
+
+// entry finds tag in the IFD at offset ifd.
+func (r exifReader) entry(ifd uint32, tag uint16) (exifEntry, bool) {
+	if ifd == 0 {
+		return exifEntry{}, false
+	}
+	n := uint32(r.u16(ifd))
+	for i := range n {
+		at := ifd + 2 + i*exifIFDEntrySize
+		if uint64(at)+exifIFDEntrySize > uint64(len(r.tiff)) {
+			break
+		}
+		if r.u16(at) == tag {
+			return exifEntry{typ: r.u16(at + 2), count: r.u32(at + 4), at: at}, true
+		}
+	}
+	return exifEntry{}, false
+}
+
+// coordinate reads a degrees/minutes/seconds GPS tag and its hemisphere
+// reference, negating it for the negative hemisphere.
+func (r exifReader) coordinate(ifd uint32, tag, refTag uint16, negative byte, limit float64) (float64, bool) {
+	e, found := r.entry(ifd, tag)
+	if !found || e.typ != exifTypeRational || e.count != 3 {
+		return 0, false
+	}
+	values := r.u32(e.at + 8)
+	value := 0.0
+	for i, scale := range []float64{1, 60, 3600} {
+		at := values + uint32(i)*8
+		num, den := r.u32(at), r.u32(at+4)
+		if den == 0 {
+			return 0, false
+		}
+		value += float64(num) / float64(den) / scale
+	}
+	if value > limit {
+		return 0, false
+	}
+	ref, found := r.entry(ifd, refTag)
+	if !found || ref.typ != exifTypeASCII {
+		return 0, false
+	}
+	if uint64(ref.at)+9 <= uint64(len(r.tiff)) && r.tiff[ref.at+8] == negative {
+		value = -value
+	}
+	return value, true
+}
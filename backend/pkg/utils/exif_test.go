@@ -0,0 +1,89 @@
+package utils_test
+
+import (
+	"encoding/binary"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/utils"
+)
+
+// gpsJPEG builds a minimal big-endian JPEG whose EXIF GPS tags hold the
+// position, with seconds to two decimals.
+func gpsJPEG(lat, lon float64) []byte {
+	be := binary.BigEndian
+	dms := func(v float64) []byte {
+		v = math.Abs(v)
+		deg := math.Floor(v)
+		minutes := math.Floor((v - deg) * 60)
+		seconds := math.Round(((v-deg)*60 - minutes) * 60 * 100)
+		out := make([]byte, 0, 24)
+		for _, r := range [][2]uint32{{uint32(deg), 1}, {uint32(minutes), 1}, {uint32(seconds), 100}} {
+			out = be.AppendUint32(out, r[0])
+			out = be.AppendUint32(out, r[1])
+		}
+		return out
+	}
+	entry := func(out []byte, tag, typ uint16, count, value uint32) []byte {
+		out = be.AppendUint16(out, tag)
+		out = be.AppendUint16(out, typ)
+		out = be.AppendUint32(out, count)
+		return be.AppendUint32(out, value)
+	}
+	ref := func(negative bool, pos, neg byte) uint32 {
+		if negative {
+			return uint32(neg) << 24
+		}
+		return uint32(pos) << 24
+	}
+
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = be.AppendUint16(tiff, 1)
+	tiff = entry(tiff, 0x8825, 4, 1, 26)
+	tiff = be.AppendUint32(tiff, 0)
+	tiff = be.AppendUint16(tiff, 4)
+	tiff = entry(tiff, 1, 2, 2, ref(lat < 0, 'N', 'S'))
+	tiff = entry(tiff, 2, 5, 3, 80)
+	tiff = entry(tiff, 3, 2, 2, ref(lon < 0, 'E', 'W'))
+	tiff = entry(tiff, 4, 5, 3, 104)
+	tiff = be.AppendUint32(tiff, 0)
+	tiff = append(tiff, dms(lat)...)
+	tiff = append(tiff, dms(lon)...)
+
+	out := []byte{0xFF, 0xD8, 0xFF, 0xE1}
+	out = be.AppendUint16(out, uint16(2+6+len(tiff)))
+	out = append(out, "Exif\x00\x00"...)
+	out = append(out, tiff...)
+	return append(out, 0xFF, 0xD9)
+}
+
+var _ = Describe("ImageGPS", func() {
+	It("reads the position of a geotagged JPEG", func() {
+		lat, lon, ok := utils.ImageGPS(gpsJPEG(45.9868, 9.2572))
+		Expect(ok).To(BeTrue())
+		Expect(lat).To(BeNumerically("~", 45.9868, 0.0001))
+		Expect(lon).To(BeNumerically("~", 9.2572, 0.0001))
+	})
+
+	It("applies the hemisphere references", func() {
+		lat, lon, ok := utils.ImageGPS(gpsJPEG(-33.8568, -70.6693))
+		Expect(ok).To(BeTrue())
+		Expect(lat).To(BeNumerically("~", -33.8568, 0.0001))
+		Expect(lon).To(BeNumerically("~", -70.6693, 0.0001))
+	})
+
+	It("ignores images without a usable position", func() {
+		_, _, ok := utils.ImageGPS([]byte("\x89PNG\r\n\x1a\n"))
+		Expect(ok).To(BeFalse())
+		_, _, ok = utils.ImageGPS([]byte{0xFF, 0xD8, 0xFF, 0xDA, 0x00, 0x02})
+		Expect(ok).To(BeFalse())
+		_, _, ok = utils.ImageGPS(gpsJPEG(0, 0))
+		Expect(ok).To(BeFalse())
+
+		truncated := gpsJPEG(45.9868, 9.2572)
+		_, _, ok = utils.ImageGPS(truncated[:60])
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"io"
+	"regexp"
+	"time"
+
+	"github.com/gomarkdown/markdown"
+	"github.com/gomarkdown/markdown/ast"
+	"github.com/gomarkdown/markdown/html"
+)
+
+// wikiLinkRe matches a wiki-style link such as [[2024-05-14]].
+var wikiLinkRe = regexp.MustCompile(`\[\[([^\[\]\n]+)\]\]`)
+
+// wikiLink is one link found in a text literal: its byte range in the
+// literal and the day it points to.
+type wikiLink struct {
+	start, end int
+	target     string
+}
+
+// findWikiLinks returns the links of a text literal. Only day links
+// ([[YYYY-MM-DD]]) are links for now; any other [[...]] stays plain text, so
+// entry-ID targets can be added here without touching the callers.
+func findWikiLinks(text []byte) []wikiLink {
+	var links []wikiLink
+	for _, m := range wikiLinkRe.FindAllSubmatchIndex(text, -1) {
+		target := string(text[m[2]:m[3]])
+		if _, err := time.Parse("2006-01-02", target); err != nil {
+			continue
+		}
+		links = append(links, wikiLink{start: m[0], end: m[1], target: target})
+	}
+	return links
+}
+
+// insideLink reports whether node is part of a markdown link, whose text
+// must not be turned into another link.
+func insideLink(node ast.Node) bool {
+	for p := node.GetParent(); p != nil; p = p.GetParent() {
+		if _, ok := p.(*ast.Link); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDateLinksFromMarkdown returns the distinct days an entry links to with
+// [[YYYY-MM-DD]], in order of appearance. Links in code and in the text of
+// markdown links are ignored.
+func GetDateLinksFromMarkdown(md string) []string {
+	lister := &linkLister{seen: map[string]struct{}{}}
+	_ = markdown.ToHTML([]byte(md), nil, lister)
+
+	return lister.Dates
+}
+
+type linkLister struct {
+	html.Renderer
+	Dates []string
+	seen  map[string]struct{}
+}
+
+func (r *linkLister) RenderNode(w io.Writer, node ast.Node, entering bool) ast.WalkStatus {
+	if text, ok := node.(*ast.Text); ok && entering && !insideLink(text) {
+		for _, link := range findWikiLinks(text.Literal) {
+			if _, dup := r.seen[link.target]; dup {
+				continue
+			}
+			r.seen[link.target] = struct{}{}
+			r.Dates = append(r.Dates, link.target)
+		}
+		return ast.GoToNext
+	}
+
+	return r.Renderer.RenderNode(w, node, entering)
+}
@@ -0,0 +1,22 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/utils"
+)
+
+var _ = Describe("GetDateLinksFromMarkdown", func() {
+	It("returns the distinct linked days in order", func() {
+		md := "# Trip\n\nBack to the lake, like on *[[2023-07-02]]*.\n\n" +
+			"- [[2022-12-31]]\n- [[2023-07-02]] again\n"
+		Expect(utils.GetDateLinksFromMarkdown(md)).To(Equal([]string{"2023-07-02", "2022-12-31"}))
+	})
+
+	It("ignores invalid days, code and link text", func() {
+		md := "[[2023-02-30]] [[tomorrow]] `[[2023-01-01]]`\n\n" +
+			"    [[2023-01-02]]\n\n[on [[2023-01-03]]](https://example.com)"
+		Expect(utils.GetDateLinksFromMarkdown(md)).To(BeEmpty())
+	})
+})
@@ -14,14 +14,46 @@ import (
 type imagePrefixRenderer struct {
 	html.Renderer
 	ImagePrefix string
+	// Captions maps asset file names to AI captions, used as alt text for
+	// images whose markdown gives none.
+	Captions map[string]string
+	// DateLinkPrefix is prepended to the day of a [[YYYY-MM-DD]] link to
+	// build the URL it navigates to.
+	DateLinkPrefix string
 }
 
 func NewImagePrefixRenderer(imagePrefix string) *imagePrefixRenderer {
 	return &imagePrefixRenderer{
-		ImagePrefix: imagePrefix,
+		ImagePrefix:    imagePrefix,
+		DateLinkPrefix: "/?date=",
 	}
 }
 
+// WithCaptions sets the captions used as default alt text.
+func (r *imagePrefixRenderer) WithCaptions(captions map[string]string) *imagePrefixRenderer {
+	r.Captions = captions
+	return r
+}
+
+// altText returns the image's markdown alt text, falling back to its title
+// and then to the asset's caption.
+func (r *imagePrefixRenderer) altText(img *ast.Image) string {
+	var b strings.Builder
+	ast.WalkFunc(img, func(node ast.Node, entering bool) ast.WalkStatus {
+		if leaf := node.AsLeaf(); entering && leaf != nil {
+			b.Write(leaf.Literal)
+		}
+		return ast.GoToNext
+	})
+	if alt := strings.TrimSpace(b.String()); alt != "" {
+		return alt
+	}
+	if title := strings.TrimSpace(string(img.Title)); title != "" {
+		return title
+	}
+	return r.Captions[filepath.Clean(string(img.Destination))]
+}
+
 func isVideoExtension(ext string) bool {
 	switch strings.ToLower(ext) {
 	case ".mp4", ".webm", ".ogg", ".mov", ".m4v":
@@ -31,6 +63,26 @@ func isVideoExtension(ext string) bool {
 	}
 }
 
+func isAudioExtension(ext string) bool {
+	switch strings.ToLower(ext) {
+	case ".mp3", ".m4a", ".wav", ".aac", ".flac", ".opus":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsImageFile reports whether name has the extension of an image the web app
+// shows inline, as opposed to a video or voice memo.
+func IsImageFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".jpg", ".jpeg", ".png", ".gif", ".webp", ".heic", ".avif":
+		return true
+	default:
+		return false
+	}
+}
+
 func videoMimeType(ext string) string {
 	switch strings.ToLower(ext) {
 	case ".mp4", ".m4v":
@@ -52,6 +104,20 @@ func (r *imagePrefixRenderer) RenderNode(w io.Writer, node ast.Node, entering bo
 		ext := strings.ToLower(filepath.Ext(dest))
 		newSrc := fmt.Sprintf("%s%s", r.ImagePrefix, dest)
 
+		if isAudioExtension(ext) {
+			if entering {
+				label := r.altText(img)
+				if label == "" {
+					label = "Voice memo"
+				}
+				_, _ = fmt.Fprintf(w, `<br><audio class="diary-audio" src="%s" controls preload="metadata" aria-label="%s">`,
+					newSrc, template.HTMLEscapeString(label))
+			} else {
+				_, _ = io.WriteString(w, "</audio><br>")
+			}
+			return ast.SkipChildren
+		}
+
 		if entering {
 			if isVideoExtension(ext) {
 				label := string(img.Title)
@@ -67,7 +133,8 @@ func (r *imagePrefixRenderer) RenderNode(w io.Writer, node ast.Node, entering bo
 					_, _ = fmt.Fprintf(w, `<source src="%s">`, newSrc)
 				}
 			} else {
-				_, _ = fmt.Fprintf(w, `<br><a href="%s"><img src="%s" alt="%s" class="diary-image"`, newSrc, newSrc, img.Title)
+				_, _ = fmt.Fprintf(w, `<br><a href="%s"><img src="%s" alt="%s" class="diary-image"`,
+					newSrc, newSrc, template.HTMLEscapeString(r.altText(img)))
 			}
 		} else {
 			if isVideoExtension(ext) {
@@ -79,6 +146,31 @@ func (r *imagePrefixRenderer) RenderNode(w io.Writer, node ast.Node, entering bo
 		return ast.SkipChildren
 	}
 
+	if text, ok := node.(*ast.Text); ok && entering && !insideLink(text) {
+		if links := findWikiLinks(text.Literal); len(links) > 0 {
+			r.renderDateLinks(w, text, links)
+			return ast.GoToNext
+		}
+	}
+
 	// fallback to default
 	return r.Renderer.RenderNode(w, node, entering)
 }
+
+// renderDateLinks writes text with its [[YYYY-MM-DD]] links turned into
+// links to those days. The text around them renders as usual.
+func (r *imagePrefixRenderer) renderDateLinks(w io.Writer, text *ast.Text, links []wikiLink) {
+	plain := func(literal []byte) {
+		if len(literal) > 0 {
+			r.Text(w, &ast.Text{Leaf: ast.Leaf{Literal: literal, Parent: text.Parent}})
+		}
+	}
+	pos := 0
+	for _, link := range links {
+		plain(text.Literal[pos:link.start])
+		_, _ = fmt.Fprintf(w, `<a class="diary-link" href="%s%s">%s</a>`,
+			r.DateLinkPrefix, link.target, link.target)
+		pos = link.end
+	}
+	plain(text.Literal[pos:])
+}
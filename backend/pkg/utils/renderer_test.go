@@ -0,0 +1,53 @@
+package utils_test
+
+import (
+	"github.com/gomarkdown/markdown"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/utils"
+)
+
+var _ = Describe("imagePrefixRenderer", func() {
+	render := func(md string) string {
+		r := utils.NewImagePrefixRenderer("/web/assets/").
+			WithCaptions(map[string]string{"dog.jpg": `A dog & a "ball"`})
+		return string(markdown.ToHTML([]byte(md), nil, r))
+	}
+
+	It("uses the markdown alt text when given", func() {
+		Expect(render("![Rex at the park](dog.jpg)")).To(ContainSubstring(`alt="Rex at the park"`))
+	})
+
+	It("falls back to the escaped caption when the alt text is empty", func() {
+		Expect(render("![](dog.jpg)")).To(ContainSubstring(`alt="A dog &amp; a &#34;ball&#34;"`))
+	})
+
+	It("renders voice memos as audio players", func() {
+		Expect(render("![](memo.m4a)")).To(ContainSubstring(
+			`<audio class="diary-audio" src="/web/assets/memo.m4a" controls preload="metadata" aria-label="Voice memo"></audio>`))
+	})
+
+	It("leaves the alt text empty without a caption", func() {
+		Expect(render("![](cat.jpg)")).To(ContainSubstring(`src="/web/assets/cat.jpg" alt=""`))
+	})
+})
+
+var _ = Describe("imagePrefixRenderer date links", func() {
+	render := func(md string) string {
+		return string(markdown.ToHTML([]byte(md), nil, utils.NewImagePrefixRenderer("/web/assets/")))
+	}
+
+	It("turns day links into navigation links", func() {
+		Expect(render("Met Ann & Bob on [[2024-05-14]], see also [[2024-05-20]].")).To(Equal(
+			"<p>Met Ann &amp; Bob on " +
+				`<a class="diary-link" href="/?date=2024-05-14">2024-05-14</a>, see also ` +
+				`<a class="diary-link" href="/?date=2024-05-20">2024-05-20</a>.</p>` + "\n"))
+	})
+
+	It("leaves other targets, code and markdown links alone", func() {
+		out := render("[[someday]] `[[2024-05-14]]` [see [[2024-05-15]]](x)")
+		Expect(out).ToNot(ContainSubstring("diary-link"))
+		Expect(out).To(ContainSubstring("[[someday]]"))
+	})
+})
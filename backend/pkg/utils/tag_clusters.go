@@ -0,0 +1,260 @@
+package utils
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Reasons two tags ended up in the same TagCluster.
+const (
+	// TagClusterCase: equal after case-folding and treating "-", "_" and
+	// spaces alike ("Road trip", "road-trip").
+	TagClusterCase = "case"
+	// TagClusterStem: equal after stripping English inflections ("run",
+	// "running", "Runs").
+	TagClusterStem = "stem"
+	// TagClusterEdit: a small edit distance apart ("birthday", "brithday").
+	TagClusterEdit = "edit"
+	// TagClusterAI: grouped by the AI provider ("car", "auto").
+	TagClusterAI = "ai"
+)
+
+// TagCount is a tag with the number of entries using it.
+type TagCount struct {
+	Name  string
+	Count int
+}
+
+// TagCluster is a group of tags that probably mean the same thing. Tags are
+// sorted by count (most used first); Canonical is the first of them, the
+// natural name to merge the others into.
+type TagCluster struct {
+	Canonical string
+	Tags      []TagCount
+	Reasons   []string
+}
+
+// ClusterTags groups near-duplicate tags by case-folding, stemming and edit
+// distance. groups are extra clusters from another source (the AI provider);
+// names in them that are not among tags are ignored. Clusters are transitive:
+// when "Hikes" ~ "hikes" and "hikes" ~ "hiking", all three form one cluster.
+// Only clusters of two or more tags are returned, the most used first.
+func ClusterTags(tags []TagCount, groups [][]string) []TagCluster {
+	index := make(map[string]int, len(tags))
+	for i, t := range tags {
+		index[t.Name] = i
+	}
+	parent := make([]int, len(tags))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	type edge struct {
+		a, b   int
+		reason string
+	}
+	var edges []edge
+	link := func(a, b int, reason string) {
+		edges = append(edges, edge{a, b, reason})
+		parent[find(a)] = find(b)
+	}
+
+	// Tags sharing a normalized form or a stem are linked to the first tag
+	// seen with it.
+	byNorm := map[string]int{}
+	byStem := map[string]int{}
+	norms := make([]string, len(tags))
+	for i, t := range tags {
+		norms[i] = normalizeTag(t.Name)
+		if j, ok := byNorm[norms[i]]; ok {
+			link(i, j, TagClusterCase)
+			continue
+		}
+		byNorm[norms[i]] = i
+		stem := stemTag(norms[i])
+		if j, ok := byStem[stem]; ok {
+			link(i, j, TagClusterStem)
+			continue
+		}
+		byStem[stem] = i
+	}
+	for i := range tags {
+		for j := i + 1; j < len(tags); j++ {
+			if find(i) != find(j) && closeSpelling(norms[i], norms[j]) {
+				link(i, j, TagClusterEdit)
+			}
+		}
+	}
+	for _, g := range groups {
+		first := -1
+		for _, name := range g {
+			i, ok := index[name]
+			if !ok {
+				continue
+			}
+			if first < 0 {
+				first = i
+			} else if i != first {
+				link(i, first, TagClusterAI)
+			}
+		}
+	}
+
+	members := map[int][]TagCount{}
+	reasons := map[int][]string{}
+	for i, t := range tags {
+		root := find(i)
+		members[root] = append(members[root], t)
+	}
+	for _, e := range edges {
+		root := find(e.a)
+		if !slices.Contains(reasons[root], e.reason) {
+			reasons[root] = append(reasons[root], e.reason)
+		}
+	}
+
+	var clusters []TagCluster
+	total := map[string]int{}
+	for root, ms := range members {
+		if len(ms) < 2 {
+			continue
+		}
+		sort.Slice(ms, func(i, j int) bool {
+			if ms[i].Count != ms[j].Count {
+				return ms[i].Count > ms[j].Count
+			}
+			return ms[i].Name < ms[j].Name
+		})
+		rs := reasons[root]
+		sort.Strings(rs)
+		clusters = append(clusters, TagCluster{Canonical: ms[0].Name, Tags: ms, Reasons: rs})
+		for _, m := range ms {
+			total[ms[0].Name] += m.Count
+		}
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		a, b := clusters[i].Canonical, clusters[j].Canonical
+		if total[a] != total[b] {
+			return total[a] > total[b]
+		}
+		return a < b
+	})
+	return clusters
+}
+
+// normalizeTag case-folds name and treats runs of spaces, "-" and "_" as a
+// single space.
+func normalizeTag(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return unicode.IsSpace(r) || r == '-' || r == '_'
+	})
+	return strings.Join(fields, " ")
+}
+
+// stemTag strips common English inflections from every word of a normalized
+// tag. It is deliberately simple: it only has to map the forms people
+// actually use as tags ("hike", "hikes", "hiking", "hiked") onto one key.
+func stemTag(norm string) string {
+	words := strings.Fields(norm)
+	for i, w := range words {
+		words[i] = stemWord(w)
+	}
+	return strings.Join(words, " ")
+}
+
+func stemWord(w string) string {
+	switch {
+	case len(w) > 4 && strings.HasSuffix(w, "ies"):
+		return w[:len(w)-3] + "y"
+	case strings.HasSuffix(w, "sses"):
+		return w[:len(w)-2]
+	case len(w) > 4 && (strings.HasSuffix(w, "ches") || strings.HasSuffix(w, "shes") ||
+		strings.HasSuffix(w, "xes")):
+		return w[:len(w)-2]
+	case len(w) > 3 && strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") && !strings.HasSuffix(w, "us"):
+		return w[:len(w)-1]
+	case len(w) > 5 && strings.HasSuffix(w, "ing"):
+		return restoreStem(w[:len(w)-3])
+	case len(w) > 4 && strings.HasSuffix(w, "ed"):
+		return restoreStem(w[:len(w)-2])
+	}
+	return w
+}
+
+// restoreStem undoes the spelling changes English makes before "-ing" and
+// "-ed": a doubled final consonant ("running") or a dropped "e" ("hiking").
+func restoreStem(s string) string {
+	n := len(s)
+	if n >= 3 && s[n-1] == s[n-2] && !isVowel(s[n-1]) && !strings.ContainsRune("lsz", rune(s[n-1])) {
+		return s[:n-1]
+	}
+	if n == 3 && !isVowel(s[0]) && isVowel(s[1]) && !isVowel(s[2]) && !strings.ContainsRune("wxy", rune(s[2])) {
+		return s + "e"
+	}
+	return s
+}
+
+func isVowel(c byte) bool {
+	return strings.IndexByte("aeiou", c) >= 0
+}
+
+// closeSpelling reports whether two normalized tags are probably typos of
+// each other: one edit apart from five characters on, two from nine. Short
+// tags and tags with digits ("2023", "2024") are never close.
+func closeSpelling(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	shorter := min(len(ra), len(rb))
+	if shorter < 5 || strings.ContainsFunc(a+b, unicode.IsDigit) {
+		return false
+	}
+	limit := 1
+	if shorter >= 9 {
+		limit = 2
+	}
+	if abs(len(ra)-len(rb)) > limit {
+		return false
+	}
+	return editDistance(ra, rb) <= limit
+}
+
+// editDistance is the optimal string alignment distance between a and b:
+// Levenshtein distance with swapping two adjacent characters counting as one
+// edit, the most common typo.
+func editDistance(a, b []rune) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
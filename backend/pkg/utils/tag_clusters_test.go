@@ -0,0 +1,66 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/utils"
+)
+
+var _ = Describe("ClusterTags", func() {
+	names := func(c utils.TagCluster) []string {
+		out := make([]string, 0, len(c.Tags))
+		for _, t := range c.Tags {
+			out = append(out, t.Name)
+		}
+		return out
+	}
+
+	It("groups case, spacing and inflection variants under the most used tag", func() {
+		clusters := utils.ClusterTags([]utils.TagCount{
+			{Name: "run", Count: 2},
+			{Name: "running", Count: 7},
+			{Name: "Runs", Count: 1},
+			{Name: "Road trip", Count: 1},
+			{Name: "road-trip", Count: 3},
+			{Name: "hiking", Count: 4},
+			{Name: "hikes", Count: 1},
+			{Name: "family", Count: 9},
+		}, nil)
+
+		Expect(clusters).To(HaveLen(3))
+		Expect(clusters[0].Canonical).To(Equal("running"))
+		Expect(names(clusters[0])).To(Equal([]string{"running", "run", "Runs"}))
+		Expect(clusters[0].Reasons).To(Equal([]string{utils.TagClusterStem}))
+		Expect(names(clusters[1])).To(Equal([]string{"hiking", "hikes"}))
+		Expect(names(clusters[2])).To(Equal([]string{"road-trip", "Road trip"}))
+		Expect(clusters[2].Reasons).To(Equal([]string{utils.TagClusterCase}))
+	})
+
+	It("groups typos but not short tags or numbers", func() {
+		clusters := utils.ClusterTags([]utils.TagCount{
+			{Name: "birthday", Count: 5},
+			{Name: "brithday", Count: 1},
+			{Name: "cat", Count: 3},
+			{Name: "car", Count: 3},
+			{Name: "trip 2023", Count: 1},
+			{Name: "trip 2024", Count: 1},
+		}, nil)
+
+		Expect(clusters).To(HaveLen(1))
+		Expect(names(clusters[0])).To(Equal([]string{"birthday", "brithday"}))
+		Expect(clusters[0].Reasons).To(Equal([]string{utils.TagClusterEdit}))
+	})
+
+	It("merges extra groups and ignores unknown names in them", func() {
+		clusters := utils.ClusterTags([]utils.TagCount{
+			{Name: "car", Count: 3},
+			{Name: "auto", Count: 1},
+			{Name: "cars", Count: 1},
+		}, [][]string{{"auto", "car", "vehicle"}, {"unknown"}})
+
+		Expect(clusters).To(HaveLen(1))
+		Expect(names(clusters[0])).To(Equal([]string{"car", "auto", "cars"}))
+		Expect(clusters[0].Reasons).To(Equal([]string{utils.TagClusterAI, utils.TagClusterStem}))
+	})
+})
@@ -0,0 +1,50 @@
+package utils
+
+import "strings"
+
+// TagSeparator separates the levels of a hierarchical tag such as
+// "travel/italy/rome". Tags without it are flat and behave as before.
+const TagSeparator = "/"
+
+// NormalizeTagPath trims every level of tag and drops empty ones, so
+// " travel / italy/" becomes "travel/italy". A flat tag is only trimmed.
+func NormalizeTagPath(tag string) string {
+	if !strings.Contains(tag, TagSeparator) {
+		return strings.TrimSpace(tag)
+	}
+	levels := strings.Split(tag, TagSeparator)
+	kept := levels[:0]
+	for _, l := range levels {
+		if l = strings.TrimSpace(l); l != "" {
+			kept = append(kept, l)
+		}
+	}
+	return strings.Join(kept, TagSeparator)
+}
+
+// TagAncestors returns the proper ancestors of tag, outermost first:
+// "travel" and "travel/italy" for "travel/italy/rome"; none for a flat tag.
+func TagAncestors(tag string) []string {
+	var out []string
+	for i := range len(tag) {
+		if tag[i] == TagSeparator[0] {
+			out = append(out, tag[:i])
+		}
+	}
+	return out
+}
+
+// TagInSubtree reports whether tag is root or one of its descendants.
+func TagInSubtree(tag, root string) bool {
+	return tag == root || strings.HasPrefix(tag, root+TagSeparator)
+}
+
+// MoveTagSubtree returns tag with its root prefix replaced by newRoot when tag
+// is in root's subtree: "travel/italy" moves to "trips/italy" when "travel"
+// becomes "trips".
+func MoveTagSubtree(tag, root, newRoot string) (string, bool) {
+	if !TagInSubtree(tag, root) {
+		return tag, false
+	}
+	return newRoot + tag[len(root):], true
+}
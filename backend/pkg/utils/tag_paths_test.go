@@ -0,0 +1,37 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/utils"
+)
+
+var _ = Describe("Hierarchical tags", func() {
+	It("normalizes levels and leaves flat tags alone", func() {
+		Expect(utils.NormalizeTagPath(" travel / italy/")).To(Equal("travel/italy"))
+		Expect(utils.NormalizeTagPath("a//b")).To(Equal("a/b"))
+		Expect(utils.NormalizeTagPath(" road trip ")).To(Equal("road trip"))
+		Expect(utils.NormalizeTagPath(" / ")).To(BeEmpty())
+	})
+
+	It("lists ancestors outermost first", func() {
+		Expect(utils.TagAncestors("travel/italy/rome")).To(Equal([]string{"travel", "travel/italy"}))
+		Expect(utils.TagAncestors("travel")).To(BeEmpty())
+	})
+
+	It("matches a subtree by whole levels", func() {
+		Expect(utils.TagInSubtree("travel", "travel")).To(BeTrue())
+		Expect(utils.TagInSubtree("travel/italy", "travel")).To(BeTrue())
+		Expect(utils.TagInSubtree("travels", "travel")).To(BeFalse())
+		Expect(utils.TagInSubtree("travel", "travel/italy")).To(BeFalse())
+	})
+
+	It("moves a subtree to a new root", func() {
+		moved, ok := utils.MoveTagSubtree("travel/italy/rome", "travel", "trips")
+		Expect(ok).To(BeTrue())
+		Expect(moved).To(Equal("trips/italy/rome"))
+		_, ok = utils.MoveTagSubtree("travels", "travel", "trips")
+		Expect(ok).To(BeFalse())
+	})
+})
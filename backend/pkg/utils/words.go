@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/gomarkdown/markdown"
+	"github.com/gomarkdown/markdown/ast"
+)
+
+// CountWords returns the number of words in the text of a markdown body:
+// markup, link destinations and embedded assets do not count, and neither do
+// tokens without a letter or digit (such as a lone dash).
+func CountWords(md string) int {
+	if strings.TrimSpace(md) == "" {
+		return 0
+	}
+	words := 0
+	ast.WalkFunc(markdown.Parse([]byte(md), nil), func(node ast.Node, entering bool) ast.WalkStatus {
+		if !entering {
+			return ast.GoToNext
+		}
+		switch n := node.(type) {
+		case *ast.Image:
+			return ast.SkipChildren
+		case *ast.Text, *ast.Code, *ast.CodeBlock:
+			for _, field := range strings.Fields(string(n.AsLeaf().Literal)) {
+				if strings.IndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) >= 0 {
+					words++
+				}
+			}
+		}
+		return ast.GoToNext
+	})
+	return words
+}
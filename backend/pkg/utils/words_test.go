@@ -0,0 +1,20 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/utils"
+)
+
+var _ = Describe("CountWords", func() {
+	It("counts the words of the text", func() {
+		Expect(utils.CountWords("")).To(Equal(0))
+		Expect(utils.CountWords("# A day\n\nWent **out** - to the [lake](https://example.com/a/b).\n\n- one\n- two")).
+			To(Equal(9))
+	})
+
+	It("skips embedded assets and counts code", func() {
+		Expect(utils.CountWords("![A long caption here](dog.jpg)\n\nRan `go test` twice, café & crêpes")).To(Equal(6))
+	})
+})
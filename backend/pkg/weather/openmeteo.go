@@ -0,0 +1,151 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// openMeteoForecastURL serves roughly the last three months and the
+	// coming two weeks.
+	openMeteoForecastURL = "https://api.open-meteo.com"
+	// openMeteoArchiveURL serves the reanalysis archive, which trails the
+	// present by a few days.
+	openMeteoArchiveURL = "https://archive-api.open-meteo.com"
+	// openMeteoArchiveAfter is how old a day must be to be asked from the
+	// archive; newer days come from the forecast API.
+	openMeteoArchiveAfter = 60 * 24 * time.Hour
+	// openMeteoForecastDays is how far ahead the forecast API reaches.
+	openMeteoForecastDays = 15 * 24 * time.Hour
+	openMeteoTimeout      = 10 * time.Second
+)
+
+// openMeteo is a Provider backed by Open-Meteo (https://open-meteo.com), which
+// needs no API key.
+type openMeteo struct {
+	httpClient  *http.Client
+	forecastURL string
+	archiveURL  string
+	now         func() time.Time
+}
+
+// newOpenMeteo builds the Open-Meteo provider; a non-empty baseURL serves both
+// the forecast and the archive API.
+func newOpenMeteo(baseURL string) *openMeteo {
+	p := &openMeteo{
+		httpClient:  &http.Client{Timeout: openMeteoTimeout},
+		forecastURL: openMeteoForecastURL,
+		archiveURL:  openMeteoArchiveURL,
+		now:         time.Now,
+	}
+	if baseURL = strings.TrimRight(baseURL, "/"); baseURL != "" {
+		p.forecastURL, p.archiveURL = baseURL, baseURL
+	}
+	return p
+}
+
+func (p *openMeteo) Enabled() bool { return true }
+
+func (p *openMeteo) Name() string { return ProviderOpenMeteo }
+
+// openMeteoDaily is the "daily" block of an Open-Meteo answer; values are
+// null where the model has no data.
+type openMeteoDaily struct {
+	Time          []string   `json:"time"`
+	WeatherCode   []*int     `json:"weather_code"`
+	TempMax       []*float64 `json:"temperature_2m_max"`
+	TempMin       []*float64 `json:"temperature_2m_min"`
+	Precipitation []*float64 `json:"precipitation_sum"`
+}
+
+func (p *openMeteo) Lookup(ctx context.Context, lat, lon float64, date string) (*Snapshot, error) {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q: %w", date, err)
+	}
+	now := p.now()
+	if day.After(now.Add(openMeteoForecastDays)) {
+		return nil, nil
+	}
+	endpoint := p.forecastURL + "/v1/forecast"
+	if now.Sub(day) > openMeteoArchiveAfter {
+		endpoint = p.archiveURL + "/v1/archive"
+	}
+
+	query := url.Values{}
+	query.Set("latitude", strconv.FormatFloat(lat, 'f', 4, 64))
+	query.Set("longitude", strconv.FormatFloat(lon, 'f', 4, 64))
+	query.Set("start_date", date)
+	query.Set("end_date", date)
+	query.Set("daily", "weather_code,temperature_2m_max,temperature_2m_min,precipitation_sum")
+	query.Set("timezone", "auto")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("open-meteo request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("open-meteo returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	var answer struct {
+		Daily openMeteoDaily `json:"daily"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&answer); err != nil {
+		return nil, fmt.Errorf("decoding open-meteo answer: %w", err)
+	}
+	d := answer.Daily
+	if len(d.Time) == 0 || len(d.WeatherCode) == 0 || len(d.TempMax) == 0 || len(d.TempMin) == 0 ||
+		d.WeatherCode[0] == nil || d.TempMax[0] == nil || d.TempMin[0] == nil {
+		return nil, nil
+	}
+	snapshot := &Snapshot{
+		Condition: wmoCondition(*d.WeatherCode[0]),
+		TempMinC:  *d.TempMin[0],
+		TempMaxC:  *d.TempMax[0],
+	}
+	if len(d.Precipitation) > 0 && d.Precipitation[0] != nil {
+		snapshot.PrecipitationMM = *d.Precipitation[0]
+	}
+	return snapshot, nil
+}
+
+// wmoCondition maps a WMO weather interpretation code, as reported by
+// Open-Meteo, to a condition.
+func wmoCondition(code int) string {
+	switch {
+	case code == 0:
+		return ConditionClear
+	case code <= 2:
+		return ConditionPartlyCloudy
+	case code == 3:
+		return ConditionCloudy
+	case code == 45 || code == 48:
+		return ConditionFog
+	case code >= 51 && code <= 57:
+		return ConditionDrizzle
+	case code >= 61 && code <= 67:
+		return ConditionRain
+	case code >= 71 && code <= 77, code == 85 || code == 86:
+		return ConditionSnow
+	case code >= 80 && code <= 82:
+		return ConditionShowers
+	case code >= 95:
+		return ConditionThunderstorm
+	default:
+		return ConditionCloudy
+	}
+}
@@ -0,0 +1,133 @@
+// Package weather looks up the weather of a day at a place, for the snapshot
+// stored with entries that have coordinates.
+package weather
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+)
+
+// Provider names accepted by config.Config.WeatherProvider.
+const (
+	ProviderOpenMeteo = "open-meteo"
+	ProviderFake      = "fake"
+	ProviderNone      = "none"
+)
+
+// Weather conditions, coarse enough for every provider to map onto.
+const (
+	ConditionClear        = "clear"
+	ConditionPartlyCloudy = "partly-cloudy"
+	ConditionCloudy       = "cloudy"
+	ConditionFog          = "fog"
+	ConditionDrizzle      = "drizzle"
+	ConditionRain         = "rain"
+	ConditionSnow         = "snow"
+	ConditionShowers      = "showers"
+	ConditionThunderstorm = "thunderstorm"
+)
+
+// Snapshot is the weather of one day at one place.
+type Snapshot struct {
+	Condition       string
+	TempMinC        float64
+	TempMaxC        float64
+	PrecipitationMM float64
+}
+
+// Provider looks up daily weather.
+type Provider interface {
+	// Enabled reports whether lookups are actually available.
+	Enabled() bool
+	// Name identifies the provider; it is stored next to each snapshot.
+	Name() string
+	// Lookup returns the weather of date ("2006-01-02") at the coordinates,
+	// or nil when the provider has no data for that day or is disabled.
+	Lookup(ctx context.Context, lat, lon float64, date string) (*Snapshot, error)
+}
+
+// NewProvider builds the Provider for config.Config.WeatherProvider; an
+// unknown name is an error.
+func NewProvider(logger *slog.Logger, cfg *config.Config) (Provider, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.WeatherProvider)) {
+	case ProviderOpenMeteo:
+		return newOpenMeteo(cfg.WeatherURL), nil
+	case ProviderFake:
+		return NewFakeProvider(), nil
+	case "", ProviderNone:
+		logger.Info("Weather snapshots disabled by configuration")
+		return disabledProvider{}, nil
+	default:
+		return nil, fmt.Errorf("unknown weather_provider %q (want %s, %s or %s)",
+			cfg.WeatherProvider, ProviderOpenMeteo, ProviderFake, ProviderNone)
+	}
+}
+
+// NewDisabledProvider returns a Provider that is always disabled.
+func NewDisabledProvider() Provider { return disabledProvider{} }
+
+type disabledProvider struct{}
+
+func (disabledProvider) Enabled() bool { return false }
+
+func (disabledProvider) Name() string { return "" }
+
+func (disabledProvider) Lookup(context.Context, float64, float64, string) (*Snapshot, error) {
+	return nil, nil
+}
+
+// fakeConditions are the conditions the fake provider picks from.
+var fakeConditions = []string{
+	ConditionClear, ConditionPartlyCloudy, ConditionCloudy, ConditionRain, ConditionShowers,
+}
+
+// fakeProvider derives plausible weather from the place and day alone, so
+// tests and demos get stable snapshots without network access.
+type fakeProvider struct{}
+
+// NewFakeProvider returns the deterministic local Provider.
+func NewFakeProvider() Provider { return fakeProvider{} }
+
+func (fakeProvider) Enabled() bool { return true }
+
+func (fakeProvider) Name() string { return ProviderFake }
+
+func (fakeProvider) Lookup(_ context.Context, lat, lon float64, date string) (*Snapshot, error) {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q: %w", date, err)
+	}
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%.2f,%.2f,%s", lat, lon, date)
+	seed := h.Sum32()
+
+	// Warmer towards the equator and in the local summer.
+	season := math.Cos(2 * math.Pi * float64(day.YearDay()-200) / 365)
+	if lat < 0 {
+		season = -season
+	}
+	maxC := 28 - 0.4*math.Abs(lat) + 8*season + float64(seed%5)
+	condition := fakeConditions[seed%uint32(len(fakeConditions))]
+	precipitation := 0.0
+	if condition == ConditionRain || condition == ConditionShowers {
+		precipitation = float64(1 + seed%15)
+	}
+	return &Snapshot{
+		Condition:       condition,
+		TempMinC:        round1(maxC - 7),
+		TempMaxC:        round1(maxC),
+		PrecipitationMM: precipitation,
+	}, nil
+}
+
+// round1 rounds to one decimal, the precision providers report.
+func round1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
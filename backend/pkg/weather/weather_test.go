@@ -0,0 +1,92 @@
+package weather
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestFakeProviderIsDeterministic(t *testing.T) {
+	ctx := context.Background()
+	p := NewFakeProvider()
+	a, err := p.Lookup(ctx, 45.98, 9.25, "2024-07-03")
+	if err != nil || a == nil {
+		t.Fatalf("Lookup got %+v err %v", a, err)
+	}
+	b, _ := p.Lookup(ctx, 45.98, 9.25, "2024-07-03")
+	if *a != *b {
+		t.Fatalf("same place and day must give the same weather: %+v vs %+v", a, b)
+	}
+	if a.TempMinC >= a.TempMaxC {
+		t.Fatalf("min above max: %+v", a)
+	}
+	winter, _ := p.Lookup(ctx, 45.98, 9.25, "2024-01-10")
+	if winter.TempMaxC >= a.TempMaxC {
+		t.Fatalf("northern winter should be colder than summer: %+v vs %+v", winter, a)
+	}
+	if _, err := p.Lookup(ctx, 0, 0, "yesterday"); err == nil {
+		t.Fatal("expected an error for an invalid date")
+	}
+}
+
+func TestOpenMeteoLookup(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Query().Get("start_date") == "2024-03-02" {
+			_, _ = w.Write([]byte(`{"daily":{"time":["2024-03-02"],"weather_code":[null],` +
+				`"temperature_2m_max":[null],"temperature_2m_min":[null],"precipitation_sum":[null]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"daily":{"time":["2024-03-01"],"weather_code":[63],` +
+			`"temperature_2m_max":[12.3],"temperature_2m_min":[4.1],"precipitation_sum":[8.2]}}`))
+	}))
+	defer srv.Close()
+
+	p := newOpenMeteo(srv.URL)
+	p.now = func() time.Time { return time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC) }
+	ctx := context.Background()
+
+	got, err := p.Lookup(ctx, 50.08, 14.42, "2024-03-01")
+	if err != nil {
+		t.Fatalf("Lookup: %v", err)
+	}
+	want := Snapshot{Condition: ConditionRain, TempMinC: 4.1, TempMaxC: 12.3, PrecipitationMM: 8.2}
+	if got == nil || *got != want {
+		t.Fatalf("Lookup got %+v, want %+v", got, want)
+	}
+	if got, err := p.Lookup(ctx, 50.08, 14.42, "2024-03-02"); got != nil || err != nil {
+		t.Fatalf("a day without data got %+v err %v", got, err)
+	}
+	if _, err := p.Lookup(ctx, 50.08, 14.42, "2023-06-01"); err != nil {
+		t.Fatalf("Lookup: %v", err)
+	}
+	if got, _ := p.Lookup(ctx, 50.08, 14.42, "2024-06-01"); got != nil {
+		t.Fatalf("a day beyond the forecast got %+v", got)
+	}
+	if len(paths) != 3 || paths[0] != "/v1/forecast" || paths[2] != "/v1/archive" {
+		t.Fatalf("requested %v", paths)
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	for name, enabled := range map[string]bool{"": false, "none": false, "fake": true, "Open-Meteo": true} {
+		p, err := NewProvider(discardLogger(), &config.Config{WeatherProvider: name})
+		if err != nil || p.Enabled() != enabled {
+			t.Fatalf("%q: enabled %v err %v", name, p != nil && p.Enabled(), err)
+		}
+	}
+	if _, err := NewProvider(discardLogger(), &config.Config{WeatherProvider: "sky"}); err == nil {
+		t.Fatal("expected an error for an unknown provider")
+	}
+}
@@ -0,0 +1,73 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Personal Access Token Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	It("creates a scoped token, uses it, and revokes it", func() {
+		_, _, err := setup.APIClient.PutItems(context.Background(), "2024-03-01", "Title", "Body", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		resp := setup.APIClient.CreateAccessToken(context.Background(), "export", goclient.ItemsRead)
+		Expect(resp.StatusCode()).To(Equal(http.StatusCreated))
+		created := resp.JSON201
+
+		sessionToken := setup.APIClient.authHeader
+		setup.APIClient.SetToken(created.Secret)
+
+		items, httpResp, err := setup.APIClient.GetItems(context.Background(), "2024-03-01", "", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(httpResp.StatusCode).To(Equal(http.StatusOK))
+		Expect(items.Items).To(HaveLen(1))
+
+		_, httpResp, err = setup.APIClient.PutItems(context.Background(), "2024-03-02", "T", "B", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(httpResp.StatusCode).To(Equal(http.StatusForbidden))
+
+		Expect(setup.APIClient.GetAccessTokens(context.Background()).StatusCode()).To(Equal(http.StatusForbidden))
+
+		setup.APIClient.authHeader = sessionToken
+		Expect(setup.APIClient.RevokeAccessToken(context.Background(), created.Token.Id).StatusCode()).
+			To(Equal(http.StatusNoContent))
+
+		setup.APIClient.SetToken(created.Secret)
+		_, httpResp, err = setup.APIClient.GetItems(context.Background(), "2024-03-01", "", "")
+		Expect(err).To(HaveOccurred())
+		Expect(httpResp.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+	It("lets a read-only token read custom fields but not define them", func() {
+		Expect(setup.APIClient.CreateCustomField(context.Background(), goclient.CustomFieldCreateRequest{
+			Key: "rating", Name: "Day rating", Type: "scale",
+		}).StatusCode()).To(Equal(http.StatusCreated))
+
+		created := setup.APIClient.CreateAccessToken(context.Background(), "fields", goclient.ItemsRead)
+		Expect(created.StatusCode()).To(Equal(http.StatusCreated))
+		setup.APIClient.SetToken(created.JSON201.Secret)
+
+		Expect(setup.APIClient.GetCustomFields(context.Background()).StatusCode()).To(Equal(http.StatusOK))
+
+		Expect(setup.APIClient.GetCustomFieldStats(context.Background(), "rating").StatusCode()).To(Equal(http.StatusOK))
+
+		Expect(setup.APIClient.CreateCustomField(context.Background(), goclient.CustomFieldCreateRequest{
+			Key: "sick", Name: "Sick", Type: "boolean",
+		}).StatusCode()).To(Equal(http.StatusForbidden))
+	})
+})
@@ -0,0 +1,78 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/auth"
+)
+
+var _ = Describe("Account Lockout Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	apiLogin := func(email, password string) int {
+		_, httpResp, _ := setup.APIClient.Authorize(context.Background(), email, password)
+		return httpResp.StatusCode
+	}
+
+	webLogin := func(username, password string) int {
+		//nolint:noctx
+		resp, err := (&http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}}).PostForm(setup.ServerAddr+"/web/login", url.Values{
+			"username": {username}, "password": {password},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	It("locks the account on both login paths after repeated failures", func() {
+		for i := 0; i < auth.LockoutThreshold-1; i++ {
+			Expect(apiLogin(setup.TestEmail, "wrong")).To(Equal(http.StatusUnauthorized))
+		}
+		// The web form counts against the same account.
+		Expect(webLogin(setup.TestEmail, "wrong")).To(Equal(http.StatusUnauthorized))
+
+		Expect(apiLogin(setup.TestEmail, setup.TestPass)).To(Equal(http.StatusTooManyRequests))
+		Expect(webLogin(setup.TestEmail, setup.TestPass)).To(Equal(http.StatusTooManyRequests))
+
+		// The lock is stored, so it outlives the server process.
+		lockout, err := setup.Storage.GetLoginLockout(auth.LoginKey(setup.TestEmail))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lockout.Failures).To(Equal(auth.LockoutThreshold))
+
+		// What the unlock command does.
+		Expect(setup.Storage.ClearLoginLockout(auth.LoginKey(setup.TestEmail))).To(Succeed())
+		Expect(apiLogin(setup.TestEmail, setup.TestPass)).To(Equal(http.StatusOK))
+	})
+
+	It("treats unknown accounts like real ones", func() {
+		for i := 0; i < auth.LockoutThreshold; i++ {
+			Expect(apiLogin("nobody@test.com", "guess")).To(Equal(http.StatusUnauthorized))
+		}
+		Expect(apiLogin("nobody@test.com", "guess")).To(Equal(http.StatusTooManyRequests))
+		Expect(apiLogin(setup.TestEmail, setup.TestPass)).To(Equal(http.StatusOK))
+	})
+
+	It("resets the failure count after a successful login", func() {
+		for i := 0; i < auth.LockoutThreshold-1; i++ {
+			Expect(apiLogin(setup.TestEmail, "wrong")).To(Equal(http.StatusUnauthorized))
+		}
+		Expect(apiLogin(setup.TestEmail, setup.TestPass)).To(Equal(http.StatusOK))
+		Expect(apiLogin(setup.TestEmail, "wrong")).To(Equal(http.StatusUnauthorized))
+		Expect(apiLogin(setup.TestEmail, setup.TestPass)).To(Equal(http.StatusOK))
+	})
+})
@@ -0,0 +1,73 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("AI Jobs Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	getJobs := func() *goclient.JobsResponse {
+		resp := setup.APIClient.GetJobs(context.Background(), goclient.GetJobsParams{})
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		return resp.JSON200
+	}
+
+	It("reports queued jobs and the remaining backfill", func() {
+		ctx := context.Background()
+		for _, date := range []string{"2024-01-01", "2024-01-02"} {
+			_, _, err := setup.APIClient.PutItems(ctx, date, "Day "+date, "Some text.", nil)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		user, err := setup.Storage.GetUserByUsername(setup.TestEmail)
+		Expect(err).ToNot(HaveOccurred())
+
+		jobs := getJobs()
+		Expect(jobs.Counts).To(Equal(goclient.JobCounts{}))
+		Expect(jobs.BackfillRemainingDays).To(BeZero(), "the backfill is off")
+		Expect(jobs.Jobs).To(BeEmpty())
+
+		Expect(setup.Storage.SetFamilyAISettings(user.FamilyID, true, true, false, false, false)).To(Succeed())
+		// Days written before AI tagging existed have never been analyzed.
+		Expect(setup.Storage.GetDB().Model(&models.Item{}).Where("family_id = ?", user.FamilyID).
+			Update("tags_source_hash", "").Error).To(Succeed())
+		_, err = setup.Storage.EnqueueAIJob(user.FamilyID, models.AIJobTagDay, "2024-01-01")
+		Expect(err).ToNot(HaveOccurred())
+
+		jobs = getJobs()
+		Expect(jobs.Counts).To(Equal(goclient.JobCounts{Queued: 1}))
+		Expect(jobs.BackfillRemainingDays).To(Equal(2))
+		Expect(jobs.BackfillDone).To(BeFalse())
+		Expect(jobs.Jobs).To(HaveLen(1))
+		Expect(string(jobs.Jobs[0].Kind)).To(Equal(models.AIJobTagDay))
+		Expect(jobs.Jobs[0].Date.String()).To(Equal("2024-01-01"))
+		Expect(string(jobs.Jobs[0].Status)).To(Equal(models.AIJobQueued))
+		Expect(jobs.Jobs[0].LastError).To(BeNil())
+	})
+
+	It("rejects an out-of-range limit", func() {
+		for _, limit := range []int{-1, 501} {
+			Expect(setup.APIClient.GetJobs(context.Background(), goclient.GetJobsParams{Limit: &limit}).StatusCode()).
+				To(Equal(http.StatusBadRequest), "limit %d", limit)
+		}
+		resp := setup.APIClient.GetJobs(context.Background(), goclient.GetJobsParams{Limit: ptr(1)})
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		Expect(resp.JSON200.Jobs).To(BeEmpty())
+	})
+})
@@ -0,0 +1,66 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("AI Usage Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironmentWithConfig(func(cfg *config.Config) {
+			cfg.AIMonthlyTokenBudget = 1000
+		})
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	getUsage := func() *goclient.AIUsageResponse {
+		resp := setup.APIClient.GetFamilyAIUsage(context.Background())
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		return resp.JSON200
+	}
+
+	It("reports monthly usage against the budget", func() {
+		month := time.Now().UTC().Format(ai.UsageMonthFormat)
+
+		usage := getUsage()
+		Expect(usage.Month).To(Equal(month))
+		Expect(usage.MonthlyTokenBudget).To(Equal(int64(1000)))
+		Expect(usage.BudgetExhausted).To(BeFalse())
+		Expect(usage.Months).To(BeEmpty())
+
+		user, err := setup.Storage.GetUserByUsername(setup.TestEmail)
+		Expect(err).ToNot(HaveOccurred())
+		// As the metered suggester would after each answered call.
+		Expect(setup.Storage.AddAIUsage(&models.AIUsage{
+			FamilyID: user.FamilyID, Month: "2020-01", Requests: 3, InputTokens: 50, OutputTokens: 5,
+		})).To(Succeed())
+		Expect(setup.Storage.AddAIUsage(&models.AIUsage{
+			FamilyID: user.FamilyID, Month: month, Requests: 1, InputTokens: 600, OutputTokens: 100, Images: 2,
+		})).To(Succeed())
+		Expect(setup.Storage.AddAIUsage(&models.AIUsage{
+			FamilyID: user.FamilyID, Month: month, Requests: 1, InputTokens: 250, OutputTokens: 50,
+		})).To(Succeed())
+
+		usage = getUsage()
+		Expect(usage.BudgetExhausted).To(BeTrue())
+		Expect(usage.Months).To(Equal([]goclient.AIUsageMonth{
+			{Month: month, Requests: 2, InputTokens: 850, OutputTokens: 150, Images: 2},
+			{Month: "2020-01", Requests: 3, InputTokens: 50, OutputTokens: 5},
+		}))
+	})
+})
@@ -0,0 +1,92 @@
+package flows_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Ask Your Diary Flow", func() {
+	var (
+		setup   *SharedTestSetup
+		model   *httptest.Server
+		prompts []string
+	)
+
+	BeforeEach(func() {
+		prompts = nil
+		model = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Messages []struct {
+					Content string `json:"content"`
+				} `json:"messages"`
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+			prompts = append(prompts, req.Messages[0].Content)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"choices": []map[string]any{{"message": map[string]any{
+					"content": "You hiked the ridge trail on [2024-05-01].",
+				}}},
+			})
+		}))
+		setup = SetupTestEnvironmentWithConfig(func(cfg *config.Config) {
+			cfg.AIProvider = "openai"
+			cfg.AIBaseURL = model.URL + "/v1"
+			cfg.AIModel = "test-model"
+		})
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+		model.Close()
+	})
+
+	It("answers from the family's own entries with citations", func() {
+		for _, e := range []struct{ date, title, body string }{
+			{"2024-05-01", "Ridge", "Hiked the ridge trail, windy at the top"},
+			{"2024-05-02", "Office", "Budget meetings"},
+		} {
+			_, _, err := setup.APIClient.PutItems(context.Background(), e.date, e.title, e.body, nil)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		other, err := setup.Storage.CreateFamily("OtherFamily")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(setup.Storage.PutItem(other.ID, &models.Item{
+			Date: "2024-05-03", Title: "Secret", Body: "Another family's ridge trail hike",
+		})).To(Succeed())
+
+		const question = "When did I hike the ridge trail?"
+		Expect(setup.APIClient.Ask(context.Background(), question).StatusCode()).
+			To(Equal(http.StatusServiceUnavailable))
+
+		Expect(setup.APIClient.UpdateFamilySettings(context.Background(),
+			goclient.FamilySettingsRequest{AiSummariesEnabled: ptr(true)}).StatusCode()).To(Equal(http.StatusOK))
+
+		resp := setup.APIClient.Ask(context.Background(), question)
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		answer := resp.JSON200
+		Expect(answer.Answer).To(ContainSubstring("ridge trail"))
+		Expect(answer.Citations).To(HaveLen(1))
+		Expect(answer.Citations[0].Date.String()).To(Equal("2024-05-01"))
+		Expect(answer.Citations[0].Title).To(Equal("Ridge"))
+
+		Expect(prompts).To(HaveLen(1))
+		Expect(prompts[0]).To(ContainSubstring("2024-05-01: Ridge"))
+		Expect(prompts[0]).ToNot(ContainSubstring("Another family"))
+		Expect(prompts[0]).ToNot(ContainSubstring("Budget meetings"))
+	})
+
+	It("rejects blank questions", func() {
+		Expect(setup.APIClient.Ask(context.Background(), "  ").StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,76 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Asset Captions Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	listAssets := func(date string) []goclient.AssetInfo {
+		resp := setup.APIClient.ListAssets(context.Background(), date)
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		return *resp.JSON200
+	}
+
+	It("lists assets with their captions and finds entries by caption", func() {
+		user, err := setup.Storage.GetUserByUsername(setup.TestEmail)
+		Expect(err).ToNot(HaveOccurred())
+		dir := filepath.Join(setup.Cfg.DataPath, config.AssetsDirName, user.FamilyID.String())
+		Expect(os.MkdirAll(dir, 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "beach.jpg"), []byte("jpeg"), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "other.jpg"), []byte("jpg"), 0o600)).To(Succeed())
+
+		_, _, err = setup.APIClient.PutItems(context.Background(), "2024-06-01", "Holiday", "![](beach.jpg)", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// As the caption task would.
+		Expect(setup.Storage.PutAssetCaption(&models.AssetCaption{
+			FamilyID: user.FamilyID, Filename: "beach.jpg", Caption: "Two children building a sandcastle", Model: "m",
+		})).To(Succeed())
+
+		assets := listAssets("")
+		Expect(assets).To(HaveLen(2))
+		Expect(assets[0].Filename).To(Equal("beach.jpg"))
+		Expect(assets[0].Size).To(Equal(int64(4)))
+		Expect(assets[0].Caption).ToNot(BeNil())
+		Expect(*assets[0].Caption).To(Equal("Two children building a sandcastle"))
+		Expect(assets[1].Caption).To(BeNil())
+
+		assets = listAssets("2024-06-01")
+		Expect(assets).To(HaveLen(1))
+		Expect(assets[0].Filename).To(Equal("beach.jpg"))
+		Expect(listAssets("2024-06-02")).To(BeEmpty())
+
+		found, _, err := setup.APIClient.GetItems(context.Background(), "", "sandcastle", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found.Items).To(HaveLen(1))
+		Expect(found.Items[0].Date).To(Equal("2024-06-01"))
+	})
+
+	It("lets the family opt into captions", func() {
+		family := setup.APIClient.UpdateFamilySettings(context.Background(),
+			goclient.FamilySettingsRequest{AiCaptionsEnabled: ptr(true)})
+		Expect(family.StatusCode()).To(Equal(http.StatusOK))
+		Expect(family.JSON200.AiCaptionsEnabled).To(HaveValue(BeTrue()))
+	})
+})
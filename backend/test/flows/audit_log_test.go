@@ -0,0 +1,79 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Audit Log Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	getAudit := func(params goclient.GetAuditEventsParams) *goclient.AuditLogResponse {
+		resp := setup.APIClient.GetAuditEvents(context.Background(), params)
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		return resp.JSON200
+	}
+
+	It("records logins and tag changes for the family", func() {
+		_, httpResp, _ := setup.APIClient.Authorize(context.Background(), setup.TestEmail, "wrong-password")
+		Expect(httpResp.StatusCode).To(Equal(http.StatusUnauthorized))
+		_, httpResp, _ = setup.APIClient.Authorize(context.Background(), "nobody@test.com", "whatever")
+		Expect(httpResp.StatusCode).To(Equal(http.StatusUnauthorized))
+		setup.LoginAndGetToken()
+
+		_, _, err := setup.APIClient.PutItems(context.Background(), "2024-03-01", "Title", "Body", []string{"trip"})
+		Expect(err).ToNot(HaveOccurred())
+		newName := "travel"
+		Expect(setup.APIClient.UpdateTag(context.Background(), "trip", goclient.TagUpdateRequest{NewName: &newName}).
+			StatusCode()).To(Equal(http.StatusOK))
+		Expect(setup.APIClient.DeleteTag(context.Background(), "travel", false).StatusCode()).
+			To(Equal(http.StatusNoContent))
+
+		page := getAudit(goclient.GetAuditEventsParams{})
+		Expect(page.HasMore).To(BeFalse())
+		actions := make([]string, len(page.Events))
+		for i, e := range page.Events {
+			actions[i] = e.Action
+			Expect(e.Actor).To(Equal(setup.TestEmail))
+			Expect(e.IpAddress).ToNot(BeEmpty())
+		}
+		// Newest first; the unknown user's failure belongs to no family.
+		Expect(actions).To(Equal([]string{"tag.deleted", "tag.renamed", "login.succeeded", "login.failed"}))
+		Expect(page.Events[1].Target).To(Equal("trip → travel"))
+		Expect(page.Events[2].Target).To(Equal("api"))
+	})
+
+	It("pages through older events", func() {
+		for i := 0; i < 4; i++ {
+			setup.LoginAndGetToken()
+		}
+
+		limit := int32(3)
+		first := getAudit(goclient.GetAuditEventsParams{Limit: &limit})
+		Expect(first.Events).To(HaveLen(3))
+		Expect(first.HasMore).To(BeTrue())
+		Expect(first.NextBefore).ToNot(BeNil())
+
+		second := getAudit(goclient.GetAuditEventsParams{Limit: &limit, Before: first.NextBefore})
+		Expect(second.Events).To(HaveLen(1))
+		Expect(second.HasMore).To(BeFalse())
+		Expect(second.Events[0].Id).To(BeNumerically("<", first.Events[2].Id))
+
+		tooMany := int32(1000)
+		Expect(setup.APIClient.GetAuditEvents(context.Background(), goclient.GetAuditEventsParams{Limit: &tooMany}).
+			StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+})
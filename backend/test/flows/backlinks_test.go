@@ -0,0 +1,61 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Backlinks Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	put := func(date, title, body string) {
+		_, _, err := setup.APIClient.PutItems(context.Background(), date, title, body, nil)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("lists the entries linking to a day and follows edits", func() {
+		put("2024-06-01", "Hike", "Up the hill.")
+		put("2024-06-10", "Again", "Went back.\nSame path as on [[2024-06-01]], faster.")
+		put("2024-06-05", "Plans", "Planning [[2024-06-01]]-style trip for [[2024-06-20]]")
+
+		ctx := context.Background()
+		res := setup.APIClient.GetItemBacklinks(ctx, "2024-06-01")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Backlinks).To(HaveLen(2))
+		Expect(res.JSON200.Backlinks[0].Date.String()).To(Equal("2024-06-05"))
+		Expect(res.JSON200.Backlinks[1].Date.String()).To(Equal("2024-06-10"))
+		Expect(res.JSON200.Backlinks[1].Title).To(Equal("Again"))
+		Expect(res.JSON200.Backlinks[1].Snippet).To(Equal("Same path as on [[2024-06-01]], faster."))
+
+		// A day without an entry has backlinks too.
+		res = setup.APIClient.GetItemBacklinks(ctx, "2024-06-20")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Backlinks).To(HaveLen(1))
+
+		put("2024-06-05", "Plans", "Nothing linked any more")
+		put("2024-06-10", "Again", "Went back. `[[2024-06-01]]` is just code now.")
+		res = setup.APIClient.GetItemBacklinks(ctx, "2024-06-01")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Backlinks).To(BeEmpty())
+
+		// The generated client only sends valid dates.
+		req, err := setup.APIClient.newRequest(ctx, http.MethodGet, "/v1/items/not-a-day/backlinks", nil)
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := setup.APIClient.do(req)
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+})
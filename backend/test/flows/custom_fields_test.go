@@ -0,0 +1,77 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Custom Fields Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	It("defines fields, saves and filters values, syncs them and aggregates them", func() {
+		ctx := context.Background()
+		for _, field := range []goclient.CustomFieldCreateRequest{
+			{Key: "rating", Name: "Day rating", Type: "scale"},
+			{Key: "sick", Name: "Sick", Type: "boolean"},
+		} {
+			Expect(setup.APIClient.CreateCustomField(ctx, field).StatusCode()).To(Equal(http.StatusCreated))
+		}
+
+		saved := setup.APIClient.PutItem(ctx, goclient.ItemsRequest{
+			Date: toDate("2024-03-01"), Title: "Good day", Fields: &map[string]any{"rating": 5, "sick": false},
+		})
+		Expect(saved.StatusCode()).To(Equal(http.StatusOK))
+		Expect(saved.JSON200.Fields).To(HaveValue(Equal(map[string]any{"rating": 5.0, "sick": false})))
+		Expect(setup.APIClient.PutItem(ctx, goclient.ItemsRequest{
+			Date: toDate("2024-03-02"), Title: "Bad day", Fields: &map[string]any{"rating": 2, "sick": true},
+		}).StatusCode()).To(Equal(http.StatusOK))
+		Expect(setup.APIClient.PutItem(ctx, goclient.ItemsRequest{
+			Date: toDate("2024-03-03"), Title: "Odd day", Fields: &map[string]any{"rating": 9},
+		}).StatusCode()).To(Equal(http.StatusBadRequest))
+
+		// A client that does not know about fields keeps them.
+		_, _, err := setup.APIClient.PutItems(ctx, "2024-03-01", "Good day", "Edited", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		list := setup.APIClient.ListItems(ctx, goclient.GetItemsParams{Fields: ptr("rating>=4,sick=false")})
+		Expect(list.StatusCode()).To(Equal(http.StatusOK))
+		Expect(list.JSON200.Items).To(HaveLen(1))
+		Expect(list.JSON200.Items[0].Date.String()).To(Equal("2024-03-01"))
+		Expect(list.JSON200.Items[0].Fields).To(HaveValue(HaveKeyWithValue("rating", 5.0)))
+		Expect(setup.APIClient.ListItems(ctx, goclient.GetItemsParams{Fields: ptr("mood=calm")}).StatusCode()).
+			To(Equal(http.StatusBadRequest))
+
+		changes, _, err := setup.APIClient.GetChanges(ctx, 0, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes.Changes).ToNot(BeEmpty())
+		last := changes.Changes[len(changes.Changes)-1].ItemSnapshot
+		Expect(last.Date).To(Equal("2024-03-01"))
+		Expect(last.Fields).To(Equal(map[string]any{"rating": 5.0, "sick": false}))
+
+		stats := setup.APIClient.GetCustomFieldStats(ctx, "sick")
+		Expect(stats.StatusCode()).To(Equal(http.StatusOK))
+		Expect(stats.JSON200.Count).To(Equal(2))
+		Expect(stats.JSON200.Avg).To(HaveValue(Equal(0.5)))
+		Expect(stats.JSON200.Values).To(HaveLen(2))
+
+		Expect(setup.APIClient.DeleteCustomField(ctx, "rating").StatusCode()).To(Equal(http.StatusNoContent))
+		fields := setup.APIClient.GetCustomFields(ctx)
+		Expect(fields.StatusCode()).To(Equal(http.StatusOK))
+		Expect(fields.JSON200.Fields).To(HaveLen(1))
+		Expect(fields.JSON200.Fields[0].Key).To(Equal("sick"))
+	})
+})
@@ -0,0 +1,87 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Hierarchical Tags Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	datesTagged := func(tags string) []string {
+		list, _, err := setup.APIClient.GetItems(context.Background(), "", "", tags)
+		Expect(err).ToNot(HaveOccurred())
+		var dates []string
+		for _, it := range list.Items {
+			dates = append(dates, it.Date)
+		}
+		return dates
+	}
+
+	getTree := func() []goclient.TagTreeNode {
+		resp := setup.APIClient.GetTagTree(context.Background())
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		return resp.JSON200.Roots
+	}
+
+	It("filters by subtree, builds the tree and renames and deletes subtrees", func() {
+		ctx := context.Background()
+		for date, tags := range map[string][]string{
+			"2024-01-01": {"travel/italy/rome"},
+			"2024-01-02": {"travel/france", "work"},
+			"2024-01-03": {"travelogue"},
+		} {
+			_, _, err := setup.APIClient.PutItems(ctx, date, "Day", "Text", tags)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(datesTagged("travel")).To(ConsistOf("2024-01-01", "2024-01-02"))
+		Expect(datesTagged("travel/italy")).To(ConsistOf("2024-01-01"))
+		Expect(datesTagged("work")).To(ConsistOf("2024-01-02"), "flat tags match as before")
+
+		roots := getTree()
+		Expect(roots).To(HaveLen(3))
+		Expect(roots[0].Path).To(Equal("travel"))
+		Expect(roots[0].Count).To(Equal(2))
+		Expect(roots[0].OwnCount).To(BeZero())
+		Expect(roots[0].Children).To(HaveLen(2))
+		Expect(roots[0].Children[1].Name).To(Equal("italy"))
+		Expect(roots[0].Children[1].Children[0].Path).To(Equal("travel/italy/rome"))
+
+		// The generated client escapes the slash; it reaches the tag-path routes.
+		Expect(setup.APIClient.UpdateTag(ctx, "travel/italy", goclient.TagUpdateRequest{
+			NewName: ptr("trips/italy"), Subtree: ptr(true),
+		}).StatusCode()).To(Equal(http.StatusOK))
+		Expect(datesTagged("trips")).To(ConsistOf("2024-01-01"))
+
+		// So does a client that leaves it unescaped, which the generated one cannot.
+		req, err := setup.APIClient.newRequest(ctx, http.MethodDelete, "/v1/tags/trips/italy?subtree=true", nil)
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := setup.APIClient.do(req)
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+		Expect(datesTagged("trips")).To(BeEmpty())
+
+		Expect(setup.APIClient.DeleteTag(ctx, "travel", true).StatusCode()).To(Equal(http.StatusNoContent))
+		Expect(datesTagged("travelogue")).To(ConsistOf("2024-01-03"))
+		Expect(getTree()).To(Equal([]goclient.TagTreeNode{
+			{Name: "travelogue", Path: "travelogue", Count: 1, OwnCount: 1, Children: []goclient.TagTreeNode{}},
+			{Name: "work", Path: "work", Count: 1, OwnCount: 1, Children: []goclient.TagTreeNode{}},
+		}))
+	})
+})
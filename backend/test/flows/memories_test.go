@@ -0,0 +1,71 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Memories Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	put := func(date, title, body string) {
+		_, _, err := setup.APIClient.PutItems(context.Background(), date, title, body, nil)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("returns the same day in earlier years and the week a month ago", func() {
+		put("2022-09-15", "Two years", "![beach](beach.jpg)")
+		put("2023-09-15", "One year", "Just text")
+		put("2024-08-12", "Monday a month ago", "![](clip.mp4)")
+		put("2024-08-19", "Next week", "")
+		put("2024-09-15", "Today", "")
+
+		ctx := context.Background()
+		res := setup.APIClient.GetMemories(ctx, "2024-09-15")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Date.String()).To(Equal("2024-09-15"))
+		Expect(res.JSON200.OnThisDay).To(HaveLen(2))
+		Expect(res.JSON200.OnThisDay[0].Title).To(Equal("One year"))
+		Expect(res.JSON200.OnThisDay[0].Image).To(BeNil())
+		Expect(res.JSON200.OnThisDay[1].YearsAgo).To(Equal(2))
+		Expect(res.JSON200.OnThisDay[1].Image).To(HaveValue(Equal("beach.jpg")))
+		Expect(res.JSON200.MonthAgoFrom.String()).To(Equal("2024-08-12"))
+		Expect(res.JSON200.MonthAgoTo.String()).To(Equal("2024-08-18"))
+		Expect(res.JSON200.MonthAgo).To(HaveLen(1))
+		Expect(res.JSON200.MonthAgo[0].Date.String()).To(Equal("2024-08-12"))
+
+		// The generated client only sends valid dates.
+		req, err := setup.APIClient.newRequest(ctx, http.MethodGet, "/v1/memories?date=someday", nil)
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := setup.APIClient.do(req)
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+
+	It("lets the family opt into the daily digest", func() {
+		ctx := context.Background()
+		updated := setup.APIClient.UpdateFamilySettings(ctx, goclient.FamilySettingsRequest{
+			MemoriesDigestEnabled: ptr(true),
+		})
+		Expect(updated.StatusCode()).To(Equal(http.StatusOK))
+		Expect(updated.JSON200.MemoriesDigestEnabled).To(HaveValue(BeTrue()))
+		family := setup.APIClient.GetFamily(ctx)
+		Expect(family.StatusCode()).To(Equal(http.StatusOK))
+		Expect(family.JSON200.MemoriesDigestEnabled).To(HaveValue(BeTrue()))
+	})
+})
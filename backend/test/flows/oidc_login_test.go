@@ -0,0 +1,156 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/auth/oidctest"
+	"github.com/ya-breeze/diary.be/pkg/config"
+)
+
+var _ = Describe("OIDC Login Flow", func() {
+	var (
+		setup   *SharedTestSetup
+		idp     *oidctest.Provider
+		browser *http.Client
+	)
+
+	start := func(configure func(*config.Config)) {
+		idp = oidctest.New("diary", "client-secret")
+		setup = SetupTestEnvironmentWithConfig(func(cfg *config.Config) {
+			cfg.OIDCIssuer = idp.Issuer
+			cfg.OIDCClientID = "diary"
+			cfg.OIDCClientSecret = "client-secret"
+			// The provider only echoes this back; the test replays the query
+			// against the real server address.
+			cfg.OIDCRedirectURL = "http://diary.test/web/oidc/callback"
+			cfg.OIDCScopes = "openid email"
+			cfg.OIDCUsernameClaim = "email"
+			if configure != nil {
+				configure(cfg)
+			}
+		})
+
+		jar, err := cookiejar.New(nil)
+		Expect(err).ToNot(HaveOccurred())
+		browser = &http.Client{
+			Jar: jar,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+
+	get := func(rawURL string) *http.Response {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, rawURL, nil)
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := browser.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		return resp
+	}
+
+	// signIn walks the browser through login → provider consent → callback and
+	// returns the callback response.
+	signIn := func() *http.Response {
+		resp := get(setup.ServerAddr + "/web/oidc/login?redirect=/web/search")
+		Expect(resp.StatusCode).To(Equal(http.StatusFound))
+
+		callback, err := idp.Approve(resp.Header.Get("Location"))
+		Expect(err).ToNot(HaveOccurred())
+		return get(setup.ServerAddr + callback.Path + "?" + callback.RawQuery)
+	}
+
+	cookieValue := func(resp *http.Response, name string) string {
+		for _, c := range resp.Cookies() {
+			if c.Name == name {
+				return c.Value
+			}
+		}
+		return ""
+	}
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+		idp.Close()
+	})
+
+	It("signs an existing user in with session cookies", func() {
+		start(nil)
+		idp.SetClaims(map[string]any{"email": setup.TestEmail, "email_verified": true})
+
+		resp := signIn()
+		Expect(resp.StatusCode).To(Equal(http.StatusSeeOther))
+		Expect(resp.Header.Get("Location")).To(Equal("/web/search"))
+		Expect(cookieValue(resp, "kin_access")).ToNot(BeEmpty())
+		Expect(cookieValue(resp, "kin_refresh")).ToNot(BeEmpty())
+	})
+
+	It("rejects a callback whose state does not match the cookie", func() {
+		start(nil)
+		idp.SetClaims(map[string]any{"email": setup.TestEmail, "email_verified": true})
+
+		resp := get(setup.ServerAddr + "/web/oidc/login")
+		Expect(resp.StatusCode).To(Equal(http.StatusFound))
+		callback, err := idp.Approve(resp.Header.Get("Location"))
+		Expect(err).ToNot(HaveOccurred())
+
+		q := callback.Query()
+		q.Set("state", "forged")
+		resp = get(setup.ServerAddr + callback.Path + "?" + q.Encode())
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		Expect(cookieValue(resp, "kin_access")).To(BeEmpty())
+	})
+
+	It("refuses unknown identities when just-in-time creation is off", func() {
+		start(nil)
+		idp.SetClaims(map[string]any{"email": "stranger@example.com", "email_verified": true})
+
+		resp := signIn()
+		Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+	})
+
+	It("refuses unverified email addresses", func() {
+		start(nil)
+		idp.SetClaims(map[string]any{"email": setup.TestEmail, "email_verified": false})
+
+		resp := signIn()
+		Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+
+		// A provider that does not say the address is verified is refused too.
+		idp.SetClaims(map[string]any{"email": setup.TestEmail})
+		resp = signIn()
+		Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+	})
+
+	It("creates new users in the configured family", func() {
+		start(func(cfg *config.Config) { cfg.OIDCJITFamily = "TestFamily" })
+		idp.SetClaims(map[string]any{"email": "newcomer@example.com", "email_verified": true})
+
+		resp := signIn()
+		Expect(resp.StatusCode).To(Equal(http.StatusSeeOther))
+
+		user, err := setup.Storage.GetUserByUsername("newcomer@example.com")
+		Expect(err).ToNot(HaveOccurred())
+		family, err := setup.Storage.GetFamilyByName("TestFamily")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(user.FamilyID).To(Equal(family.ID))
+
+		// A second sign-in reuses the same account.
+		resp = signIn()
+		Expect(resp.StatusCode).To(Equal(http.StatusSeeOther))
+		again, err := setup.Storage.GetUserByUsername("newcomer@example.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(again.ID).To(Equal(user.ID))
+	})
+
+	It("is not routed when OIDC is not configured", func() {
+		start(func(cfg *config.Config) { cfg.OIDCIssuer = "" })
+		resp := get(setup.ServerAddr + "/web/oidc/login")
+		Expect(resp.StatusCode).ToNot(Equal(http.StatusFound))
+	})
+})
@@ -0,0 +1,75 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+	"github.com/ya-breeze/diary.be/pkg/weather"
+)
+
+var _ = Describe("Places Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironmentWithConfig(func(cfg *config.Config) {
+			cfg.WeatherProvider = weather.ProviderFake
+		})
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	It("saves places with weather, syncs them and lists them by visits", func() {
+		ctx := context.Background()
+		saved := setup.APIClient.PutItem(ctx, goclient.ItemsRequest{
+			Date: toDate("2024-07-01"), Title: "Lake day",
+			Place: &goclient.PlaceRequest{Name: ptr("Como"), Latitude: ptr(45.98), Longitude: ptr(9.25)},
+		})
+		Expect(saved.StatusCode()).To(Equal(http.StatusOK))
+		Expect(saved.JSON200.Place).ToNot(BeNil())
+		Expect(saved.JSON200.Place.Source).To(Equal(goclient.PlaceSource("manual")))
+		Expect(saved.JSON200.Weather).ToNot(BeNil())
+		Expect(saved.JSON200.Weather.Provider).To(Equal(weather.ProviderFake))
+
+		Expect(setup.APIClient.PutItem(ctx, goclient.ItemsRequest{
+			Date: toDate("2024-07-02"), Title: "Back again",
+			Place: &goclient.PlaceRequest{Name: ptr("Como"), Latitude: ptr(45.981), Longitude: ptr(9.252)},
+		}).StatusCode()).To(Equal(http.StatusOK))
+		Expect(setup.APIClient.PutItem(ctx, goclient.ItemsRequest{
+			Date: toDate("2024-07-03"), Title: "Somewhere", Place: &goclient.PlaceRequest{Latitude: ptr(45.98)},
+		}).StatusCode()).To(Equal(http.StatusBadRequest))
+
+		// A client that does not know about places keeps them.
+		_, _, err := setup.APIClient.PutItems(ctx, "2024-07-01", "Lake day", "Edited", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		changes, _, err := setup.APIClient.GetChanges(ctx, 0, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes.Changes).To(HaveLen(3))
+		last := changes.Changes[2].ItemSnapshot
+		Expect(last.Date).To(Equal("2024-07-01"))
+		Expect(last.Place).ToNot(BeNil())
+		Expect(last.Place.Name).To(Equal("Como"))
+		Expect(last.Weather).ToNot(BeNil())
+
+		places := setup.APIClient.GetPlaces(ctx, goclient.GetPlacesParams{})
+		Expect(places.StatusCode()).To(Equal(http.StatusOK))
+		Expect(places.JSON200.Places).To(HaveLen(1))
+		Expect(places.JSON200.Places[0].Name).To(Equal("Como"))
+		Expect(places.JSON200.Places[0].Count).To(Equal(2))
+		Expect(places.JSON200.Places[0].FirstDate.String()).To(Equal("2024-07-01"))
+		Expect(places.JSON200.Places[0].LastDate.String()).To(Equal("2024-07-02"))
+
+		places = setup.APIClient.GetPlaces(ctx, goclient.GetPlacesParams{From: ptr(toDate("2024-07-02"))})
+		Expect(places.StatusCode()).To(Equal(http.StatusOK))
+		Expect(places.JSON200.Places).To(HaveLen(1))
+		Expect(places.JSON200.Places[0].Count).To(Equal(1))
+	})
+})
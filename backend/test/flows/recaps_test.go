@@ -0,0 +1,103 @@
+package flows_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Summaries and Recaps Flow", func() {
+	var (
+		setup   *SharedTestSetup
+		model   *httptest.Server
+		prompts []string
+	)
+
+	BeforeEach(func() {
+		prompts = nil
+		model = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Messages []struct {
+					Content string `json:"content"`
+				} `json:"messages"`
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+			prompts = append(prompts, req.Messages[0].Content)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"choices": []map[string]any{{"message": map[string]any{"content": "I went for a long walk."}}},
+			})
+		}))
+		setup = SetupTestEnvironmentWithConfig(func(cfg *config.Config) {
+			cfg.AIProvider = "openai"
+			cfg.AIBaseURL = model.URL + "/v1"
+			cfg.AIModel = "test-model"
+		})
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+		model.Close()
+	})
+
+	It("summarizes an entry only after the family opts in", func() {
+		_, _, err := setup.APIClient.PutItems(context.Background(), "2024-03-01", "Walk", "Walked along the river", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(setup.APIClient.SummarizeItem(context.Background(), "2024-03-01").StatusCode()).
+			To(Equal(http.StatusServiceUnavailable))
+		Expect(prompts).To(BeEmpty())
+
+		family := setup.APIClient.UpdateFamilySettings(context.Background(),
+			goclient.FamilySettingsRequest{AiSummariesEnabled: ptr(true)})
+		Expect(family.StatusCode()).To(Equal(http.StatusOK))
+		Expect(family.JSON200.AiSummariesEnabled).To(HaveValue(BeTrue()))
+
+		summary := setup.APIClient.SummarizeItem(context.Background(), "2024-03-01")
+		Expect(summary.StatusCode()).To(Equal(http.StatusOK))
+		Expect(summary.JSON200.Date.String()).To(Equal("2024-03-01"))
+		Expect(summary.JSON200.Summary).To(Equal("I went for a long walk."))
+		Expect(prompts).To(HaveLen(1))
+		Expect(prompts[0]).To(ContainSubstring("Walked along the river"))
+
+		Expect(setup.APIClient.SummarizeItem(context.Background(), "2024-03-02").StatusCode()).
+			To(Equal(http.StatusNotFound))
+	})
+
+	It("lists the family's recaps by period", func() {
+		user, err := setup.Storage.GetUserByUsername(setup.TestEmail)
+		Expect(err).ToNot(HaveOccurred())
+		for _, r := range []*models.Recap{
+			{FamilyID: user.FamilyID, Period: models.RecapPeriodMonth, StartDate: "2024-02-01", EndDate: "2024-02-29", Summary: "February"},
+			{FamilyID: user.FamilyID, Period: models.RecapPeriodWeek, StartDate: "2024-03-04", EndDate: "2024-03-10", Summary: "A week", EntryCount: 3},
+		} {
+			Expect(setup.Storage.CreateRecap(r)).To(Succeed())
+		}
+
+		list := func(period string) []goclient.Recap {
+			resp := setup.APIClient.GetRecaps(context.Background(), period)
+			Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+			return *resp.JSON200
+		}
+
+		all := list("")
+		Expect(all).To(HaveLen(2))
+		Expect(all[0].Period).To(Equal(goclient.RecapPeriodWeek))
+		Expect(all[0].StartDate.String()).To(Equal("2024-03-04"))
+		Expect(all[0].EndDate.String()).To(Equal("2024-03-10"))
+		Expect(all[0].Summary).To(Equal("A week"))
+		Expect(all[0].EntryCount).To(Equal(3))
+		Expect(list("month")).To(ConsistOf(HaveField("Summary", "February")))
+
+		Expect(setup.APIClient.GetRecaps(context.Background(), "year").StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,98 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/database/models"
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Semantic Search Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironmentWithConfig(func(cfg *config.Config) {
+			cfg.AIEmbeddingProvider = ai.ProviderFake
+			cfg.AIMonthlyTokenBudget = 1000
+		})
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	// embedAll stores vectors for every entry, as the background task would.
+	embedAll := func() {
+		fake := ai.NewFakeEmbedder()
+		user, err := setup.Storage.GetUserByUsername(setup.TestEmail)
+		Expect(err).ToNot(HaveOccurred())
+		items, err := setup.Storage.GetItemsWithStaleEmbeddings(user.FamilyID, fake.Model(), 100)
+		Expect(err).ToNot(HaveOccurred())
+		for _, item := range items {
+			vectors, err := fake.EmbedDocuments(context.Background(), []string{ai.EmbeddingText(item.Title, item.Body)})
+			Expect(err).ToNot(HaveOccurred())
+			e := &models.ItemEmbedding{
+				FamilyID: user.FamilyID, Date: item.Date, Model: fake.Model(), SourceHash: item.TagsSourceHash,
+			}
+			e.SetVector(vectors[0])
+			Expect(setup.Storage.PutItemEmbedding(e)).To(Succeed())
+		}
+	}
+
+	enableSearch := func() {
+		resp := setup.APIClient.UpdateFamilySettings(context.Background(),
+			goclient.FamilySettingsRequest{AiSearchEnabled: ptr(true)})
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+	}
+
+	It("ranks the family's entries by meaning once the family opts in", func() {
+		for _, e := range []struct{ date, title, body string }{
+			{"2024-05-01", "Hike", "We walked the forest trail up to the lake"},
+			{"2024-05-02", "Office", "Budget meetings all day"},
+		} {
+			_, _, err := setup.APIClient.PutItems(context.Background(), e.date, e.title, e.body, nil)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		embedAll()
+
+		Expect(setup.APIClient.SemanticSearch(context.Background(), "forest trail", 0).StatusCode()).
+			To(Equal(http.StatusServiceUnavailable))
+
+		enableSearch()
+
+		resp := setup.APIClient.SemanticSearch(context.Background(), "forest trail", 5)
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		matches := resp.JSON200.Matches
+		Expect(matches).To(HaveLen(1))
+		Expect(matches[0].Item.Date.String()).To(Equal("2024-05-01"))
+		Expect(matches[0].Score).To(BeNumerically(">", 0))
+	})
+
+	It("refuses to embed the query once the family's monthly budget is spent", func() {
+		_, _, err := setup.APIClient.PutItems(context.Background(), "2024-05-01", "Hike", "The forest trail", nil)
+		Expect(err).ToNot(HaveOccurred())
+		embedAll()
+		enableSearch()
+
+		user, err := setup.Storage.GetUserByUsername(setup.TestEmail)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(setup.Storage.AddAIUsage(&models.AIUsage{
+			FamilyID: user.FamilyID, Month: time.Now().UTC().Format(ai.UsageMonthFormat), InputTokens: 1000,
+		})).To(Succeed())
+
+		Expect(setup.APIClient.SemanticSearch(context.Background(), "forest trail", 0).StatusCode()).
+			To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("rejects a blank query", func() {
+		Expect(setup.APIClient.SemanticSearch(context.Background(), " ", 0).StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+})
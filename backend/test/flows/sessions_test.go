@@ -0,0 +1,202 @@
+package flows_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// userAgentTransport stamps every request with a fixed User-Agent so a test
+// client looks like one device across requests.
+type userAgentTransport string
+
+func (ua userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", string(ua))
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+var _ = Describe("Session Management Flow", func() {
+	type session struct {
+		ID         string `json:"id"`
+		UserAgent  string `json:"userAgent"`
+		IPAddress  string `json:"ipAddress"`
+		LastSeenAt string `json:"lastSeenAt"`
+		Current    bool   `json:"current"`
+	}
+
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	// browser is a cookie-carrying client identified by its User-Agent.
+	browser := func(userAgent string) *http.Client {
+		jar, err := cookiejar.New(nil)
+		Expect(err).ToNot(HaveOccurred())
+		client := &http.Client{Jar: jar, Transport: userAgentTransport(userAgent)}
+
+		body, err := json.Marshal(map[string]string{"email": setup.TestEmail, "password": setup.TestPass})
+		Expect(err).ToNot(HaveOccurred())
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost,
+			setup.ServerAddr+"/v1/authorize", bytes.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		return client
+	}
+
+	call := func(client *http.Client, method, path string, out any) int {
+		req, err := http.NewRequestWithContext(context.Background(), method, setup.ServerAddr+path, nil)
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		if out != nil && resp.StatusCode == http.StatusOK {
+			Expect(json.NewDecoder(resp.Body).Decode(out)).To(Succeed())
+		}
+		return resp.StatusCode
+	}
+
+	// refresh presents the refresh cookie, which kin-core scopes to the proxied
+	// /api/auth/refresh path, to the backend's /auth/refresh route.
+	refresh := func(client *http.Client) int {
+		proxied, err := url.Parse(setup.ServerAddr + "/api/auth/refresh")
+		Expect(err).ToNot(HaveOccurred())
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost,
+			setup.ServerAddr+"/auth/refresh", nil)
+		Expect(err).ToNot(HaveOccurred())
+		for _, c := range client.Jar.Cookies(proxied) {
+			if c.Name == "kin_refresh" {
+				req.AddCookie(c)
+			}
+		}
+		resp, err := client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// accessToken returns the access token a browser holds in its cookie jar.
+	accessToken := func(client *http.Client) string {
+		server, err := url.Parse(setup.ServerAddr + "/")
+		Expect(err).ToNot(HaveOccurred())
+		for _, c := range client.Jar.Cookies(server) {
+			if c.Name == "kin_access" {
+				return c.Value
+			}
+		}
+		Fail("no access token cookie")
+		return ""
+	}
+
+	// bearer calls path with token in the Authorization header, as API
+	// clients do, and returns the status.
+	bearer := func(token, path string) int {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, setup.ServerAddr+path, nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	listSessions := func(client *http.Client) []session {
+		var sessions []session
+		Expect(call(client, http.MethodGet, "/v1/sessions", &sessions)).To(Equal(http.StatusOK))
+		return sessions
+	}
+
+	It("lists each signed-in device and marks the current one", func() {
+		laptop := browser("Laptop/1.0")
+		browser("Phone/1.0")
+
+		sessions := listSessions(laptop)
+		Expect(sessions).To(HaveLen(2))
+		agents := map[string]bool{}
+		for _, s := range sessions {
+			agents[s.UserAgent] = s.Current
+			Expect(s.IPAddress).ToNot(BeEmpty())
+		}
+		Expect(agents).To(Equal(map[string]bool{"Laptop/1.0": true, "Phone/1.0": false}))
+	})
+
+	It("keeps the same session across token refreshes", func() {
+		laptop := browser("Laptop/1.0")
+		before := listSessions(laptop)
+		Expect(before).To(HaveLen(1))
+
+		Expect(refresh(laptop)).To(Equal(http.StatusOK))
+
+		after := listSessions(laptop)
+		Expect(after).To(HaveLen(1))
+		Expect(after[0].ID).To(Equal(before[0].ID))
+		Expect(after[0].Current).To(BeTrue())
+	})
+
+	It("signs out a single session", func() {
+		laptop := browser("Laptop/1.0")
+		phone := browser("Phone/1.0")
+
+		var phoneID string
+		for _, s := range listSessions(laptop) {
+			if !s.Current {
+				phoneID = s.ID
+			}
+		}
+		Expect(phoneID).ToNot(BeEmpty())
+
+		phoneToken := accessToken(phone)
+		Expect(bearer(phoneToken, "/v1/user")).To(Equal(http.StatusOK))
+
+		Expect(call(laptop, http.MethodDelete, "/v1/sessions/"+phoneID, nil)).To(Equal(http.StatusNoContent))
+		Expect(call(laptop, http.MethodDelete, "/v1/sessions/"+phoneID, nil)).To(Equal(http.StatusNotFound))
+		Expect(refresh(phone)).To(Equal(http.StatusUnauthorized))
+		Expect(bearer(phoneToken, "/v1/user")).To(Equal(http.StatusUnauthorized))
+		Expect(call(phone, http.MethodGet, "/v1/user", nil)).To(Equal(http.StatusUnauthorized))
+		Expect(call(laptop, http.MethodGet, "/v1/user", nil)).To(Equal(http.StatusOK))
+		Expect(listSessions(laptop)).To(HaveLen(1))
+	})
+
+	It("signs out everywhere else", func() {
+		laptop := browser("Laptop/1.0")
+		phone := browser("Phone/1.0")
+		tablet := browser("Tablet/1.0")
+
+		var result struct {
+			Revoked int `json:"revoked"`
+		}
+		phoneToken := accessToken(phone)
+		Expect(call(laptop, http.MethodPost, "/v1/sessions/revoke-others", &result)).To(Equal(http.StatusOK))
+		Expect(result.Revoked).To(Equal(2))
+
+		// The signed-out devices lose access at once, not when their access
+		// token expires.
+		Expect(bearer(phoneToken, "/v1/user")).To(Equal(http.StatusUnauthorized))
+		Expect(call(tablet, http.MethodGet, "/v1/user", nil)).To(Equal(http.StatusUnauthorized))
+		Expect(call(laptop, http.MethodGet, "/v1/user", nil)).To(Equal(http.StatusOK))
+
+		Expect(refresh(phone)).To(Equal(http.StatusUnauthorized))
+		Expect(refresh(tablet)).To(Equal(http.StatusUnauthorized))
+		Expect(refresh(laptop)).To(Equal(http.StatusOK))
+
+		sessions := listSessions(laptop)
+		Expect(sessions).To(HaveLen(1))
+		Expect(sessions[0].UserAgent).To(Equal("Laptop/1.0"))
+	})
+})
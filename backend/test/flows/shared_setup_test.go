@@ -15,6 +15,8 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
@@ -36,6 +38,9 @@ type TestItemsResponse struct {
 	Title        string
 	Body         string
 	Tags         []string
+	Fields       map[string]any
+	Place        *goclient.Place
+	Weather      *goclient.Weather
 	PreviousDate *string
 	NextDate     *string
 }
@@ -118,6 +123,39 @@ func (c *TestAPIClient) do(req *http.Request) (*http.Response, error) {
 	return c.httpClient.Do(req)
 }
 
+// api returns the generated client, authenticated like the helpers here.
+func (c *TestAPIClient) api() *goclient.ClientWithResponses {
+	GinkgoHelper()
+	client, err := goclient.NewClientWithResponses(c.serverAddr,
+		goclient.WithHTTPClient(c.httpClient),
+		goclient.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			if c.authHeader != "" {
+				req.Header.Set("Authorization", c.authHeader)
+			}
+			return nil
+		}))
+	Expect(err).ToNot(HaveOccurred())
+	return client
+}
+
+// ptr returns a pointer to v, for the optional fields of generated requests.
+func ptr[T any](v T) *T { return &v }
+
+// toDate parses a YYYY-MM-DD day for the generated client.
+func toDate(date string) openapi_types.Date {
+	GinkgoHelper()
+	parsed, err := time.Parse("2006-01-02", date)
+	Expect(err).ToNot(HaveOccurred())
+	return openapi_types.Date{Time: parsed}
+}
+
+// must fails the spec on a transport or decoding error and returns resp.
+func must[R any](resp R, err error) R {
+	GinkgoHelper()
+	Expect(err).ToNot(HaveOccurred())
+	return resp
+}
+
 // Authorize logs in with email/password and returns the JWT token.
 func (c *TestAPIClient) Authorize(ctx context.Context, email, password string) (*TestAuthResponse, *http.Response, error) {
 	req, err := c.newRequest(ctx, http.MethodPost, "/v1/authorize",
@@ -311,12 +349,241 @@ func (c *TestAPIClient) UploadAssetsBatch(ctx context.Context, files []*os.File)
 	return result, resp, nil
 }
 
+// --- Typed helpers over the generated client ---
+//
+// These return the generated response as is; callers check StatusCode() and
+// read the JSON<status> field.
+
+// CreateAccessToken creates a personal access token limited to scopes.
+func (c *TestAPIClient) CreateAccessToken(
+	ctx context.Context, name string, scopes ...goclient.AccessTokenScope,
+) *goclient.CreateAccessTokenResponse {
+	GinkgoHelper()
+	return must(c.api().CreateAccessTokenWithResponse(ctx, goclient.CreateAccessTokenRequest{Name: name, Scopes: scopes}))
+}
+
+// GetAccessTokens lists the caller's personal access tokens.
+func (c *TestAPIClient) GetAccessTokens(ctx context.Context) *goclient.GetAccessTokensResponse {
+	GinkgoHelper()
+	return must(c.api().GetAccessTokensWithResponse(ctx))
+}
+
+// RevokeAccessToken revokes one of the caller's personal access tokens.
+func (c *TestAPIClient) RevokeAccessToken(ctx context.Context, id uuid.UUID) *goclient.RevokeAccessTokenResponse {
+	GinkgoHelper()
+	return must(c.api().RevokeAccessTokenWithResponse(ctx, id))
+}
+
+// UpdateTag renames the tag or changes its metadata.
+func (c *TestAPIClient) UpdateTag(
+	ctx context.Context, name string, update goclient.TagUpdateRequest,
+) *goclient.UpdateTagResponse {
+	GinkgoHelper()
+	return must(c.api().UpdateTagWithResponse(ctx, name, update))
+}
+
+// DeleteTag removes the tag from every entry, with its descendants if subtree.
+func (c *TestAPIClient) DeleteTag(ctx context.Context, name string, subtree bool) *goclient.DeleteTagResponse {
+	GinkgoHelper()
+	params := &goclient.DeleteTagParams{}
+	if subtree {
+		params.Subtree = &subtree
+	}
+	return must(c.api().DeleteTagWithResponse(ctx, name, params))
+}
+
+// GetAuditEvents fetches a page of the family's audit log.
+func (c *TestAPIClient) GetAuditEvents(
+	ctx context.Context, params goclient.GetAuditEventsParams,
+) *goclient.GetAuditEventsResponse {
+	GinkgoHelper()
+	return must(c.api().GetAuditEventsWithResponse(ctx, &params))
+}
+
+// GetFamily fetches the caller's family and its settings.
+func (c *TestAPIClient) GetFamily(ctx context.Context) *goclient.GetFamilyResponse {
+	GinkgoHelper()
+	return must(c.api().GetFamilyWithResponse(ctx))
+}
+
+// UpdateFamilySettings changes the settings set in settings.
+func (c *TestAPIClient) UpdateFamilySettings(
+	ctx context.Context, settings goclient.FamilySettingsRequest,
+) *goclient.UpdateFamilySettingsResponse {
+	GinkgoHelper()
+	return must(c.api().UpdateFamilySettingsWithResponse(ctx, settings))
+}
+
+// SummarizeItem asks for the AI summary of the entry of date.
+func (c *TestAPIClient) SummarizeItem(ctx context.Context, date string) *goclient.SummarizeItemResponse {
+	GinkgoHelper()
+	return must(c.api().SummarizeItemWithResponse(ctx, toDate(date)))
+}
+
+// GetRecaps lists the family's recaps, only those of period unless it is empty.
+func (c *TestAPIClient) GetRecaps(ctx context.Context, period string) *goclient.GetRecapsResponse {
+	GinkgoHelper()
+	params := &goclient.GetRecapsParams{}
+	if period != "" {
+		params.Period = ptr(goclient.GetRecapsParamsPeriod(period))
+	}
+	return must(c.api().GetRecapsWithResponse(ctx, params))
+}
+
+// SemanticSearch finds the entries closest in meaning to q; limit 0 keeps the
+// server's default.
+func (c *TestAPIClient) SemanticSearch(ctx context.Context, q string, limit int32) *goclient.SemanticSearchItemsResponse {
+	GinkgoHelper()
+	params := &goclient.SemanticSearchItemsParams{Q: q}
+	if limit > 0 {
+		params.Limit = &limit
+	}
+	return must(c.api().SemanticSearchItemsWithResponse(ctx, params))
+}
+
+// Ask asks the diary a question.
+func (c *TestAPIClient) Ask(ctx context.Context, question string) *goclient.AskDiaryResponse {
+	GinkgoHelper()
+	return must(c.api().AskDiaryWithResponse(ctx, goclient.AskRequest{Question: question}))
+}
+
+// ListAssets lists the family's assets, only those the entry of date
+// references unless date is empty.
+func (c *TestAPIClient) ListAssets(ctx context.Context, date string) *goclient.ListAssetsResponse {
+	GinkgoHelper()
+	params := &goclient.ListAssetsParams{}
+	if date != "" {
+		params.Date = ptr(toDate(date))
+	}
+	return must(c.api().ListAssetsWithResponse(ctx, params))
+}
+
+// TranscribeItemAsset appends the transcript of the entry's audio asset to it.
+func (c *TestAPIClient) TranscribeItemAsset(
+	ctx context.Context, date, asset string,
+) *goclient.TranscribeItemAssetResponse {
+	GinkgoHelper()
+	return must(c.api().TranscribeItemAssetWithResponse(ctx, toDate(date), goclient.TranscribeRequest{Asset: asset}))
+}
+
+// GetFamilyAIUsage fetches the family's monthly AI usage and budget.
+func (c *TestAPIClient) GetFamilyAIUsage(ctx context.Context) *goclient.GetFamilyAIUsageResponse {
+	GinkgoHelper()
+	return must(c.api().GetFamilyAIUsageWithResponse(ctx))
+}
+
+// SuggestItemTags asks the model for tags for an unsaved entry.
+func (c *TestAPIClient) SuggestItemTags(ctx context.Context, date, title, body string) *goclient.SuggestItemTagsResponse {
+	GinkgoHelper()
+	return must(c.api().SuggestItemTagsWithResponse(ctx,
+		goclient.SuggestTagsRequest{Date: toDate(date), Title: title, Body: &body}))
+}
+
+// GetJobs fetches the family's AI job counts and most recent jobs.
+func (c *TestAPIClient) GetJobs(ctx context.Context, params goclient.GetJobsParams) *goclient.GetJobsResponse {
+	GinkgoHelper()
+	return must(c.api().GetJobsWithResponse(ctx, &params))
+}
+
+// GetTagConsolidation fetches clusters of near-duplicate tags, asking the AI
+// provider too if ai.
+func (c *TestAPIClient) GetTagConsolidation(ctx context.Context, ai bool) *goclient.GetTagConsolidationResponse {
+	GinkgoHelper()
+	params := &goclient.GetTagConsolidationParams{}
+	if ai {
+		params.Ai = &ai
+	}
+	return must(c.api().GetTagConsolidationWithResponse(ctx, params))
+}
+
+// MergeTags applies merges to every entry of the family.
+func (c *TestAPIClient) MergeTags(ctx context.Context, merges ...goclient.TagMerge) *goclient.MergeTagsResponse {
+	GinkgoHelper()
+	return must(c.api().MergeTagsWithResponse(ctx, goclient.TagMergeRequest{Merges: merges}))
+}
+
+// GetTagTree fetches the family's tags as a hierarchy.
+func (c *TestAPIClient) GetTagTree(ctx context.Context) *goclient.GetTagTreeResponse {
+	GinkgoHelper()
+	return must(c.api().GetTagTreeWithResponse(ctx))
+}
+
+// GetTags fetches the family's tags and their metadata.
+func (c *TestAPIClient) GetTags(ctx context.Context) *goclient.GetTagsResponse {
+	GinkgoHelper()
+	return must(c.api().GetTagsWithResponse(ctx))
+}
+
+// PutItem saves req as is, for the entry fields PutItems does not take.
+func (c *TestAPIClient) PutItem(ctx context.Context, req goclient.ItemsRequest) *goclient.PutItemsResponse {
+	GinkgoHelper()
+	return must(c.api().PutItemsWithResponse(ctx, req))
+}
+
+// ListItems fetches entries with the filters PutItems does not take.
+func (c *TestAPIClient) ListItems(ctx context.Context, params goclient.GetItemsParams) *goclient.GetItemsResponse {
+	GinkgoHelper()
+	return must(c.api().GetItemsWithResponse(ctx, &params))
+}
+
+// CreateCustomField defines a custom field for the family.
+func (c *TestAPIClient) CreateCustomField(
+	ctx context.Context, field goclient.CustomFieldCreateRequest,
+) *goclient.CreateCustomFieldResponse {
+	GinkgoHelper()
+	return must(c.api().CreateCustomFieldWithResponse(ctx, field))
+}
+
+// GetCustomFields lists the family's custom fields.
+func (c *TestAPIClient) GetCustomFields(ctx context.Context) *goclient.GetCustomFieldsResponse {
+	GinkgoHelper()
+	return must(c.api().GetCustomFieldsWithResponse(ctx))
+}
+
+// DeleteCustomField removes a custom field and its values.
+func (c *TestAPIClient) DeleteCustomField(ctx context.Context, key string) *goclient.DeleteCustomFieldResponse {
+	GinkgoHelper()
+	return must(c.api().DeleteCustomFieldWithResponse(ctx, key))
+}
+
+// GetCustomFieldStats aggregates the values of a custom field over all entries.
+func (c *TestAPIClient) GetCustomFieldStats(ctx context.Context, key string) *goclient.GetCustomFieldStatsResponse {
+	GinkgoHelper()
+	return must(c.api().GetCustomFieldStatsWithResponse(ctx, key, &goclient.GetCustomFieldStatsParams{}))
+}
+
+// GetPlaces lists where the family's entries were written, most visited first.
+func (c *TestAPIClient) GetPlaces(ctx context.Context, params goclient.GetPlacesParams) *goclient.GetPlacesResponse {
+	GinkgoHelper()
+	return must(c.api().GetPlacesWithResponse(ctx, &params))
+}
+
+// GetItemBacklinks lists the entries linking to a day.
+func (c *TestAPIClient) GetItemBacklinks(ctx context.Context, date string) *goclient.GetItemBacklinksResponse {
+	GinkgoHelper()
+	return must(c.api().GetItemBacklinksWithResponse(ctx, toDate(date)))
+}
+
+// GetMemories fetches the memories of a day.
+func (c *TestAPIClient) GetMemories(ctx context.Context, date string) *goclient.GetMemoriesResponse {
+	GinkgoHelper()
+	return must(c.api().GetMemoriesWithResponse(ctx, &goclient.GetMemoriesParams{Date: ptr(toDate(date))}))
+}
+
+// GetStats aggregates the family's writing statistics.
+func (c *TestAPIClient) GetStats(ctx context.Context, params goclient.GetStatsParams) *goclient.GetStatsResponse {
+	GinkgoHelper()
+	return must(c.api().GetStatsWithResponse(ctx, &params))
+}
+
 // --- conversion helpers ---
 
 func toTestItemsResponse(r goclient.ItemsResponse) *TestItemsResponse {
 	t := &TestItemsResponse{
-		Date:  r.Date.Time.Format("2006-01-02"),
-		Title: r.Title,
+		Date:    r.Date.Time.Format("2006-01-02"),
+		Title:   r.Title,
+		Place:   r.Place,
+		Weather: r.Weather,
 	}
 	if r.Body != nil {
 		t.Body = *r.Body
@@ -324,6 +591,9 @@ func toTestItemsResponse(r goclient.ItemsResponse) *TestItemsResponse {
 	if r.Tags != nil {
 		t.Tags = *r.Tags
 	}
+	if r.Fields != nil {
+		t.Fields = *r.Fields
+	}
 	if r.PreviousDate != nil {
 		s := r.PreviousDate.Time.Format("2006-01-02")
 		t.PreviousDate = &s
@@ -373,6 +643,12 @@ func newCancellableContext() (context.Context, context.CancelFunc) {
 }
 
 func SetupTestEnvironment() *SharedTestSetup {
+	return SetupTestEnvironmentWithConfig(nil)
+}
+
+// SetupTestEnvironmentWithConfig lets a test adjust the configuration before
+// the server starts.
+func SetupTestEnvironmentWithConfig(configure func(*config.Config)) *SharedTestSetup {
 	setup := &SharedTestSetup{}
 
 	setup.Logger = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
@@ -388,6 +664,9 @@ func SetupTestEnvironment() *SharedTestSetup {
 		DisableRateLimit: true,
 		CookieSecure:     false,
 	}
+	if configure != nil {
+		configure(setup.Cfg)
+	}
 
 	setup.Storage = database.NewStorage(setup.Logger, setup.Cfg)
 	Expect(setup.Storage.Open()).To(Succeed())
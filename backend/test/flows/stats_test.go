@@ -0,0 +1,73 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Stats Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	put := func(date, body string, tags ...string) {
+		_, _, err := setup.APIClient.PutItems(context.Background(), date, "Day", body, tags)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("aggregates words, streaks, months, tags, assets and the heatmap", func() {
+		put("2023-12-31", "Old year ends")
+		put("2024-01-01", "New *year* begins ![](fireworks.jpg)", "holiday")
+		put("2024-01-02", "Back to work", "work")
+		put("2024-02-10", "Skiing ![](slope.jpg) ![](run.mp4)", "holiday")
+
+		ctx := context.Background()
+		res := setup.APIClient.GetStats(ctx, goclient.GetStatsParams{
+			From: ptr(toDate("2024-01-01")), To: ptr(toDate("2024-12-31")),
+		})
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		stats := res.JSON200
+		Expect(stats.Entries).To(Equal(3))
+		Expect(stats.Words).To(Equal(7))
+		Expect(stats.Days).To(HaveLen(3))
+		Expect(stats.Days[0].Date.String()).To(Equal("2024-01-01"))
+		Expect(stats.Days[0].Words).To(Equal(3))
+		Expect(stats.CurrentStreak.Days).To(Equal(0))
+		Expect(stats.LongestStreak).To(Equal(goclient.Streak{
+			Days: 2, Start: ptr(toDate("2024-01-01")), End: ptr(toDate("2024-01-02")),
+		}))
+		Expect(stats.Months).To(HaveLen(2))
+		Expect(stats.Months[1].Month).To(Equal("2024-02"))
+		Expect(stats.Months[1].Words).To(Equal(1))
+		Expect(stats.Tags).To(HaveLen(3))
+		Expect(stats.Tags[0].Month).To(Equal("2024-01"))
+		Expect(stats.Tags[2].Tag).To(Equal("holiday"))
+		Expect(stats.Assets).To(Equal(3))
+		Expect(stats.EntriesWithAssets).To(Equal(2))
+		Expect(stats.Heatmap.Year).To(Equal(2024))
+		Expect(stats.Heatmap.Words).To(HaveLen(366))
+		Expect(stats.Heatmap.Words[0]).To(Equal(3))
+		Expect(stats.Heatmap.Words[40]).To(Equal(1))
+
+		res = setup.APIClient.GetStats(ctx, goclient.GetStatsParams{})
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Entries).To(Equal(4))
+		Expect(res.JSON200.LongestStreak.Days).To(Equal(3))
+
+		Expect(setup.APIClient.GetStats(ctx, goclient.GetStatsParams{
+			From: ptr(toDate("2024-02-01")), To: ptr(toDate("2024-01-01")),
+		}).StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+})
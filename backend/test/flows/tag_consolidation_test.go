@@ -0,0 +1,98 @@
+package flows_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Tag Consolidation Flow", func() {
+	var (
+		setup *SharedTestSetup
+		model *httptest.Server
+		calls int
+	)
+
+	BeforeEach(func() {
+		calls = 0
+		model = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"choices": []map[string]any{{"message": map[string]any{"content": `[["car", "auto"]]`}}},
+			})
+		}))
+		setup = SetupTestEnvironmentWithConfig(func(cfg *config.Config) {
+			cfg.AIProvider = "openai"
+			cfg.AIBaseURL = model.URL + "/v1"
+			cfg.AIModel = "test-model"
+		})
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+		model.Close()
+	})
+
+	getClusters := func(ai bool) *goclient.TagConsolidationResponse {
+		resp := setup.APIClient.GetTagConsolidation(context.Background(), ai)
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		return resp.JSON200
+	}
+	stat := func(name string, count int) types.GomegaMatcher {
+		return And(HaveField("Name", name), HaveField("Count", count))
+	}
+
+	It("suggests clusters, asks the AI only when allowed, and merges them", func() {
+		ctx := context.Background()
+		for date, tags := range map[string][]string{
+			"2024-01-01": {"running", "car"},
+			"2024-01-02": {"running", "Runs"},
+			"2024-01-03": {"run", "auto"},
+		} {
+			_, _, err := setup.APIClient.PutItems(ctx, date, "Day", "Text", tags)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		out := getClusters(true)
+		Expect(out.AiUsed).To(BeFalse(), "the family has not turned AI tagging on")
+		Expect(calls).To(BeZero())
+		Expect(out.Clusters).To(HaveLen(1))
+		Expect(out.Clusters[0].Canonical).To(Equal("running"))
+		Expect(out.Clusters[0].Tags).To(HaveExactElements(stat("running", 2), stat("Runs", 1), stat("run", 1)))
+
+		Expect(setup.APIClient.UpdateFamilySettings(ctx, goclient.FamilySettingsRequest{AiTaggingEnabled: ptr(true)}).
+			StatusCode()).To(Equal(http.StatusOK))
+		Expect(getClusters(false).Clusters).To(HaveLen(1))
+		Expect(calls).To(BeZero(), "AI clustering is opt-in per request")
+
+		out = getClusters(true)
+		Expect(out.AiUsed).To(BeTrue())
+		Expect(calls).To(Equal(1))
+		Expect(out.Clusters).To(HaveLen(2))
+		Expect(out.Clusters[1].Canonical).To(Equal("auto"))
+		Expect(out.Clusters[1].Reasons).To(Equal([]goclient.TagClusterReasons{"ai"}))
+
+		merged := setup.APIClient.MergeTags(ctx,
+			goclient.TagMerge{From: []string{"Runs", "run"}, To: "running"},
+			goclient.TagMerge{From: []string{"auto"}, To: "car"})
+		Expect(merged.StatusCode()).To(Equal(http.StatusOK))
+		Expect(merged.JSON200.UpdatedEntries).To(Equal(2))
+
+		Expect(getClusters(false).Clusters).To(BeEmpty())
+		user, err := setup.Storage.GetUserByUsername(setup.TestEmail)
+		Expect(err).ToNot(HaveOccurred())
+		item, err := setup.Storage.GetItem(user.FamilyID, "2024-01-03")
+		Expect(err).ToNot(HaveOccurred())
+		Expect([]string(item.Tags)).To(Equal([]string{"running", "car"}))
+	})
+})
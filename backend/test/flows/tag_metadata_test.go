@@ -0,0 +1,49 @@
+package flows_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Tag Metadata Flow", func() {
+	var setup *SharedTestSetup
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	It("edits metadata, resolves aliases on save and lists metadata with the tags", func() {
+		ctx := context.Background()
+
+		resp := setup.APIClient.UpdateTag(ctx, "travel/new-york", goclient.TagUpdateRequest{
+			Color: ptr("#1e88e5"), Description: ptr("Trips to New York"), Aliases: &[]string{"nyc"},
+		})
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		updated := *resp.JSON200
+		Expect(updated).To(Equal(goclient.TagMetadata{
+			Name: "travel/new-york", Color: ptr("#1e88e5"), Description: ptr("Trips to New York"), Aliases: []string{"nyc"},
+		}))
+
+		item, _, err := setup.APIClient.PutItems(ctx, "2024-05-01", "Day", "Text", []string{"NYC", "work"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item.Tags).To(Equal([]string{"travel/new-york", "work"}))
+
+		Expect(setup.APIClient.UpdateTag(ctx, "work", goclient.TagUpdateRequest{Aliases: &[]string{"nyc"}}).
+			StatusCode()).To(Equal(http.StatusConflict))
+
+		tags := setup.APIClient.GetTags(ctx)
+		Expect(tags.StatusCode()).To(Equal(http.StatusOK))
+		Expect(tags.JSON200.Tags).To(Equal([]string{"travel/new-york", "work"}))
+		Expect(tags.JSON200.Metadata).To(Equal([]goclient.TagMetadata{updated}))
+	})
+})
@@ -0,0 +1,108 @@
+package flows_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Tagging Policy Flow", func() {
+	var (
+		setup   *SharedTestSetup
+		model   *httptest.Server
+		prompts []string
+	)
+
+	BeforeEach(func() {
+		prompts = nil
+		model = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Messages []struct {
+					Content string `json:"content"`
+				} `json:"messages"`
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+			prompts = append(prompts, req.Messages[0].Content)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"choices": []map[string]any{{"message": map[string]any{
+					"content": `{"tags":[{"name":"anna","confidence":0.9},{"name":"park","confidence":0.8},` +
+						`{"name":"picnic","confidence":0.7},{"name":"sun","confidence":0.6}]}`,
+				}}},
+			})
+		}))
+		setup = SetupTestEnvironmentWithConfig(func(cfg *config.Config) {
+			cfg.AIProvider = "openai"
+			cfg.AIBaseURL = model.URL + "/v1"
+			cfg.AIModel = "test-model"
+		})
+		setup.LoginAndGetToken()
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+		model.Close()
+	})
+
+	suggest := func() []string {
+		resp := setup.APIClient.SuggestItemTags(context.Background(), "2024-05-02", "Lunch", "Picnic with Anna in the park")
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		names := make([]string, 0, len(resp.JSON200.Tags))
+		for _, t := range resp.JSON200.Tags {
+			names = append(names, t.Name)
+		}
+		return names
+	}
+
+	It("applies the family's instructions, vocabulary and tag limit", func() {
+		_, _, err := setup.APIClient.PutItems(context.Background(), "2024-05-01", "Walk", "", []string{"anna", "park"})
+		Expect(err).ToNot(HaveOccurred())
+
+		defaults := setup.APIClient.GetFamily(context.Background())
+		Expect(defaults.StatusCode()).To(Equal(http.StatusOK))
+		Expect(defaults.JSON200.AiTaggingInstructions).To(HaveValue(BeEmpty()))
+		Expect(defaults.JSON200.AiTaggingClosedVocabulary).To(HaveValue(BeFalse()))
+		Expect(defaults.JSON200.AiTaggingMaxTags).To(HaveValue(Equal(6)))
+
+		updated := setup.APIClient.UpdateFamilySettings(context.Background(), goclient.FamilySettingsRequest{
+			AiTaggingEnabled:          ptr(true),
+			AiTaggingInstructions:     ptr("  Use people's names as tags.  "),
+			AiTaggingClosedVocabulary: ptr(true),
+			AiTaggingMaxTags:          ptr(3),
+		})
+		Expect(updated.StatusCode()).To(Equal(http.StatusOK))
+		Expect(updated.JSON200.AiTaggingInstructions).To(HaveValue(Equal("Use people's names as tags.")))
+		Expect(updated.JSON200.AiTaggingClosedVocabulary).To(HaveValue(BeTrue()))
+		Expect(updated.JSON200.AiTaggingMaxTags).To(HaveValue(Equal(3)))
+
+		Expect(suggest()).To(Equal([]string{"anna", "park"}))
+		Expect(prompts).To(HaveLen(1))
+		Expect(prompts[0]).To(ContainSubstring("<family_instructions>\nUse people's names as tags.\n</family_instructions>"))
+		Expect(prompts[0]).To(ContainSubstring("Never introduce a new tag"))
+		Expect(prompts[0]).To(ContainSubstring("Return 1 to 3 tags"))
+
+		// Opening the vocabulary lets new tags through, up to the limit.
+		Expect(setup.APIClient.UpdateFamilySettings(context.Background(),
+			goclient.FamilySettingsRequest{AiTaggingClosedVocabulary: ptr(false)}).StatusCode()).To(Equal(http.StatusOK))
+		Expect(suggest()).To(Equal([]string{"anna", "park", "picnic"}))
+	})
+
+	It("rejects out-of-range settings", func() {
+		for _, settings := range []goclient.FamilySettingsRequest{
+			{AiTaggingMaxTags: ptr(0)},
+			{AiTaggingMaxTags: ptr(21)},
+			{AiTaggingInstructions: ptr(strings.Repeat("x", 1001))},
+		} {
+			Expect(setup.APIClient.UpdateFamilySettings(context.Background(), settings).StatusCode()).
+				To(Equal(http.StatusBadRequest))
+		}
+	})
+})
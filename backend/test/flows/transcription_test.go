@@ -0,0 +1,94 @@
+package flows_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/ai"
+	"github.com/ya-breeze/diary.be/pkg/config"
+	"github.com/ya-breeze/diary.be/pkg/generated/goclient"
+)
+
+var _ = Describe("Voice Memo Transcription Flow", func() {
+	var (
+		setup   *SharedTestSetup
+		whisper *httptest.Server
+	)
+
+	BeforeEach(func() {
+		// A stand-in for a whisper.cpp server.
+		whisper = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, header, err := r.FormFile("file")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(header.Filename).To(Equal("memo.m4a"))
+			_, _ = io.WriteString(w, `{"text":" We fed the ducks by the river. "}`)
+		}))
+		setup = SetupTestEnvironmentWithConfig(func(cfg *config.Config) {
+			cfg.AITranscriptionProvider = ai.ProviderWhisper
+			cfg.AITranscriptionURL = whisper.URL
+		})
+		setup.LoginAndGetToken()
+
+		user, err := setup.Storage.GetUserByUsername(setup.TestEmail)
+		Expect(err).ToNot(HaveOccurred())
+		dir := filepath.Join(setup.Cfg.DataPath, config.AssetsDirName, user.FamilyID.String())
+		Expect(os.MkdirAll(dir, 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "memo.m4a"), []byte("audio"), 0o600)).To(Succeed())
+
+		Expect(setup.APIClient.UpdateFamilySettings(context.Background(),
+			goclient.FamilySettingsRequest{AiTranscriptionEnabled: ptr(true)}).StatusCode()).To(Equal(http.StatusOK))
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+		whisper.Close()
+	})
+
+	It("appends the transcript to the entry and records the change for sync", func() {
+		_, _, err := setup.APIClient.PutItems(context.Background(), "2024-07-01", "Park", "![](memo.m4a)", []string{"walk"})
+		Expect(err).ToNot(HaveOccurred())
+		before, _, err := setup.APIClient.GetChanges(context.Background(), 0, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		resp := setup.APIClient.TranscribeItemAsset(context.Background(), "2024-07-01", "memo.m4a")
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+		result := resp.JSON200
+		Expect(result.Transcript).To(Equal("We fed the ducks by the river."))
+		Expect(result.Item.Body).To(HaveValue(Equal("![](memo.m4a)\n\nWe fed the ducks by the river.")))
+		Expect(result.Item.Tags).To(HaveValue(Equal([]string{"walk"})))
+
+		after, _, err := setup.APIClient.GetChanges(context.Background(), 0, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after.Changes).To(HaveLen(len(before.Changes) + 1))
+		last := after.Changes[len(after.Changes)-1]
+		Expect(last.ItemSnapshot).ToNot(BeNil())
+		Expect(last.ItemSnapshot.Body).To(ContainSubstring("We fed the ducks"))
+
+		again := setup.APIClient.TranscribeItemAsset(context.Background(), "2024-07-01", "memo.m4a")
+		Expect(again.StatusCode()).To(Equal(http.StatusConflict), "the transcript is already in the entry")
+	})
+
+	It("only transcribes audio referenced by the entry", func() {
+		_, _, err := setup.APIClient.PutItems(context.Background(), "2024-07-02", "Quiet", "No memo today", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, tc := range []struct {
+			date, asset string
+			status      int
+		}{
+			{"2024-07-02", "memo.m4a", http.StatusBadRequest},
+			{"2024-07-02", "photo.jpg", http.StatusBadRequest},
+			{"2024-07-03", "memo.m4a", http.StatusNotFound},
+		} {
+			resp := setup.APIClient.TranscribeItemAsset(context.Background(), tc.date, tc.asset)
+			Expect(resp.StatusCode()).To(Equal(tc.status), "%s %s", tc.date, tc.asset)
+		}
+	})
+})
@@ -0,0 +1,145 @@
+package flows_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ya-breeze/diary.be/pkg/auth"
+)
+
+var _ = Describe("Two-Factor Login Flow", func() {
+	var (
+		setup  *SharedTestSetup
+		secret string
+	)
+
+	postJSON := func(path string, body any, out any) *http.Response {
+		req, err := setup.APIClient.newRequest(context.Background(), http.MethodPost, path, body)
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := setup.APIClient.do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		if out != nil && resp.StatusCode < 300 {
+			Expect(json.NewDecoder(resp.Body).Decode(out)).To(Succeed())
+		}
+		return resp
+	}
+
+	codeAt := func(offset int64) string {
+		code, err := auth.TOTPCode(secret, auth.TOTPStep(time.Now())+offset)
+		Expect(err).ToNot(HaveOccurred())
+		return code
+	}
+
+	hasCookie := func(resp *http.Response, name string) bool {
+		for _, c := range resp.Cookies() {
+			if c.Name == name && c.Value != "" {
+				return true
+			}
+		}
+		return false
+	}
+
+	BeforeEach(func() {
+		setup = SetupTestEnvironment()
+		setup.LoginAndGetToken()
+
+		var enrollment struct {
+			Secret          string `json:"secret"`
+			ProvisioningURI string `json:"provisioningUri"`
+		}
+		resp := postJSON("/v1/user/2fa/enroll", nil, &enrollment)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		secret = enrollment.Secret
+
+		var recovery struct {
+			RecoveryCodes []string `json:"recoveryCodes"`
+		}
+		resp = postJSON("/v1/user/2fa/confirm", map[string]string{"code": codeAt(-1)}, &recovery)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(recovery.RecoveryCodes).ToNot(BeEmpty())
+
+		setup.APIClient.authHeader = ""
+	})
+
+	AfterEach(func() {
+		setup.TeardownTestEnvironment()
+	})
+
+	It("issues cookies only after the second factor on the API", func() {
+		var challenge struct {
+			MfaToken string `json:"mfaToken"`
+		}
+		resp := postJSON("/v1/authorize", map[string]string{
+			"email": setup.TestEmail, "password": setup.TestPass,
+		}, &challenge)
+		Expect(resp.StatusCode).To(Equal(http.StatusAccepted))
+		Expect(challenge.MfaToken).ToNot(BeEmpty())
+		Expect(hasCookie(resp, "kin_access")).To(BeFalse())
+
+		var token struct {
+			Token string `json:"token"`
+		}
+		resp = postJSON("/v1/authorize/2fa", map[string]string{
+			"mfaToken": challenge.MfaToken, "code": codeAt(0),
+		}, &token)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(token.Token).ToNot(BeEmpty())
+		Expect(hasCookie(resp, "kin_access")).To(BeTrue())
+		Expect(hasCookie(resp, "kin_refresh")).To(BeTrue())
+
+		setup.APIClient.SetToken(token.Token)
+		_, httpResp, err := setup.APIClient.GetItems(context.Background(), "", "", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(httpResp.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("requires the code on the web login before setting cookies", func() {
+		client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}}
+
+		//nolint:noctx
+		resp, err := client.PostForm(setup.ServerAddr+"/web/login", url.Values{
+			"username": {setup.TestEmail}, "password": {setup.TestPass},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		// The second-step form is a template rendered relative to the backend
+		// directory, so only assert that the password alone did not log in.
+		Expect(resp.StatusCode).ToNot(Equal(http.StatusSeeOther))
+		Expect(hasCookie(resp, "kin_access")).To(BeFalse())
+
+		var challenge struct {
+			MfaToken string `json:"mfaToken"`
+		}
+		apiResp := postJSON("/v1/authorize", map[string]string{
+			"email": setup.TestEmail, "password": setup.TestPass,
+		}, &challenge)
+		Expect(apiResp.StatusCode).To(Equal(http.StatusAccepted))
+		mfaToken := challenge.MfaToken
+
+		//nolint:noctx
+		resp, err = client.PostForm(setup.ServerAddr+"/web/login/2fa", url.Values{
+			"mfa_token": {mfaToken}, "code": {"000000"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+
+		//nolint:noctx
+		resp, err = client.PostForm(setup.ServerAddr+"/web/login/2fa", url.Values{
+			"mfa_token": {mfaToken}, "code": {codeAt(0)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusSeeOther))
+		Expect(hasCookie(resp, "kin_access")).To(BeTrue())
+	})
+})
@@ -1,6 +1,19 @@
 {{ template "header.tpl" . }}
 
 <main>
+    {{ if .MFAToken }}
+    <h2>Two-factor verification</h2>
+    <form action="/web/login/2fa" method="POST">
+        <label for="code">Authenticator or recovery code:</label>
+        <input type="text" id="code" name="code" autocomplete="one-time-code" inputmode="numeric" autofocus required>
+        <br>
+        <input type="hidden" name="mfa_token" value="{{ .MFAToken }}">
+        {{ if .RedirectURL }}
+            <input type="hidden" name="redirect" value="{{ .RedirectURL }}">
+        {{ end }}
+        <button type="submit">Verify</button>
+    </form>
+    {{ else }}
     <h2>Please login</h2>
     <form action="/web/login" method="POST">
         <label for="username">Username:</label>
@@ -14,6 +27,12 @@
         {{ end }}
         <button type="submit">Login</button>
     </form>
+    {{ if .OIDCEnabled }}
+    <p>
+        <a href="/web/oidc/login{{ if .RedirectURL }}?redirect={{ .RedirectURL }}{{ end }}">Sign in with single sign-on</a>
+    </p>
+    {{ end }}
+    {{ end }}
 </main>
 
-{{ template "footer.tpl" . }}
\ No newline at end of file
+{{ template "footer.tpl" . }}